@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/config"
+)
+
+// printConfig implements the config subcommand, it prints the effective config as env vars with secrets redacted:
+//
+//	server config
+func printConfig(w io.Writer, cfg config.Config) {
+	values := cfg.Values()
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		_, _ = fmt.Fprintf(w, "%s=%s\n", key, values[key])
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/pg"
+	"github.com/inna-maikut/avito-shop/internal/model"
+	"github.com/inna-maikut/avito-shop/internal/repository"
+	"github.com/inna-maikut/avito-shop/internal/usecases/ledger_exporting"
+)
+
+// runExport implements the export subcommand, the same export as GET /api/admin/export:
+//
+//	server export -format ndjson -from 2025-01-01 -to 2025-03-31 -employee alice -out ledger.ndjson
+func runExport(ctx context.Context, cfg config.Config, args []string) (err error) {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", string(model.ExportFormatCSV), "csv or ndjson")
+	from := flags.String("from", "", "first day of the period (UTC), YYYY-MM-DD")
+	to := flags.String("to", "", "last day of the period (UTC, inclusive), YYYY-MM-DD")
+	employee := flags.String("employee", "", "export only transfers, purchases and refunds of this username")
+	out := flags.String("out", "", "output file, stdout by default")
+	err = flags.Parse(args)
+	if err != nil {
+		return fmt.Errorf("flags.Parse: %w", err)
+	}
+
+	params := model.ExportParams{
+		Format:           model.ExportFormat(*format),
+		EmployeeUsername: *employee,
+	}
+	if *from != "" {
+		fromTime, err := time.Parse(time.DateOnly, *from)
+		if err != nil {
+			return fmt.Errorf("parse -from: %w", err)
+		}
+		params.From = &fromTime
+	}
+	if *to != "" {
+		toTime, err := time.Parse(time.DateOnly, *to)
+		if err != nil {
+			return fmt.Errorf("parse -to: %w", err)
+		}
+		toTime = toTime.AddDate(0, 0, 1)
+		params.To = &toTime
+	}
+
+	// memory storage lives inside the running server, use GET /api/admin/export there
+	if cfg.Storage == config.StorageMemory {
+		return errors.New("export subcommand needs postgres storage")
+	}
+
+	db, cancelDB, err := pg.NewPool(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("unable to init database: %w", err)
+	}
+	defer cancelDB()
+
+	employeeRepo, err := repository.NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return fmt.Errorf("create user repository: %w", err)
+	}
+
+	exportRepo, err := repository.NewExportRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return fmt.Errorf("create export repository: %w", err)
+	}
+
+	ledgerExportingUseCase, err := ledger_exporting.New(exportRepo, employeeRepo)
+	if err != nil {
+		return fmt.Errorf("create ledger exporting use case: %w", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("os.Create: %w", err)
+		}
+		defer func() {
+			err = errors.Join(err, f.Close())
+		}()
+		w = f
+	}
+
+	err = ledgerExportingUseCase.Export(ctx, w, params)
+	if err != nil {
+		return fmt.Errorf("ledgerExportingUseCase.Export: %w", err)
+	}
+
+	return nil
+}
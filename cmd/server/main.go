@@ -4,42 +4,84 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
-	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
+	"github.com/inna-maikut/avito-shop/internal/api/allowance"
 	"github.com/inna-maikut/avito-shop/internal/api/auth"
 	"github.com/inna-maikut/avito-shop/internal/api/buy"
+	"github.com/inna-maikut/avito-shop/internal/api/cart"
+	"github.com/inna-maikut/avito-shop/internal/api/employees"
+	"github.com/inna-maikut/avito-shop/internal/api/export"
+	"github.com/inna-maikut/avito-shop/internal/api/grpc_server"
 	"github.com/inna-maikut/avito-shop/internal/api/info"
+	"github.com/inna-maikut/avito-shop/internal/api/orders"
+	"github.com/inna-maikut/avito-shop/internal/api/scheduled_transfer"
 	"github.com/inna-maikut/avito-shop/internal/api/send_coin"
+	"github.com/inna-maikut/avito-shop/internal/api/send_coin_batch"
+	"github.com/inna-maikut/avito-shop/internal/api/shoppb"
+	"github.com/inna-maikut/avito-shop/internal/api/stats"
+	"github.com/inna-maikut/avito-shop/internal/api/transfer_requests"
+	"github.com/inna-maikut/avito-shop/internal/api/wishlist"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/cron"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/middleware"
-	"github.com/inna-maikut/avito-shop/internal/infrastructure/pg"
-	"github.com/inna-maikut/avito-shop/internal/repository"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/tlsconfig"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/worker"
+	"github.com/inna-maikut/avito-shop/internal/model"
+	"github.com/inna-maikut/avito-shop/internal/usecases/allowance_granting"
+	"github.com/inna-maikut/avito-shop/internal/usecases/allowance_managing"
 	"github.com/inna-maikut/avito-shop/internal/usecases/authenticating"
 	"github.com/inna-maikut/avito-shop/internal/usecases/buying"
+	"github.com/inna-maikut/avito-shop/internal/usecases/cart_managing"
+	"github.com/inna-maikut/avito-shop/internal/usecases/coin_expiring"
 	"github.com/inna-maikut/avito-shop/internal/usecases/coin_sending"
+	"github.com/inna-maikut/avito-shop/internal/usecases/employee_searching"
 	"github.com/inna-maikut/avito-shop/internal/usecases/info_collecting"
-)
-
-const (
-	readHeaderTimeout = time.Second
+	"github.com/inna-maikut/avito-shop/internal/usecases/ledger_exporting"
+	"github.com/inna-maikut/avito-shop/internal/usecases/order_fulfilling"
+	"github.com/inna-maikut/avito-shop/internal/usecases/policy_checking"
+	"github.com/inna-maikut/avito-shop/internal/usecases/scheduled_transfer_executing"
+	"github.com/inna-maikut/avito-shop/internal/usecases/stats_collecting"
+	"github.com/inna-maikut/avito-shop/internal/usecases/transfer_approving"
+	"github.com/inna-maikut/avito-shop/internal/usecases/transfer_scheduling"
+	"github.com/inna-maikut/avito-shop/internal/usecases/wishlist_managing"
 )
 
 func main() {
 	cfg := config.Load()
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		printConfig(os.Stdout, cfg)
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		err := runExport(ctx, cfg, os.Args[2:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "export:", err)
+			cancel()
+			os.Exit(1)
+		}
+		return
+	}
+
 	logger := zap.Must(zap.NewProduction())
-	if os.Getenv("APP_ENV") == "development" {
+	if cfg.AppEnv == "development" {
 		logger = zap.Must(zap.NewDevelopment())
 	}
 	defer func() {
@@ -54,62 +96,73 @@ func main() {
 		_ = logger.Sync()
 	}()
 
-	db, cancelDB, err := pg.NewDB(ctx, cfg)
+	logger.Info("config loaded", zap.Any("config", cfg.Values()))
+
+	st, closeStorage, err := newStorage(ctx, cfg)
 	if err != nil {
-		panic(fmt.Errorf("unable to init database: %w", err))
+		panic(fmt.Errorf("create %s storage: %w", cfg.Storage, err))
 	}
-	defer cancelDB()
-
-	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	defer closeStorage()
 
-	tokenProvider, err := jwt.NewProviderFromEnv()
+	tokenProvider, err := jwt.NewProvider(cfg.JWTSecret, cfg.JWTTokenTTL)
 	if err != nil {
 		panic(fmt.Errorf("create jwt provider: %w", err))
 	}
 
-	employeeRepo, err := repository.NewEmployeeRepository(db, trmsqlx.DefaultCtxGetter)
+	authenticatingUseCase, err := authenticating.New(st.trManager, st.employeeRepo, st.coinLotRepo, tokenProvider,
+		cfg.InitialCoins, cfg.PasswordBcryptCost)
 	if err != nil {
-		panic(fmt.Errorf("create user repository: %w", err))
+		panic(fmt.Errorf("create authenticating use case: %w", err))
 	}
 
-	transactionRepo, err := repository.NewTransactionRepository(db, trmsqlx.DefaultCtxGetter)
+	authHandler, err := auth.New(authenticatingUseCase, logger)
 	if err != nil {
-		panic(fmt.Errorf("create transaction repository: %w", err))
+		panic(fmt.Errorf("create auth handler: %w", err))
 	}
 
-	inventoryRepo, err := repository.NewInventoryRepository(db, trmsqlx.DefaultCtxGetter)
+	givingBudget := model.GivingBudget{MonthlyAmount: cfg.MonthlyGivingBudget}
+
+	infoCollectingUseCase, err := info_collecting.New(st.employeeRepo, st.transactionRepo, st.inventoryRepo,
+		st.coinLotRepo, st.transferRequestRepo, st.infoRepo, givingBudget, info_collecting.QueryMode(cfg.InfoQueryMode))
 	if err != nil {
-		panic(fmt.Errorf("create inventory repository: %w", err))
+		panic(fmt.Errorf("create info collecting use case: %w", err))
 	}
 
-	merchRepo, err := repository.NewMerchRepository(db, trmsqlx.DefaultCtxGetter)
+	infoHandler, err := info.New(infoCollectingUseCase, logger)
 	if err != nil {
-		panic(fmt.Errorf("create merch repository: %w", err))
+		panic(fmt.Errorf("create info handler: %w", err))
 	}
 
-	authenticatingUseCase, err := authenticating.New(employeeRepo, tokenProvider)
+	transferPolicy, err := cfg.TransferPolicy()
 	if err != nil {
-		panic(fmt.Errorf("create authenticating use case: %w", err))
+		panic(fmt.Errorf("load transfer policy: %w", err))
 	}
 
-	authHandler, err := auth.New(authenticatingUseCase, logger)
+	policyCheckingUseCase, err := policy_checking.NewFromConfig(transferPolicy, st.transactionRepo)
 	if err != nil {
-		panic(fmt.Errorf("create auth handler: %w", err))
+		panic(fmt.Errorf("create policy checking use case: %w", err))
 	}
 
-	infoCollectingUseCase, err := info_collecting.New(employeeRepo, transactionRepo, inventoryRepo)
+	transferApproval, err := cfg.TransferApproval()
 	if err != nil {
-		panic(fmt.Errorf("create authenticating use case: %w", err))
+		panic(fmt.Errorf("load transfer approval: %w", err))
 	}
 
-	infoHandler, err := info.New(infoCollectingUseCase, logger)
+	coinSendingUseCase, err := coin_sending.New(st.trManager, st.employeeRepo, st.transactionRepo, policyCheckingUseCase,
+		st.coinLotRepo, st.transferRequestRepo, givingBudget, transferApproval)
 	if err != nil {
-		panic(fmt.Errorf("create info handler: %w", err))
+		panic(fmt.Errorf("create coin sending use case: %w", err))
 	}
 
-	coinSendingUseCase, err := coin_sending.New(trManager, employeeRepo, transactionRepo)
+	transferApprovingUseCase, err := transfer_approving.New(st.trManager, st.nestedTrManager, st.transferRequestRepo,
+		st.employeeRepo, st.coinLotRepo, coinSendingUseCase, transferApproval)
 	if err != nil {
-		panic(fmt.Errorf("create coin sending use case: %w", err))
+		panic(fmt.Errorf("create transfer approving use case: %w", err))
+	}
+
+	transferRequestsHandler, err := transfer_requests.New(transferApprovingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create transfer requests handler: %w", err))
 	}
 
 	sendCoinHandler, err := send_coin.New(coinSendingUseCase, logger)
@@ -117,7 +170,13 @@ func main() {
 		panic(fmt.Errorf("create send coin handler: %w", err))
 	}
 
-	buyingUseCase, err := buying.New(trManager, employeeRepo, inventoryRepo, merchRepo)
+	sendCoinBatchHandler, err := send_coin_batch.New(coinSendingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create send coin batch handler: %w", err))
+	}
+
+	buyingUseCase, err := buying.New(st.trManager, st.employeeRepo, st.inventoryRepo, st.merchRepo,
+		st.merchVariantRepo, st.coinLotRepo, st.cartRepo, st.orderRepo)
 	if err != nil {
 		panic(fmt.Errorf("create buying use case: %w", err))
 	}
@@ -127,6 +186,116 @@ func main() {
 		panic(fmt.Errorf("create buy handler: %w", err))
 	}
 
+	cartManagingUseCase, err := cart_managing.New(st.cartRepo, st.merchRepo, st.merchVariantRepo)
+	if err != nil {
+		panic(fmt.Errorf("create cart managing use case: %w", err))
+	}
+
+	cartHandler, err := cart.New(cartManagingUseCase, buyingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create cart handler: %w", err))
+	}
+
+	orderFulfillingUseCase, err := order_fulfilling.New(st.trManager, st.orderRepo, st.employeeRepo, st.inventoryRepo,
+		st.merchVariantRepo, st.coinLotRepo, st.ledgerEntryRepo)
+	if err != nil {
+		panic(fmt.Errorf("create order fulfilling use case: %w", err))
+	}
+
+	ordersHandler, err := orders.New(orderFulfillingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create orders handler: %w", err))
+	}
+
+	wishlistManagingUseCase, err := wishlist_managing.New(st.wishlistRepo, st.merchRepo, st.employeeRepo, st.coinLotRepo)
+	if err != nil {
+		panic(fmt.Errorf("create wishlist managing use case: %w", err))
+	}
+
+	wishlistHandler, err := wishlist.New(wishlistManagingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create wishlist handler: %w", err))
+	}
+
+	transferSchedulingUseCase, err := transfer_scheduling.New(st.employeeRepo, st.scheduledTransferRepo,
+		st.scheduledTransferRunRepo)
+	if err != nil {
+		panic(fmt.Errorf("create transfer scheduling use case: %w", err))
+	}
+
+	scheduledTransferHandler, err := scheduled_transfer.New(transferSchedulingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create scheduled transfer handler: %w", err))
+	}
+
+	scheduledTransferExecutingUseCase, err := scheduled_transfer_executing.New(st.trManager, st.nestedTrManager,
+		st.scheduledTransferRepo, st.scheduledTransferRunRepo, coinSendingUseCase)
+	if err != nil {
+		panic(fmt.Errorf("create scheduled transfer executing use case: %w", err))
+	}
+
+	coinExpiringUseCase, err := coin_expiring.New(st.trManager, st.employeeRepo, st.coinLotRepo, st.ledgerEntryRepo)
+	if err != nil {
+		panic(fmt.Errorf("create coin expiring use case: %w", err))
+	}
+
+	coinExpirySchedule, err := cron.Parse(cfg.CoinExpirySchedule)
+	if err != nil {
+		panic(fmt.Errorf("parse coin expiry schedule: %w", err))
+	}
+
+	allowanceGrantingUseCase, err := allowance_granting.New(st.trManager, st.employeeRepo, st.coinLotRepo,
+		st.ledgerEntryRepo, st.allowanceRepo)
+	if err != nil {
+		panic(fmt.Errorf("create allowance granting use case: %w", err))
+	}
+
+	allowanceManagingUseCase, err := allowance_managing.New(st.allowanceRepo)
+	if err != nil {
+		panic(fmt.Errorf("create allowance managing use case: %w", err))
+	}
+
+	allowanceHandler, err := allowance.New(allowanceManagingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create allowance handler: %w", err))
+	}
+
+	statsCollectingUseCase, err := stats_collecting.New(st.statsRepo, st.employeeRepo)
+	if err != nil {
+		panic(fmt.Errorf("create stats collecting use case: %w", err))
+	}
+
+	statsHandler, err := stats.New(statsCollectingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create stats handler: %w", err))
+	}
+
+	ledgerExportingUseCase, err := ledger_exporting.New(st.exportRepo, st.employeeRepo)
+	if err != nil {
+		panic(fmt.Errorf("create ledger exporting use case: %w", err))
+	}
+
+	exportHandler, err := export.New(ledgerExportingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create export handler: %w", err))
+	}
+
+	employeeSearchingUseCase, err := employee_searching.New(st.employeeRepo)
+	if err != nil {
+		panic(fmt.Errorf("create employee searching use case: %w", err))
+	}
+
+	employeesHandler, err := employees.New(employeeSearchingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create employees handler: %w", err))
+	}
+
+	grpcServer, err := grpc_server.New(authenticatingUseCase, infoCollectingUseCase, coinSendingUseCase,
+		buyingUseCase, logger)
+	if err != nil {
+		panic(fmt.Errorf("create grpc server: %w", err))
+	}
+
 	noAuthMW, err := middleware.CreateNoAuthMiddleware()
 	if err != nil {
 		panic(fmt.Errorf("create no auth middleware: %w", err))
@@ -139,24 +308,244 @@ func main() {
 	authMux := http.NewServeMux()
 
 	authMux.HandleFunc("GET /api/info", infoHandler.Handle)
+	authMux.HandleFunc("GET /api/employees", employeesHandler.Handle)
 	authMux.HandleFunc("POST /api/sendCoin", sendCoinHandler.Handle)
+	authMux.HandleFunc("POST /api/sendCoin/batch", sendCoinBatchHandler.Handle)
+	authMux.HandleFunc("GET /api/transferRequests", transferRequestsHandler.HandleList)
+	authMux.HandleFunc("GET /api/approvals/transferRequests", transferRequestsHandler.HandleApprovalList)
+	authMux.HandleFunc("POST /api/approvals/transferRequests/{id}/approve", transferRequestsHandler.HandleApprove)
+	authMux.HandleFunc("POST /api/approvals/transferRequests/{id}/reject", transferRequestsHandler.HandleReject)
+	authMux.HandleFunc("GET /api/inbox", transferRequestsHandler.HandleInbox)
+	authMux.HandleFunc("POST /api/inbox/{id}/accept", transferRequestsHandler.HandleAccept)
+	authMux.HandleFunc("POST /api/inbox/{id}/decline", transferRequestsHandler.HandleDecline)
 	authMux.HandleFunc("GET /api/buy/{merchName}", buyHandler.Handle)
+	authMux.HandleFunc("GET /api/cart", cartHandler.HandleGet)
+	authMux.HandleFunc("POST /api/cart/items", cartHandler.HandleAdd)
+	authMux.HandleFunc("DELETE /api/cart/items/{item}", cartHandler.HandleRemove)
+	authMux.HandleFunc("POST /api/cart/checkout", cartHandler.HandleCheckout)
+	authMux.HandleFunc("GET /api/orders", ordersHandler.HandleList)
+	authMux.HandleFunc("POST /api/orders/{id}/cancel", ordersHandler.HandleCancel)
+	authMux.HandleFunc("GET /api/staff/orders", ordersHandler.HandleStaffList)
+	authMux.HandleFunc("POST /api/staff/orders/{id}/status", ordersHandler.HandleMove)
+	authMux.HandleFunc("GET /api/wishlist", wishlistHandler.HandleList)
+	authMux.HandleFunc("POST /api/wishlist", wishlistHandler.HandleAdd)
+	authMux.HandleFunc("DELETE /api/wishlist/{item}", wishlistHandler.HandleRemove)
+	authMux.HandleFunc("GET /api/scheduledTransfers", scheduledTransferHandler.HandleList)
+	authMux.HandleFunc("POST /api/scheduledTransfers", scheduledTransferHandler.HandleCreate)
+	authMux.HandleFunc("GET /api/scheduledTransfers/{id}", scheduledTransferHandler.HandleGet)
+	authMux.HandleFunc("PUT /api/scheduledTransfers/{id}", scheduledTransferHandler.HandleUpdate)
+	authMux.HandleFunc("DELETE /api/scheduledTransfers/{id}", scheduledTransferHandler.HandleDelete)
+	authMux.HandleFunc("GET /api/admin/allowance", allowanceHandler.HandleGet)
+	authMux.HandleFunc("PUT /api/admin/allowance", allowanceHandler.HandleUpdate)
+	authMux.HandleFunc("GET /api/admin/export", exportHandler.Handle)
+	authMux.HandleFunc("GET /api/stats/leaderboard", statsHandler.HandleLeaderboard)
+	authMux.HandleFunc("PUT /api/stats/privacy", statsHandler.HandleUpdatePrivacy)
 
 	m := http.NewServeMux()
 	m.Handle("POST /api/auth", noAuthMW(http.HandlerFunc(authHandler.Handle)))
-	m.Handle("/", authMW(authMux))
+	m.Handle("/", authMW(middleware.RequestDetails(authMux)))
 
 	s := &http.Server{
-		Handler:           m,
+		Handler:           middleware.Observe(logger)(m),
 		Addr:              "0.0.0.0:" + strconv.Itoa(cfg.ServerPort),
-		ReadHeaderTimeout: readHeaderTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+	}
+
+	grpcOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			middleware.UnaryRecoveryInterceptor(logger),
+			middleware.UnaryAuthInterceptor(tokenProvider, shoppb.ShopService_Auth_FullMethodName)),
+		grpc.ChainStreamInterceptor(
+			middleware.StreamRecoveryInterceptor(logger),
+			middleware.StreamAuthInterceptor(tokenProvider, shoppb.ShopService_Auth_FullMethodName)),
+	}
+
+	// both servers share the certificate, so a reload applies to both of them
+	var tlsReloader *tlsconfig.Reloader
+	if cfg.TLSEnabled() {
+		tlsReloader, err = tlsconfig.NewReloader(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			panic(fmt.Errorf("load tls certificate: %w", err))
+		}
+
+		s.TLSConfig, err = tlsconfig.New(tlsReloader, cfg.TLSClientCAFile, cfg.TLSClientAuth)
+		if err != nil {
+			panic(fmt.Errorf("create tls config: %w", err))
+		}
+
+		grpcOptions = append(grpcOptions, grpc.Creds(credentials.NewTLS(s.TLSConfig)))
+	}
+
+	gs := grpc.NewServer(grpcOptions...)
+	shoppb.RegisterShopServiceServer(gs, grpcServer)
+
+	grpcListener, err := net.Listen("tcp", "0.0.0.0:"+strconv.Itoa(cfg.GRPCServerPort))
+	if err != nil {
+		panic(fmt.Errorf("grpc server listen: %w", err))
+	}
+
+	var workers sync.WaitGroup
+
+	if tlsReloader != nil {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			worker.Run(ctx, logger, "tls_reload", cfg.TLSReloadInterval, func(_ context.Context) error {
+				reloaded, err := tlsReloader.ReloadIfChanged()
+				if reloaded {
+					logger.Info("tls certificate reloaded", zap.String("reason", "files changed"))
+				}
+				return err
+			})
+		}()
+
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			reloadTLSOnSignal(ctx, logger, tlsReloader)
+		}()
 	}
 
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.Run(ctx, logger, "scheduled_transfer", cfg.ScheduledTransferInterval, func(ctx context.Context) error {
+			executed, err := scheduledTransferExecutingUseCase.ExecuteDue(ctx, time.Now())
+			if executed > 0 {
+				logger.Info("scheduled transfers executed", zap.Int("count", executed))
+			}
+			return err
+		})
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.RunSchedule(ctx, logger, "coin_expiry", coinExpirySchedule, func(ctx context.Context) error {
+			expired, err := coinExpiringUseCase.ExpireDue(ctx, time.Now())
+			if expired > 0 {
+				logger.Info("expired coins written off", zap.Int64("amount", expired))
+			}
+			return err
+		})
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.Run(ctx, logger, "allowance", cfg.AllowanceInterval, func(ctx context.Context) error {
+			granted, err := allowanceGrantingUseCase.GrantDue(ctx, time.Now())
+			if granted > 0 {
+				logger.Info("allowance granted", zap.Int("employees", granted))
+			}
+			return err
+		})
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.Run(ctx, logger, "transfer_request_expiry", cfg.TransferApprovalExpiryInterval,
+			func(ctx context.Context) error {
+				expired, err := transferApprovingUseCase.ExpireDue(ctx, time.Now())
+				if expired > 0 {
+					logger.Info("transfer requests expired or accepted", zap.Int("count", expired))
+				}
+				return err
+			})
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.Run(ctx, logger, "stats_refresh", cfg.StatsRefreshInterval, statsCollectingUseCase.Refresh)
+	}()
+
+	grpcDone := make(chan struct{})
+	go func() {
+		defer close(grpcDone)
+
+		logger.Info("starting grpc server...")
+		if err := gs.Serve(grpcListener); err != nil {
+			logger.Error("grpc server Serve", zap.Error(err))
+			cancel()
+		}
+	}()
+
+	grpcShutdownDone := make(chan struct{})
+	go func() {
+		defer close(grpcShutdownDone)
+		<-ctx.Done()
+
+		logger.Info("shutting down grpc server...")
+		stopped := make(chan struct{})
+		go func() {
+			gs.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(cfg.ServerShutdownTimeout):
+			logger.Error("grpc server graceful stop timed out")
+			gs.Stop()
+		}
+	}()
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), cfg.ServerShutdownTimeout)
+		defer cancelShutdown()
+
+		logger.Info("shutting down http server...")
+		if err := s.Shutdown(shutdownCtx); err != nil {
+			logger.Error("http server shutdown", zap.Error(err))
+		}
+	}()
+
 	logger.Info("starting http server...")
 
 	// And we serve HTTP until the world ends.
-	err = s.ListenAndServe()
-	if err != nil && !errors.Is(err, context.Canceled) {
+	if cfg.TLSEnabled() {
+		// the certificate comes from TLSConfig.GetCertificate
+		err = s.ListenAndServeTLS("", "")
+	} else {
+		err = s.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		panic(fmt.Errorf("http server ListenAndServe: %w", err))
 	}
+
+	// wait for in-flight requests and worker jobs to finish
+	<-shutdownDone
+	<-grpcShutdownDone
+	<-grpcDone
+	workers.Wait()
+}
+
+// reloadTLSOnSignal reloads the certificate on every SIGHUP until ctx is canceled.
+func reloadTLSOnSignal(ctx context.Context, logger *zap.Logger, reloader *tlsconfig.Reloader) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			err := reloader.Reload()
+			if err != nil {
+				logger.Error("tls certificate reload", zap.Error(err))
+				continue
+			}
+			logger.Info("tls certificate reloaded", zap.String("reason", "SIGHUP"))
+		}
+	}
 }
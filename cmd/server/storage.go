@@ -0,0 +1,392 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/settings"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/pg"
+	"github.com/inna-maikut/avito-shop/internal/model"
+	"github.com/inna-maikut/avito-shop/internal/repository"
+	"github.com/inna-maikut/avito-shop/internal/repository/memory"
+)
+
+// storage is the transaction managers and repositories of the service,
+// the interfaces list methods both the postgres and the memory repositories have.
+type storage struct {
+	trManager trManager
+	// nestedTrManager opens a savepoint when called inside a transaction
+	nestedTrManager trManager
+
+	employeeRepo             employeeRepo
+	transactionRepo          transactionRepo
+	inventoryRepo            inventoryRepo
+	merchRepo                merchRepo
+	merchVariantRepo         merchVariantRepo
+	scheduledTransferRepo    scheduledTransferRepo
+	scheduledTransferRunRepo scheduledTransferRunRepo
+	coinLotRepo              coinLotRepo
+	ledgerEntryRepo          ledgerEntryRepo
+	allowanceRepo            allowanceRepo
+	statsRepo                statsRepo
+	exportRepo               exportRepo
+	cartRepo                 cartRepo
+	wishlistRepo             wishlistRepo
+	orderRepo                orderRepo
+	transferRequestRepo      transferRequestRepo
+	infoRepo                 infoRepo
+}
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type employeeRepo interface {
+	GetByUsername(ctx context.Context, username string) (*model.Employee, error)
+	GetByUsernames(ctx context.Context, usernames []string) ([]model.Employee, error)
+	GetByID(ctx context.Context, employeeID int64) (*model.Employee, error)
+	Create(ctx context.Context, username, passwordHash string, balance int64) (*model.Employee, error)
+	GetByIDWithLock(ctx context.Context, employeeID int64) (*model.Employee, error)
+	IncreaseBalance(ctx context.Context, employeeID, amount int64) error
+	SetGivingBudget(ctx context.Context, employeeID, budget int64, period time.Time) error
+	SetHiddenFromStats(ctx context.Context, employeeID int64, hidden bool) error
+	Search(ctx context.Context, query string, limit, offset int) ([]model.EmployeeDirectoryEntry, error)
+}
+
+type transactionRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error)
+	GetTotalsByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error)
+	Add(ctx context.Context, senderID, receiverID, amount int64) error
+	GetSentAmountSince(ctx context.Context, senderID int64, since time.Time) (int64, error)
+	GetLastSentTime(ctx context.Context, senderID, receiverID int64) (*time.Time, error)
+}
+
+type inventoryRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.Inventory, error)
+	Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error
+	Remove(ctx context.Context, employeeID, variantID, quantity int64) error
+	AddPurchase(ctx context.Context, employeeID, merchID, variantID, quantity, price, orderID int64) error
+}
+
+type merchRepo interface {
+	GetByName(ctx context.Context, name string) (*model.Merch, error)
+	GetByIDs(ctx context.Context, merchIDs []int64) ([]model.Merch, error)
+}
+
+type merchVariantRepo interface {
+	GetByMerch(ctx context.Context, merchID int64) ([]model.MerchVariant, error)
+	GetByIDs(ctx context.Context, variantIDs []int64) ([]model.MerchVariant, error)
+	DecreaseStock(ctx context.Context, variantID, quantity int64) error
+	IncreaseStock(ctx context.Context, variantID, quantity int64) error
+}
+
+type scheduledTransferRepo interface {
+	Create(ctx context.Context, st model.ScheduledTransfer) (*model.ScheduledTransfer, error)
+	GetByID(ctx context.Context, id int64) (*model.ScheduledTransfer, error)
+	GetByOwner(ctx context.Context, ownerID int64) ([]model.ScheduledTransfer, error)
+	GetDueWithLock(ctx context.Context, now time.Time) (*model.ScheduledTransfer, error)
+	Update(ctx context.Context, st model.ScheduledTransfer) error
+	SetNextRunTime(ctx context.Context, id int64, nextRunTime *time.Time, isActive bool) error
+	Delete(ctx context.Context, id, ownerID int64) error
+}
+
+type scheduledTransferRunRepo interface {
+	Add(ctx context.Context, run model.ScheduledTransferRun) error
+	GetLastByScheduledTransfer(ctx context.Context, scheduledTransferID int64, limit int) ([]model.ScheduledTransferRun, error)
+}
+
+type coinLotRepo interface {
+	Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error
+	GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error)
+	GetExpired(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error)
+	GetEmployeesWithExpired(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	Decrease(ctx context.Context, lotID, amount int64) error
+}
+
+type ledgerEntryRepo interface {
+	Add(ctx context.Context, entry model.LedgerEntry) error
+	AddOnce(ctx context.Context, entry model.LedgerEntry) (bool, error)
+	GetEmployeesWithoutEntry(ctx context.Context, kind model.LedgerEntryKind, periodTime time.Time, limit int) ([]int64, error)
+}
+
+type allowanceRepo interface {
+	Get(ctx context.Context) (*model.Allowance, error)
+	Update(ctx context.Context, allowance model.Allowance) error
+	AdvanceNextGrantTime(ctx context.Context, periodTime, next time.Time) (bool, error)
+}
+
+type statsRepo interface {
+	GetTopReceivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error)
+	GetTopGivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error)
+	GetTopMerch(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardMerch, error)
+	Refresh(ctx context.Context) error
+}
+
+type exportRepo interface {
+	Stream(ctx context.Context, filter model.ExportFilter, fn func(row model.ExportRow) error) error
+}
+
+type cartRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.CartItem, error)
+	Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error
+	Remove(ctx context.Context, employeeID, variantID int64) error
+	Clear(ctx context.Context, employeeID int64) error
+}
+
+type wishlistRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.WishlistItem, error)
+	Add(ctx context.Context, employeeID, merchID, price int64) error
+	Remove(ctx context.Context, employeeID, merchID int64) error
+}
+
+type orderRepo interface {
+	Add(ctx context.Context, employeeID, merchID, variantID, quantity, price int64) (int64, error)
+	AddLots(ctx context.Context, orderID int64, parts []model.CoinLotPart) error
+	GetLots(ctx context.Context, orderID int64) ([]model.CoinLotPart, error)
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.Order, error)
+	GetByStatuses(ctx context.Context, statuses []model.OrderStatus) ([]model.Order, error)
+	GetByIDWithLock(ctx context.Context, orderID int64) (*model.Order, error)
+	UpdateStatus(ctx context.Context, orderID int64, status model.OrderStatus, updateTime time.Time) error
+}
+
+type transferRequestRepo interface {
+	Create(ctx context.Context, request model.TransferRequest) (*model.TransferRequest, error)
+	AddLots(ctx context.Context, requestID int64, parts []model.CoinLotPart) error
+	MoveLots(ctx context.Context, fromRequestID, toRequestID int64) error
+	GetLots(ctx context.Context, requestID int64) ([]model.CoinLotPart, error)
+	GetBySender(ctx context.Context, senderID int64) ([]model.TransferRequest, error)
+	GetByStatus(ctx context.Context, kind model.TransferRequestKind, status model.TransferRequestStatus) ([]model.TransferRequest, error)
+	GetPendingByEmployee(ctx context.Context, employeeID int64) ([]model.TransferRequest, error)
+	GetExpiredIDs(ctx context.Context, now time.Time) ([]int64, error)
+	GetByIDWithLock(ctx context.Context, requestID int64) (*model.TransferRequest, error)
+	Decide(ctx context.Context, requestID int64, status model.TransferRequestStatus, decidedBy int64, decideTime time.Time) error
+}
+
+type infoRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory) (model.EmployeeInfoData, error)
+	GetByEmployeeSingleQuery(ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory) (model.EmployeeInfoData, error)
+}
+
+// newStorage creates the storage chosen by cfg.Storage, the returned func closes it.
+func newStorage(ctx context.Context, cfg config.Config) (storage, func(), error) {
+	if cfg.Storage == config.StorageMemory {
+		st, err := newMemoryStorage()
+		if err != nil {
+			return storage{}, nil, err
+		}
+		return st, func() {}, nil
+	}
+
+	return newPostgresStorage(ctx, cfg)
+}
+
+func newPostgresStorage(ctx context.Context, cfg config.Config) (st storage, closeDB func(), err error) {
+	db, closeDB, err := pg.NewPool(ctx, cfg)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("unable to init database: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			closeDB()
+		}
+	}()
+
+	st.trManager = manager.Must(trmpgx.NewDefaultFactory(db))
+	st.nestedTrManager = manager.Must(trmpgx.NewDefaultFactory(db),
+		manager.WithSettings(settings.Must(settings.WithPropagation(trm.PropagationNested))))
+
+	st.employeeRepo, err = repository.NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create user repository: %w", err)
+	}
+
+	st.transactionRepo, err = repository.NewTransactionRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create transaction repository: %w", err)
+	}
+
+	st.inventoryRepo, err = repository.NewInventoryRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create inventory repository: %w", err)
+	}
+
+	st.merchRepo, err = repository.NewMerchRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create merch repository: %w", err)
+	}
+
+	st.merchVariantRepo, err = repository.NewMerchVariantRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create merch variant repository: %w", err)
+	}
+
+	st.scheduledTransferRepo, err = repository.NewScheduledTransferRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create scheduled transfer repository: %w", err)
+	}
+
+	st.scheduledTransferRunRepo, err = repository.NewScheduledTransferRunRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create scheduled transfer run repository: %w", err)
+	}
+
+	st.coinLotRepo, err = repository.NewCoinLotRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create coin lot repository: %w", err)
+	}
+
+	st.ledgerEntryRepo, err = repository.NewLedgerEntryRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create ledger entry repository: %w", err)
+	}
+
+	st.allowanceRepo, err = repository.NewAllowanceRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create allowance repository: %w", err)
+	}
+
+	st.statsRepo, err = repository.NewStatsRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create stats repository: %w", err)
+	}
+
+	st.exportRepo, err = repository.NewExportRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create export repository: %w", err)
+	}
+
+	st.cartRepo, err = repository.NewCartRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create cart repository: %w", err)
+	}
+
+	st.wishlistRepo, err = repository.NewWishlistRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create wishlist repository: %w", err)
+	}
+
+	st.orderRepo, err = repository.NewOrderRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create order repository: %w", err)
+	}
+
+	st.transferRequestRepo, err = repository.NewTransferRequestRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create transfer request repository: %w", err)
+	}
+
+	st.infoRepo, err = repository.NewInfoRepository(db, trmpgx.DefaultCtxGetter)
+	if err != nil {
+		return storage{}, nil, fmt.Errorf("create info repository: %w", err)
+	}
+
+	return st, closeDB, nil
+}
+
+// newMemoryStorage keeps everything in memory, data is lost on restart.
+func newMemoryStorage() (st storage, err error) {
+	s := memory.NewStorage()
+
+	st.trManager, err = memory.NewTrManager(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create transaction manager: %w", err)
+	}
+
+	st.nestedTrManager, err = memory.NewNestedTrManager(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create nested transaction manager: %w", err)
+	}
+
+	st.employeeRepo, err = memory.NewEmployeeRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create user repository: %w", err)
+	}
+
+	st.transactionRepo, err = memory.NewTransactionRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create transaction repository: %w", err)
+	}
+
+	st.inventoryRepo, err = memory.NewInventoryRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create inventory repository: %w", err)
+	}
+
+	st.merchRepo, err = memory.NewMerchRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create merch repository: %w", err)
+	}
+
+	st.merchVariantRepo, err = memory.NewMerchVariantRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create merch variant repository: %w", err)
+	}
+
+	st.scheduledTransferRepo, err = memory.NewScheduledTransferRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create scheduled transfer repository: %w", err)
+	}
+
+	st.scheduledTransferRunRepo, err = memory.NewScheduledTransferRunRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create scheduled transfer run repository: %w", err)
+	}
+
+	st.coinLotRepo, err = memory.NewCoinLotRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create coin lot repository: %w", err)
+	}
+
+	st.ledgerEntryRepo, err = memory.NewLedgerEntryRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create ledger entry repository: %w", err)
+	}
+
+	st.allowanceRepo, err = memory.NewAllowanceRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create allowance repository: %w", err)
+	}
+
+	st.statsRepo, err = memory.NewStatsRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create stats repository: %w", err)
+	}
+
+	st.exportRepo, err = memory.NewExportRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create export repository: %w", err)
+	}
+
+	st.cartRepo, err = memory.NewCartRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create cart repository: %w", err)
+	}
+
+	st.wishlistRepo, err = memory.NewWishlistRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create wishlist repository: %w", err)
+	}
+
+	st.orderRepo, err = memory.NewOrderRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create order repository: %w", err)
+	}
+
+	st.transferRequestRepo, err = memory.NewTransferRequestRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create transfer request repository: %w", err)
+	}
+
+	st.infoRepo, err = memory.NewInfoRepository(s)
+	if err != nil {
+		return storage{}, fmt.Errorf("create info repository: %w", err)
+	}
+
+	return st, nil
+}
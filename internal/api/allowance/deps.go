@@ -0,0 +1,13 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package allowance
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type allowanceManaging interface {
+	Get(ctx context.Context) (*model.Allowance, error)
+	Update(ctx context.Context, params model.AllowanceParams) (*model.Allowance, error)
+}
@@ -0,0 +1,98 @@
+package allowance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type Handler struct {
+	allowanceManaging allowanceManaging
+	logger            internal.Logger
+}
+
+func New(allowanceManaging allowanceManaging, logger internal.Logger) (*Handler, error) {
+	if allowanceManaging == nil {
+		return nil, errors.New("allowanceManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		allowanceManaging: allowanceManaging,
+		logger:            logger,
+	}, nil
+}
+
+func (h *Handler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if tokenInfo.Role != model.RoleAdmin {
+		api_handler.Forbidden(w, api.ErrorCodeForbidden, "admin role required")
+		return
+	}
+
+	allowance, err := h.allowanceManaging.Get(ctx)
+	if err != nil {
+		err = fmt.Errorf("allowanceManaging.Get: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/admin/allowance internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertAllowance(*allowance))
+}
+
+func (h *Handler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if tokenInfo.Role != model.RoleAdmin {
+		api_handler.Forbidden(w, api.ErrorCodeForbidden, "admin role required")
+		return
+	}
+
+	var request api.AllowanceRequest
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	allowance, err := h.allowanceManaging.Update(ctx, model.AllowanceParams{
+		Amount:         int64(request.Amount),
+		CronExpression: request.Cron,
+		IsActive:       request.IsActive,
+	})
+	if err != nil {
+		if api_handler.DomainError(w, err, api_handler.WithMessage(model.ErrInvalidSchedule, "invalid cron expression")) {
+			return
+		}
+
+		err = fmt.Errorf("allowanceManaging.Update: %w", err)
+		logging.FromContext(ctx, h.logger).Error("PUT /api/admin/allowance internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertAllowance(*allowance))
+}
+
+func convertAllowance(allowance model.Allowance) api.Allowance {
+	return api.Allowance{
+		Amount:        int(allowance.Amount),
+		Cron:          allowance.CronExpression,
+		IsActive:      allowance.IsActive,
+		NextGrantTime: allowance.NextGrantTime,
+	}
+}
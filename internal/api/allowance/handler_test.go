@@ -0,0 +1,101 @@
+package allowance
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func newRequest(method, target string, body []byte, role model.Role) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+		Role:       role,
+	}))
+}
+
+func TestHandler_HandleUpdate_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	allowanceManagingMock := NewMockallowanceManaging(ctrl)
+
+	nextGrantTime := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	allowanceManagingMock.EXPECT().
+		Update(gomock.Any(), model.AllowanceParams{
+			Amount:         300,
+			CronExpression: "0 0 1 * *",
+			IsActive:       true,
+		}).
+		Return(&model.Allowance{
+			Amount:         300,
+			CronExpression: "0 0 1 * *",
+			IsActive:       true,
+			NextGrantTime:  &nextGrantTime,
+		}, nil)
+
+	handler, err := New(allowanceManagingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodPut, "/api/admin/allowance",
+		[]byte(`{"amount": 300, "cron": "0 0 1 * *", "isActive": true}`), model.RoleAdmin)
+	w := httptest.NewRecorder()
+	handler.HandleUpdate(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.Allowance
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, api.Allowance{
+		Amount:        300,
+		Cron:          "0 0 1 * *",
+		IsActive:      true,
+		NextGrantTime: &nextGrantTime,
+	}, response)
+}
+
+func TestHandler_HandleUpdate_ErrInvalidSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	allowanceManagingMock := NewMockallowanceManaging(ctrl)
+
+	allowanceManagingMock.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		Return(nil, model.ErrInvalidSchedule)
+
+	handler, err := New(allowanceManagingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodPut, "/api/admin/allowance",
+		[]byte(`{"amount": 300, "cron": "monthly", "isActive": true}`), model.RoleAdmin)
+	w := httptest.NewRecorder()
+	handler.HandleUpdate(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_NotAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	allowanceManagingMock := NewMockallowanceManaging(ctrl)
+
+	handler, err := New(allowanceManagingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleGet(w, newRequest(http.MethodGet, "/api/admin/allowance", nil, model.RoleEmployee))
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.HandleUpdate(w, newRequest(http.MethodPut, "/api/admin/allowance",
+		[]byte(`{"amount": 300, "cron": "0 0 1 * *", "isActive": true}`), model.RoleEmployee))
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package allowance -typed -destination mock_deps_test.go
+//
+
+// Package allowance is a generated GoMock package.
+package allowance
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockallowanceManaging is a mock of allowanceManaging interface.
+type MockallowanceManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockallowanceManagingMockRecorder
+}
+
+// MockallowanceManagingMockRecorder is the mock recorder for MockallowanceManaging.
+type MockallowanceManagingMockRecorder struct {
+	mock *MockallowanceManaging
+}
+
+// NewMockallowanceManaging creates a new mock instance.
+func NewMockallowanceManaging(ctrl *gomock.Controller) *MockallowanceManaging {
+	mock := &MockallowanceManaging{ctrl: ctrl}
+	mock.recorder = &MockallowanceManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockallowanceManaging) EXPECT() *MockallowanceManagingMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockallowanceManaging) Get(ctx context.Context) (*model.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*model.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockallowanceManagingMockRecorder) Get(ctx any) *MockallowanceManagingGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockallowanceManaging)(nil).Get), ctx)
+	return &MockallowanceManagingGetCall{Call: call}
+}
+
+// MockallowanceManagingGetCall wrap *gomock.Call
+type MockallowanceManagingGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockallowanceManagingGetCall) Return(arg0 *model.Allowance, arg1 error) *MockallowanceManagingGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockallowanceManagingGetCall) Do(f func(context.Context) (*model.Allowance, error)) *MockallowanceManagingGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockallowanceManagingGetCall) DoAndReturn(f func(context.Context) (*model.Allowance, error)) *MockallowanceManagingGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockallowanceManaging) Update(ctx context.Context, params model.AllowanceParams) (*model.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, params)
+	ret0, _ := ret[0].(*model.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockallowanceManagingMockRecorder) Update(ctx, params any) *MockallowanceManagingUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockallowanceManaging)(nil).Update), ctx, params)
+	return &MockallowanceManagingUpdateCall{Call: call}
+}
+
+// MockallowanceManagingUpdateCall wrap *gomock.Call
+type MockallowanceManagingUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockallowanceManagingUpdateCall) Return(arg0 *model.Allowance, arg1 error) *MockallowanceManagingUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockallowanceManagingUpdateCall) Do(f func(context.Context, model.AllowanceParams) (*model.Allowance, error)) *MockallowanceManagingUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockallowanceManagingUpdateCall) DoAndReturn(f func(context.Context, model.AllowanceParams) (*model.Allowance, error)) *MockallowanceManagingUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
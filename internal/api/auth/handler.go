@@ -10,7 +10,7 @@ import (
 	"github.com/inna-maikut/avito-shop/internal"
 	"github.com/inna-maikut/avito-shop/internal/api"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
-	"github.com/inna-maikut/avito-shop/internal/model"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
 )
 
 type Handler struct {
@@ -38,24 +38,24 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if authRequest.Username == "" || len(authRequest.Username) > 1024 {
-		api_handler.BadRequest(w, "username should contain at least one character and no more than 1024 bytes")
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "username should contain at least one character and no more than 1024 bytes")
 		return
 	}
 
 	if authRequest.Password == "" || len(authRequest.Password) > 1024 {
-		api_handler.BadRequest(w, "password should contain at least one character and no more than 1024 bytes")
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "password should contain at least one character and no more than 1024 bytes")
 		return
 	}
 
 	token, err := h.authenticating.Auth(r.Context(), authRequest.Username, authRequest.Password)
 	if err != nil {
-		if errors.Is(err, model.ErrWrongEmployeePassword) {
-			api_handler.Unauthorized(w, "wrong user password")
+		if api_handler.DomainError(w, err) {
 			return
 		}
 
 		err = fmt.Errorf("authenticating.Auth: %w", err)
-		h.logger.Error("POST /api/auth internal error", zap.Error(err), zap.Any("request", authRequest))
+		logging.FromContext(r.Context(), h.logger).Error("POST /api/auth internal error", zap.Error(err),
+			zap.Any("request", authRequest))
 		api_handler.InternalError(w, "internal server error")
 		return
 	}
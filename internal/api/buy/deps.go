@@ -3,8 +3,10 @@ package buy
 
 import (
 	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
 type buying interface {
-	Buy(ctx context.Context, employeeID int64, merchName string) error
+	Buy(ctx context.Context, employeeID int64, merchName string, options model.VariantOptions) error
 }
@@ -8,8 +8,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
@@ -37,23 +39,23 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	merchName := r.PathValue("merchName")
 	if merchName == "" {
-		api_handler.BadRequest(w, "merchName is required")
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "merchName is required")
 		return
 	}
 
-	err := h.buying.Buy(ctx, tokenInfo.EmployeeID, merchName)
+	options := model.VariantOptions{
+		Size:  r.URL.Query().Get("size"),
+		Color: r.URL.Query().Get("color"),
+	}
+
+	err := h.buying.Buy(ctx, tokenInfo.EmployeeID, merchName, options)
 	if err != nil {
-		if errors.Is(err, model.ErrMerchNotFound) {
-			api_handler.BadRequest(w, "no merch with name "+merchName)
-			return
-		}
-		if errors.Is(err, model.ErrNotEnoughBalance) {
-			api_handler.BadRequest(w, "not enough balance")
+		if api_handler.DomainError(w, err, api_handler.Merch(merchName)...) {
 			return
 		}
 
 		err = fmt.Errorf("buying.Buy: %w", err)
-		h.logger.Error("GET /api/buy/{merchName} internal error", zap.Error(err),
+		logging.FromContext(ctx, h.logger).Error("GET /api/buy/{merchName} internal error", zap.Error(err),
 			zap.String("merchName", merchName))
 		api_handler.InternalError(w, "internal server error")
 		return
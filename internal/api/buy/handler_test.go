@@ -21,7 +21,7 @@ func TestHandler_Handle_Success(t *testing.T) {
 	buyingMock := NewMockbuying(ctrl)
 
 	buyingMock.EXPECT().
-		Buy(gomock.Any(), int64(1234), "socks").
+		Buy(gomock.Any(), int64(1234), "socks", model.VariantOptions{}).
 		Return(nil)
 
 	handler, err := New(buyingMock, zap.NewNop())
@@ -44,7 +44,7 @@ func TestHandler_Handle_ErrMerchNotFound(t *testing.T) {
 	buyingMock := NewMockbuying(ctrl)
 
 	buyingMock.EXPECT().
-		Buy(gomock.Any(), int64(1234), "no-such-merch").
+		Buy(gomock.Any(), int64(1234), "no-such-merch", model.VariantOptions{}).
 		Return(model.ErrMerchNotFound)
 
 	handler, err := New(buyingMock, zap.NewNop())
@@ -71,7 +71,7 @@ func TestHandler_Handle_ErrNotEnoughBalance(t *testing.T) {
 	buyingMock := NewMockbuying(ctrl)
 
 	buyingMock.EXPECT().
-		Buy(gomock.Any(), int64(1234), "socks").
+		Buy(gomock.Any(), int64(1234), "socks", model.VariantOptions{}).
 		Return(model.ErrNotEnoughBalance)
 
 	handler, err := New(buyingMock, zap.NewNop())
@@ -98,7 +98,7 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	buyingMock := NewMockbuying(ctrl)
 
 	buyingMock.EXPECT().
-		Buy(gomock.Any(), int64(1234), "socks").
+		Buy(gomock.Any(), int64(1234), "socks", model.VariantOptions{}).
 		Return(assert.AnError)
 
 	handler, err := New(buyingMock, zap.NewNop())
@@ -119,3 +119,65 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "internal server error", *response.Errors)
 }
+
+func TestHandler_Handle_Variant(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "success",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "variant_required",
+			err:        model.ErrVariantRequired,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "size or color of t-shirt should be chosen",
+		},
+		{
+			name:       "variant_not_found",
+			err:        model.ErrVariantNotFound,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "no such size or color of t-shirt",
+		},
+		{
+			name:       "out_of_stock",
+			err:        model.ErrOutOfStock,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "out of stock",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			buyingMock := NewMockbuying(ctrl)
+
+			buyingMock.EXPECT().
+				Buy(gomock.Any(), int64(1234), "t-shirt", model.VariantOptions{Size: "XL", Color: "black"}).
+				Return(tc.err)
+
+			handler, err := New(buyingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/buy/t-shirt?size=XL&color=black", nil)
+			req.SetPathValue("merchName", "t-shirt")
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				EmployeeID: 1234,
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantStatus, w.Code)
+			if tc.wantError != "" {
+				var response api.ErrorResponse
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantError, *response.Errors)
+			}
+		})
+	}
+}
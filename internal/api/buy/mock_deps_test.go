@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/inna-maikut/avito-shop/internal/model"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,17 +41,17 @@ func (m *Mockbuying) EXPECT() *MockbuyingMockRecorder {
 }
 
 // Buy mocks base method.
-func (m *Mockbuying) Buy(ctx context.Context, employeeID int64, merchName string) error {
+func (m *Mockbuying) Buy(ctx context.Context, employeeID int64, merchName string, options model.VariantOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Buy", ctx, employeeID, merchName)
+	ret := m.ctrl.Call(m, "Buy", ctx, employeeID, merchName, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Buy indicates an expected call of Buy.
-func (mr *MockbuyingMockRecorder) Buy(ctx, employeeID, merchName any) *MockbuyingBuyCall {
+func (mr *MockbuyingMockRecorder) Buy(ctx, employeeID, merchName, options any) *MockbuyingBuyCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Buy", reflect.TypeOf((*Mockbuying)(nil).Buy), ctx, employeeID, merchName)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Buy", reflect.TypeOf((*Mockbuying)(nil).Buy), ctx, employeeID, merchName, options)
 	return &MockbuyingBuyCall{Call: call}
 }
 
@@ -66,13 +67,13 @@ func (c *MockbuyingBuyCall) Return(arg0 error) *MockbuyingBuyCall {
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockbuyingBuyCall) Do(f func(context.Context, int64, string) error) *MockbuyingBuyCall {
+func (c *MockbuyingBuyCall) Do(f func(context.Context, int64, string, model.VariantOptions) error) *MockbuyingBuyCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockbuyingBuyCall) DoAndReturn(f func(context.Context, int64, string) error) *MockbuyingBuyCall {
+func (c *MockbuyingBuyCall) DoAndReturn(f func(context.Context, int64, string, model.VariantOptions) error) *MockbuyingBuyCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,24 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package cart
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type cartManaging interface {
+	Get(ctx context.Context, employeeID int64) (model.Cart, error)
+	Add(
+		ctx context.Context,
+		employeeID int64,
+		merchName string,
+		options model.VariantOptions,
+		quantity int64,
+	) (model.Cart, error)
+	Remove(ctx context.Context, employeeID int64, merchName string, options model.VariantOptions) (model.Cart, error)
+}
+
+type buying interface {
+	Checkout(ctx context.Context, employeeID int64) (model.Cart, error)
+}
@@ -0,0 +1,207 @@
+package cart
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type Handler struct {
+	cartManaging cartManaging
+	buying       buying
+	logger       internal.Logger
+}
+
+func New(cartManaging cartManaging, buying buying, logger internal.Logger) (*Handler, error) {
+	if cartManaging == nil {
+		return nil, errors.New("cartManaging is nil")
+	}
+	if buying == nil {
+		return nil, errors.New("buying is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		cartManaging: cartManaging,
+		buying:       buying,
+		logger:       logger,
+	}, nil
+}
+
+func (h *Handler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	cart, err := h.cartManaging.Get(ctx, tokenInfo.EmployeeID)
+	if err != nil {
+		err = fmt.Errorf("cartManaging.Get: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/cart internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertCart(cart))
+}
+
+func (h *Handler) HandleAdd(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	var request api.CartItemRequest
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	options := model.VariantOptions{
+		Size:  valueOrEmpty(request.Size),
+		Color: valueOrEmpty(request.Color),
+	}
+
+	cart, err := h.cartManaging.Add(ctx, tokenInfo.EmployeeID, request.Item, options, int64(request.Quantity))
+	if err != nil {
+		if api_handler.DomainError(w, err, api_handler.Merch(request.Item)...) {
+			return
+		}
+
+		err = fmt.Errorf("cartManaging.Add: %w", err)
+		logging.FromContext(ctx, h.logger).Error("POST /api/cart/items internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo),
+			zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertCart(cart))
+}
+
+func (h *Handler) HandleRemove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	merchName := r.PathValue("item")
+	if merchName == "" {
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "item is required")
+		return
+	}
+
+	options := model.VariantOptions{
+		Size:  r.URL.Query().Get("size"),
+		Color: r.URL.Query().Get("color"),
+	}
+
+	cart, err := h.cartManaging.Remove(ctx, tokenInfo.EmployeeID, merchName, options)
+	if err != nil {
+		if api_handler.DomainError(w, err, api_handler.Merch(merchName)...) {
+			return
+		}
+
+		err = fmt.Errorf("cartManaging.Remove: %w", err)
+		logging.FromContext(ctx, h.logger).Error("DELETE /api/cart/items/{item} internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.String("item", merchName))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertCart(cart))
+}
+
+func (h *Handler) HandleCheckout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	cart, err := h.buying.Checkout(ctx, tokenInfo.EmployeeID)
+	if err != nil {
+		var checkoutErr *model.CheckoutError
+		if errors.As(err, &checkoutErr) {
+			writeCheckoutError(w, checkoutErr)
+			return
+		}
+		if api_handler.DomainError(w, err) {
+			return
+		}
+
+		err = fmt.Errorf("buying.Checkout: %w", err)
+		logging.FromContext(ctx, h.logger).Error("POST /api/cart/checkout internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertCart(cart))
+}
+
+func writeCheckoutError(w http.ResponseWriter, checkoutErr *model.CheckoutError) {
+	lines := make([]api.CheckoutLineError, 0, len(checkoutErr.Lines))
+	for _, line := range checkoutErr.Lines {
+		code, text := lineError(line.Err)
+		lines = append(lines, api.CheckoutLineError{
+			Item:  line.MerchName,
+			Size:  api_handler.NonEmptyOrNil(line.Variant.Size),
+			Color: api_handler.NonEmptyOrNil(line.Variant.Color),
+			Error: text,
+			Code:  code,
+		})
+	}
+
+	problem := api_handler.NewProblem(w, http.StatusBadRequest, api.ErrorCodeCheckoutRejected,
+		"some cart lines can't be bought, nothing was bought")
+	api_handler.WriteProblem(w, http.StatusBadRequest, api.CheckoutErrorResponse{
+		Type:      problem.Type,
+		Title:     problem.Title,
+		Status:    problem.Status,
+		Code:      problem.Code,
+		Detail:    problem.Detail,
+		RequestId: problem.RequestId,
+		Errors:    problem.Errors,
+		Lines:     &lines,
+	})
+}
+
+func lineError(err error) (api.ErrorCode, string) {
+	_, code, message, ok := api_handler.Describe(err,
+		api_handler.ErrorOverride{Err: model.ErrMerchNotFound, Message: "merch is no longer sold"},
+		api_handler.ErrorOverride{
+			Err: model.ErrVariantNotFound, Code: api.ErrorCodeMerchNotFound, Message: "merch is no longer sold",
+		})
+	if !ok {
+		return api.ErrorCodeCheckoutRejected, "line rejected"
+	}
+	return code, message
+}
+
+func convertCart(cart model.Cart) api.CartResponse {
+	lines := make([]api.CartLine, 0, len(cart.Lines))
+	for _, line := range cart.Lines {
+		lines = append(lines, api.CartLine{
+			Item:     line.MerchName,
+			Size:     api_handler.NonEmptyOrNil(line.Variant.Size),
+			Color:    api_handler.NonEmptyOrNil(line.Variant.Color),
+			Quantity: int(line.Quantity),
+			Price:    int(line.Price),
+			Amount:   int(line.Amount()),
+		})
+	}
+
+	return api.CartResponse{
+		Lines: lines,
+		Total: int(cart.Total),
+	}
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
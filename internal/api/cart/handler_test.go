@@ -0,0 +1,264 @@
+package cart
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+var testCart = model.Cart{
+	Lines: []model.CartLine{
+		{MerchID: 2, MerchName: "cup", Quantity: 2, Price: 20},
+		{MerchID: 8, MerchName: "socks", Quantity: 1, Price: 10},
+	},
+	Total: 50,
+}
+
+func newRequest(method, target string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+}
+
+func TestHandler_HandleGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cartManagingMock := NewMockcartManaging(ctrl)
+
+	cartManagingMock.EXPECT().Get(gomock.Any(), int64(1234)).Return(testCart, nil)
+
+	handler, err := New(cartManagingMock, NewMockbuying(ctrl), zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleGet(w, newRequest(http.MethodGet, "/api/cart", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.CartResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, api.CartResponse{
+		Lines: []api.CartLine{
+			{Item: "cup", Quantity: 2, Price: 20, Amount: 40},
+			{Item: "socks", Quantity: 1, Price: 10, Amount: 10},
+		},
+		Total: 50,
+	}, response)
+}
+
+func TestHandler_HandleAdd_MerchNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cartManagingMock := NewMockcartManaging(ctrl)
+
+	cartManagingMock.EXPECT().
+		Add(gomock.Any(), int64(1234), "car", model.VariantOptions{}, int64(1)).
+		Return(model.Cart{}, model.ErrMerchNotFound)
+
+	handler, err := New(cartManagingMock, NewMockbuying(ctrl), zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleAdd(w, newRequest(http.MethodPost, "/api/cart/items", []byte(`{"item": "car", "quantity": 1}`)))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "no merch with name car", *response.Errors)
+}
+
+func TestHandler_HandleAdd_Variant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cartManagingMock := NewMockcartManaging(ctrl)
+
+	variant := model.MerchVariant{ID: 21, MerchID: 1, Size: "XXL", Color: "black", PriceDelta: 10, Active: true}
+	cartManagingMock.EXPECT().
+		Add(gomock.Any(), int64(1234), "t-shirt", model.VariantOptions{Size: "XXL", Color: "black"}, int64(1)).
+		Return(model.Cart{
+			Lines: []model.CartLine{{MerchID: 1, MerchName: "t-shirt", Variant: variant, Quantity: 1, Price: 90}},
+			Total: 90,
+		}, nil)
+
+	handler, err := New(cartManagingMock, NewMockbuying(ctrl), zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleAdd(w, newRequest(http.MethodPost, "/api/cart/items",
+		[]byte(`{"item": "t-shirt", "size": "XXL", "color": "black", "quantity": 1}`)))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.CartResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, api.CartResponse{
+		Lines: []api.CartLine{
+			{Item: "t-shirt", Size: pointerOf("XXL"), Color: pointerOf("black"), Quantity: 1, Price: 90, Amount: 90},
+		},
+		Total: 90,
+	}, response)
+}
+
+func TestHandler_HandleAdd_VariantRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cartManagingMock := NewMockcartManaging(ctrl)
+
+	cartManagingMock.EXPECT().
+		Add(gomock.Any(), int64(1234), "t-shirt", model.VariantOptions{}, int64(1)).
+		Return(model.Cart{}, model.ErrVariantRequired)
+
+	handler, err := New(cartManagingMock, NewMockbuying(ctrl), zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleAdd(w, newRequest(http.MethodPost, "/api/cart/items", []byte(`{"item": "t-shirt", "quantity": 1}`)))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "size or color of t-shirt should be chosen", *response.Errors)
+}
+
+func TestHandler_HandleRemove_NotInCart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cartManagingMock := NewMockcartManaging(ctrl)
+
+	cartManagingMock.EXPECT().
+		Remove(gomock.Any(), int64(1234), "cup", model.VariantOptions{}).
+		Return(model.Cart{}, model.ErrCartItemNotFound)
+
+	handler, err := New(cartManagingMock, NewMockbuying(ctrl), zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodDelete, "/api/cart/items/cup", nil)
+	req.SetPathValue("item", "cup")
+	w := httptest.NewRecorder()
+	handler.HandleRemove(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_HandleCheckout(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantBody   any
+	}{
+		{
+			name:       "success",
+			wantStatus: http.StatusOK,
+			wantBody: &api.CartResponse{
+				Lines: []api.CartLine{
+					{Item: "cup", Quantity: 2, Price: 20, Amount: 40},
+					{Item: "socks", Quantity: 1, Price: 10, Amount: 10},
+				},
+				Total: 50,
+			},
+		},
+		{
+			name: "checkout_error",
+			err: &model.CheckoutError{Lines: []model.CheckoutLineError{
+				{MerchID: 1, MerchName: "t-shirt", Variant: model.MerchVariant{Size: "L", Color: "white"}, Err: model.ErrOutOfStock},
+				{MerchID: 8, MerchName: "socks", Err: model.ErrNotEnoughBalance},
+			}},
+			wantStatus: http.StatusBadRequest,
+			wantBody: &api.CheckoutErrorResponse{
+				Type:   "urn:avito-shop:problem:CHECKOUT_REJECTED",
+				Title:  "Checkout rejected",
+				Status: http.StatusBadRequest,
+				Code:   api.ErrorCodeCheckoutRejected,
+				Detail: pointerOf("some cart lines can't be bought, nothing was bought"),
+				Errors: pointerOf("some cart lines can't be bought, nothing was bought"),
+				Lines: &[]api.CheckoutLineError{
+					{
+						Item: "t-shirt", Size: pointerOf("L"), Color: pointerOf("white"), Error: "out of stock",
+						Code: api.ErrorCodeOutOfStock,
+					},
+					{Item: "socks", Error: "not enough balance", Code: api.ErrorCodeNotEnoughBalance},
+				},
+			},
+		},
+		{
+			name:       "cart_empty",
+			err:        model.ErrCartEmpty,
+			wantStatus: http.StatusBadRequest,
+			wantBody: &api.ErrorResponse{
+				Type:   "urn:avito-shop:problem:CART_EMPTY",
+				Title:  "Cart is empty",
+				Status: http.StatusBadRequest,
+				Code:   api.ErrorCodeCartEmpty,
+				Detail: pointerOf("cart is empty"),
+				Errors: pointerOf("cart is empty"),
+			},
+		},
+		{
+			name:       "internal_error",
+			err:        assert.AnError,
+			wantStatus: http.StatusInternalServerError,
+			wantBody: &api.ErrorResponse{
+				Type:   "urn:avito-shop:problem:INTERNAL_ERROR",
+				Title:  "Internal server error",
+				Status: http.StatusInternalServerError,
+				Code:   api.ErrorCodeInternalError,
+				Detail: pointerOf("internal server error"),
+				Errors: pointerOf("internal server error"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			buyingMock := NewMockbuying(ctrl)
+
+			res := testCart
+			if tc.err != nil {
+				res = model.Cart{}
+			}
+			buyingMock.EXPECT().Checkout(gomock.Any(), int64(1234)).Return(res, tc.err)
+
+			handler, err := New(NewMockcartManaging(ctrl), buyingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			handler.HandleCheckout(w, newRequest(http.MethodPost, "/api/cart/checkout", nil))
+
+			require.Equal(t, tc.wantStatus, w.Code)
+			if tc.wantStatus != http.StatusOK {
+				require.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+			}
+			body := newOfSameType(tc.wantBody)
+			err = json.Unmarshal(w.Body.Bytes(), body)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantBody, body)
+		})
+	}
+}
+
+func newOfSameType(v any) any {
+	switch v.(type) {
+	case *api.CartResponse:
+		return &api.CartResponse{}
+	case *api.CheckoutErrorResponse:
+		return &api.CheckoutErrorResponse{}
+	default:
+		return &api.ErrorResponse{}
+	}
+}
+
+func pointerOf[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,220 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package cart -typed -destination mock_deps_test.go
+//
+
+// Package cart is a generated GoMock package.
+package cart
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcartManaging is a mock of cartManaging interface.
+type MockcartManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockcartManagingMockRecorder
+}
+
+// MockcartManagingMockRecorder is the mock recorder for MockcartManaging.
+type MockcartManagingMockRecorder struct {
+	mock *MockcartManaging
+}
+
+// NewMockcartManaging creates a new mock instance.
+func NewMockcartManaging(ctrl *gomock.Controller) *MockcartManaging {
+	mock := &MockcartManaging{ctrl: ctrl}
+	mock.recorder = &MockcartManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcartManaging) EXPECT() *MockcartManagingMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockcartManaging) Add(ctx context.Context, employeeID int64, merchName string, options model.VariantOptions, quantity int64) (model.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, merchName, options, quantity)
+	ret0, _ := ret[0].(model.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockcartManagingMockRecorder) Add(ctx, employeeID, merchName, options, quantity any) *MockcartManagingAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockcartManaging)(nil).Add), ctx, employeeID, merchName, options, quantity)
+	return &MockcartManagingAddCall{Call: call}
+}
+
+// MockcartManagingAddCall wrap *gomock.Call
+type MockcartManagingAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcartManagingAddCall) Return(arg0 model.Cart, arg1 error) *MockcartManagingAddCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcartManagingAddCall) Do(f func(context.Context, int64, string, model.VariantOptions, int64) (model.Cart, error)) *MockcartManagingAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcartManagingAddCall) DoAndReturn(f func(context.Context, int64, string, model.VariantOptions, int64) (model.Cart, error)) *MockcartManagingAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockcartManaging) Get(ctx context.Context, employeeID int64) (model.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, employeeID)
+	ret0, _ := ret[0].(model.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockcartManagingMockRecorder) Get(ctx, employeeID any) *MockcartManagingGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockcartManaging)(nil).Get), ctx, employeeID)
+	return &MockcartManagingGetCall{Call: call}
+}
+
+// MockcartManagingGetCall wrap *gomock.Call
+type MockcartManagingGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcartManagingGetCall) Return(arg0 model.Cart, arg1 error) *MockcartManagingGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcartManagingGetCall) Do(f func(context.Context, int64) (model.Cart, error)) *MockcartManagingGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcartManagingGetCall) DoAndReturn(f func(context.Context, int64) (model.Cart, error)) *MockcartManagingGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Remove mocks base method.
+func (m *MockcartManaging) Remove(ctx context.Context, employeeID int64, merchName string, options model.VariantOptions) (model.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, employeeID, merchName, options)
+	ret0, _ := ret[0].(model.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockcartManagingMockRecorder) Remove(ctx, employeeID, merchName, options any) *MockcartManagingRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockcartManaging)(nil).Remove), ctx, employeeID, merchName, options)
+	return &MockcartManagingRemoveCall{Call: call}
+}
+
+// MockcartManagingRemoveCall wrap *gomock.Call
+type MockcartManagingRemoveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcartManagingRemoveCall) Return(arg0 model.Cart, arg1 error) *MockcartManagingRemoveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcartManagingRemoveCall) Do(f func(context.Context, int64, string, model.VariantOptions) (model.Cart, error)) *MockcartManagingRemoveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcartManagingRemoveCall) DoAndReturn(f func(context.Context, int64, string, model.VariantOptions) (model.Cart, error)) *MockcartManagingRemoveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Mockbuying is a mock of buying interface.
+type Mockbuying struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuyingMockRecorder
+}
+
+// MockbuyingMockRecorder is the mock recorder for Mockbuying.
+type MockbuyingMockRecorder struct {
+	mock *Mockbuying
+}
+
+// NewMockbuying creates a new mock instance.
+func NewMockbuying(ctrl *gomock.Controller) *Mockbuying {
+	mock := &Mockbuying{ctrl: ctrl}
+	mock.recorder = &MockbuyingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockbuying) EXPECT() *MockbuyingMockRecorder {
+	return m.recorder
+}
+
+// Checkout mocks base method.
+func (m *Mockbuying) Checkout(ctx context.Context, employeeID int64) (model.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, employeeID)
+	ret0, _ := ret[0].(model.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockbuyingMockRecorder) Checkout(ctx, employeeID any) *MockbuyingCheckoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*Mockbuying)(nil).Checkout), ctx, employeeID)
+	return &MockbuyingCheckoutCall{Call: call}
+}
+
+// MockbuyingCheckoutCall wrap *gomock.Call
+type MockbuyingCheckoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbuyingCheckoutCall) Return(arg0 model.Cart, arg1 error) *MockbuyingCheckoutCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbuyingCheckoutCall) Do(f func(context.Context, int64) (model.Cart, error)) *MockbuyingCheckoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbuyingCheckoutCall) DoAndReturn(f func(context.Context, int64) (model.Cart, error)) *MockbuyingCheckoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package employees
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type employeeSearching interface {
+	Search(ctx context.Context, query string, limit, offset int) (model.EmployeeDirectoryPage, error)
+}
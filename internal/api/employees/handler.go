@@ -0,0 +1,101 @@
+package employees
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+type Handler struct {
+	employeeSearching employeeSearching
+	logger            internal.Logger
+}
+
+func New(employeeSearching employeeSearching, logger internal.Logger) (*Handler, error) {
+	if employeeSearching == nil {
+		return nil, errors.New("employeeSearching is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		employeeSearching: employeeSearching,
+		logger:            logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	query := r.URL.Query()
+	limit, ok := parseInt(w, query.Get("limit"), "limit", defaultLimit)
+	if !ok {
+		return
+	}
+	if limit < 1 || limit > maxLimit {
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, fmt.Sprintf("limit should be from 1 to %d", maxLimit))
+		return
+	}
+	offset, ok := parseInt(w, query.Get("offset"), "offset", 0)
+	if !ok {
+		return
+	}
+	if offset < 0 {
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "offset should not be negative")
+		return
+	}
+
+	page, err := h.employeeSearching.Search(ctx, query.Get("query"), limit, offset)
+	if err != nil {
+		err = fmt.Errorf("employeeSearching.Search: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/employees internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.String("query", r.URL.RawQuery))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := api.EmployeeListResponse{
+		Employees: make([]api.DirectoryEmployee, 0, len(page.Employees)),
+	}
+	for _, employee := range page.Employees {
+		res.Employees = append(res.Employees, api.DirectoryEmployee{
+			Username:    employee.Username,
+			DisplayName: employee.DisplayName,
+		})
+	}
+	if page.HasMore {
+		nextOffset := offset + limit
+		res.NextOffset = &nextOffset
+	}
+
+	api_handler.OK(w, res)
+}
+
+func parseInt(w http.ResponseWriter, value, name string, defaultValue int) (int, bool) {
+	if value == "" {
+		return defaultValue, true
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, name+" should be an integer")
+		return 0, false
+	}
+
+	return v, true
+}
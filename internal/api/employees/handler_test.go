@@ -0,0 +1,83 @@
+package employees
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func newRequest(target string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	employeeSearchingMock := NewMockemployeeSearching(ctrl)
+
+	employeeSearchingMock.EXPECT().
+		Search(gomock.Any(), "ann", 2, 4).
+		Return(model.EmployeeDirectoryPage{
+			Employees: []model.EmployeeDirectoryEntry{
+				{Username: "anna", DisplayName: "Anna Ivanova"},
+				{Username: "anne"},
+			},
+			HasMore: true,
+		}, nil)
+
+	handler, err := New(employeeSearchingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("/api/employees?query=ann&limit=2&offset=4"))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `
+	{
+		"employees": [
+			{"username": "anna", "displayName": "Anna Ivanova"},
+			{"username": "anne", "displayName": ""}
+		],
+		"nextOffset": 6
+	}`, w.Body.String())
+}
+
+func TestHandler_Handle_LastPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	employeeSearchingMock := NewMockemployeeSearching(ctrl)
+
+	employeeSearchingMock.EXPECT().
+		Search(gomock.Any(), "", defaultLimit, 0).
+		Return(model.EmployeeDirectoryPage{}, nil)
+
+	handler, err := New(employeeSearchingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("/api/employees"))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"employees": []}`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	employeeSearchingMock := NewMockemployeeSearching(ctrl)
+
+	handler, err := New(employeeSearchingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("/api/employees?limit=1000"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
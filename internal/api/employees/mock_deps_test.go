@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package employees -typed -destination mock_deps_test.go
+//
+
+// Package employees is a generated GoMock package.
+package employees
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockemployeeSearching is a mock of employeeSearching interface.
+type MockemployeeSearching struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeSearchingMockRecorder
+}
+
+// MockemployeeSearchingMockRecorder is the mock recorder for MockemployeeSearching.
+type MockemployeeSearchingMockRecorder struct {
+	mock *MockemployeeSearching
+}
+
+// NewMockemployeeSearching creates a new mock instance.
+func NewMockemployeeSearching(ctrl *gomock.Controller) *MockemployeeSearching {
+	mock := &MockemployeeSearching{ctrl: ctrl}
+	mock.recorder = &MockemployeeSearchingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeSearching) EXPECT() *MockemployeeSearchingMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockemployeeSearching) Search(ctx context.Context, query string, limit, offset int) (model.EmployeeDirectoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit, offset)
+	ret0, _ := ret[0].(model.EmployeeDirectoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockemployeeSearchingMockRecorder) Search(ctx, query, limit, offset any) *MockemployeeSearchingSearchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockemployeeSearching)(nil).Search), ctx, query, limit, offset)
+	return &MockemployeeSearchingSearchCall{Call: call}
+}
+
+// MockemployeeSearchingSearchCall wrap *gomock.Call
+type MockemployeeSearchingSearchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeSearchingSearchCall) Return(arg0 model.EmployeeDirectoryPage, arg1 error) *MockemployeeSearchingSearchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeSearchingSearchCall) Do(f func(context.Context, string, int, int) (model.EmployeeDirectoryPage, error)) *MockemployeeSearchingSearchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeSearchingSearchCall) DoAndReturn(f func(context.Context, string, int, int) (model.EmployeeDirectoryPage, error)) *MockemployeeSearchingSearchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
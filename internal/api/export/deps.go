@@ -0,0 +1,13 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package export
+
+import (
+	"context"
+	"io"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type ledgerExporting interface {
+	Export(ctx context.Context, w io.Writer, params model.ExportParams) error
+}
@@ -0,0 +1,139 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+var contentTypes = map[model.ExportFormat]string{
+	model.ExportFormatCSV:    "text/csv; charset=utf-8",
+	model.ExportFormatNDJSON: "application/x-ndjson",
+}
+
+type Handler struct {
+	ledgerExporting ledgerExporting
+	logger          internal.Logger
+}
+
+func New(ledgerExporting ledgerExporting, logger internal.Logger) (*Handler, error) {
+	if ledgerExporting == nil {
+		return nil, errors.New("ledgerExporting is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		ledgerExporting: ledgerExporting,
+		logger:          logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if tokenInfo.Role != model.RoleAdmin {
+		api_handler.Forbidden(w, api.ErrorCodeForbidden, "admin role required")
+		return
+	}
+
+	params, ok := parseParams(w, r)
+	if !ok {
+		return
+	}
+
+	sw := &streamWriter{
+		w:           w,
+		contentType: contentTypes[params.Format],
+		filename:    "ledger." + string(params.Format),
+	}
+
+	err := h.ledgerExporting.Export(ctx, sw, params)
+	if err != nil {
+		if sw.started {
+			// the status is already sent, abort the connection so the client doesn't take a truncated export as complete
+			logging.FromContext(ctx, h.logger).Error("GET /api/admin/export stream error", zap.Error(err),
+				zap.Any("tokenInfo", tokenInfo))
+			panic(http.ErrAbortHandler)
+		}
+
+		if api_handler.DomainError(w, err) {
+			return
+		}
+
+		err = fmt.Errorf("ledgerExporting.Export: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/admin/export internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.String("query", r.URL.RawQuery))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+}
+
+func parseParams(w http.ResponseWriter, r *http.Request) (model.ExportParams, bool) {
+	query := r.URL.Query()
+
+	params := model.ExportParams{
+		Format:           model.ExportFormatCSV,
+		EmployeeUsername: query.Get("employee"),
+	}
+	if v := query.Get("format"); v != "" {
+		params.Format = model.ExportFormat(v)
+	}
+
+	if v := query.Get("from"); v != "" {
+		from, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "from should be a date in YYYY-MM-DD format")
+			return model.ExportParams{}, false
+		}
+		params.From = &from
+	}
+	if v := query.Get("to"); v != "" {
+		to, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "to should be a date in YYYY-MM-DD format")
+			return model.ExportParams{}, false
+		}
+		// the API period includes the last day
+		to = to.AddDate(0, 0, 1)
+		params.To = &to
+	}
+
+	return params, true
+}
+
+// streamWriter sends response headers on the first write,
+// so errors happening before any row is exported still get a regular error response.
+type streamWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	if !s.started {
+		s.started = true
+		s.w.Header().Set("Content-Type", s.contentType)
+		s.w.Header().Set("Content-Disposition", `attachment; filename="`+s.filename+`"`)
+		s.w.WriteHeader(http.StatusOK)
+	}
+
+	n, err := s.w.Write(p)
+	if err != nil {
+		return n, fmt.Errorf("w.Write: %w", err)
+	}
+
+	return n, nil
+}
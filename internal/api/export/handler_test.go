@@ -0,0 +1,105 @@
+package export
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func newRequest(target string, role model.Role) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+		Role:       role,
+	}))
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ledgerExportingMock := NewMockledgerExporting(ctrl)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	ledgerExportingMock.EXPECT().
+		Export(gomock.Any(), gomock.Any(), model.ExportParams{
+			Format:           model.ExportFormatNDJSON,
+			From:             &from,
+			To:               &to,
+			EmployeeUsername: "test1",
+		}).
+		DoAndReturn(func(_ context.Context, w io.Writer, _ model.ExportParams) error {
+			_, err := io.WriteString(w, "{}\n")
+			return err
+		})
+
+	handler, err := New(ledgerExportingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("/api/admin/export?format=ndjson&from=2025-01-01&to=2025-03-31&employee=test1",
+		model.RoleAdmin))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	require.Equal(t, "{}\n", w.Body.String())
+}
+
+func TestHandler_Handle_ErrInvalidExportFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ledgerExportingMock := NewMockledgerExporting(ctrl)
+
+	ledgerExportingMock.EXPECT().
+		Export(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(model.ErrInvalidExportFormat)
+
+	handler, err := New(ledgerExportingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("/api/admin/export?format=xml", model.RoleAdmin))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Handle_StreamError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ledgerExportingMock := NewMockledgerExporting(ctrl)
+
+	ledgerExportingMock.EXPECT().
+		Export(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, w io.Writer, _ model.ExportParams) error {
+			_, _ = io.WriteString(w, "kind,id\n")
+			return assert.AnError
+		})
+
+	handler, err := New(ledgerExportingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.Handle(httptest.NewRecorder(), newRequest("/api/admin/export", model.RoleAdmin))
+	})
+}
+
+func TestHandler_Handle_NotAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ledgerExportingMock := NewMockledgerExporting(ctrl)
+
+	handler, err := New(ledgerExportingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("/api/admin/export", model.RoleEmployee))
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
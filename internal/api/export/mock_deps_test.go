@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package export -typed -destination mock_deps_test.go
+//
+
+// Package export is a generated GoMock package.
+package export
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockledgerExporting is a mock of ledgerExporting interface.
+type MockledgerExporting struct {
+	ctrl     *gomock.Controller
+	recorder *MockledgerExportingMockRecorder
+}
+
+// MockledgerExportingMockRecorder is the mock recorder for MockledgerExporting.
+type MockledgerExportingMockRecorder struct {
+	mock *MockledgerExporting
+}
+
+// NewMockledgerExporting creates a new mock instance.
+func NewMockledgerExporting(ctrl *gomock.Controller) *MockledgerExporting {
+	mock := &MockledgerExporting{ctrl: ctrl}
+	mock.recorder = &MockledgerExportingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockledgerExporting) EXPECT() *MockledgerExportingMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockledgerExporting) Export(ctx context.Context, w io.Writer, params model.ExportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, w, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockledgerExportingMockRecorder) Export(ctx, w, params any) *MockledgerExportingExportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockledgerExporting)(nil).Export), ctx, w, params)
+	return &MockledgerExportingExportCall{Call: call}
+}
+
+// MockledgerExportingExportCall wrap *gomock.Call
+type MockledgerExportingExportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockledgerExportingExportCall) Return(arg0 error) *MockledgerExportingExportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockledgerExportingExportCall) Do(f func(context.Context, io.Writer, model.ExportParams) error) *MockledgerExportingExportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockledgerExportingExportCall) DoAndReturn(f func(context.Context, io.Writer, model.ExportParams) error) *MockledgerExportingExportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 )
@@ -19,6 +20,110 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for CoinHistoryMode.
+const (
+	ByCounterparty CoinHistoryMode = "byCounterparty"
+	List           CoinHistoryMode = "list"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeAmountAboveMaximum              ErrorCode = "AMOUNT_ABOVE_MAXIMUM"
+	ErrorCodeAmountBelowMinimum              ErrorCode = "AMOUNT_BELOW_MINIMUM"
+	ErrorCodeApprovalRequired                ErrorCode = "APPROVAL_REQUIRED"
+	ErrorCodeCartEmpty                       ErrorCode = "CART_EMPTY"
+	ErrorCodeCartItemNotFound                ErrorCode = "CART_ITEM_NOT_FOUND"
+	ErrorCodeCheckoutRejected                ErrorCode = "CHECKOUT_REJECTED"
+	ErrorCodeDailyLimitExceeded              ErrorCode = "DAILY_LIMIT_EXCEEDED"
+	ErrorCodeEmployeeNotFound                ErrorCode = "EMPLOYEE_NOT_FOUND"
+	ErrorCodeForbidden                       ErrorCode = "FORBIDDEN"
+	ErrorCodeInternalError                   ErrorCode = "INTERNAL_ERROR"
+	ErrorCodeInvalidAmount                   ErrorCode = "INVALID_AMOUNT"
+	ErrorCodeInvalidPeriod                   ErrorCode = "INVALID_PERIOD"
+	ErrorCodeInvalidSchedule                 ErrorCode = "INVALID_SCHEDULE"
+	ErrorCodeMerchNotFound                   ErrorCode = "MERCH_NOT_FOUND"
+	ErrorCodeMonthlyLimitExceeded            ErrorCode = "MONTHLY_LIMIT_EXCEEDED"
+	ErrorCodeNotEnoughBalance                ErrorCode = "NOT_ENOUGH_BALANCE"
+	ErrorCodeNotFound                        ErrorCode = "NOT_FOUND"
+	ErrorCodeOrderNotFound                   ErrorCode = "ORDER_NOT_FOUND"
+	ErrorCodeOrderStatusTransitionNotAllowed ErrorCode = "ORDER_STATUS_TRANSITION_NOT_ALLOWED"
+	ErrorCodeOutOfStock                      ErrorCode = "OUT_OF_STOCK"
+	ErrorCodeRecipientNotFound               ErrorCode = "RECIPIENT_NOT_FOUND"
+	ErrorCodeScheduledTransferNotFound       ErrorCode = "SCHEDULED_TRANSFER_NOT_FOUND"
+	ErrorCodeSelfApprovalNotAllowed          ErrorCode = "SELF_APPROVAL_NOT_ALLOWED"
+	ErrorCodeSelfTransferNotAllowed          ErrorCode = "SELF_TRANSFER_NOT_ALLOWED"
+	ErrorCodeTransferBlocked                 ErrorCode = "TRANSFER_BLOCKED"
+	ErrorCodeTransferCooldown                ErrorCode = "TRANSFER_COOLDOWN"
+	ErrorCodeTransferRequestDecided          ErrorCode = "TRANSFER_REQUEST_DECIDED"
+	ErrorCodeTransferRequestExpired          ErrorCode = "TRANSFER_REQUEST_EXPIRED"
+	ErrorCodeTransferRequestNotFound         ErrorCode = "TRANSFER_REQUEST_NOT_FOUND"
+	ErrorCodeTransfersRejected               ErrorCode = "TRANSFERS_REJECTED"
+	ErrorCodeUnauthorized                    ErrorCode = "UNAUTHORIZED"
+	ErrorCodeValidationFailed                ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeVariantNotFound                 ErrorCode = "VARIANT_NOT_FOUND"
+	ErrorCodeVariantRequired                 ErrorCode = "VARIANT_REQUIRED"
+	ErrorCodeWishlistItemNotFound            ErrorCode = "WISHLIST_ITEM_NOT_FOUND"
+	ErrorCodeWrongPassword                   ErrorCode = "WRONG_PASSWORD"
+)
+
+// Defines values for OrderStatus.
+const (
+	Cancelled      OrderStatus = "cancelled"
+	Delivered      OrderStatus = "delivered"
+	Placed         OrderStatus = "placed"
+	ReadyForPickup OrderStatus = "ready_for_pickup"
+)
+
+// Defines values for ScheduledTransferRunStatus.
+const (
+	Failed  ScheduledTransferRunStatus = "failed"
+	Success ScheduledTransferRunStatus = "success"
+)
+
+// Defines values for TransferRequestKind.
+const (
+	Acceptance TransferRequestKind = "acceptance"
+	Approval   TransferRequestKind = "approval"
+)
+
+// Defines values for TransferRequestStatus.
+const (
+	Accepted TransferRequestStatus = "accepted"
+	Approved TransferRequestStatus = "approved"
+	Declined TransferRequestStatus = "declined"
+	Expired  TransferRequestStatus = "expired"
+	Pending  TransferRequestStatus = "pending"
+	Rejected TransferRequestStatus = "rejected"
+)
+
+// Defines values for GetApiAdminExportParamsFormat.
+const (
+	Csv    GetApiAdminExportParamsFormat = "csv"
+	Ndjson GetApiAdminExportParamsFormat = "ndjson"
+)
+
+// Allowance defines model for Allowance.
+type Allowance struct {
+	Amount   int    `json:"amount"`
+	Cron     string `json:"cron"`
+	IsActive bool   `json:"isActive"`
+
+	// NextGrantTime Начало следующего периода начисления.
+	NextGrantTime *time.Time `json:"nextGrantTime,omitempty"`
+}
+
+// AllowanceRequest defines model for AllowanceRequest.
+type AllowanceRequest struct {
+	// Amount Количество монет, начисляемых каждому сотруднику за период.
+	Amount int `json:"amount"`
+
+	// Cron Расписание начислений в формате cron "минута час день месяц день_недели" (UTC), например "0 0 1 * *".
+	Cron string `json:"cron"`
+
+	// IsActive Приостановленное начисление не выполняется, пропущенные периоды не начисляются.
+	IsActive bool `json:"isActive"`
+}
+
 // AuthRequest defines model for AuthRequest.
 type AuthRequest struct {
 	// Password Пароль для аутентификации.
@@ -34,24 +139,299 @@ type AuthResponse struct {
 	Token *string `json:"token,omitempty"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
+// CartItemRequest defines model for CartItemRequest.
+type CartItemRequest struct {
+	// Color Цвет, обязателен для предметов с выбором цвета.
+	Color *string `json:"color,omitempty"`
+
+	// Item Название предмета.
+	Item string `json:"item"`
+
+	// Quantity Сколько штук добавить.
+	Quantity int `json:"quantity"`
+
+	// Size Размер, обязателен для предметов с выбором размера.
+	Size *string `json:"size,omitempty"`
+}
+
+// CartLine defines model for CartLine.
+type CartLine struct {
+	// Amount Стоимость позиции.
+	Amount int     `json:"amount"`
+	Color  *string `json:"color,omitempty"`
+	Item   string  `json:"item"`
+
+	// Price Текущая цена одной штуки.
+	Price    int     `json:"price"`
+	Quantity int     `json:"quantity"`
+	Size     *string `json:"size,omitempty"`
+}
+
+// CartResponse defines model for CartResponse.
+type CartResponse struct {
+	Lines []CartLine `json:"lines"`
+
+	// Total Стоимость всей корзины по текущим ценам.
+	Total int `json:"total"`
+}
+
+// CheckoutErrorResponse defines model for CheckoutErrorResponse.
+type CheckoutErrorResponse struct {
+	// Code Код ошибки. Коды стабильны, клиентам стоит опираться на них, а не на текст detail:
+	// - `INTERNAL_ERROR` - внутренняя ошибка сервера
+	// - `VALIDATION_FAILED` - запрос не прошёл проверку: неверное тело, параметр или значение
+	// - `UNAUTHORIZED` - нет токена или он недействителен
+	// - `NOT_FOUND` - нет такого метода API
+	// - `FORBIDDEN` - не хватает роли
+	// - `WRONG_PASSWORD` - неверный пароль
+	// - `EMPLOYEE_NOT_FOUND` - сотрудник не найден
+	// - `RECIPIENT_NOT_FOUND` - получатель перевода не найден
+	// - `SELF_TRANSFER_NOT_ALLOWED` - перевод самому себе
+	// - `INVALID_AMOUNT` - сумма перевода не положительная
+	// - `NOT_ENOUGH_BALANCE` - не хватает монет
+	// - `AMOUNT_BELOW_MINIMUM` - сумма меньше минимальной по политике переводов
+	// - `AMOUNT_ABOVE_MAXIMUM` - сумма больше максимальной по политике переводов
+	// - `DAILY_LIMIT_EXCEEDED` - превышен дневной лимит переводов
+	// - `MONTHLY_LIMIT_EXCEEDED` - превышен месячный лимит переводов
+	// - `TRANSFER_COOLDOWN` - повторный перевод этому сотруднику слишком рано
+	// - `TRANSFER_BLOCKED` - переводы между этими сотрудниками запрещены
+	// - `APPROVAL_REQUIRED` - перевод требует одобрения и не может быть в пакете
+	// - `TRANSFERS_REJECTED` - часть переводов пакета отклонена, подробности в recipients
+	// - `TRANSFER_REQUEST_NOT_FOUND` - заявка на перевод не найдена
+	// - `TRANSFER_REQUEST_DECIDED` - по заявке уже принято решение
+	// - `TRANSFER_REQUEST_EXPIRED` - срок заявки истёк
+	// - `SELF_APPROVAL_NOT_ALLOWED` - решение по собственной заявке
+	// - `SCHEDULED_TRANSFER_NOT_FOUND` - запланированный перевод не найден
+	// - `INVALID_SCHEDULE` - неверное расписание перевода
+	// - `MERCH_NOT_FOUND` - товар не найден
+	// - `VARIANT_REQUIRED` - не выбран размер или цвет
+	// - `VARIANT_NOT_FOUND` - нет такого размера или цвета
+	// - `OUT_OF_STOCK` - товар закончился
+	// - `CART_EMPTY` - корзина пуста
+	// - `CART_ITEM_NOT_FOUND` - товара нет в корзине
+	// - `CHECKOUT_REJECTED` - часть позиций корзины нельзя купить, подробности в lines
+	// - `WISHLIST_ITEM_NOT_FOUND` - товара нет в списке желаний
+	// - `ORDER_NOT_FOUND` - заказ не найден
+	// - `ORDER_STATUS_TRANSITION_NOT_ALLOWED` - заказ нельзя перевести в этот статус
+	// - `INVALID_PERIOD` - начало периода позже конца
+	Code ErrorCode `json:"code"`
+
+	// Detail Описание конкретной ошибки.
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors То же, что detail. Оставлено для старых клиентов.
+	// Deprecated:
+	Errors *string `json:"errors,omitempty"`
+
+	// Lines Позиции, которые нельзя купить.
+	Lines *[]CheckoutLineError `json:"lines,omitempty"`
+
+	// RequestId Идентификатор запроса из заголовка X-Request-ID.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Status HTTP-статус ответа.
+	Status int `json:"status"`
+
+	// Title Краткое описание типа ошибки, одинаковое для всех ошибок с этим code.
+	Title string `json:"title"`
+
+	// Type URI типа ошибки, однозначно соответствует code.
+	Type string `json:"type"`
+}
+
+// CheckoutLineError defines model for CheckoutLineError.
+type CheckoutLineError struct {
+	// Code Код ошибки. Коды стабильны, клиентам стоит опираться на них, а не на текст detail:
+	// - `INTERNAL_ERROR` - внутренняя ошибка сервера
+	// - `VALIDATION_FAILED` - запрос не прошёл проверку: неверное тело, параметр или значение
+	// - `UNAUTHORIZED` - нет токена или он недействителен
+	// - `NOT_FOUND` - нет такого метода API
+	// - `FORBIDDEN` - не хватает роли
+	// - `WRONG_PASSWORD` - неверный пароль
+	// - `EMPLOYEE_NOT_FOUND` - сотрудник не найден
+	// - `RECIPIENT_NOT_FOUND` - получатель перевода не найден
+	// - `SELF_TRANSFER_NOT_ALLOWED` - перевод самому себе
+	// - `INVALID_AMOUNT` - сумма перевода не положительная
+	// - `NOT_ENOUGH_BALANCE` - не хватает монет
+	// - `AMOUNT_BELOW_MINIMUM` - сумма меньше минимальной по политике переводов
+	// - `AMOUNT_ABOVE_MAXIMUM` - сумма больше максимальной по политике переводов
+	// - `DAILY_LIMIT_EXCEEDED` - превышен дневной лимит переводов
+	// - `MONTHLY_LIMIT_EXCEEDED` - превышен месячный лимит переводов
+	// - `TRANSFER_COOLDOWN` - повторный перевод этому сотруднику слишком рано
+	// - `TRANSFER_BLOCKED` - переводы между этими сотрудниками запрещены
+	// - `APPROVAL_REQUIRED` - перевод требует одобрения и не может быть в пакете
+	// - `TRANSFERS_REJECTED` - часть переводов пакета отклонена, подробности в recipients
+	// - `TRANSFER_REQUEST_NOT_FOUND` - заявка на перевод не найдена
+	// - `TRANSFER_REQUEST_DECIDED` - по заявке уже принято решение
+	// - `TRANSFER_REQUEST_EXPIRED` - срок заявки истёк
+	// - `SELF_APPROVAL_NOT_ALLOWED` - решение по собственной заявке
+	// - `SCHEDULED_TRANSFER_NOT_FOUND` - запланированный перевод не найден
+	// - `INVALID_SCHEDULE` - неверное расписание перевода
+	// - `MERCH_NOT_FOUND` - товар не найден
+	// - `VARIANT_REQUIRED` - не выбран размер или цвет
+	// - `VARIANT_NOT_FOUND` - нет такого размера или цвета
+	// - `OUT_OF_STOCK` - товар закончился
+	// - `CART_EMPTY` - корзина пуста
+	// - `CART_ITEM_NOT_FOUND` - товара нет в корзине
+	// - `CHECKOUT_REJECTED` - часть позиций корзины нельзя купить, подробности в lines
+	// - `WISHLIST_ITEM_NOT_FOUND` - товара нет в списке желаний
+	// - `ORDER_NOT_FOUND` - заказ не найден
+	// - `ORDER_STATUS_TRANSITION_NOT_ALLOWED` - заказ нельзя перевести в этот статус
+	// - `INVALID_PERIOD` - начало периода позже конца
+	Code  ErrorCode `json:"code"`
+	Color *string   `json:"color,omitempty"`
+
+	// Error Причина, по которой позицию нельзя купить.
+	Error string  `json:"error"`
+	Item  string  `json:"item"`
+	Size  *string `json:"size,omitempty"`
+}
+
+// CoinHistoryMode defines model for CoinHistoryMode.
+type CoinHistoryMode string
+
+// DirectoryEmployee defines model for DirectoryEmployee.
+type DirectoryEmployee struct {
+	DisplayName string `json:"displayName"`
+
+	// Username Имя пользователя, которое указывается в toUser при отправке монет.
+	Username string `json:"username"`
+}
+
+// EmployeeListResponse defines model for EmployeeListResponse.
+type EmployeeListResponse struct {
+	Employees []DirectoryEmployee `json:"employees"`
+
+	// NextOffset Смещение следующей страницы, отсутствует на последней странице.
+	NextOffset *int `json:"nextOffset,omitempty"`
+}
+
+// ErrorCode Код ошибки. Коды стабильны, клиентам стоит опираться на них, а не на текст detail:
+// - `INTERNAL_ERROR` - внутренняя ошибка сервера
+// - `VALIDATION_FAILED` - запрос не прошёл проверку: неверное тело, параметр или значение
+// - `UNAUTHORIZED` - нет токена или он недействителен
+// - `NOT_FOUND` - нет такого метода API
+// - `FORBIDDEN` - не хватает роли
+// - `WRONG_PASSWORD` - неверный пароль
+// - `EMPLOYEE_NOT_FOUND` - сотрудник не найден
+// - `RECIPIENT_NOT_FOUND` - получатель перевода не найден
+// - `SELF_TRANSFER_NOT_ALLOWED` - перевод самому себе
+// - `INVALID_AMOUNT` - сумма перевода не положительная
+// - `NOT_ENOUGH_BALANCE` - не хватает монет
+// - `AMOUNT_BELOW_MINIMUM` - сумма меньше минимальной по политике переводов
+// - `AMOUNT_ABOVE_MAXIMUM` - сумма больше максимальной по политике переводов
+// - `DAILY_LIMIT_EXCEEDED` - превышен дневной лимит переводов
+// - `MONTHLY_LIMIT_EXCEEDED` - превышен месячный лимит переводов
+// - `TRANSFER_COOLDOWN` - повторный перевод этому сотруднику слишком рано
+// - `TRANSFER_BLOCKED` - переводы между этими сотрудниками запрещены
+// - `APPROVAL_REQUIRED` - перевод требует одобрения и не может быть в пакете
+// - `TRANSFERS_REJECTED` - часть переводов пакета отклонена, подробности в recipients
+// - `TRANSFER_REQUEST_NOT_FOUND` - заявка на перевод не найдена
+// - `TRANSFER_REQUEST_DECIDED` - по заявке уже принято решение
+// - `TRANSFER_REQUEST_EXPIRED` - срок заявки истёк
+// - `SELF_APPROVAL_NOT_ALLOWED` - решение по собственной заявке
+// - `SCHEDULED_TRANSFER_NOT_FOUND` - запланированный перевод не найден
+// - `INVALID_SCHEDULE` - неверное расписание перевода
+// - `MERCH_NOT_FOUND` - товар не найден
+// - `VARIANT_REQUIRED` - не выбран размер или цвет
+// - `VARIANT_NOT_FOUND` - нет такого размера или цвета
+// - `OUT_OF_STOCK` - товар закончился
+// - `CART_EMPTY` - корзина пуста
+// - `CART_ITEM_NOT_FOUND` - товара нет в корзине
+// - `CHECKOUT_REJECTED` - часть позиций корзины нельзя купить, подробности в lines
+// - `WISHLIST_ITEM_NOT_FOUND` - товара нет в списке желаний
+// - `ORDER_NOT_FOUND` - заказ не найден
+// - `ORDER_STATUS_TRANSITION_NOT_ALLOWED` - заказ нельзя перевести в этот статус
+// - `INVALID_PERIOD` - начало периода позже конца
+type ErrorCode string
+
+// ErrorResponse Описание ошибки в формате Problem Details (RFC 7807), отдаётся как application/problem+json.
 type ErrorResponse struct {
-	// Errors Сообщение об ошибке, описывающее проблему.
+	// Code Код ошибки. Коды стабильны, клиентам стоит опираться на них, а не на текст detail:
+	// - `INTERNAL_ERROR` - внутренняя ошибка сервера
+	// - `VALIDATION_FAILED` - запрос не прошёл проверку: неверное тело, параметр или значение
+	// - `UNAUTHORIZED` - нет токена или он недействителен
+	// - `NOT_FOUND` - нет такого метода API
+	// - `FORBIDDEN` - не хватает роли
+	// - `WRONG_PASSWORD` - неверный пароль
+	// - `EMPLOYEE_NOT_FOUND` - сотрудник не найден
+	// - `RECIPIENT_NOT_FOUND` - получатель перевода не найден
+	// - `SELF_TRANSFER_NOT_ALLOWED` - перевод самому себе
+	// - `INVALID_AMOUNT` - сумма перевода не положительная
+	// - `NOT_ENOUGH_BALANCE` - не хватает монет
+	// - `AMOUNT_BELOW_MINIMUM` - сумма меньше минимальной по политике переводов
+	// - `AMOUNT_ABOVE_MAXIMUM` - сумма больше максимальной по политике переводов
+	// - `DAILY_LIMIT_EXCEEDED` - превышен дневной лимит переводов
+	// - `MONTHLY_LIMIT_EXCEEDED` - превышен месячный лимит переводов
+	// - `TRANSFER_COOLDOWN` - повторный перевод этому сотруднику слишком рано
+	// - `TRANSFER_BLOCKED` - переводы между этими сотрудниками запрещены
+	// - `APPROVAL_REQUIRED` - перевод требует одобрения и не может быть в пакете
+	// - `TRANSFERS_REJECTED` - часть переводов пакета отклонена, подробности в recipients
+	// - `TRANSFER_REQUEST_NOT_FOUND` - заявка на перевод не найдена
+	// - `TRANSFER_REQUEST_DECIDED` - по заявке уже принято решение
+	// - `TRANSFER_REQUEST_EXPIRED` - срок заявки истёк
+	// - `SELF_APPROVAL_NOT_ALLOWED` - решение по собственной заявке
+	// - `SCHEDULED_TRANSFER_NOT_FOUND` - запланированный перевод не найден
+	// - `INVALID_SCHEDULE` - неверное расписание перевода
+	// - `MERCH_NOT_FOUND` - товар не найден
+	// - `VARIANT_REQUIRED` - не выбран размер или цвет
+	// - `VARIANT_NOT_FOUND` - нет такого размера или цвета
+	// - `OUT_OF_STOCK` - товар закончился
+	// - `CART_EMPTY` - корзина пуста
+	// - `CART_ITEM_NOT_FOUND` - товара нет в корзине
+	// - `CHECKOUT_REJECTED` - часть позиций корзины нельзя купить, подробности в lines
+	// - `WISHLIST_ITEM_NOT_FOUND` - товара нет в списке желаний
+	// - `ORDER_NOT_FOUND` - заказ не найден
+	// - `ORDER_STATUS_TRANSITION_NOT_ALLOWED` - заказ нельзя перевести в этот статус
+	// - `INVALID_PERIOD` - начало периода позже конца
+	Code ErrorCode `json:"code"`
+
+	// Detail Описание конкретной ошибки.
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors То же, что detail. Оставлено для старых клиентов.
+	// Deprecated:
 	Errors *string `json:"errors,omitempty"`
+
+	// RequestId Идентификатор запроса из заголовка X-Request-ID.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Status HTTP-статус ответа.
+	Status int `json:"status"`
+
+	// Title Краткое описание типа ошибки, одинаковое для всех ошибок с этим code.
+	Title string `json:"title"`
+
+	// Type URI типа ошибки, однозначно соответствует code.
+	Type string `json:"type"`
+}
+
+// ExpiringCoins defines model for ExpiringCoins.
+type ExpiringCoins struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// ExpireTime Когда монеты сгорят.
+	ExpireTime time.Time `json:"expireTime"`
 }
 
 // InfoResponse defines model for InfoResponse.
 type InfoResponse struct {
 	CoinHistory *struct {
-		Received *[]struct {
-			// Amount Количество полученных монет.
+		// PendingReceived Входящие переводы, ожидающие решения. Их монеты появятся в coins и received после принятия.
+		PendingReceived *[]TransferRequest `json:"pendingReceived,omitempty"`
+
+		// PendingSent Отправленные переводы, ожидающие решения. Их монеты уже списаны с coins, но ещё не попали в sent.
+		PendingSent *[]TransferRequest `json:"pendingSent,omitempty"`
+		Received    *[]struct {
+			// Amount Количество полученных монет, при history=byCounterparty - сумма всех переводов от пользователя.
 			Amount *int `json:"amount,omitempty"`
 
 			// FromUser Имя пользователя, который отправил монеты.
 			FromUser *string `json:"fromUser,omitempty"`
 		} `json:"received,omitempty"`
 		Sent *[]struct {
-			// Amount Количество отправленных монет.
+			// Amount Количество отправленных монет, при history=byCounterparty - сумма всех переводов пользователю.
 			Amount *int `json:"amount,omitempty"`
 
 			// ToUser Имя пользователя, которому отправлены монеты.
@@ -60,55 +440,610 @@ type InfoResponse struct {
 	} `json:"coinHistory,omitempty"`
 
 	// Coins Количество доступных монет.
-	Coins     *int `json:"coins,omitempty"`
-	Inventory *[]struct {
+	Coins *int `json:"coins,omitempty"`
+
+	// ExpiringSoon Монеты, которые сгорят в ближайшие 30 дней, по датам сгорания.
+	ExpiringSoon *[]ExpiringCoins `json:"expiringSoon,omitempty"`
+
+	// GivingBudget Остаток бюджета на благодарности коллегам в текущем месяце. Его можно только отправить через /api/sendCoin, переводы списывают сначала его, затем монеты.
+	GivingBudget *int `json:"givingBudget,omitempty"`
+	Inventory    *[]struct {
+		// Color Цвет, если у предмета есть выбор цвета.
+		Color *string `json:"color,omitempty"`
+
 		// Quantity Количество предметов.
 		Quantity *int `json:"quantity,omitempty"`
 
+		// Size Размер, если у предмета есть выбор размера.
+		Size *string `json:"size,omitempty"`
+
 		// Type Тип предмета.
 		Type *string `json:"type,omitempty"`
 	} `json:"inventory,omitempty"`
 }
 
+// LeaderboardEmployee defines model for LeaderboardEmployee.
+type LeaderboardEmployee struct {
+	// Amount Сумма переводов за период.
+	Amount int `json:"amount"`
+
+	// Count Количество переводов за период.
+	Count int    `json:"count"`
+	User  string `json:"user"`
+}
+
+// LeaderboardMerch defines model for LeaderboardMerch.
+type LeaderboardMerch struct {
+	// Quantity Количество покупок за период.
+	Quantity int `json:"quantity"`
+
+	// Type Тип предмета.
+	Type string `json:"type"`
+}
+
+// LeaderboardResponse defines model for LeaderboardResponse.
+type LeaderboardResponse struct {
+	// From Первый день периода (UTC).
+	From string `json:"from"`
+
+	// To Последний день периода (UTC, включительно).
+	To string `json:"to"`
+
+	// TopGivers Сотрудники, отправившие больше всего монет.
+	TopGivers []LeaderboardEmployee `json:"topGivers"`
+
+	// TopMerch Самый покупаемый мерч.
+	TopMerch []LeaderboardMerch `json:"topMerch"`
+
+	// TopReceivers Сотрудники, получившие больше всего монет.
+	TopReceivers []LeaderboardEmployee `json:"topReceivers"`
+}
+
+// Order defines model for Order.
+type Order struct {
+	// Amount Стоимость заказа, возвращается при отмене.
+	Amount     int       `json:"amount"`
+	Color      *string   `json:"color,omitempty"`
+	CreateTime time.Time `json:"createTime"`
+
+	// Employee Имя пользователя, купившего предмет.
+	Employee string `json:"employee"`
+	Id       int64  `json:"id"`
+	Item     string `json:"item"`
+
+	// Price Цена одной штуки на момент покупки.
+	Price      int         `json:"price"`
+	Quantity   int         `json:"quantity"`
+	Size       *string     `json:"size,omitempty"`
+	Status     OrderStatus `json:"status"`
+	UpdateTime time.Time   `json:"updateTime"`
+}
+
+// OrderStatus defines model for OrderStatus.
+type OrderStatus string
+
+// OrderStatusRequest defines model for OrderStatusRequest.
+type OrderStatusRequest struct {
+	Status OrderStatus `json:"status"`
+}
+
+// OrdersResponse defines model for OrdersResponse.
+type OrdersResponse struct {
+	Orders []Order `json:"orders"`
+}
+
+// ScheduledTransfer defines model for ScheduledTransfer.
+type ScheduledTransfer struct {
+	// Amount Количество монет в одном переводе.
+	Amount int `json:"amount"`
+
+	// Cron Расписание повторяющегося перевода, пустое для разового.
+	Cron     string `json:"cron"`
+	Id       int64  `json:"id"`
+	IsActive bool   `json:"isActive"`
+
+	// NextRunTime Время следующего перевода, отсутствует если переводов больше не будет.
+	NextRunTime *time.Time `json:"nextRunTime,omitempty"`
+
+	// ToUser Имя пользователя, которому отправляются монеты.
+	ToUser string `json:"toUser"`
+}
+
+// ScheduledTransferListResponse defines model for ScheduledTransferListResponse.
+type ScheduledTransferListResponse struct {
+	ScheduledTransfers []ScheduledTransfer `json:"scheduledTransfers"`
+}
+
+// ScheduledTransferRequest defines model for ScheduledTransferRequest.
+type ScheduledTransferRequest struct {
+	// Amount Количество монет в одном переводе.
+	Amount int `json:"amount"`
+
+	// Cron Расписание повторяющегося перевода в формате cron "минута час день месяц день_недели" (UTC), например "0 12 * * 5".
+	Cron *string `json:"cron,omitempty"`
+
+	// IsActive Выключенный перевод не выполняется.
+	IsActive *bool `json:"isActive,omitempty"`
+
+	// RunAt Время разового перевода. Нельзя указывать вместе с cron.
+	RunAt *time.Time `json:"runAt,omitempty"`
+
+	// ToUser Имя пользователя, которому нужно отправлять монеты.
+	ToUser string `json:"toUser"`
+}
+
+// ScheduledTransferResponse defines model for ScheduledTransferResponse.
+type ScheduledTransferResponse struct {
+	// Runs Последние запуски, сначала новые.
+	Runs              []ScheduledTransferRun `json:"runs"`
+	ScheduledTransfer ScheduledTransfer      `json:"scheduledTransfer"`
+}
+
+// ScheduledTransferRun defines model for ScheduledTransferRun.
+type ScheduledTransferRun struct {
+	// Error Причина неудачного перевода.
+	Error   *string                    `json:"error,omitempty"`
+	RunTime time.Time                  `json:"runTime"`
+	Status  ScheduledTransferRunStatus `json:"status"`
+}
+
+// ScheduledTransferRunStatus defines model for ScheduledTransferRun.Status.
+type ScheduledTransferRunStatus string
+
+// SendCoinBatchErrorResponse defines model for SendCoinBatchErrorResponse.
+type SendCoinBatchErrorResponse struct {
+	// Code Код ошибки. Коды стабильны, клиентам стоит опираться на них, а не на текст detail:
+	// - `INTERNAL_ERROR` - внутренняя ошибка сервера
+	// - `VALIDATION_FAILED` - запрос не прошёл проверку: неверное тело, параметр или значение
+	// - `UNAUTHORIZED` - нет токена или он недействителен
+	// - `NOT_FOUND` - нет такого метода API
+	// - `FORBIDDEN` - не хватает роли
+	// - `WRONG_PASSWORD` - неверный пароль
+	// - `EMPLOYEE_NOT_FOUND` - сотрудник не найден
+	// - `RECIPIENT_NOT_FOUND` - получатель перевода не найден
+	// - `SELF_TRANSFER_NOT_ALLOWED` - перевод самому себе
+	// - `INVALID_AMOUNT` - сумма перевода не положительная
+	// - `NOT_ENOUGH_BALANCE` - не хватает монет
+	// - `AMOUNT_BELOW_MINIMUM` - сумма меньше минимальной по политике переводов
+	// - `AMOUNT_ABOVE_MAXIMUM` - сумма больше максимальной по политике переводов
+	// - `DAILY_LIMIT_EXCEEDED` - превышен дневной лимит переводов
+	// - `MONTHLY_LIMIT_EXCEEDED` - превышен месячный лимит переводов
+	// - `TRANSFER_COOLDOWN` - повторный перевод этому сотруднику слишком рано
+	// - `TRANSFER_BLOCKED` - переводы между этими сотрудниками запрещены
+	// - `APPROVAL_REQUIRED` - перевод требует одобрения и не может быть в пакете
+	// - `TRANSFERS_REJECTED` - часть переводов пакета отклонена, подробности в recipients
+	// - `TRANSFER_REQUEST_NOT_FOUND` - заявка на перевод не найдена
+	// - `TRANSFER_REQUEST_DECIDED` - по заявке уже принято решение
+	// - `TRANSFER_REQUEST_EXPIRED` - срок заявки истёк
+	// - `SELF_APPROVAL_NOT_ALLOWED` - решение по собственной заявке
+	// - `SCHEDULED_TRANSFER_NOT_FOUND` - запланированный перевод не найден
+	// - `INVALID_SCHEDULE` - неверное расписание перевода
+	// - `MERCH_NOT_FOUND` - товар не найден
+	// - `VARIANT_REQUIRED` - не выбран размер или цвет
+	// - `VARIANT_NOT_FOUND` - нет такого размера или цвета
+	// - `OUT_OF_STOCK` - товар закончился
+	// - `CART_EMPTY` - корзина пуста
+	// - `CART_ITEM_NOT_FOUND` - товара нет в корзине
+	// - `CHECKOUT_REJECTED` - часть позиций корзины нельзя купить, подробности в lines
+	// - `WISHLIST_ITEM_NOT_FOUND` - товара нет в списке желаний
+	// - `ORDER_NOT_FOUND` - заказ не найден
+	// - `ORDER_STATUS_TRANSITION_NOT_ALLOWED` - заказ нельзя перевести в этот статус
+	// - `INVALID_PERIOD` - начало периода позже конца
+	Code ErrorCode `json:"code"`
+
+	// Detail Описание конкретной ошибки.
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors То же, что detail. Оставлено для старых клиентов.
+	// Deprecated:
+	Errors *string `json:"errors,omitempty"`
+
+	// Recipients Отклоненные переводы.
+	Recipients *[]SendCoinBatchRecipientError `json:"recipients,omitempty"`
+
+	// RequestId Идентификатор запроса из заголовка X-Request-ID.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Status HTTP-статус ответа.
+	Status int `json:"status"`
+
+	// Title Краткое описание типа ошибки, одинаковое для всех ошибок с этим code.
+	Title string `json:"title"`
+
+	// Type URI типа ошибки, однозначно соответствует code.
+	Type string `json:"type"`
+}
+
+// SendCoinBatchRecipientError defines model for SendCoinBatchRecipientError.
+type SendCoinBatchRecipientError struct {
+	// Code Код ошибки. Коды стабильны, клиентам стоит опираться на них, а не на текст detail:
+	// - `INTERNAL_ERROR` - внутренняя ошибка сервера
+	// - `VALIDATION_FAILED` - запрос не прошёл проверку: неверное тело, параметр или значение
+	// - `UNAUTHORIZED` - нет токена или он недействителен
+	// - `NOT_FOUND` - нет такого метода API
+	// - `FORBIDDEN` - не хватает роли
+	// - `WRONG_PASSWORD` - неверный пароль
+	// - `EMPLOYEE_NOT_FOUND` - сотрудник не найден
+	// - `RECIPIENT_NOT_FOUND` - получатель перевода не найден
+	// - `SELF_TRANSFER_NOT_ALLOWED` - перевод самому себе
+	// - `INVALID_AMOUNT` - сумма перевода не положительная
+	// - `NOT_ENOUGH_BALANCE` - не хватает монет
+	// - `AMOUNT_BELOW_MINIMUM` - сумма меньше минимальной по политике переводов
+	// - `AMOUNT_ABOVE_MAXIMUM` - сумма больше максимальной по политике переводов
+	// - `DAILY_LIMIT_EXCEEDED` - превышен дневной лимит переводов
+	// - `MONTHLY_LIMIT_EXCEEDED` - превышен месячный лимит переводов
+	// - `TRANSFER_COOLDOWN` - повторный перевод этому сотруднику слишком рано
+	// - `TRANSFER_BLOCKED` - переводы между этими сотрудниками запрещены
+	// - `APPROVAL_REQUIRED` - перевод требует одобрения и не может быть в пакете
+	// - `TRANSFERS_REJECTED` - часть переводов пакета отклонена, подробности в recipients
+	// - `TRANSFER_REQUEST_NOT_FOUND` - заявка на перевод не найдена
+	// - `TRANSFER_REQUEST_DECIDED` - по заявке уже принято решение
+	// - `TRANSFER_REQUEST_EXPIRED` - срок заявки истёк
+	// - `SELF_APPROVAL_NOT_ALLOWED` - решение по собственной заявке
+	// - `SCHEDULED_TRANSFER_NOT_FOUND` - запланированный перевод не найден
+	// - `INVALID_SCHEDULE` - неверное расписание перевода
+	// - `MERCH_NOT_FOUND` - товар не найден
+	// - `VARIANT_REQUIRED` - не выбран размер или цвет
+	// - `VARIANT_NOT_FOUND` - нет такого размера или цвета
+	// - `OUT_OF_STOCK` - товар закончился
+	// - `CART_EMPTY` - корзина пуста
+	// - `CART_ITEM_NOT_FOUND` - товара нет в корзине
+	// - `CHECKOUT_REJECTED` - часть позиций корзины нельзя купить, подробности в lines
+	// - `WISHLIST_ITEM_NOT_FOUND` - товара нет в списке желаний
+	// - `ORDER_NOT_FOUND` - заказ не найден
+	// - `ORDER_STATUS_TRANSITION_NOT_ALLOWED` - заказ нельзя перевести в этот статус
+	// - `INVALID_PERIOD` - начало периода позже конца
+	Code ErrorCode `json:"code"`
+
+	// Error Причина, по которой перевод отклонен.
+	Error string `json:"error"`
+
+	// Index Номер перевода в запросе, начиная с 0.
+	Index  int    `json:"index"`
+	ToUser string `json:"toUser"`
+}
+
+// SendCoinBatchRequest defines model for SendCoinBatchRequest.
+type SendCoinBatchRequest struct {
+	Transfers []SendCoinRequest `json:"transfers"`
+}
+
 // SendCoinRequest defines model for SendCoinRequest.
 type SendCoinRequest struct {
 	// Amount Количество монет, которые необходимо отправить.
 	Amount int `json:"amount"`
 
+	// RequireAcceptance Перевод ждёт, пока получатель примет или отклонит его. Если получатель не решит вовремя, перевод принимается автоматически.
+	RequireAcceptance *bool `json:"requireAcceptance,omitempty"`
+
 	// ToUser Имя пользователя, которому нужно отправить монеты.
 	ToUser string `json:"toUser"`
 }
 
+// StatsPrivacy defines model for StatsPrivacy.
+type StatsPrivacy struct {
+	// Hidden Не показывать сотрудника в рейтингах.
+	Hidden bool `json:"hidden"`
+}
+
+// TransferRequest defines model for TransferRequest.
+type TransferRequest struct {
+	// Amount Удерживается с баланса отправителя, пока перевод ожидает решения.
+	Amount     int        `json:"amount"`
+	CreateTime time.Time  `json:"createTime"`
+	DecideTime *time.Time `json:"decideTime,omitempty"`
+
+	// DecidedBy Одобряющий или получатель, принявший решение.
+	DecidedBy *string `json:"decidedBy,omitempty"`
+
+	// ExpireTime Если до этого времени решения нет, монеты возвращаются отправителю, а перевод, ожидающий получателя, принимается.
+	ExpireTime time.Time `json:"expireTime"`
+	FromUser   string    `json:"fromUser"`
+	Id         int64     `json:"id"`
+
+	// Kind approval - перевод ждёт одобряющего, acceptance - получателя.
+	Kind TransferRequestKind `json:"kind"`
+
+	// RequireAcceptance После одобрения перевод будет ждать решения получателя.
+	RequireAcceptance bool                  `json:"requireAcceptance"`
+	Status            TransferRequestStatus `json:"status"`
+	ToUser            string                `json:"toUser"`
+}
+
+// TransferRequestKind approval - перевод ждёт одобряющего, acceptance - получателя.
+type TransferRequestKind string
+
+// TransferRequestStatus defines model for TransferRequestStatus.
+type TransferRequestStatus string
+
+// TransferRequestsResponse defines model for TransferRequestsResponse.
+type TransferRequestsResponse struct {
+	TransferRequests []TransferRequest `json:"transferRequests"`
+}
+
+// WishlistItem defines model for WishlistItem.
+type WishlistItem struct {
+	// AddedPrice Цена на момент добавления в список.
+	AddedPrice int `json:"addedPrice"`
+
+	// Affordable Хватает ли текущего баланса на покупку.
+	Affordable bool   `json:"affordable"`
+	Item       string `json:"item"`
+
+	// MissingCoins Сколько монет не хватает, 0 если хватает.
+	MissingCoins int `json:"missingCoins"`
+
+	// Price Текущая цена.
+	Price int `json:"price"`
+
+	// PriceDrop На сколько подешевел предмет с момента добавления, 0 если не подешевел.
+	PriceDrop int `json:"priceDrop"`
+}
+
+// WishlistItemRequest defines model for WishlistItemRequest.
+type WishlistItemRequest struct {
+	// Item Название предмета.
+	Item string `json:"item"`
+}
+
+// WishlistResponse defines model for WishlistResponse.
+type WishlistResponse struct {
+	Items []WishlistItem `json:"items"`
+}
+
+// GetApiAdminExportParams defines parameters for GetApiAdminExport.
+type GetApiAdminExportParams struct {
+	Format *GetApiAdminExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Первый день периода (UTC) в формате YYYY-MM-DD.
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Последний день периода (UTC, включительно) в формате YYYY-MM-DD.
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Employee Выгрузить только переводы, покупки и возвраты этого сотрудника.
+	Employee *string `form:"employee,omitempty" json:"employee,omitempty"`
+}
+
+// GetApiAdminExportParamsFormat defines parameters for GetApiAdminExport.
+type GetApiAdminExportParamsFormat string
+
+// GetApiApprovalsTransferRequestsParams defines parameters for GetApiApprovalsTransferRequests.
+type GetApiApprovalsTransferRequestsParams struct {
+	// Status Статус переводов, по умолчанию - ожидающие решения (pending).
+	Status *TransferRequestStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiBuyItemParams defines parameters for GetApiBuyItem.
+type GetApiBuyItemParams struct {
+	// Size Размер, обязателен для предметов с выбором размера (S, M, L, XL, XXL).
+	Size *string `form:"size,omitempty" json:"size,omitempty"`
+
+	// Color Цвет, обязателен для предметов с выбором цвета.
+	Color *string `form:"color,omitempty" json:"color,omitempty"`
+}
+
+// DeleteApiCartItemsItemParams defines parameters for DeleteApiCartItemsItem.
+type DeleteApiCartItemsItemParams struct {
+	// Size Размер, обязателен для предметов с выбором размера (S, M, L, XL, XXL).
+	Size *string `form:"size,omitempty" json:"size,omitempty"`
+
+	// Color Цвет, обязателен для предметов с выбором цвета.
+	Color *string `form:"color,omitempty" json:"color,omitempty"`
+}
+
+// GetApiEmployeesParams defines parameters for GetApiEmployees.
+type GetApiEmployeesParams struct {
+	// Query Строка поиска, без неё возвращаются все сотрудники по алфавиту.
+	Query  *string `form:"query,omitempty" json:"query,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiInfoParams defines parameters for GetApiInfo.
+type GetApiInfoParams struct {
+	// History Вид истории монет в coinHistory.received и coinHistory.sent:
+	// list - каждый перевод отдельно, byCounterparty - одна запись на сотрудника и направление
+	// с суммой всех переводов, сначала самые крупные.
+	History *CoinHistoryMode `form:"history,omitempty" json:"history,omitempty"`
+}
+
+// GetApiStaffOrdersParams defines parameters for GetApiStaffOrders.
+type GetApiStaffOrdersParams struct {
+	// Status Статус заказов, по умолчанию - незавершённые (placed и ready_for_pickup).
+	Status *OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiStatsLeaderboardParams defines parameters for GetApiStatsLeaderboard.
+type GetApiStatsLeaderboardParams struct {
+	// From Первый день периода (UTC) в формате YYYY-MM-DD, по умолчанию - начало текущего месяца.
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Последний день периода (UTC, включительно) в формате YYYY-MM-DD, по умолчанию - сегодня.
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Размер каждого рейтинга.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PutApiAdminAllowanceJSONRequestBody defines body for PutApiAdminAllowance for application/json ContentType.
+type PutApiAdminAllowanceJSONRequestBody = AllowanceRequest
+
 // PostApiAuthJSONRequestBody defines body for PostApiAuth for application/json ContentType.
 type PostApiAuthJSONRequestBody = AuthRequest
 
+// PostApiCartItemsJSONRequestBody defines body for PostApiCartItems for application/json ContentType.
+type PostApiCartItemsJSONRequestBody = CartItemRequest
+
+// PostApiScheduledTransfersJSONRequestBody defines body for PostApiScheduledTransfers for application/json ContentType.
+type PostApiScheduledTransfersJSONRequestBody = ScheduledTransferRequest
+
+// PutApiScheduledTransfersIdJSONRequestBody defines body for PutApiScheduledTransfersId for application/json ContentType.
+type PutApiScheduledTransfersIdJSONRequestBody = ScheduledTransferRequest
+
 // PostApiSendCoinJSONRequestBody defines body for PostApiSendCoin for application/json ContentType.
 type PostApiSendCoinJSONRequestBody = SendCoinRequest
 
+// PostApiSendCoinBatchJSONRequestBody defines body for PostApiSendCoinBatch for application/json ContentType.
+type PostApiSendCoinBatchJSONRequestBody = SendCoinBatchRequest
+
+// PostApiStaffOrdersIdStatusJSONRequestBody defines body for PostApiStaffOrdersIdStatus for application/json ContentType.
+type PostApiStaffOrdersIdStatusJSONRequestBody = OrderStatusRequest
+
+// PutApiStatsPrivacyJSONRequestBody defines body for PutApiStatsPrivacy for application/json ContentType.
+type PutApiStatsPrivacyJSONRequestBody = StatsPrivacy
+
+// PostApiWishlistJSONRequestBody defines body for PostApiWishlist for application/json ContentType.
+type PostApiWishlistJSONRequestBody = WishlistItemRequest
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xXy27bRhT9FWLaJSspfQCBdnbRh7MKmhRZGF7Q0thianHomaELwRCgR9wmsGEXXRUB",
-	"WqPtD9CqVNOyRP3CnT8q7iVlvW0ZsQMU8U4cjnjPnDl3zpl9VhBlX3jc04rl95kqlHjZoZ8rgS59x3cD",
-	"rjQ++lL4XGqX00vfUepHIYv4u8hVQbq+doXH8gxOITQ1iOHSHFnQhktzYkFomqYBHeibBkTmFUTQhdD8",
-	"BBFEGWazLSHLjmb50Wdtpis+Z3mmtHS9bVa1WaC49Jwyn1PyN+hhlUFSFc4hhhaEVJHKL4diqmLVZpLv",
-	"Bq7kRZZfH5W3Ryg3rv4kNl/ygkaYCW3KF57is7xp8QP3Zlfw5MXzT0wDYugivCvAbYhN3TRMEwYQWtC1",
-	"4BxC8wYi8wbnQd8cQs8yNeiYummamqlDCL35a5kB+pWUQi5GyvG1mkP2nxBDDGcphAg6Fj5aEJvXEMEZ",
-	"LsHGoQFEpm4OaSeOaXbHggFJ4wwuoQM901wS6pq3JRYjLQjX+9ZVWsjK7EvJC9zd4yRUV/Oymp3ilEXg",
-	"6TkrfYt6gsj8TPw2oAXxUGRNHEx2wBxY0IMY+tAxjbEFuZ7m21wi/i0pyt8rLm8tXduCLsSoDFMzh3Bh",
-	"0QOSGEILIrgcK20Ol2QzHXCkdCr4rHiy+juhZxzf5S0o0uKdCYIYJTUHgjl8d5rmzUDhqWWJGe/l5Shx",
-	"vT3uDVW9YHN2A8fTrq4srV48LKANPSyLVC7YDRqZ+eRfEMFg+iPhnfH5jHvFL4XrLXSd20nxit6pLupY",
-	"OExn2AHE0IYIp061lmmYo3tXat804V/ozy2+hGTH7SlFZQ85mrUm6vRCIF1deYYun1C6yh3JJZoWPm3S",
-	"09dDO37y4jmzk0yAX0rejqCUtPZZtUpS3RJkb67ewTcrT9eslT1XC0uVhM9stselSmh6lMllcsij8Lnn",
-	"+C7Ls89oCH1VlwhU1vHdrJNi8kUiBRSCg1yvFVmePRVKr/guAU+Y4EqvimIlcQRPp4ea4/s7boH+l32p",
-	"hDcKOfjrY8m3WJ59lB2loGwagbLj+ac6SbeWAaeBxJII86e53B2XTv2Oak9J7W9ThwF0zGvoQ3httDEn",
-	"GST78ztENxkc5sH7HTrQgo6pUUK5oNiSWL+pp3AevWc4IbTS3ouGnQl9wvLFe6XmV2x606ATFL3xBHdv",
-	"FJ1Cy9SJuIS+MJP0bVAuO+gDDH5ZvNEWRNPxBNPZZLaEMGPBKdJgkYKwUoxbdE04XnSmHSHYGM6hDSGd",
-	"U3WSYsI09GheeiZDFzM2roV6ezOoZPfR0qrI6Daf0+DfcOzv1aCypnmZDgfplLnmmEnX95nr0XWBuj+5",
-	"FZBFsuk2tcd2bvoE3Zjfwot7bRTBWoltP3TW/72zUkckTY174foGymOs8d5SckuteSIB0SZM2fWV0ofO",
-	"eI3G8XbD7tFNJm5PN7jJg8I/XIWfXllHqvII+uYVrbuXesyxNRGsITQHNs3DmmQdIaIjJ4ooiSesRRbB",
-	"DqFP29klX7kYaxOVRv8bA9/wjnBPoW/6CrJ88HvoqYeemu2pP6691lnQNjXThH+GF8L5Oes4k0C/qSyX",
-	"e8N4FMid9IKWz2Z3RMHZKQml849zj3OsulH9LwAA//8p9wCT9xUAAA==",
+	"H4sIAAAAAAAC/+09a3Mbx5F/ZYuXD3IOlCjZuaRUdR9AErJg83UkZdln6ZgVsDQRgwCyAGQxLlVRpCXb",
+	"JZ14cuUqqdTFji91+QxShAS+oL8A/KPrx8zuzO7sYkESIiUhVbGIfczO9HT39Lu/HsmVVyvlklOqVUeu",
+	"fj1Sza04qzb9mS4Wy1/ZpZyDPypuueK4tYJDt+zVcr1Uw79qaxW4P1Io1ZwvHHfkfmok55ZLyp1qzS2U",
+	"vsAbhWo6VyvcdZSbd8rlomOX8G7JuVf70LVLtcXCKj2Sd6o5t1CpFXC4kc5fO43ut51G56DTtroP4J9m",
+	"Z7e72X3a/R7+eg4XO686ze56p9Vpd3Y7DatzRC+0xLNH8NfWxZHUyHLZXbVh6iN5u+aM1vBjqeBcYTqu",
+	"8/t6wXXyI1c/l6sVS1MWctt7s3znd06uhuvwoDYPIzjVWhzwAkv8C0z9AOb5LSzkQXejs4OrOoSLR3Bh",
+	"I6UtqbsFizrsPu4+tDr7AJYXsOg2XNhE4LS7G9317iZcgmV39uFi5yWCRIHQRX/Vhr0LTOxv8N0H8DZ+",
+	"uUFjNg3w7exZnR2r+w18fh2m3YAVNC0c0bo1Ar9bnSPYrw2YB+5j94EF04P3uk9wjbjgre4j79oSrpl+",
+	"AEBujVgXbixOvMcQ6LyiNeA76zDymDVmXbZ+af3y1sjF8E7qWBdY1k8MDII1LqsNEOe14N/GJdJF+M9O",
+	"9zHAA7frCHcC4A3zh/nh3Nrw301CSxgInmtqgO8+FkNomwl4TEMoS/Bo40TYWK+tRCJixa5Wvyq7eRNo",
+	"YHK4kgPcnl2coQVXcPtwezZg2t8gZsG1R/BvSyMsb1jDbtSrjluyjQT+Z8DeLYuh2n0CCIvbQUjEn082",
+	"i3hK9j6f8mcZDbYq8MWqgfvVyl86BjL56ObiKEyqDROC6XkT3mUMA3J8hTS4T7QI6NFSUOTQAmgjDWwC",
+	"3SKNHZrXEprohO3WsjVnNXKPc+Vi2TVA+x8AXGYq7c42oPBLCWpt7ojOSIZIbLgyIO8HjPzbROVtnPkj",
+	"HqrTMNMfzM7MzuGbOz470T9lHuv3dTgfCrU1w3g/A9QZcfbxgPiOAL5P0Ie5NuBLLbj0BIddte8VVusw",
+	"qctjY/CrUBK/TAyxWviDY2aIMHtiQacBwXV/OOPKA1hMIFWgcTsCMaYKJaeP4+dnmiByVkbYJ0yMLwFy",
+	"QfJSjwyJYJE7H7pRcQs5E1T/FyC1T6yzAaCDjyJ5NCw60JEj73n7GjUZFUGiN7M/6Mr5piTkosAdzTCK",
+	"sBH0B45Nf/zCdZZhgH+65Etfl4TodcnbOp/gbde11+h3uWYXk+3dDjCSJp7J+4RoL+kAfkx7ahGiMqhb",
+	"TMIEao3teIALwIcXI6diBMaKk/uyXK9lXLfsqlCxi8VZWPbn8evXX7ufioRm6MxSkDXF697AtfMZfEQH",
+	"CRwsSJb7xI49lpBsX8SycG9ojuENCrPo2wo8/BcNbDrv9Po+vTqBD8aSnSO/YJJ2UOLAnU4xHvgwIvJS",
+	"6b37NB5kycm9L7Lj2acYIEbkKhdK1wvVWtldmxZAyzvLdr2IokcRbuAgJWTon8ufd9YmkHAdtwKUpbJL",
+	"f46TMI0cjplZrRTLa46BiPOFaqVor80I6eX0RJuUvg1NizgcnAiAt3hACukSheta+QZ8hU+VlkUvvaLT",
+	"YweFDkVZ6EsUUldmArmEyRSAM5rJOeKp5IwuDHQDx0O9cHZ5ueoYjyw6NL/3hPOQYghHBrLDdZYzuo+6",
+	"j1MENhK1NljLAnADxCw+a14hA+VBCP2DA3SaCVikDwojOD06NquAuzjD72A923TQWXwRWLdQVLbhFqLR",
+	"ES1mHzUkIQ83kJc/4KMAV9Qmgl1HVOs+YRw6YtW41X0I7zZ8VUQeCfC2lXdqdqF49VZp1PptdmYxMz+T",
+	"nlrKzM/Pzv/WGgU0ZEWOBBsUX7dwXH/GDdwFlGR2WJ6hYT5JT2Un04vZ2Zmla+nsVGaSRnoptLk2ykRH",
+	"UgqEobrPOgdSmeJhgPtcZYbEv1lDEwJXm9hZg3aJBC3QDBFISCMvhaIlUIRmc2MmfWPx+ux89t/FRIhm",
+	"LF94R8iI99sozElldE/o5S1f0qMBZ2YXl67N3pgJjNYgofQ5q/Es/6FpIj2Xpbeuzc6PZycnMzPyLav7",
+	"UDCGBg+xzhYBevrm/OzMh0tz6YWFm7Pz3ockOIBX7EkgEJehdzLTc1Ozn2UyS9oEwxYCHw86e6yC0+vz",
+	"mYnsXDYzs6i/LxjZJunxzMSeSB0XJ+QZYAxDLmSmri0tzqdnFq5l5mnY9NTU7M2MHFgZw2JNyDdrNAG/",
+	"mgIrCaGW0tMwp0VeE6zmEM0OUTNhdb3deSF3D0kIJU1vBzMzszc+vL40np5Kz0xkojbFZ7L0Ik9haTwD",
+	"q1iazs5kp29MByd0yEYNIBHi0S0C+iEas2gO8uiVc8T5tZih60sBYlC/mR6f/SSzNJ3+1PTNbUYD+c0G",
+	"0vbJvjoJhPvZ0lR2Ogug+nQik5mUu8YPdx/jx1j3YcwUHzmg7xJHMo88PTuzeD3h2NJW9K3E+Z6je9g2",
+	"MTs7NTl7c8bD4R1x6HrUo2HffxLBRprU6JhowbT2fSUOFqx/cnxqduJjE3KjJI5o8QIPK/4WLcPwLSKC",
+	"ls8txYHXfcy4MDc3PwvUsDSf+bcb2XkzITGz3pYnHUNnW3DwFvLvlqCSQ6IQemobgM6aBDEWRA0kHG2B",
+	"C/DZjzITi/xZNuxJ1TGwF+ogDZZd9okgj5jjskgK4Fgnlf1IGOZa+H2QEwqVAgoPOnhxzZmFIHtCTXyL",
+	"RKKGd6qr4AhxJnFIhYadBP7nISJSij8ySWkv5JHVomNwAzUr3J/vtNMmNGzm0zm5UbBEXO6+OjSsuEVQ",
+	"fNbZ93mmt88Bnql/UKh3D2h7+ayS9sw9bfo88MT1zOQNOI11jqxBEgY8EMIPn8YNYbPa6wlWjVXLbwUP",
+	"Lj7H100W5gAfZ1aRmZ+4HjjONoQ8vR4xhU/S89k08EuNQjwz7jZTrmaFkae/tG1pw/Q87HV7TnAssZDZ",
+	"G4tLs9eWFhaBQQRX8VIMdkTq2gHyO3pnIj0P2DM9t/gZfVpR7AnJ4UR+4I1Pz2YXM9NRwBIHI1L6jj4W",
+	"Iwfs18THOMtoCvf17bCZIUp3jCNz0uxZ2MkuXJ/KLvSxAg996AR7QeIZY9Iew3t+0ojepGlFIA6/s7CY",
+	"XryxwCSSJRE2KLbo4/jL9hG46S+SzxXEGdosNGh1H2ikMpeZz85K7PK9XkEHF8OfeBAjyyPcekX91WV3",
+	"uBGSwuGaKgvDTw9A8Lcnn8LfuvQJF8KiJVw0CIxwNVLmg3u6JCcmoAticNEkZPmXNTkILpsEFbhsljLg",
+	"RkhAUK+JExy/Fjxplcf8c1B9N3Q8mW6KQ8Z0SxwUEoSmIwDvxXBxBcLyMYSEzkQJM3QWqVxSH1OZFvz0",
+	"+ZH8oZMrXg1yEcQlM3Hj+DqReldiSVBZI1NO2MKTGrk3ilQxetcmo0cVycPTw7NoGyrZxYywPXk3PrGL",
+	"hbyNuvk1UIidvHrvRsmu11bKbuEP+vWZcu1auV7Srl0ru3cK+bxTUi/edMulL+Z8X5l3Q1pDTCPNS0HI",
+	"dHPBKS4vunapuuy4cJ880frksqW7uKS0dCGqs86UyvUvVsbtIrn8lXv89LgDo00LZ0nobvpO+a4zLRwr",
+	"yt1JgNvaVGG1UMvcyzlOXp/OdLlUW4m5LxczUS4X8+WvSqZ748Vy7kv9tXSl4pZhofPSJGN4rTrvoD3G",
+	"fFN400wwDjwyCfuRjx0kc68SnAPuk5yjeZ8WcitOvg4Yp2xnaCpiM+WzGmAdN7dieucT2y3YpZoJMuKW",
+	"6a3Zem12eaEGgFavop8CcJVcJNpFdEiahpF2cBPobxaqK2iujXp31s2boUA3Fmp2rV4lYBWQXGOxf85x",
+	"C+X8yG1pilNNmgFz3I9BkVQxzBmCHebc8p2is2pNkgGtal2YvzZh/fo3Y79+j62OeG53n0lzLkoM+5Zd",
+	"qRQLOWIylyr8/j//rlouXSTH04l8BGzHS7Iskh9ASlsnAVVoC6oR0mTwJ1O9cMRUQEOzcUuv1ty6kwp7",
+	"9tokk6VAgCQ1iad20er8KIyaMvKiLT2nQjpalyEuvpUTpD/jfFwmuGzeaIHfDcUMkO6vGSFZWH/J154L",
+	"exHrkZ+OCnoezU4av14lFAx/+vri4tyoKuoxJoRd5oqjslaoFY3WYbbl0nY1pXnX30ayIbxi5drbuhSr",
+	"+6Qj0Is7/LLwT5OXECEsX0FlFCYpLRIWYp1xwXwhOMcb89nYeRyR0Mpi7ZFUVT2AaAZ5+WXnng0nIn68",
+	"7pau2ncLtfJodaVcuSrI5apRZIz3ftBdCWhv82LcTsTGYSR0P1VPLabLvP0OHRkRcXB/ISVzl82KYhjy",
+	"DSDCArls8agnCXNTvm8CRba0XI52A+V8/5wh4sgp5eHTIMU4hbuOiVB/6D4k7XCLXNPNkNWM0OgF3EJW",
+	"+lQ8pNpAulvAVf6MGK2Ch/w6W4BaW74zDWdaRcuXK6ajeH90s44IHUzk1Aoc/yaXloDCgmPEmB8Vp95B",
+	"OIjspJAQZivV2kLow+CgEDvA0Gb3++4zxXD+inRQOvOqMO3ThIarIIM35kmoy/dOCOBpIEhJ7+kKI+m/",
+	"6t7hgBXdY48Gc2abTc5Gn66ZsJfd8io6cE/kImbTm+r6baGzTNnjhLFjwY2oCnw8lU1om7B4QBthBtrT",
+	"iLO1fOIdEF6B8BrZqH+yfTA9kZOHThLIq/GGQZjHnDfIkMrG6N//8VcUiqpRjx2yI26ToPYCDWl09jet",
+	"98ekQ2hPRp3sCnfaofe+sDAnZ7P6eWzA5i8Kd+H2eD3/hWPmskIkY+v7dvcpzOqFdE4csQftQIiBu2Rt",
+	"VEyVFGtIe/6cloGqgB9S1cQrXkxzpwls+L+lFxhZ9hFHYPnhijo1k3WV9hTx/KV1ya4ULgFx5nGtqbAf",
+	"SbJyDhfBSGK85FsOYSkUIZ+yvBjFwyg8VbCiULoLEBfHeARP6B1d2mQ3mYX0EgjxtBhrOVZNxEP2iiaN",
+	"iQA1nwXB8EvzWhOFefa9lF5hnVEyNKhLID8nCYk9HjuZcmzQme+UbTcfHe4UEyYa4WgnXpwwz6C/8/yY",
+	"X6kLRt87EMoP7pRzux0PNzKwhIHWN3oi9yFOLX2ACZZ1CkhjUIVio4mVlUdL/ijdGMMyKRiIxRYv5UP3",
+	"ZVB6h5lCyuZITyVIq9Vj4JRFOjxIBJRv4cd+tKM+WfkQBFK3agw4CzrnW6mgMLYjDj49BIPDcZ+H1L9E",
+	"p52JYo3RwRUPMUMTb2C6kAzyZKRrcA4RXiQu1f32ODPiT5qnIzS9PmDpS+9nB8kAhRBiEyoG1qTiigJ9",
+	"EwWRmfJkwfiel5FiJXbIkLJDFqHv1ShRJTaU4o7MEYtxEcQ517Frnv0hiSUh5YU8HkuwZu8wbbfM5VO5",
+	"mDncOK9NDhb2Lx+Y5Zh+0xD+EZd3IGRDikpji6JKUKebmKAaFeNwWjGB07FXyfe5fcFQbLSaexua6p0R",
+	"oRrQfNzRZhJJEwveEqXbulK0c2S4h6Hya0uwgKVKIfdlvYKhyk4RyY1u59BRVUS3nCmiWxk8MjnqGOAN",
+	"gEqMELm6avRxWab7iYOlmYH0YlViUNN8Qj6lU7NikuInieUwKLA1T55qqsTqUaqkSPkV/E6PEkr5gTCK",
+	"nVvI4mz9hldPyFJ6ZjLP10sR9tsfaLKH5OGIzmJWlxMRri7VEZN8rJ2XZMTbpmO2mdw4PDhLiZ/tmsBa",
+	"EuJLYlap/vJgQ8gfn8tQDT6enE7DZNaLZg0fS7SE004wP1dkfDap5JevYCa59atEueQi6cjo8PwBpGqp",
+	"cjTjAyaNqeSmPHDAmnopXYvnKQE+F4LrRavzVyVETU81EhYMAcQNsu8R2M+EaRyRq+IoZE0mH86TPnlH",
+	"kG0kpLAoBgE7UU2gmDale3mTAhNBvwla5qjqAJpSE2sw4VnWS0Zzvum875Nz9eJUIymGRDJo1kuGrLEk",
+	"2Yoc5rlJdlhyG5tR2xgU4B/EyRC4GpJHq/VczqmicLvMUWC3eyGb/GgqTj5cEBbdcbuWWxlQqqwfNx/h",
+	"aFQj8M1uxuR4qa7Hi1PrK1E2boiTp8yeLDFWY9yB5AXzYVHKO/eMlQ/aMsjccOZp4ShNv+YLpyohPx7r",
+	"5dfqIUTRtBQ5qmfGbWBXIoSOWv+SkhhX8Q2v2vey/KqszSB/9pChavGiU+BLp1iSx5BkjqkXD0XQzaHR",
+	"u2PeQbGeNHCbSk3WXfLEjGW7WA3LGT/peInZRM/YtUqpjI2oXD1P7tnwEx0VnKZsKhLO0HflKxqGoY78",
+	"qAN6a4cONCGPpEKUI8IqRCaaZ7RqsGAoZD0B74A5RRGEBi9ltAYlY8CBUJ1zC3ftnCE+ZoWjhE1Mo+nt",
+	"qSatmdLFSHZep3TVDQL2c3j4YYLSQuLzpnkfX+n4O+md6xSqoqazo9i+zWIQbMIDmROmbIC3aQoy62xY",
+	"xr/IbFk19iVCXenftJmnGN/jvJMfXzNGX4rsO6EEkf+iFUliKSUWSVjE9wJZX+bwzLgoMo+md9EXzZmO",
+	"JFMJ0uWRAyC1PK6nhVYFLNG+cm/azqecdq5tpCGSac8Eiq1UBP9Irp6oETjHt/98CYdon5FPH+Mr0Uze",
+	"rEUYMzUDNOAZdoj7S64Q2DcDLM2MNZlFNLA23/ScWAhBSw5BUdkSk23HsyuH4aZhuEbZCfjXx2IHdcjb",
+	"Iig/nEErT1ZlR1QTRsqyvYmZE+QJ3lKjkN/BlfoLMlmxzZBWjeUcS4gj0aDCcO4F2Dte+gF/if4E7oSp",
+	"dvkkn4yxYNcCTyaW/HpGBUZId96HTDusZhAYjic42fJzvTw+Yd+OXz/swCcnJdsQDibzSWMvAyPJ23eM",
+	"gdz/p1cUoKgSNX6IWHHgcJTlSaSrqbtpJuFIf9dqoVpVI5hjK6ipZsFwEYSUNabEw2i3zNDor+RXzBiT",
+	"sKvmcnIWCYzKCl6x3ZL4IKZBHwTciiSBKJuN8DVst7ZWPyJWGzlBTRjhRPM8Zz4+qivT8CawZb2QPlI0",
+	"O70SfKY1xU0rmnd4fCIRw9Bouxe34BHD06IQ11zdLdTW0Da1yhMZd2zXcbHgI/66Q7+uSRHgo5uYkkqz",
+	"ICqjuz5YVmq1CoxLyv4yBaqIzI2R9FzWSmOmgoWZCvAGxggw3C9fHLs4hosAcJTsSgEuvU+XsCZlbYUm",
+	"RSF/dh7030u2WoVXxDIiICljCHNdRj50aulKIY1P+yV7ESQMexrwytgY20wAO1lKV/OOMN/IL/7bazP8",
+	"j9DSg+I+sUakjSM/UnmHOQM8/cHY5ZiJqAlQyScUsIIZJoUm74ZX7qMl9UK03NCc3j+DOf1RjdMNRYQ2",
+	"iA5J0pUFqMTsqUofDPer2C0d1Kx/6K/400WN7MieqRLc57fvA51W66urNsaZcg0/Ef3DCviRqDdAdjgO",
+	"wFAjvHxLgdBfDFWf1TAh68Rgr9QNJDhXjyBB4sjj5fza6VOfJzzp/A/dUffPL/WPnRH1a2WyFBvrkCkN",
+	"mVIvpvRnjuJmuyhr12qlmNjqNcnZlGfc0AuSCz4o7ENsaxGFU/y70lR7su0DoCiCB2iOZbemSB3hCEWu",
+	"XaTGoOyq9p9XbGsVBbJEpg4qzvDYPru+FWOTamlGj+hzMmq+FIY/smisE30c0JHwRMvW03yq6mS+FU7X",
+	"Nv1uCVeXSJVDU+q6XmUThd5I8SrDIEFBzbVXnRp5OwB7CggQ4MYuxsZxDVBpRkopSO7XKc1V7yrmAP5V",
+	"yhNZmJyLx4+pNoRRfAb/G52eHp2kPGbj1Dne1Z84yKVYIQNu/cetW/mvP7g/iv9ckf/8YiTZnE8paPtY",
+	"a6Lg3VNe0Q8KlgrOsKEroMGETS1S1PIJekcU6Xys2mJNpv2o5SnRmv4igyu43ZdYcG9UYKTGqA0JKM69",
+	"2iVE4djnhqLCUFR4B0SFMEs4Fhfwo/e7z/zUWT8BgII8RWTrN6K2wxGdcjscr/5Als2lc/WUxAJhta5e",
+	"Mhl+40wT8sWgaTl8khqyILyyGaE4VxGrQflouJ5vRRbpUzTB98qNty4I0/l7UTzV8z0kw70Ip0ifXLc/",
+	"fI+01Q8Z7rvGcNshr/IhsZZ31pAUKLYrCwgYpCpiJYHATL/8EOVvB2F/bHD3ZqaXvi7k74v7bDg3KBto",
+	"KfY5VSE/EjQBqVyrp0cbYVcpV02GLbgax8Gz+bSY6OvjckZ0CgRC+aphMCrkXHK6UJyUrNJClgVYySEj",
+	"LZfAFrYAr0jwkE8Omk9SkuI6uwot4dd/QJXSBP8wBCPT+j84g/X/FF+V+e3l+V5glVH0xoIym14sWsMP",
+	"eA7UiaJXvCpUG6ZgjqendiKkWFQ3xhgHV2OO0xQxmDKjo48jhqNEzv8Jw+UihwfM8IAZHjDDA+ZMD5gw",
+	"6z3GEdNfvOybcdSI8Jl4Fl+nE2UgTnGlze3r9oerrWJ7mF0oyC26g+25PT3OLf9/k7iMz0b+KxoBLD0J",
+	"QPoq9dbClMX7E5d5eSW+RGQd0xs5KieGM0jg4m7CdByP5u/U10COrDmr93uYf8fra1kR/NhbzuQHoyXN",
+	"3l65QfbmtS4spKzplDWVsj7F/386FW09xpou/c18UD2ZTdPj6j/H8BYOjcpDXpZcYvqL3/gnGH3N1e60",
+	"BDuPt+RsLfLExFWw0cAgdUKto/NbEuj6boSMqo2oNqmWvdbtmltxiX7X2omGWHcpJ5pU9BRnEUFkR4sz",
+	"RUSmMb1UuEppXtlepVdYm8D1vUy3GBCnNjcC75tjK4FhUV3FZHuNpuyMK9M01IbgpMb4Jj4l/o7UMm4/",
+	"NiTeMzsfdkD4fMbyqJ8yfMhlvLTucij+KjEUjYgiOkznB8KEcuiptdFld/jjXGDyMMgZ/BrIvdgCFy0Y",
+	"jKorxz8jdbc3Nwo0JfRjNFteGO35tpUOOcDr5AB/9DLuzFLiTuBAF/3Qww0HROGycPlQ9uZz0eoWOf69",
+	"WF8DgSs6bd4pgrIapvRJuq7S+lC/fWv02yGXfOODs87EJxJsCBDRWvftZeR/507KRiZOTdV0Ec7nvTJ2",
+	"vVcYacZ7LkHYqEhNESnz3BcYi3ttU0sN2p1nMa4YEgFNsWItUR+sAczqG+/Y2oxiQ/Knv4er9r0pp/QF",
+	"gvDy2JUPjHkGppGK2CTTnE5yZYwqZ1HvTa9wlvhlyks3f6C8vFx1Ir6gDjlm9u4PjJfKbdcqtg7jW4eC",
+	"Zp/86a/Ukwjb95hDQAVhe0GgWBzMr8pEAUEvqKrZpqgd66f7dZ9aXE5NGhiM1cc0t2pb9J1/wU0YpODq",
+	"jaLwx0LpTvleD96YpWfemEjzIcq/pmyUYzRTZIsLZ6BpoU3xMdIBfBVRzFRz6JyEmBGNZPNcQmoYTjYM",
+	"JzsvqkN8OBWSGQvTlBitUDTWdHxrvTp++1U2DaucbO+0QnsPhUpmYF2iRtr54l2TYlJD5jVkXkPmdc7j",
+	"RBts4Ow+8aI7dRCYG74MJIZU5XBcqCxWl1gu9zSx/IBSoyALgY6tQC8RpRP4Rb/Fdku7jj2Hr94qYW03",
+	"TNrdJ41o19gsQ3PwYsnNUL9g4QNoCGqnyXEJqYgyyS2/C4hS8q/TvFXCqAHpM6DQuuj+wwaxWPTaa1JQ",
+	"t2zECwfNrVKEmUi0QE6cazzhg3CaqtsP1AKjNXwfWl6GauhJI3RQsPMLuDxiA4pacxTrlafoOaqvecR6",
+	"JrlHgjyHw9zhQaqOQLEeewq/87usxXA8btU2SKkq0AxuaDQ5v9iK7c5bSrENNJNEd+1Bb3RDVN7e0kt7",
+	"NJTQaq4d7Y0ZaByxx/5N+Vz4sOLatgeU4NEIYTcrLNwO8ZzoK4zv2fwET2rQlGXEkx+DNVTkIUBbcE5P",
+	"Jk/h8CYq1RM99s1v7yo6igy1EmVOf/KA945lp2llCr10Lx/prdBTdOi+MOQx+ghIIRh+JR4AKLdoTdwI",
+	"gmRypZy5wsHMnRZjzuqF8AsD5C7xfSOHx/ibI3SqFfulgngg3HfrEhyy0la4pWq4PH+El4+LBMedjREY",
+	"fPqhopEdQ19zzKipseBQiRzSc1/0/CcTxarleNuiHuoF6pL6ntZSSe86CxoinUsXsLXpeyFDk18tPO6Y",
+	"IqE7UZRomN6z+ZHTSa4bynu+vBfBzqPa7r4TIuHfKa33QIp6L/sAEwGlLzksEqsHdZi9XVLYkHLOtc3y",
+	"ZV+A4tZ5oKpvoowodKfHliI7qrWwZXFX2ScaxmYx8vUYcqI7WkQS+VBYHQqrQ7b39re66Fdk8ORl0XG5",
+	"Z7qkbM08KK4S7DGdmJn0R/BXxq681gicn4VvmuxqZIfAsBvRYIOCiRvG5qGeTqTFvzA6bXNmnNJulmq/",
+	"mNqHYsgyOtxV858etYAXwg1+d0NBpUN2+SZUvPspqCE3KUxjk9tVcFGFKIMY35SwbXa/R3eMeJpCVDj+",
+	"fs9gdXu7bfXRrcaBUMj791w2KTeD9qmB4V66Y9dyK4nZ7jg9PVjeS98YNAMeBAvRFnB6NSzUqnqi9kRE",
+	"8Qqd5yYpYeE6uUKlgKsYcrAhBztLDnbkNTxjjyLVqIwEsCxoJxz2W77v8IDm25YpmYYUFu8JIo02t/lS",
+	"WWPNXl5OFgi0gI960UB99CbRWrPE9iVBmn9JUIN1dL/zYhOa1gX2qqLxwHXs/NoSaO9LlULuy3rltHqU",
+	"0NJeS2eS0wh4GgqFb24ZZFPALRZuUOKY3uX2JH/yo8tOuzXJaUHexD450kwwnfMRaaaw7Gx+QbLDQciz",
+	"CvM8I9tkdKybEvD0BtYSUStzkdxEhnCWyzQ7E2dP6NFIjSFbPgu2PAzsO4NeVlzvqhUKzmNXEsxBhDdo",
+	"FAJSp5AsR2/Vx8bed0LipXcj7xQLdx2XQvv8CNOGdYGDi4tO/j0Z5ifDBhuBsEEs9eILssGYQDYwqkVf",
+	"X8sBBrtZhDU77p2y7eZjexqLitcPhFrntxOGS+wM1PoFR/V55r6ODCRac5ue3yDJv+H1tg/rSZQG0WKE",
+	"AuD8HK5AQwLBPjcuJhyjQjVov91i8MvNV5aBtoMd9klSDQwY67kgIdTJXjFYUeuKaoCMB191SgFiL/3o",
+	"dHoU99ClpFTUNgToHdKebXUfRVcJe5M7HcdBhvDhOefDcU/r19UT+W9qa3SRzif7GWvYF70pMZWOLvdX",
+	"6WiQCq5CCkMtdxjWeGphyjqLjlIJFfNwi08BjCFpBfJ//Tuak3BHsgflJE5ZXBip4dci8nOZtBJFRNvI",
+	"bUQFd4WjhQ7dilu4a+dIBYuLMsFn58SjA/JFqJ943REloW8PWcSQRSRnET+zsCezGFRBTz9RqYgZa9Jk",
+	"MiLICDr2qxEoRNpnH29D++5hvbEhpoZSZ5NUGQs0Yw+1NGvKengHekekUDW9mOIt7FeJy971SeGrQnUF",
+	"q1D0IIGb8rEBor78xhDl3/g0sxfkuG8ILbBXKxISwfAB7tUXsAl4lVVQa+8+FP7ThrflsVZyDW9PX7yS",
+	"w59hY4JEVPNz9OYMa3APSf2knQpiiN9w1PTTaUAlsME0Grg9pM1hwP1Z1Mf3iGYftzdINO9osXwFKA0D",
+	"J0nwLce9K9lD3S1ijbFarXL10qViOWcXV0AquPqbsd+Mjdy/ff//Aed9wfQD8gAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
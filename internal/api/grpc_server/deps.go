@@ -0,0 +1,30 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type authenticating interface {
+	Auth(ctx context.Context, username, password string) (string, error)
+}
+
+type infoCollecting interface {
+	Collect(ctx context.Context, employeeID int64, history model.CoinHistory) (model.EmployeeInfo, error)
+}
+
+type coinSending interface {
+	Send(
+		ctx context.Context,
+		employeeID int64,
+		targetUsername string,
+		amount int64,
+		requireAcceptance bool,
+	) (*model.TransferRequest, error)
+}
+
+type buying interface {
+	Buy(ctx context.Context, employeeID int64, merchName string, options model.VariantOptions) error
+}
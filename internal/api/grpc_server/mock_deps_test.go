@@ -0,0 +1,265 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package grpc_server -typed -destination mock_deps_test.go
+//
+
+// Package grpc_server is a generated GoMock package.
+package grpc_server
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockauthenticating is a mock of authenticating interface.
+type Mockauthenticating struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthenticatingMockRecorder
+}
+
+// MockauthenticatingMockRecorder is the mock recorder for Mockauthenticating.
+type MockauthenticatingMockRecorder struct {
+	mock *Mockauthenticating
+}
+
+// NewMockauthenticating creates a new mock instance.
+func NewMockauthenticating(ctrl *gomock.Controller) *Mockauthenticating {
+	mock := &Mockauthenticating{ctrl: ctrl}
+	mock.recorder = &MockauthenticatingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockauthenticating) EXPECT() *MockauthenticatingMockRecorder {
+	return m.recorder
+}
+
+// Auth mocks base method.
+func (m *Mockauthenticating) Auth(ctx context.Context, username, password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth", ctx, username, password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockauthenticatingMockRecorder) Auth(ctx, username, password any) *MockauthenticatingAuthCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*Mockauthenticating)(nil).Auth), ctx, username, password)
+	return &MockauthenticatingAuthCall{Call: call}
+}
+
+// MockauthenticatingAuthCall wrap *gomock.Call
+type MockauthenticatingAuthCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthenticatingAuthCall) Return(arg0 string, arg1 error) *MockauthenticatingAuthCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthenticatingAuthCall) Do(f func(context.Context, string, string) (string, error)) *MockauthenticatingAuthCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthenticatingAuthCall) DoAndReturn(f func(context.Context, string, string) (string, error)) *MockauthenticatingAuthCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockinfoCollecting is a mock of infoCollecting interface.
+type MockinfoCollecting struct {
+	ctrl     *gomock.Controller
+	recorder *MockinfoCollectingMockRecorder
+}
+
+// MockinfoCollectingMockRecorder is the mock recorder for MockinfoCollecting.
+type MockinfoCollectingMockRecorder struct {
+	mock *MockinfoCollecting
+}
+
+// NewMockinfoCollecting creates a new mock instance.
+func NewMockinfoCollecting(ctrl *gomock.Controller) *MockinfoCollecting {
+	mock := &MockinfoCollecting{ctrl: ctrl}
+	mock.recorder = &MockinfoCollectingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinfoCollecting) EXPECT() *MockinfoCollectingMockRecorder {
+	return m.recorder
+}
+
+// Collect mocks base method.
+func (m *MockinfoCollecting) Collect(ctx context.Context, employeeID int64, history model.CoinHistory) (model.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collect", ctx, employeeID, history)
+	ret0, _ := ret[0].(model.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Collect indicates an expected call of Collect.
+func (mr *MockinfoCollectingMockRecorder) Collect(ctx, employeeID, history any) *MockinfoCollectingCollectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockinfoCollecting)(nil).Collect), ctx, employeeID, history)
+	return &MockinfoCollectingCollectCall{Call: call}
+}
+
+// MockinfoCollectingCollectCall wrap *gomock.Call
+type MockinfoCollectingCollectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockinfoCollectingCollectCall) Return(arg0 model.EmployeeInfo, arg1 error) *MockinfoCollectingCollectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockinfoCollectingCollectCall) Do(f func(context.Context, int64, model.CoinHistory) (model.EmployeeInfo, error)) *MockinfoCollectingCollectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockinfoCollectingCollectCall) DoAndReturn(f func(context.Context, int64, model.CoinHistory) (model.EmployeeInfo, error)) *MockinfoCollectingCollectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinSending is a mock of coinSending interface.
+type MockcoinSending struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinSendingMockRecorder
+}
+
+// MockcoinSendingMockRecorder is the mock recorder for MockcoinSending.
+type MockcoinSendingMockRecorder struct {
+	mock *MockcoinSending
+}
+
+// NewMockcoinSending creates a new mock instance.
+func NewMockcoinSending(ctrl *gomock.Controller) *MockcoinSending {
+	mock := &MockcoinSending{ctrl: ctrl}
+	mock.recorder = &MockcoinSendingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinSending) EXPECT() *MockcoinSendingMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockcoinSending) Send(ctx context.Context, employeeID int64, targetUsername string, amount int64, requireAcceptance bool) (*model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, employeeID, targetUsername, amount, requireAcceptance)
+	ret0, _ := ret[0].(*model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockcoinSendingMockRecorder) Send(ctx, employeeID, targetUsername, amount, requireAcceptance any) *MockcoinSendingSendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockcoinSending)(nil).Send), ctx, employeeID, targetUsername, amount, requireAcceptance)
+	return &MockcoinSendingSendCall{Call: call}
+}
+
+// MockcoinSendingSendCall wrap *gomock.Call
+type MockcoinSendingSendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinSendingSendCall) Return(arg0 *model.TransferRequest, arg1 error) *MockcoinSendingSendCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinSendingSendCall) Do(f func(context.Context, int64, string, int64, bool) (*model.TransferRequest, error)) *MockcoinSendingSendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinSendingSendCall) DoAndReturn(f func(context.Context, int64, string, int64, bool) (*model.TransferRequest, error)) *MockcoinSendingSendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Mockbuying is a mock of buying interface.
+type Mockbuying struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuyingMockRecorder
+}
+
+// MockbuyingMockRecorder is the mock recorder for Mockbuying.
+type MockbuyingMockRecorder struct {
+	mock *Mockbuying
+}
+
+// NewMockbuying creates a new mock instance.
+func NewMockbuying(ctrl *gomock.Controller) *Mockbuying {
+	mock := &Mockbuying{ctrl: ctrl}
+	mock.recorder = &MockbuyingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockbuying) EXPECT() *MockbuyingMockRecorder {
+	return m.recorder
+}
+
+// Buy mocks base method.
+func (m *Mockbuying) Buy(ctx context.Context, employeeID int64, merchName string, options model.VariantOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Buy", ctx, employeeID, merchName, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Buy indicates an expected call of Buy.
+func (mr *MockbuyingMockRecorder) Buy(ctx, employeeID, merchName, options any) *MockbuyingBuyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Buy", reflect.TypeOf((*Mockbuying)(nil).Buy), ctx, employeeID, merchName, options)
+	return &MockbuyingBuyCall{Call: call}
+}
+
+// MockbuyingBuyCall wrap *gomock.Call
+type MockbuyingBuyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbuyingBuyCall) Return(arg0 error) *MockbuyingBuyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbuyingBuyCall) Do(f func(context.Context, int64, string, model.VariantOptions) error) *MockbuyingBuyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbuyingBuyCall) DoAndReturn(f func(context.Context, int64, string, model.VariantOptions) error) *MockbuyingBuyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,219 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api/shoppb"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// Server implements shoppb.ShopServiceServer on top of the same use cases as the HTTP API.
+type Server struct {
+	shoppb.UnimplementedShopServiceServer
+
+	authenticating authenticating
+	infoCollecting infoCollecting
+	coinSending    coinSending
+	buying         buying
+	logger         internal.Logger
+}
+
+func New(
+	authenticating authenticating,
+	infoCollecting infoCollecting,
+	coinSending coinSending,
+	buying buying,
+	logger internal.Logger,
+) (*Server, error) {
+	if authenticating == nil {
+		return nil, errors.New("authenticating is nil")
+	}
+	if infoCollecting == nil {
+		return nil, errors.New("infoCollecting is nil")
+	}
+	if coinSending == nil {
+		return nil, errors.New("coinSending is nil")
+	}
+	if buying == nil {
+		return nil, errors.New("buying is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Server{
+		authenticating: authenticating,
+		infoCollecting: infoCollecting,
+		coinSending:    coinSending,
+		buying:         buying,
+		logger:         logger,
+	}, nil
+}
+
+func (s *Server) Auth(ctx context.Context, req *shoppb.AuthRequest) (*shoppb.AuthResponse, error) {
+	if req.GetUsername() == "" || len(req.GetUsername()) > 1024 {
+		return nil, status.Error(codes.InvalidArgument,
+			"username should contain at least one character and no more than 1024 bytes")
+	}
+
+	if req.GetPassword() == "" || len(req.GetPassword()) > 1024 {
+		return nil, status.Error(codes.InvalidArgument,
+			"password should contain at least one character and no more than 1024 bytes")
+	}
+
+	token, err := s.authenticating.Auth(ctx, req.GetUsername(), req.GetPassword())
+	if err != nil {
+		if st, ok := api_handler.GRPCStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		err = fmt.Errorf("authenticating.Auth: %w", err)
+		logging.FromContext(ctx, s.logger).Error("grpc Auth internal error", zap.Error(err), zap.String("username", req.GetUsername()))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &shoppb.AuthResponse{Token: token}, nil
+}
+
+func (s *Server) GetInfo(ctx context.Context, _ *shoppb.GetInfoRequest) (*shoppb.GetInfoResponse, error) {
+	tokenInfo := jwt.TokenInfoFromContext(ctx)
+
+	info, err := s.infoCollecting.Collect(ctx, tokenInfo.EmployeeID, model.CoinHistoryList)
+	if err != nil {
+		err = fmt.Errorf("infoCollecting.Collect: %w", err)
+		logging.FromContext(ctx, s.logger).Error("grpc GetInfo internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return convertInfo(info), nil
+}
+
+func (s *Server) SendCoin(ctx context.Context, req *shoppb.SendCoinRequest) (*shoppb.SendCoinResponse, error) {
+	tokenInfo := jwt.TokenInfoFromContext(ctx)
+
+	if req.GetToUser() == "" {
+		return nil, status.Error(codes.InvalidArgument, "toUser is required")
+	}
+	if req.GetAmount() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount should be positive")
+	}
+
+	request, err := s.coinSending.Send(ctx, tokenInfo.EmployeeID, req.GetToUser(), req.GetAmount(),
+		req.GetRequireAcceptance())
+	if err != nil {
+		if st, ok := api_handler.GRPCStatus(err, api_handler.Recipient); ok {
+			return nil, st.Err()
+		}
+
+		err = fmt.Errorf("coinSending.Send: %w", err)
+		logging.FromContext(ctx, s.logger).Error("grpc SendCoin internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.String("toUser", req.GetToUser()), zap.Int64("amount", req.GetAmount()))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	var res shoppb.SendCoinResponse
+	if request != nil {
+		res.TransferRequestId = request.ID
+	}
+
+	return &res, nil
+}
+
+func (s *Server) Buy(ctx context.Context, req *shoppb.BuyRequest) (*shoppb.BuyResponse, error) {
+	tokenInfo := jwt.TokenInfoFromContext(ctx)
+
+	if req.GetItem() == "" {
+		return nil, status.Error(codes.InvalidArgument, "item is required")
+	}
+
+	options := model.VariantOptions{
+		Size:  req.GetSize(),
+		Color: req.GetColor(),
+	}
+
+	err := s.buying.Buy(ctx, tokenInfo.EmployeeID, req.GetItem(), options)
+	if err != nil {
+		if st, ok := api_handler.GRPCStatus(err, api_handler.Merch(req.GetItem())...); ok {
+			return nil, st.Err()
+		}
+
+		err = fmt.Errorf("buying.Buy: %w", err)
+		logging.FromContext(ctx, s.logger).Error("grpc Buy internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.String("item", req.GetItem()))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &shoppb.BuyResponse{}, nil
+}
+
+func convertInfo(info model.EmployeeInfo) *shoppb.GetInfoResponse {
+	inventory := make([]*shoppb.InventoryItem, 0, len(info.Inventory))
+	for _, i := range info.Inventory {
+		inventory = append(inventory, &shoppb.InventoryItem{
+			Type:     i.MerchName,
+			Quantity: i.Quantity,
+			Size:     i.Size,
+			Color:    i.Color,
+		})
+	}
+
+	received := make([]*shoppb.ReceivedTransaction, 0, len(info.ReceivedTransactions))
+	for _, t := range info.ReceivedTransactions {
+		received = append(received, &shoppb.ReceivedTransaction{
+			FromUser: t.CounterpartyUsername,
+			Amount:   t.Amount,
+		})
+	}
+
+	sent := make([]*shoppb.SentTransaction, 0, len(info.SentTransactions))
+	for _, t := range info.SentTransactions {
+		sent = append(sent, &shoppb.SentTransaction{
+			ToUser: t.CounterpartyUsername,
+			Amount: t.Amount,
+		})
+	}
+
+	expiringSoon := make([]*shoppb.ExpiringCoins, 0, len(info.ExpiringSoon))
+	for _, c := range info.ExpiringSoon {
+		expiringSoon = append(expiringSoon, &shoppb.ExpiringCoins{
+			Amount:     c.Amount,
+			ExpireTime: timestamppb.New(c.ExpireTime),
+		})
+	}
+
+	return &shoppb.GetInfoResponse{
+		Coins:           info.Coins,
+		GivingBudget:    info.GivingBudget,
+		Inventory:       inventory,
+		Received:        received,
+		Sent:            sent,
+		ExpiringSoon:    expiringSoon,
+		PendingSent:     convertPendingTransfers(info.PendingSent),
+		PendingReceived: convertPendingTransfers(info.PendingReceived),
+	}
+}
+
+func convertPendingTransfers(requests []model.TransferRequest) []*shoppb.PendingTransfer {
+	res := make([]*shoppb.PendingTransfer, 0, len(requests))
+	for _, request := range requests {
+		res = append(res, &shoppb.PendingTransfer{
+			Id:         request.ID,
+			Kind:       string(request.Kind),
+			FromUser:   request.SenderUsername,
+			ToUser:     request.ReceiverUsername,
+			Amount:     request.Amount,
+			ExpireTime: timestamppb.New(request.ExpireTime),
+		})
+	}
+	return res
+}
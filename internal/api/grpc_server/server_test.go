@@ -0,0 +1,385 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/inna-maikut/avito-shop/internal/api/shoppb"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func authContext() context.Context {
+	return jwt.ContextWithTokenInfo(context.Background(), model.TokenInfo{EmployeeID: 1234, Username: "test1"})
+}
+
+func TestServer_Auth(t *testing.T) {
+	type mocks struct {
+		authenticating *Mockauthenticating
+		infoCollecting *MockinfoCollecting
+		coinSending    *MockcoinSending
+		buying         *Mockbuying
+	}
+
+	testCases := []struct {
+		name     string
+		prepare  func(m *mocks)
+		req      *shoppb.AuthRequest
+		wantRes  *shoppb.AuthResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.authenticating.EXPECT().Auth(gomock.Any(), "test1", "password1").Return("654321", nil)
+			},
+			req:      &shoppb.AuthRequest{Username: "test1", Password: "password1"},
+			wantRes:  &shoppb.AuthResponse{Token: "654321"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "empty_username",
+			prepare:  func(_ *mocks) {},
+			req:      &shoppb.AuthRequest{Password: "password1"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "wrong_password",
+			prepare: func(m *mocks) {
+				m.authenticating.EXPECT().Auth(gomock.Any(), "test1", "password1").
+					Return("", model.ErrWrongEmployeePassword)
+			},
+			req:      &shoppb.AuthRequest{Username: "test1", Password: "password1"},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "internal_error",
+			prepare: func(m *mocks) {
+				m.authenticating.EXPECT().Auth(gomock.Any(), "test1", "password1").Return("", assert.AnError)
+			},
+			req:      &shoppb.AuthRequest{Username: "test1", Password: "password1"},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				authenticating: NewMockauthenticating(ctrl),
+				infoCollecting: NewMockinfoCollecting(ctrl),
+				coinSending:    NewMockcoinSending(ctrl),
+				buying:         NewMockbuying(ctrl),
+			}
+
+			tc.prepare(m)
+
+			server, err := New(m.authenticating, m.infoCollecting, m.coinSending, m.buying, zap.NewNop())
+			require.NoError(t, err)
+
+			res, err := server.Auth(context.Background(), tc.req)
+			require.Equal(t, tc.wantCode, status.Code(err))
+			require.True(t, proto.Equal(tc.wantRes, res))
+		})
+	}
+}
+
+func TestServer_GetInfo(t *testing.T) {
+	expireTime := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	authenticating := NewMockauthenticating(ctrl)
+	infoCollecting := NewMockinfoCollecting(ctrl)
+	coinSending := NewMockcoinSending(ctrl)
+	buying := NewMockbuying(ctrl)
+
+	infoCollecting.EXPECT().Collect(gomock.Any(), int64(1234), model.CoinHistoryList).Return(model.EmployeeInfo{
+		Coins:        900,
+		GivingBudget: 50,
+		Inventory: []model.Inventory{
+			{MerchName: "socks", Quantity: 2},
+			{MerchName: "t-shirt", Size: "L", Color: "black", Quantity: 1},
+		},
+		ReceivedTransactions: []model.Transaction{
+			{CounterpartyUsername: "test2", Amount: 100},
+		},
+		SentTransactions: []model.Transaction{
+			{IsSender: true, CounterpartyUsername: "test3", Amount: 200},
+		},
+		PendingSent: []model.TransferRequest{{
+			ID:               7,
+			Kind:             model.TransferRequestKindAcceptance,
+			SenderUsername:   "test1",
+			ReceiverUsername: "test2",
+			Amount:           50,
+			ExpireTime:       expireTime,
+		}},
+		ExpiringSoon: []model.ExpiringCoins{{Amount: 300, ExpireTime: expireTime}},
+	}, nil)
+
+	server, err := New(authenticating, infoCollecting, coinSending, buying, zap.NewNop())
+	require.NoError(t, err)
+
+	res, err := server.GetInfo(authContext(), &shoppb.GetInfoRequest{})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&shoppb.GetInfoResponse{
+		Coins:        900,
+		GivingBudget: 50,
+		Inventory: []*shoppb.InventoryItem{
+			{Type: "socks", Quantity: 2},
+			{Type: "t-shirt", Size: "L", Color: "black", Quantity: 1},
+		},
+		Received:     []*shoppb.ReceivedTransaction{{FromUser: "test2", Amount: 100}},
+		Sent:         []*shoppb.SentTransaction{{ToUser: "test3", Amount: 200}},
+		ExpiringSoon: []*shoppb.ExpiringCoins{{Amount: 300, ExpireTime: timestamppb.New(expireTime)}},
+		PendingSent: []*shoppb.PendingTransfer{{
+			Id:         7,
+			Kind:       "acceptance",
+			FromUser:   "test1",
+			ToUser:     "test2",
+			Amount:     50,
+			ExpireTime: timestamppb.New(expireTime),
+		}},
+	}, res), res.String())
+}
+
+func TestServer_GetInfo_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticating := NewMockauthenticating(ctrl)
+	infoCollecting := NewMockinfoCollecting(ctrl)
+	coinSending := NewMockcoinSending(ctrl)
+	buying := NewMockbuying(ctrl)
+
+	infoCollecting.EXPECT().Collect(gomock.Any(), int64(1234), model.CoinHistoryList).Return(model.EmployeeInfo{}, assert.AnError)
+
+	server, err := New(authenticating, infoCollecting, coinSending, buying, zap.NewNop())
+	require.NoError(t, err)
+
+	_, err = server.GetInfo(authContext(), &shoppb.GetInfoRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestServer_SendCoin(t *testing.T) {
+	type mocks struct {
+		authenticating *Mockauthenticating
+		infoCollecting *MockinfoCollecting
+		coinSending    *MockcoinSending
+		buying         *Mockbuying
+	}
+
+	testCases := []struct {
+		name     string
+		prepare  func(m *mocks)
+		req      *shoppb.SendCoinRequest
+		wantRes  *shoppb.SendCoinResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(1234), "test3", int64(200), false).Return(nil, nil)
+			},
+			req:      &shoppb.SendCoinRequest{ToUser: "test3", Amount: 200},
+			wantRes:  &shoppb.SendCoinResponse{},
+			wantCode: codes.OK,
+		},
+		{
+			name: "pending_approval",
+			prepare: func(m *mocks) {
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(1234), "test3", int64(2000), false).
+					Return(&model.TransferRequest{ID: 7, Status: model.TransferRequestStatusPending}, nil)
+			},
+			req:      &shoppb.SendCoinRequest{ToUser: "test3", Amount: 2000},
+			wantRes:  &shoppb.SendCoinResponse{TransferRequestId: 7},
+			wantCode: codes.OK,
+		},
+		{
+			name: "require_acceptance",
+			prepare: func(m *mocks) {
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(1234), "test3", int64(200), true).
+					Return(&model.TransferRequest{ID: 8, Status: model.TransferRequestStatusPending}, nil)
+			},
+			req:      &shoppb.SendCoinRequest{ToUser: "test3", Amount: 200, RequireAcceptance: true},
+			wantRes:  &shoppb.SendCoinResponse{TransferRequestId: 8},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "non_positive_amount",
+			prepare:  func(_ *mocks) {},
+			req:      &shoppb.SendCoinRequest{ToUser: "test3", Amount: -5},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "not_enough_balance",
+			prepare: func(m *mocks) {
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+					Return(nil, model.ErrNotEnoughBalance)
+			},
+			req:      &shoppb.SendCoinRequest{ToUser: "test3", Amount: 200},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "recipient_not_found",
+			prepare: func(m *mocks) {
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+					Return(nil, model.ErrEmployeeNotFound)
+			},
+			req:      &shoppb.SendCoinRequest{ToUser: "test3", Amount: 200},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "transfer_blocked",
+			prepare: func(m *mocks) {
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+					Return(nil, model.ErrTransferBlocked)
+			},
+			req:      &shoppb.SendCoinRequest{ToUser: "test3", Amount: 200},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "internal_error",
+			prepare: func(m *mocks) {
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(1234), "test3", int64(200), false).Return(nil, assert.AnError)
+			},
+			req:      &shoppb.SendCoinRequest{ToUser: "test3", Amount: 200},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				authenticating: NewMockauthenticating(ctrl),
+				infoCollecting: NewMockinfoCollecting(ctrl),
+				coinSending:    NewMockcoinSending(ctrl),
+				buying:         NewMockbuying(ctrl),
+			}
+
+			tc.prepare(m)
+
+			server, err := New(m.authenticating, m.infoCollecting, m.coinSending, m.buying, zap.NewNop())
+			require.NoError(t, err)
+
+			res, err := server.SendCoin(authContext(), tc.req)
+			require.Equal(t, tc.wantCode, status.Code(err))
+			require.True(t, proto.Equal(tc.wantRes, res))
+		})
+	}
+}
+
+func TestServer_Buy(t *testing.T) {
+	type mocks struct {
+		authenticating *Mockauthenticating
+		infoCollecting *MockinfoCollecting
+		coinSending    *MockcoinSending
+		buying         *Mockbuying
+	}
+
+	testCases := []struct {
+		name     string
+		prepare  func(m *mocks)
+		req      *shoppb.BuyRequest
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.buying.EXPECT().Buy(gomock.Any(), int64(1234), "socks", model.VariantOptions{}).Return(nil)
+			},
+			req:      &shoppb.BuyRequest{Item: "socks"},
+			wantCode: codes.OK,
+		},
+		{
+			name: "success.variant",
+			prepare: func(m *mocks) {
+				m.buying.EXPECT().
+					Buy(gomock.Any(), int64(1234), "t-shirt", model.VariantOptions{Size: "M", Color: "white"}).
+					Return(nil)
+			},
+			req:      &shoppb.BuyRequest{Item: "t-shirt", Size: "M", Color: "white"},
+			wantCode: codes.OK,
+		},
+		{
+			name: "variant_required",
+			prepare: func(m *mocks) {
+				m.buying.EXPECT().Buy(gomock.Any(), int64(1234), "t-shirt", model.VariantOptions{}).
+					Return(model.ErrVariantRequired)
+			},
+			req:      &shoppb.BuyRequest{Item: "t-shirt"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "out_of_stock",
+			prepare: func(m *mocks) {
+				m.buying.EXPECT().Buy(gomock.Any(), int64(1234), "socks", model.VariantOptions{}).
+					Return(model.ErrOutOfStock)
+			},
+			req:      &shoppb.BuyRequest{Item: "socks"},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "empty_item",
+			prepare:  func(_ *mocks) {},
+			req:      &shoppb.BuyRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "merch_not_found",
+			prepare: func(m *mocks) {
+				m.buying.EXPECT().Buy(gomock.Any(), int64(1234), "socks", model.VariantOptions{}).Return(model.ErrMerchNotFound)
+			},
+			req:      &shoppb.BuyRequest{Item: "socks"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "not_enough_balance",
+			prepare: func(m *mocks) {
+				m.buying.EXPECT().Buy(gomock.Any(), int64(1234), "socks", model.VariantOptions{}).Return(model.ErrNotEnoughBalance)
+			},
+			req:      &shoppb.BuyRequest{Item: "socks"},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "internal_error",
+			prepare: func(m *mocks) {
+				m.buying.EXPECT().Buy(gomock.Any(), int64(1234), "socks", model.VariantOptions{}).Return(assert.AnError)
+			},
+			req:      &shoppb.BuyRequest{Item: "socks"},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				authenticating: NewMockauthenticating(ctrl),
+				infoCollecting: NewMockinfoCollecting(ctrl),
+				coinSending:    NewMockcoinSending(ctrl),
+				buying:         NewMockbuying(ctrl),
+			}
+
+			tc.prepare(m)
+
+			server, err := New(m.authenticating, m.infoCollecting, m.coinSending, m.buying, zap.NewNop())
+			require.NoError(t, err)
+
+			_, err = server.Buy(authContext(), tc.req)
+			require.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
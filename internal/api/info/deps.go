@@ -8,5 +8,5 @@ import (
 )
 
 type infoCollecting interface {
-	Collect(ctx context.Context, employeeID int64) (model.EmployeeInfo, error)
+	Collect(ctx context.Context, employeeID int64, history model.CoinHistory) (model.EmployeeInfo, error)
 }
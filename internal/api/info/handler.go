@@ -11,6 +11,7 @@ import (
 	"github.com/inna-maikut/avito-shop/internal/api"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
@@ -36,10 +37,21 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tokenInfo := jwt.TokenInfoFromContext(r.Context())
 
-	info, err := h.infoCollecting.Collect(ctx, tokenInfo.EmployeeID)
+	history := model.CoinHistoryList
+	switch v := r.URL.Query().Get("history"); api.CoinHistoryMode(v) {
+	case "", api.List:
+	case api.ByCounterparty:
+		history = model.CoinHistoryByCounterparty
+	default:
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "history should be list or byCounterparty")
+		return
+	}
+
+	info, err := h.infoCollecting.Collect(ctx, tokenInfo.EmployeeID, history)
 	if err != nil {
 		err = fmt.Errorf("infoCollecting.Collect: %w", err)
-		h.logger.Error("GET /api/info internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo))
+		logging.FromContext(ctx, h.logger).Error("GET /api/info internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.String("history", string(history)))
 		api_handler.InternalError(w, "internal server error")
 		return
 	}
@@ -49,13 +61,17 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 
 func convertToResponse(info model.EmployeeInfo) api.InfoResponse {
 	type apiInventoryItem = struct {
+		Color    *string `json:"color,omitempty"`
 		Quantity *int    `json:"quantity,omitempty"`
+		Size     *string `json:"size,omitempty"`
 		Type     *string `json:"type,omitempty"`
 	}
 	inventory := make([]apiInventoryItem, 0, len(info.Inventory))
 	for _, i := range info.Inventory {
 		inventory = append(inventory, apiInventoryItem{
+			Color:    api_handler.NonEmptyOrNil(i.Color),
 			Quantity: pointerOfInt(i.Quantity),
+			Size:     api_handler.NonEmptyOrNil(i.Size),
 			Type:     &i.MerchName,
 		})
 	}
@@ -84,17 +100,49 @@ func convertToResponse(info model.EmployeeInfo) api.InfoResponse {
 		})
 	}
 
+	expiringSoon := make([]api.ExpiringCoins, 0, len(info.ExpiringSoon))
+	for _, c := range info.ExpiringSoon {
+		expiringSoon = append(expiringSoon, api.ExpiringCoins{
+			Amount:     int(c.Amount),
+			ExpireTime: c.ExpireTime,
+		})
+	}
+
 	return api.InfoResponse{
-		Coins: pointerOfInt(info.Coins),
+		Coins:        pointerOfInt(info.Coins),
+		GivingBudget: pointerOfInt(info.GivingBudget),
 		CoinHistory: &struct {
-			Received *[]apiReceivedTransaction `json:"received,omitempty"`
-			Sent     *[]apiSentTransaction     `json:"sent,omitempty"`
+			PendingReceived *[]api.TransferRequest    `json:"pendingReceived,omitempty"`
+			PendingSent     *[]api.TransferRequest    `json:"pendingSent,omitempty"`
+			Received        *[]apiReceivedTransaction `json:"received,omitempty"`
+			Sent            *[]apiSentTransaction     `json:"sent,omitempty"`
 		}{
-			Received: &received,
-			Sent:     &sent,
+			PendingReceived: pointerOf(convertTransferRequests(info.PendingReceived)),
+			PendingSent:     pointerOf(convertTransferRequests(info.PendingSent)),
+			Received:        &received,
+			Sent:            &sent,
 		},
-		Inventory: &inventory,
+		Inventory:    &inventory,
+		ExpiringSoon: &expiringSoon,
+	}
+}
+
+func convertTransferRequests(requests []model.TransferRequest) []api.TransferRequest {
+	res := make([]api.TransferRequest, 0, len(requests))
+	for _, request := range requests {
+		res = append(res, api.TransferRequest{
+			Id:                request.ID,
+			Kind:              api.TransferRequestKind(request.Kind),
+			FromUser:          request.SenderUsername,
+			ToUser:            request.ReceiverUsername,
+			Amount:            int(request.Amount),
+			Status:            api.TransferRequestStatus(request.Status),
+			RequireAcceptance: request.RequireAcceptance,
+			ExpireTime:        request.ExpireTime,
+			CreateTime:        request.CreateTime,
+		})
 	}
+	return res
 }
 
 func pointerOf[T any](v T) *T {
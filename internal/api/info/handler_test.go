@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,9 +22,10 @@ func TestHandler_Handle_Success(t *testing.T) {
 	infoCollectingMock := NewMockinfoCollecting(ctrl)
 
 	infoCollectingMock.EXPECT().
-		Collect(gomock.Any(), int64(1001)).
+		Collect(gomock.Any(), int64(1001), model.CoinHistoryList).
 		Return(model.EmployeeInfo{
-			Coins: 100500,
+			Coins:        100500,
+			GivingBudget: 70,
 			Inventory: []model.Inventory{
 				{
 					EmployeeID: 1001,
@@ -31,6 +33,15 @@ func TestHandler_Handle_Success(t *testing.T) {
 					Quantity:   10,
 					MerchName:  "socks",
 				},
+				{
+					EmployeeID: 1001,
+					MerchID:    1,
+					VariantID:  20,
+					Quantity:   1,
+					MerchName:  "t-shirt",
+					Size:       "L",
+					Color:      "black",
+				},
 			},
 			ReceivedTransactions: []model.Transaction{
 				{
@@ -48,6 +59,27 @@ func TestHandler_Handle_Success(t *testing.T) {
 					Amount:                 500,
 				},
 			},
+			PendingSent: []model.TransferRequest{
+				{
+					ID:               7,
+					Kind:             model.TransferRequestKindAcceptance,
+					SenderID:         1001,
+					SenderUsername:   "test1",
+					ReceiverID:       1005,
+					ReceiverUsername: "test2",
+					Amount:           100,
+					Status:           model.TransferRequestStatusPending,
+					ExpireTime:       time.Date(2025, 2, 21, 12, 0, 0, 0, time.UTC),
+					CreateTime:       time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC),
+				},
+			},
+			PendingReceived: []model.TransferRequest{},
+			ExpiringSoon: []model.ExpiringCoins{
+				{
+					Amount:     200,
+					ExpireTime: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
 		}, nil)
 
 	handler, err := New(infoCollectingMock, zap.NewNop())
@@ -64,10 +96,17 @@ func TestHandler_Handle_Success(t *testing.T) {
 	require.JSONEq(t, `
 	{
 		"coins": 100500,
+		"givingBudget": 70,
 		"inventory": [
 			{
 				"quantity": 10,
 				"type": "socks"
+			},
+			{
+				"quantity": 1,
+				"type": "t-shirt",
+				"size": "L",
+				"color": "black"
 			}
 		],
 		"coinHistory": {
@@ -82,8 +121,28 @@ func TestHandler_Handle_Success(t *testing.T) {
 					"toUser": "test3",
 					"amount": 500
 				}
-			]
-		}
+			],
+			"pendingSent": [
+				{
+					"id": 7,
+					"kind": "acceptance",
+					"fromUser": "test1",
+					"toUser": "test2",
+					"amount": 100,
+					"status": "pending",
+					"requireAcceptance": false,
+					"expireTime": "2025-02-21T12:00:00Z",
+					"createTime": "2025-02-14T12:00:00Z"
+				}
+			],
+			"pendingReceived": []
+		},
+		"expiringSoon": [
+			{
+				"amount": 200,
+				"expireTime": "2025-03-01T00:00:00Z"
+			}
+		]
 	}`, w.Body.String())
 }
 
@@ -92,7 +151,7 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	infoCollectingMock := NewMockinfoCollecting(ctrl)
 
 	infoCollectingMock.EXPECT().
-		Collect(gomock.Any(), int64(1001)).
+		Collect(gomock.Any(), int64(1001), model.CoinHistoryList).
 		Return(model.EmployeeInfo{}, assert.AnError)
 
 	handler, err := New(infoCollectingMock, zap.NewNop())
@@ -111,3 +170,56 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "internal server error", *response.Errors)
 }
+
+func TestHandler_Handle_ByCounterparty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	infoCollectingMock := NewMockinfoCollecting(ctrl)
+
+	infoCollectingMock.EXPECT().
+		Collect(gomock.Any(), int64(1001), model.CoinHistoryByCounterparty).
+		Return(model.EmployeeInfo{
+			Coins: 100,
+			ReceivedTransactions: []model.Transaction{
+				{IsSender: false, CounterpartyEmployeeID: 1002, CounterpartyUsername: "test2", Amount: 700},
+			},
+			SentTransactions: []model.Transaction{},
+		}, nil)
+
+	handler, err := New(infoCollectingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/info?history=byCounterparty", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1001,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.InfoResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, *response.CoinHistory.Received, 1)
+	require.Equal(t, "test2", *(*response.CoinHistory.Received)[0].FromUser)
+	require.Equal(t, 700, *(*response.CoinHistory.Received)[0].Amount)
+}
+
+func TestHandler_Handle_InvalidHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	handler, err := New(NewMockinfoCollecting(ctrl), zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/info?history=daily", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1001,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "history should be list or byCounterparty", *response.Errors)
+}
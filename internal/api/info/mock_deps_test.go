@@ -41,18 +41,18 @@ func (m *MockinfoCollecting) EXPECT() *MockinfoCollectingMockRecorder {
 }
 
 // Collect mocks base method.
-func (m *MockinfoCollecting) Collect(ctx context.Context, employeeID int64) (model.EmployeeInfo, error) {
+func (m *MockinfoCollecting) Collect(ctx context.Context, employeeID int64, history model.CoinHistory) (model.EmployeeInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Collect", ctx, employeeID)
+	ret := m.ctrl.Call(m, "Collect", ctx, employeeID, history)
 	ret0, _ := ret[0].(model.EmployeeInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Collect indicates an expected call of Collect.
-func (mr *MockinfoCollectingMockRecorder) Collect(ctx, employeeID any) *MockinfoCollectingCollectCall {
+func (mr *MockinfoCollectingMockRecorder) Collect(ctx, employeeID, history any) *MockinfoCollectingCollectCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockinfoCollecting)(nil).Collect), ctx, employeeID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockinfoCollecting)(nil).Collect), ctx, employeeID, history)
 	return &MockinfoCollectingCollectCall{Call: call}
 }
 
@@ -68,13 +68,13 @@ func (c *MockinfoCollectingCollectCall) Return(arg0 model.EmployeeInfo, arg1 err
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockinfoCollectingCollectCall) Do(f func(context.Context, int64) (model.EmployeeInfo, error)) *MockinfoCollectingCollectCall {
+func (c *MockinfoCollectingCollectCall) Do(f func(context.Context, int64, model.CoinHistory) (model.EmployeeInfo, error)) *MockinfoCollectingCollectCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockinfoCollectingCollectCall) DoAndReturn(f func(context.Context, int64) (model.EmployeeInfo, error)) *MockinfoCollectingCollectCall {
+func (c *MockinfoCollectingCollectCall) DoAndReturn(f func(context.Context, int64, model.CoinHistory) (model.EmployeeInfo, error)) *MockinfoCollectingCollectCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
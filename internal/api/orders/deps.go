@@ -0,0 +1,15 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package orders
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type orderFulfilling interface {
+	List(ctx context.Context, employeeID int64) ([]model.Order, error)
+	ListByStatus(ctx context.Context, status model.OrderStatus) ([]model.Order, error)
+	Move(ctx context.Context, orderID int64, status model.OrderStatus) (model.Order, error)
+	Cancel(ctx context.Context, employeeID, orderID int64) (model.Order, error)
+}
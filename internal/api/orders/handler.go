@@ -0,0 +1,166 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type Handler struct {
+	orderFulfilling orderFulfilling
+	logger          internal.Logger
+}
+
+func New(orderFulfilling orderFulfilling, logger internal.Logger) (*Handler, error) {
+	if orderFulfilling == nil {
+		return nil, errors.New("orderFulfilling is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		orderFulfilling: orderFulfilling,
+		logger:          logger,
+	}, nil
+}
+
+func (h *Handler) HandleList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	orders, err := h.orderFulfilling.List(ctx, tokenInfo.EmployeeID)
+	if err != nil {
+		err = fmt.Errorf("orderFulfilling.List: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/orders internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertOrders(orders))
+}
+
+func (h *Handler) HandleCancel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	id, ok := api_handler.ParseID(w, r)
+	if !ok {
+		return
+	}
+
+	order, err := h.orderFulfilling.Cancel(ctx, tokenInfo.EmployeeID, id)
+	if err != nil {
+		if api_handler.DomainError(w, err) {
+			return
+		}
+
+		err = fmt.Errorf("orderFulfilling.Cancel: %w", err)
+		logging.FromContext(ctx, h.logger).Error("POST /api/orders/{id}/cancel internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Int64("id", id))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertOrder(order))
+}
+
+func (h *Handler) HandleStaffList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if !isStaff(tokenInfo.Role) {
+		api_handler.Forbidden(w, api.ErrorCodeForbidden, "staff role required")
+		return
+	}
+
+	status := model.OrderStatus(r.URL.Query().Get("status"))
+
+	orders, err := h.orderFulfilling.ListByStatus(ctx, status)
+	if err != nil {
+		if api_handler.DomainError(w, err) {
+			return
+		}
+
+		err = fmt.Errorf("orderFulfilling.ListByStatus: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/staff/orders internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertOrders(orders))
+}
+
+func (h *Handler) HandleMove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if !isStaff(tokenInfo.Role) {
+		api_handler.Forbidden(w, api.ErrorCodeForbidden, "staff role required")
+		return
+	}
+
+	id, ok := api_handler.ParseID(w, r)
+	if !ok {
+		return
+	}
+
+	var request api.OrderStatusRequest
+	if ok = api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	order, err := h.orderFulfilling.Move(ctx, id, model.OrderStatus(request.Status))
+	if err != nil {
+		if api_handler.DomainError(w, err) {
+			return
+		}
+
+		err = fmt.Errorf("orderFulfilling.Move: %w", err)
+		logging.FromContext(ctx, h.logger).Error("POST /api/staff/orders/{id}/status internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Int64("id", id), zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertOrder(order))
+}
+
+// isStaff reports whether the role can hand over orders, admins can do everything staff can.
+func isStaff(role model.Role) bool {
+	return role == model.RoleStaff || role == model.RoleAdmin
+}
+
+func convertOrders(orders []model.Order) api.OrdersResponse {
+	res := make([]api.Order, 0, len(orders))
+	for _, order := range orders {
+		res = append(res, convertOrder(order))
+	}
+	return api.OrdersResponse{Orders: res}
+}
+
+func convertOrder(order model.Order) api.Order {
+	return api.Order{
+		Id:         order.ID,
+		Employee:   order.EmployeeUsername,
+		Item:       order.MerchName,
+		Size:       api_handler.NonEmptyOrNil(order.Size),
+		Color:      api_handler.NonEmptyOrNil(order.Color),
+		Quantity:   int(order.Quantity),
+		Price:      int(order.Price),
+		Amount:     int(order.Amount()),
+		Status:     api.OrderStatus(order.Status),
+		CreateTime: order.CreateTime,
+		UpdateTime: order.UpdateTime,
+	}
+}
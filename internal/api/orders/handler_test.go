@@ -0,0 +1,273 @@
+package orders
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+var (
+	createTime = time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2025, 2, 15, 12, 0, 0, 0, time.UTC)
+)
+
+func newRequest(method, target string, body []byte, role model.Role) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+		Role:       role,
+	}))
+}
+
+func testOrder(status model.OrderStatus) model.Order {
+	return model.Order{
+		ID:               7,
+		EmployeeID:       1234,
+		EmployeeUsername: "test1",
+		MerchID:          1,
+		MerchName:        "t-shirt",
+		VariantID:        20,
+		Size:             "L",
+		Color:            "black",
+		Quantity:         2,
+		Price:            80,
+		Status:           status,
+		CreateTime:       createTime,
+		UpdateTime:       updateTime,
+	}
+}
+
+func TestHandler_HandleList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	orderFulfillingMock := NewMockorderFulfilling(ctrl)
+
+	orderFulfillingMock.EXPECT().
+		List(gomock.Any(), int64(1234)).
+		Return([]model.Order{testOrder(model.OrderStatusPlaced)}, nil)
+
+	handler, err := New(orderFulfillingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleList(w, newRequest(http.MethodGet, "/api/orders", nil, model.RoleEmployee))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `
+	{
+		"orders": [
+			{
+				"id": 7,
+				"employee": "test1",
+				"item": "t-shirt",
+				"size": "L",
+				"color": "black",
+				"quantity": 2,
+				"price": 80,
+				"amount": 160,
+				"status": "placed",
+				"createTime": "2025-02-14T12:00:00Z",
+				"updateTime": "2025-02-15T12:00:00Z"
+			}
+		]
+	}`, w.Body.String())
+}
+
+func TestHandler_HandleCancel(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "success",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "not_found",
+			err:        model.ErrOrderNotFound,
+			wantStatus: http.StatusNotFound,
+			wantError:  "order not found",
+		},
+		{
+			name:       "already_ready_for_pickup",
+			err:        model.ErrOrderStatusTransition,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "order can't be moved to this status",
+		},
+		{
+			name:       "internal_error",
+			err:        assert.AnError,
+			wantStatus: http.StatusInternalServerError,
+			wantError:  "internal server error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderFulfillingMock := NewMockorderFulfilling(ctrl)
+
+			res := testOrder(model.OrderStatusCancelled)
+			if tc.err != nil {
+				res = model.Order{}
+			}
+			orderFulfillingMock.EXPECT().Cancel(gomock.Any(), int64(1234), int64(7)).Return(res, tc.err)
+
+			handler, err := New(orderFulfillingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := newRequest(http.MethodPost, "/api/orders/7/cancel", nil, model.RoleEmployee)
+			req.SetPathValue("id", "7")
+			w := httptest.NewRecorder()
+			handler.HandleCancel(w, req)
+
+			require.Equal(t, tc.wantStatus, w.Code)
+			if tc.wantError != "" {
+				var response api.ErrorResponse
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantError, *response.Errors)
+				return
+			}
+
+			var response api.Order
+			err = json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+			require.Equal(t, api.Cancelled, response.Status)
+			require.Equal(t, 160, response.Amount)
+		})
+	}
+}
+
+func TestHandler_HandleStaffList(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       model.Role
+		target     string
+		prepare    func(m *MockorderFulfilling)
+		wantStatus int
+	}{
+		{
+			name:   "staff.open_orders",
+			role:   model.RoleStaff,
+			target: "/api/staff/orders",
+			prepare: func(m *MockorderFulfilling) {
+				m.EXPECT().ListByStatus(gomock.Any(), model.OrderStatus("")).
+					Return([]model.Order{testOrder(model.OrderStatusPlaced)}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "admin.delivered",
+			role:   model.RoleAdmin,
+			target: "/api/staff/orders?status=delivered",
+			prepare: func(m *MockorderFulfilling) {
+				m.EXPECT().ListByStatus(gomock.Any(), model.OrderStatusDelivered).Return(nil, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "invalid_status",
+			role:   model.RoleStaff,
+			target: "/api/staff/orders?status=lost",
+			prepare: func(m *MockorderFulfilling) {
+				m.EXPECT().ListByStatus(gomock.Any(), model.OrderStatus("lost")).
+					Return(nil, model.ErrInvalidOrderStatus)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "employee.forbidden",
+			role:       model.RoleEmployee,
+			target:     "/api/staff/orders",
+			prepare:    func(_ *MockorderFulfilling) {},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderFulfillingMock := NewMockorderFulfilling(ctrl)
+			tc.prepare(orderFulfillingMock)
+
+			handler, err := New(orderFulfillingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			handler.HandleStaffList(w, newRequest(http.MethodGet, tc.target, nil, tc.role))
+
+			require.Equal(t, tc.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleMove(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       model.Role
+		body       string
+		prepare    func(m *MockorderFulfilling)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			role: model.RoleStaff,
+			body: `{"status": "ready_for_pickup"}`,
+			prepare: func(m *MockorderFulfilling) {
+				m.EXPECT().Move(gomock.Any(), int64(7), model.OrderStatusReadyForPickup).
+					Return(testOrder(model.OrderStatusReadyForPickup), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "invalid_transition",
+			role: model.RoleStaff,
+			body: `{"status": "delivered"}`,
+			prepare: func(m *MockorderFulfilling) {
+				m.EXPECT().Move(gomock.Any(), int64(7), model.OrderStatusDelivered).
+					Return(model.Order{}, model.ErrOrderStatusTransition)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "employee.forbidden",
+			role:       model.RoleEmployee,
+			body:       `{"status": "delivered"}`,
+			prepare:    func(_ *MockorderFulfilling) {},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderFulfillingMock := NewMockorderFulfilling(ctrl)
+			tc.prepare(orderFulfillingMock)
+
+			handler, err := New(orderFulfillingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := newRequest(http.MethodPost, "/api/staff/orders/7/status", []byte(tc.body), tc.role)
+			req.SetPathValue("id", "7")
+			w := httptest.NewRecorder()
+			handler.HandleMove(w, req)
+
+			require.Equal(t, tc.wantStatus, w.Code)
+		})
+	}
+}
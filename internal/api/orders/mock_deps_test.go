@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package orders -typed -destination mock_deps_test.go
+//
+
+// Package orders is a generated GoMock package.
+package orders
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockorderFulfilling is a mock of orderFulfilling interface.
+type MockorderFulfilling struct {
+	ctrl     *gomock.Controller
+	recorder *MockorderFulfillingMockRecorder
+}
+
+// MockorderFulfillingMockRecorder is the mock recorder for MockorderFulfilling.
+type MockorderFulfillingMockRecorder struct {
+	mock *MockorderFulfilling
+}
+
+// NewMockorderFulfilling creates a new mock instance.
+func NewMockorderFulfilling(ctrl *gomock.Controller) *MockorderFulfilling {
+	mock := &MockorderFulfilling{ctrl: ctrl}
+	mock.recorder = &MockorderFulfillingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorderFulfilling) EXPECT() *MockorderFulfillingMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockorderFulfilling) Cancel(ctx context.Context, employeeID, orderID int64) (model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, employeeID, orderID)
+	ret0, _ := ret[0].(model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockorderFulfillingMockRecorder) Cancel(ctx, employeeID, orderID any) *MockorderFulfillingCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockorderFulfilling)(nil).Cancel), ctx, employeeID, orderID)
+	return &MockorderFulfillingCancelCall{Call: call}
+}
+
+// MockorderFulfillingCancelCall wrap *gomock.Call
+type MockorderFulfillingCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderFulfillingCancelCall) Return(arg0 model.Order, arg1 error) *MockorderFulfillingCancelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderFulfillingCancelCall) Do(f func(context.Context, int64, int64) (model.Order, error)) *MockorderFulfillingCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderFulfillingCancelCall) DoAndReturn(f func(context.Context, int64, int64) (model.Order, error)) *MockorderFulfillingCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockorderFulfilling) List(ctx context.Context, employeeID int64) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, employeeID)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockorderFulfillingMockRecorder) List(ctx, employeeID any) *MockorderFulfillingListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockorderFulfilling)(nil).List), ctx, employeeID)
+	return &MockorderFulfillingListCall{Call: call}
+}
+
+// MockorderFulfillingListCall wrap *gomock.Call
+type MockorderFulfillingListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderFulfillingListCall) Return(arg0 []model.Order, arg1 error) *MockorderFulfillingListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderFulfillingListCall) Do(f func(context.Context, int64) ([]model.Order, error)) *MockorderFulfillingListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderFulfillingListCall) DoAndReturn(f func(context.Context, int64) ([]model.Order, error)) *MockorderFulfillingListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListByStatus mocks base method.
+func (m *MockorderFulfilling) ListByStatus(ctx context.Context, status model.OrderStatus) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByStatus", ctx, status)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByStatus indicates an expected call of ListByStatus.
+func (mr *MockorderFulfillingMockRecorder) ListByStatus(ctx, status any) *MockorderFulfillingListByStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByStatus", reflect.TypeOf((*MockorderFulfilling)(nil).ListByStatus), ctx, status)
+	return &MockorderFulfillingListByStatusCall{Call: call}
+}
+
+// MockorderFulfillingListByStatusCall wrap *gomock.Call
+type MockorderFulfillingListByStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderFulfillingListByStatusCall) Return(arg0 []model.Order, arg1 error) *MockorderFulfillingListByStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderFulfillingListByStatusCall) Do(f func(context.Context, model.OrderStatus) ([]model.Order, error)) *MockorderFulfillingListByStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderFulfillingListByStatusCall) DoAndReturn(f func(context.Context, model.OrderStatus) ([]model.Order, error)) *MockorderFulfillingListByStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Move mocks base method.
+func (m *MockorderFulfilling) Move(ctx context.Context, orderID int64, status model.OrderStatus) (model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, orderID, status)
+	ret0, _ := ret[0].(model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockorderFulfillingMockRecorder) Move(ctx, orderID, status any) *MockorderFulfillingMoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockorderFulfilling)(nil).Move), ctx, orderID, status)
+	return &MockorderFulfillingMoveCall{Call: call}
+}
+
+// MockorderFulfillingMoveCall wrap *gomock.Call
+type MockorderFulfillingMoveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderFulfillingMoveCall) Return(arg0 model.Order, arg1 error) *MockorderFulfillingMoveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderFulfillingMoveCall) Do(f func(context.Context, int64, model.OrderStatus) (model.Order, error)) *MockorderFulfillingMoveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderFulfillingMoveCall) DoAndReturn(f func(context.Context, int64, model.OrderStatus) (model.Order, error)) *MockorderFulfillingMoveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,16 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package scheduled_transfer
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type transferScheduling interface {
+	Create(ctx context.Context, ownerID int64, params model.ScheduledTransferParams) (*model.ScheduledTransfer, error)
+	List(ctx context.Context, ownerID int64) ([]model.ScheduledTransfer, error)
+	Get(ctx context.Context, ownerID, id int64) (*model.ScheduledTransfer, []model.ScheduledTransferRun, error)
+	Update(ctx context.Context, ownerID, id int64, params model.ScheduledTransferParams) (*model.ScheduledTransfer, error)
+	Delete(ctx context.Context, ownerID, id int64) error
+}
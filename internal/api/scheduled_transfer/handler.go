@@ -0,0 +1,210 @@
+package scheduled_transfer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// errorOverrides word domain errors for scheduled transfers
+var errorOverrides = []api_handler.ErrorOverride{
+	api_handler.Recipient,
+	api_handler.WithMessage(model.ErrInvalidSchedule, "invalid schedule: set either runAt or a valid cron expression"),
+}
+
+type Handler struct {
+	transferScheduling transferScheduling
+	logger             internal.Logger
+}
+
+func New(transferScheduling transferScheduling, logger internal.Logger) (*Handler, error) {
+	if transferScheduling == nil {
+		return nil, errors.New("transferScheduling is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		transferScheduling: transferScheduling,
+		logger:             logger,
+	}, nil
+}
+
+func (h *Handler) HandleList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	scheduledTransfers, err := h.transferScheduling.List(ctx, tokenInfo.EmployeeID)
+	if err != nil {
+		err = fmt.Errorf("transferScheduling.List: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/scheduledTransfers internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := api.ScheduledTransferListResponse{
+		ScheduledTransfers: make([]api.ScheduledTransfer, 0, len(scheduledTransfers)),
+	}
+	for _, st := range scheduledTransfers {
+		res.ScheduledTransfers = append(res.ScheduledTransfers, convertScheduledTransfer(st))
+	}
+
+	api_handler.OK(w, res)
+}
+
+func (h *Handler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	var request api.ScheduledTransferRequest
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	st, err := h.transferScheduling.Create(ctx, tokenInfo.EmployeeID, convertRequest(request))
+	if err != nil {
+		if api_handler.DomainError(w, err, errorOverrides...) {
+			return
+		}
+
+		err = fmt.Errorf("transferScheduling.Create: %w", err)
+		logging.FromContext(ctx, h.logger).Error("POST /api/scheduledTransfers internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertScheduledTransfer(*st))
+}
+
+func (h *Handler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	id, ok := api_handler.ParseID(w, r)
+	if !ok {
+		return
+	}
+
+	st, runs, err := h.transferScheduling.Get(ctx, tokenInfo.EmployeeID, id)
+	if err != nil {
+		if api_handler.DomainError(w, err, errorOverrides...) {
+			return
+		}
+
+		err = fmt.Errorf("transferScheduling.Get: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/scheduledTransfers/{id} internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Int64("id", id))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := api.ScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(*st),
+		Runs:              make([]api.ScheduledTransferRun, 0, len(runs)),
+	}
+	for _, run := range runs {
+		apiRun := api.ScheduledTransferRun{
+			RunTime: run.RunTime,
+			Status:  api.ScheduledTransferRunStatus(run.Status),
+		}
+		if run.Error != "" {
+			apiRun.Error = &run.Error
+		}
+		res.Runs = append(res.Runs, apiRun)
+	}
+
+	api_handler.OK(w, res)
+}
+
+func (h *Handler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	id, ok := api_handler.ParseID(w, r)
+	if !ok {
+		return
+	}
+
+	var request api.ScheduledTransferRequest
+	if ok = api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	st, err := h.transferScheduling.Update(ctx, tokenInfo.EmployeeID, id, convertRequest(request))
+	if err != nil {
+		if api_handler.DomainError(w, err, errorOverrides...) {
+			return
+		}
+
+		err = fmt.Errorf("transferScheduling.Update: %w", err)
+		logging.FromContext(ctx, h.logger).Error("PUT /api/scheduledTransfers/{id} internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Int64("id", id), zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertScheduledTransfer(*st))
+}
+
+func (h *Handler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	id, ok := api_handler.ParseID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.transferScheduling.Delete(ctx, tokenInfo.EmployeeID, id)
+	if err != nil {
+		if api_handler.DomainError(w, err, errorOverrides...) {
+			return
+		}
+
+		err = fmt.Errorf("transferScheduling.Delete: %w", err)
+		logging.FromContext(ctx, h.logger).Error("DELETE /api/scheduledTransfers/{id} internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Int64("id", id))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func convertRequest(request api.ScheduledTransferRequest) model.ScheduledTransferParams {
+	params := model.ScheduledTransferParams{
+		ReceiverUsername: request.ToUser,
+		Amount:           int64(request.Amount),
+		RunAt:            request.RunAt,
+		IsActive:         true,
+	}
+	if request.Cron != nil {
+		params.CronExpression = *request.Cron
+	}
+	if request.IsActive != nil {
+		params.IsActive = *request.IsActive
+	}
+	return params
+}
+
+func convertScheduledTransfer(st model.ScheduledTransfer) api.ScheduledTransfer {
+	return api.ScheduledTransfer{
+		Id:          st.ID,
+		ToUser:      st.ReceiverUsername,
+		Amount:      int(st.Amount),
+		Cron:        st.CronExpression,
+		NextRunTime: st.NextRunTime,
+		IsActive:    st.IsActive,
+	}
+}
@@ -0,0 +1,186 @@
+package scheduled_transfer
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func newRequest(method, target string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+}
+
+func TestHandler_HandleCreate_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferSchedulingMock := NewMocktransferScheduling(ctrl)
+
+	nextRunTime := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	transferSchedulingMock.EXPECT().
+		Create(gomock.Any(), int64(1234), model.ScheduledTransferParams{
+			ReceiverUsername: "intern",
+			Amount:           50,
+			CronExpression:   "0 12 * * 5",
+			IsActive:         true,
+		}).
+		Return(&model.ScheduledTransfer{
+			ID:               7,
+			ReceiverUsername: "intern",
+			Amount:           50,
+			CronExpression:   "0 12 * * 5",
+			NextRunTime:      &nextRunTime,
+			IsActive:         true,
+		}, nil)
+
+	handler, err := New(transferSchedulingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodPost, "/api/scheduledTransfers",
+		[]byte(`{"toUser": "intern", "amount": 50, "cron": "0 12 * * 5"}`))
+	w := httptest.NewRecorder()
+	handler.HandleCreate(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.ScheduledTransfer
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, api.ScheduledTransfer{
+		Id:          7,
+		ToUser:      "intern",
+		Amount:      50,
+		Cron:        "0 12 * * 5",
+		NextRunTime: &nextRunTime,
+		IsActive:    true,
+	}, response)
+}
+
+func TestHandler_HandleCreate_ErrInvalidSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferSchedulingMock := NewMocktransferScheduling(ctrl)
+
+	transferSchedulingMock.EXPECT().
+		Create(gomock.Any(), int64(1234), gomock.Any()).
+		Return(nil, model.ErrInvalidSchedule)
+
+	handler, err := New(transferSchedulingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodPost, "/api/scheduledTransfers", []byte(`{"toUser": "intern", "amount": 50}`))
+	w := httptest.NewRecorder()
+	handler.HandleCreate(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "invalid schedule: set either runAt or a valid cron expression", *response.Errors)
+}
+
+func TestHandler_HandleGet_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferSchedulingMock := NewMocktransferScheduling(ctrl)
+
+	runTime := time.Date(2025, 2, 7, 12, 0, 0, 0, time.UTC)
+	transferSchedulingMock.EXPECT().
+		Get(gomock.Any(), int64(1234), int64(7)).
+		Return(&model.ScheduledTransfer{
+			ID:               7,
+			ReceiverUsername: "intern",
+			Amount:           50,
+			CronExpression:   "0 12 * * 5",
+			IsActive:         true,
+		}, []model.ScheduledTransferRun{
+			{RunTime: runTime, Status: model.ScheduledTransferRunStatusFailed, Error: "not enough balance"},
+		}, nil)
+
+	handler, err := New(transferSchedulingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodGet, "/api/scheduledTransfers/7", nil)
+	req.SetPathValue("id", "7")
+	w := httptest.NewRecorder()
+	handler.HandleGet(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.ScheduledTransferResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response.Runs, 1)
+	require.Equal(t, api.Failed, response.Runs[0].Status)
+	require.Equal(t, "not enough balance", *response.Runs[0].Error)
+}
+
+func TestHandler_HandleGet_BadID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferSchedulingMock := NewMocktransferScheduling(ctrl)
+
+	handler, err := New(transferSchedulingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodGet, "/api/scheduledTransfers/abc", nil)
+	req.SetPathValue("id", "abc")
+	w := httptest.NewRecorder()
+	handler.HandleGet(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_HandleDelete_ErrScheduledTransferNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferSchedulingMock := NewMocktransferScheduling(ctrl)
+
+	transferSchedulingMock.EXPECT().
+		Delete(gomock.Any(), int64(1234), int64(7)).
+		Return(model.ErrScheduledTransferNotFound)
+
+	handler, err := New(transferSchedulingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodDelete, "/api/scheduledTransfers/7", nil)
+	req.SetPathValue("id", "7")
+	w := httptest.NewRecorder()
+	handler.HandleDelete(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "scheduled transfer not found", *response.Errors)
+}
+
+func TestHandler_HandleList_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferSchedulingMock := NewMocktransferScheduling(ctrl)
+
+	transferSchedulingMock.EXPECT().
+		List(gomock.Any(), int64(1234)).
+		Return(nil, assert.AnError)
+
+	handler, err := New(transferSchedulingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodGet, "/api/scheduledTransfers", nil)
+	w := httptest.NewRecorder()
+	handler.HandleList(w, req)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "internal server error", *response.Errors)
+}
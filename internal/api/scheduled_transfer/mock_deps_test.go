@@ -0,0 +1,236 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package scheduled_transfer -typed -destination mock_deps_test.go
+//
+
+// Package scheduled_transfer is a generated GoMock package.
+package scheduled_transfer
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktransferScheduling is a mock of transferScheduling interface.
+type MocktransferScheduling struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransferSchedulingMockRecorder
+}
+
+// MocktransferSchedulingMockRecorder is the mock recorder for MocktransferScheduling.
+type MocktransferSchedulingMockRecorder struct {
+	mock *MocktransferScheduling
+}
+
+// NewMocktransferScheduling creates a new mock instance.
+func NewMocktransferScheduling(ctrl *gomock.Controller) *MocktransferScheduling {
+	mock := &MocktransferScheduling{ctrl: ctrl}
+	mock.recorder = &MocktransferSchedulingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransferScheduling) EXPECT() *MocktransferSchedulingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MocktransferScheduling) Create(ctx context.Context, ownerID int64, params model.ScheduledTransferParams) (*model.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ownerID, params)
+	ret0, _ := ret[0].(*model.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MocktransferSchedulingMockRecorder) Create(ctx, ownerID, params any) *MocktransferSchedulingCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktransferScheduling)(nil).Create), ctx, ownerID, params)
+	return &MocktransferSchedulingCreateCall{Call: call}
+}
+
+// MocktransferSchedulingCreateCall wrap *gomock.Call
+type MocktransferSchedulingCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferSchedulingCreateCall) Return(arg0 *model.ScheduledTransfer, arg1 error) *MocktransferSchedulingCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferSchedulingCreateCall) Do(f func(context.Context, int64, model.ScheduledTransferParams) (*model.ScheduledTransfer, error)) *MocktransferSchedulingCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferSchedulingCreateCall) DoAndReturn(f func(context.Context, int64, model.ScheduledTransferParams) (*model.ScheduledTransfer, error)) *MocktransferSchedulingCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MocktransferScheduling) Delete(ctx context.Context, ownerID, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocktransferSchedulingMockRecorder) Delete(ctx, ownerID, id any) *MocktransferSchedulingDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocktransferScheduling)(nil).Delete), ctx, ownerID, id)
+	return &MocktransferSchedulingDeleteCall{Call: call}
+}
+
+// MocktransferSchedulingDeleteCall wrap *gomock.Call
+type MocktransferSchedulingDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferSchedulingDeleteCall) Return(arg0 error) *MocktransferSchedulingDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferSchedulingDeleteCall) Do(f func(context.Context, int64, int64) error) *MocktransferSchedulingDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferSchedulingDeleteCall) DoAndReturn(f func(context.Context, int64, int64) error) *MocktransferSchedulingDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MocktransferScheduling) Get(ctx context.Context, ownerID, id int64) (*model.ScheduledTransfer, []model.ScheduledTransferRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ownerID, id)
+	ret0, _ := ret[0].(*model.ScheduledTransfer)
+	ret1, _ := ret[1].([]model.ScheduledTransferRun)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocktransferSchedulingMockRecorder) Get(ctx, ownerID, id any) *MocktransferSchedulingGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocktransferScheduling)(nil).Get), ctx, ownerID, id)
+	return &MocktransferSchedulingGetCall{Call: call}
+}
+
+// MocktransferSchedulingGetCall wrap *gomock.Call
+type MocktransferSchedulingGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferSchedulingGetCall) Return(arg0 *model.ScheduledTransfer, arg1 []model.ScheduledTransferRun, arg2 error) *MocktransferSchedulingGetCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferSchedulingGetCall) Do(f func(context.Context, int64, int64) (*model.ScheduledTransfer, []model.ScheduledTransferRun, error)) *MocktransferSchedulingGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferSchedulingGetCall) DoAndReturn(f func(context.Context, int64, int64) (*model.ScheduledTransfer, []model.ScheduledTransferRun, error)) *MocktransferSchedulingGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MocktransferScheduling) List(ctx context.Context, ownerID int64) ([]model.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ownerID)
+	ret0, _ := ret[0].([]model.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MocktransferSchedulingMockRecorder) List(ctx, ownerID any) *MocktransferSchedulingListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocktransferScheduling)(nil).List), ctx, ownerID)
+	return &MocktransferSchedulingListCall{Call: call}
+}
+
+// MocktransferSchedulingListCall wrap *gomock.Call
+type MocktransferSchedulingListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferSchedulingListCall) Return(arg0 []model.ScheduledTransfer, arg1 error) *MocktransferSchedulingListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferSchedulingListCall) Do(f func(context.Context, int64) ([]model.ScheduledTransfer, error)) *MocktransferSchedulingListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferSchedulingListCall) DoAndReturn(f func(context.Context, int64) ([]model.ScheduledTransfer, error)) *MocktransferSchedulingListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MocktransferScheduling) Update(ctx context.Context, ownerID, id int64, params model.ScheduledTransferParams) (*model.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ownerID, id, params)
+	ret0, _ := ret[0].(*model.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MocktransferSchedulingMockRecorder) Update(ctx, ownerID, id, params any) *MocktransferSchedulingUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocktransferScheduling)(nil).Update), ctx, ownerID, id, params)
+	return &MocktransferSchedulingUpdateCall{Call: call}
+}
+
+// MocktransferSchedulingUpdateCall wrap *gomock.Call
+type MocktransferSchedulingUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferSchedulingUpdateCall) Return(arg0 *model.ScheduledTransfer, arg1 error) *MocktransferSchedulingUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferSchedulingUpdateCall) Do(f func(context.Context, int64, int64, model.ScheduledTransferParams) (*model.ScheduledTransfer, error)) *MocktransferSchedulingUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferSchedulingUpdateCall) DoAndReturn(f func(context.Context, int64, int64, model.ScheduledTransferParams) (*model.ScheduledTransfer, error)) *MocktransferSchedulingUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -3,8 +3,16 @@ package send_coin
 
 import (
 	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
 type coinSending interface {
-	Send(ctx context.Context, employeeID int64, targetUsername string, amount int64) error
+	Send(
+		ctx context.Context,
+		employeeID int64,
+		targetUsername string,
+		amount int64,
+		requireAcceptance bool,
+	) (*model.TransferRequest, error)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/inna-maikut/avito-shop/internal/api"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
@@ -41,23 +42,44 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.coinSending.Send(ctx, tokenInfo.EmployeeID, sendCoinRequest.ToUser, int64(sendCoinRequest.Amount))
+	var requireAcceptance bool
+	if sendCoinRequest.RequireAcceptance != nil {
+		requireAcceptance = *sendCoinRequest.RequireAcceptance
+	}
+
+	request, err := h.coinSending.Send(ctx, tokenInfo.EmployeeID, sendCoinRequest.ToUser,
+		int64(sendCoinRequest.Amount), requireAcceptance)
 	if err != nil {
-		if errors.Is(err, model.ErrSendingCoinsToMyselfNotAllowed) {
-			api_handler.BadRequest(w, "sending coins to yourself not allowed")
-			return
-		}
-		if errors.Is(err, model.ErrNotEnoughBalance) {
-			api_handler.BadRequest(w, "not enough balance")
+		if api_handler.DomainError(w, err, api_handler.Recipient) {
 			return
 		}
 
 		err = fmt.Errorf("coinSending.Send: %w", err)
-		h.logger.Error("GET /api/sendCoin internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+		logging.FromContext(ctx, h.logger).Error("GET /api/sendCoin internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo),
 			zap.Any("request", sendCoinRequest))
 		api_handler.InternalError(w, "internal server error")
 		return
 	}
 
+	if request != nil {
+		api_handler.Accepted(w, convertTransferRequest(*request))
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 }
+
+func convertTransferRequest(request model.TransferRequest) api.TransferRequest {
+	return api.TransferRequest{
+		Id:                request.ID,
+		Kind:              api.TransferRequestKind(request.Kind),
+		FromUser:          request.SenderUsername,
+		ToUser:            request.ReceiverUsername,
+		Amount:            int(request.Amount),
+		Status:            api.TransferRequestStatus(request.Status),
+		RequireAcceptance: request.RequireAcceptance,
+		ExpireTime:        request.ExpireTime,
+		CreateTime:        request.CreateTime,
+	}
+}
@@ -3,9 +3,11 @@ package send_coin
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,8 +24,8 @@ func TestHandler_Handle_Success(t *testing.T) {
 	buyingMock := NewMockcoinSending(ctrl)
 
 	buyingMock.EXPECT().
-		Send(gomock.Any(), int64(1234), "test3", int64(200)).
-		Return(nil)
+		Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+		Return(nil, nil)
 
 	handler, err := New(buyingMock, zap.NewNop())
 	require.NoError(t, err)
@@ -40,13 +42,101 @@ func TestHandler_Handle_Success(t *testing.T) {
 	require.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestHandler_Handle_PendingApproval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	buyingMock := NewMockcoinSending(ctrl)
+
+	buyingMock.EXPECT().
+		Send(gomock.Any(), int64(1234), "test3", int64(2000), false).
+		Return(&model.TransferRequest{
+			ID:               7,
+			Kind:             model.TransferRequestKindApproval,
+			SenderUsername:   "test1",
+			ReceiverUsername: "test3",
+			Amount:           2000,
+			Status:           model.TransferRequestStatusPending,
+			ExpireTime:       time.Date(2025, 2, 17, 12, 0, 0, 0, time.UTC),
+			CreateTime:       time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC),
+		}, nil)
+
+	handler, err := New(buyingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"toUser": "test3", "amount": 2000}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusAccepted, w.Code)
+	require.JSONEq(t, `
+	{
+		"id": 7,
+		"kind": "approval",
+		"fromUser": "test1",
+		"toUser": "test3",
+		"amount": 2000,
+		"status": "pending",
+		"requireAcceptance": false,
+		"expireTime": "2025-02-17T12:00:00Z",
+		"createTime": "2025-02-14T12:00:00Z"
+	}`, w.Body.String())
+}
+
+func TestHandler_Handle_RequireAcceptance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	buyingMock := NewMockcoinSending(ctrl)
+
+	buyingMock.EXPECT().
+		Send(gomock.Any(), int64(1234), "test3", int64(200), true).
+		Return(&model.TransferRequest{
+			ID:               8,
+			Kind:             model.TransferRequestKindAcceptance,
+			SenderUsername:   "test1",
+			ReceiverUsername: "test3",
+			Amount:           200,
+			Status:           model.TransferRequestStatusPending,
+			ExpireTime:       time.Date(2025, 2, 21, 12, 0, 0, 0, time.UTC),
+			CreateTime:       time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC),
+		}, nil)
+
+	handler, err := New(buyingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"toUser": "test3", "amount": 200, "requireAcceptance": true}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusAccepted, w.Code)
+	require.JSONEq(t, `
+	{
+		"id": 8,
+		"kind": "acceptance",
+		"fromUser": "test1",
+		"toUser": "test3",
+		"amount": 200,
+		"status": "pending",
+		"requireAcceptance": false,
+		"expireTime": "2025-02-21T12:00:00Z",
+		"createTime": "2025-02-14T12:00:00Z"
+	}`, w.Body.String())
+}
+
 func TestHandler_Handle_ErrSendingCoinsToMyselfNotAllowed(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	buyingMock := NewMockcoinSending(ctrl)
 
 	buyingMock.EXPECT().
-		Send(gomock.Any(), int64(1234), "test3", int64(200)).
-		Return(model.ErrSendingCoinsToMyselfNotAllowed)
+		Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+		Return(nil, model.ErrSendingCoinsToMyselfNotAllowed)
 
 	handler, err := New(buyingMock, zap.NewNop())
 	require.NoError(t, err)
@@ -72,8 +162,8 @@ func TestHandler_Handle_ErrNotEnoughBalance(t *testing.T) {
 	buyingMock := NewMockcoinSending(ctrl)
 
 	buyingMock.EXPECT().
-		Send(gomock.Any(), int64(1234), "test3", int64(200)).
-		Return(model.ErrNotEnoughBalance)
+		Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+		Return(nil, model.ErrNotEnoughBalance)
 
 	handler, err := New(buyingMock, zap.NewNop())
 	require.NoError(t, err)
@@ -94,13 +184,94 @@ func TestHandler_Handle_ErrNotEnoughBalance(t *testing.T) {
 	require.Equal(t, "not enough balance", *response.Errors)
 }
 
+func TestHandler_Handle_ErrEmployeeNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	buyingMock := NewMockcoinSending(ctrl)
+
+	buyingMock.EXPECT().
+		Send(gomock.Any(), int64(1234), "tset3", int64(200), false).
+		Return(nil, fmt.Errorf("employeeRepo.GetByUsername: %w", model.ErrEmployeeNotFound))
+
+	handler, err := New(buyingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"toUser": "tset3", "amount": 200}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "recipient not found", *response.Errors)
+}
+
+func TestHandler_Handle_ErrDailyLimitExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	coinSendingMock := NewMockcoinSending(ctrl)
+
+	coinSendingMock.EXPECT().
+		Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+		Return(nil, fmt.Errorf("trManager.Do: %w", model.ErrDailyLimitExceeded))
+
+	handler, err := New(coinSendingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"toUser": "test3", "amount": 200}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "daily transfer limit exceeded", *response.Errors)
+}
+
+func TestHandler_Handle_ErrTransferBlocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	coinSendingMock := NewMockcoinSending(ctrl)
+
+	coinSendingMock.EXPECT().
+		Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+		Return(nil, fmt.Errorf("trManager.Do: %w", model.ErrTransferBlocked))
+
+	handler, err := New(coinSendingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"toUser": "test3", "amount": 200}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "transfers to this employee are not allowed", *response.Errors)
+}
+
 func TestHandler_Handle_InternalError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	buyingMock := NewMockcoinSending(ctrl)
 
 	buyingMock.EXPECT().
-		Send(gomock.Any(), int64(1234), "test3", int64(200)).
-		Return(assert.AnError)
+		Send(gomock.Any(), int64(1234), "test3", int64(200), false).
+		Return(nil, assert.AnError)
 
 	handler, err := New(buyingMock, zap.NewNop())
 	require.NoError(t, err)
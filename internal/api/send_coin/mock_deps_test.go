@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/inna-maikut/avito-shop/internal/model"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,17 +41,18 @@ func (m *MockcoinSending) EXPECT() *MockcoinSendingMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MockcoinSending) Send(ctx context.Context, employeeID int64, targetUsername string, amount int64) error {
+func (m *MockcoinSending) Send(ctx context.Context, employeeID int64, targetUsername string, amount int64, requireAcceptance bool) (*model.TransferRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", ctx, employeeID, targetUsername, amount)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Send", ctx, employeeID, targetUsername, amount, requireAcceptance)
+	ret0, _ := ret[0].(*model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockcoinSendingMockRecorder) Send(ctx, employeeID, targetUsername, amount any) *MockcoinSendingSendCall {
+func (mr *MockcoinSendingMockRecorder) Send(ctx, employeeID, targetUsername, amount, requireAcceptance any) *MockcoinSendingSendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockcoinSending)(nil).Send), ctx, employeeID, targetUsername, amount)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockcoinSending)(nil).Send), ctx, employeeID, targetUsername, amount, requireAcceptance)
 	return &MockcoinSendingSendCall{Call: call}
 }
 
@@ -60,19 +62,19 @@ type MockcoinSendingSendCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockcoinSendingSendCall) Return(arg0 error) *MockcoinSendingSendCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockcoinSendingSendCall) Return(arg0 *model.TransferRequest, arg1 error) *MockcoinSendingSendCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockcoinSendingSendCall) Do(f func(context.Context, int64, string, int64) error) *MockcoinSendingSendCall {
+func (c *MockcoinSendingSendCall) Do(f func(context.Context, int64, string, int64, bool) (*model.TransferRequest, error)) *MockcoinSendingSendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockcoinSendingSendCall) DoAndReturn(f func(context.Context, int64, string, int64) error) *MockcoinSendingSendCall {
+func (c *MockcoinSendingSendCall) DoAndReturn(f func(context.Context, int64, string, int64, bool) (*model.TransferRequest, error)) *MockcoinSendingSendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
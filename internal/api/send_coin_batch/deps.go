@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package send_coin_batch
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type coinSending interface {
+	SendBatch(ctx context.Context, employeeID int64, items []model.TransferItem) error
+}
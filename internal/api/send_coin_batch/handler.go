@@ -0,0 +1,112 @@
+package send_coin_batch
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type Handler struct {
+	coinSending coinSending
+	logger      internal.Logger
+}
+
+func New(coinSending coinSending, logger internal.Logger) (*Handler, error) {
+	if coinSending == nil {
+		return nil, errors.New("coinSending is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		coinSending: coinSending,
+		logger:      logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	var request api.SendCoinBatchRequest
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	if len(request.Transfers) == 0 {
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "transfers should contain at least one transfer")
+		return
+	}
+
+	items := make([]model.TransferItem, 0, len(request.Transfers))
+	for _, transfer := range request.Transfers {
+		items = append(items, model.TransferItem{
+			ReceiverUsername: transfer.ToUser,
+			Amount:           int64(transfer.Amount),
+		})
+	}
+
+	err := h.coinSending.SendBatch(ctx, tokenInfo.EmployeeID, items)
+	if err != nil {
+		var batchErr *model.BatchTransferError
+		if errors.As(err, &batchErr) {
+			writeBatchError(w, batchErr)
+			return
+		}
+		if api_handler.DomainError(w, err, api_handler.Recipient) {
+			return
+		}
+
+		err = fmt.Errorf("coinSending.SendBatch: %w", err)
+		logging.FromContext(ctx, h.logger).Error("POST /api/sendCoin/batch internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo),
+			zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeBatchError(w http.ResponseWriter, batchErr *model.BatchTransferError) {
+	recipients := make([]api.SendCoinBatchRecipientError, 0, len(batchErr.Items))
+	for _, item := range batchErr.Items {
+		code, text := itemError(item.Err)
+		recipients = append(recipients, api.SendCoinBatchRecipientError{
+			Index:  item.Index,
+			ToUser: item.ReceiverUsername,
+			Error:  text,
+			Code:   code,
+		})
+	}
+
+	problem := api_handler.NewProblem(w, http.StatusBadRequest, api.ErrorCodeTransfersRejected,
+		"some transfers are rejected, nothing was sent")
+	api_handler.WriteProblem(w, http.StatusBadRequest, api.SendCoinBatchErrorResponse{
+		Type:       problem.Type,
+		Title:      problem.Title,
+		Status:     problem.Status,
+		Code:       problem.Code,
+		Detail:     problem.Detail,
+		RequestId:  problem.RequestId,
+		Errors:     problem.Errors,
+		Recipients: &recipients,
+	})
+}
+
+func itemError(err error) (api.ErrorCode, string) {
+	_, code, message, ok := api_handler.Describe(err, api_handler.Recipient)
+	if !ok {
+		return api.ErrorCodeTransfersRejected, "transfer rejected"
+	}
+	return code, message
+}
@@ -0,0 +1,190 @@
+package send_coin_batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const validData = `{"transfers": [{"toUser": "test3", "amount": 200}, {"toUser": "test4", "amount": 100}]}`
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	coinSendingMock := NewMockcoinSending(ctrl)
+
+	coinSendingMock.EXPECT().
+		SendBatch(gomock.Any(), int64(1234), []model.TransferItem{
+			{ReceiverUsername: "test3", Amount: 200},
+			{ReceiverUsername: "test4", Amount: 100},
+		}).
+		Return(nil)
+
+	handler, err := New(coinSendingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin/batch", bytes.NewReader([]byte(validData)))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_Handle_BatchTransferError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	coinSendingMock := NewMockcoinSending(ctrl)
+
+	coinSendingMock.EXPECT().
+		SendBatch(gomock.Any(), int64(1234), gomock.Any()).
+		Return(&model.BatchTransferError{Items: []model.BatchTransferItemError{
+			{Index: 1, ReceiverUsername: "test4", Err: model.ErrEmployeeNotFound},
+			{Index: 2, ReceiverUsername: "test5", Err: model.ErrApprovalRequired},
+		}})
+
+	handler, err := New(coinSendingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin/batch", bytes.NewReader([]byte(validData)))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.SendCoinBatchErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, api.ErrorCodeTransfersRejected, response.Code)
+	require.Equal(t, "some transfers are rejected, nothing was sent", *response.Detail)
+	require.Equal(t, "some transfers are rejected, nothing was sent", *response.Errors)
+	require.Equal(t, []api.SendCoinBatchRecipientError{
+		{Index: 1, ToUser: "test4", Error: "recipient not found", Code: api.ErrorCodeRecipientNotFound},
+		{
+			Index: 2, ToUser: "test5", Error: "amount needs approval, send it with /api/sendCoin",
+			Code: api.ErrorCodeApprovalRequired,
+		},
+	}, *response.Recipients)
+}
+
+func TestHandler_Handle_BatchTransferError_PolicyAndBalance(t *testing.T) {
+	testCases := []struct {
+		name          string
+		itemErr       error
+		wantRecipient api.SendCoinBatchRecipientError
+	}{
+		{
+			name:    "policy",
+			itemErr: model.ErrDailyLimitExceeded,
+			wantRecipient: api.SendCoinBatchRecipientError{
+				Index: 1, ToUser: "test4", Error: "daily transfer limit exceeded",
+				Code: api.ErrorCodeDailyLimitExceeded,
+			},
+		},
+		{
+			name:    "not_enough_balance",
+			itemErr: model.ErrNotEnoughBalance,
+			wantRecipient: api.SendCoinBatchRecipientError{
+				Index: 1, ToUser: "test4", Error: "not enough balance", Code: api.ErrorCodeNotEnoughBalance,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			coinSendingMock := NewMockcoinSending(ctrl)
+
+			coinSendingMock.EXPECT().
+				SendBatch(gomock.Any(), int64(1234), gomock.Any()).
+				Return(&model.BatchTransferError{Items: []model.BatchTransferItemError{
+					{Index: 1, ReceiverUsername: "test4", Err: tc.itemErr},
+				}})
+
+			handler, err := New(coinSendingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/sendCoin/batch", bytes.NewReader([]byte(validData)))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				EmployeeID: 1234,
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			var response api.SendCoinBatchErrorResponse
+			err = json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+			require.Equal(t, api.ErrorCodeTransfersRejected, response.Code)
+			require.Equal(t, []api.SendCoinBatchRecipientError{tc.wantRecipient}, *response.Recipients)
+		})
+	}
+}
+
+func TestHandler_Handle_ErrNotEnoughBalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	coinSendingMock := NewMockcoinSending(ctrl)
+
+	coinSendingMock.EXPECT().
+		SendBatch(gomock.Any(), int64(1234), gomock.Any()).
+		Return(model.ErrNotEnoughBalance)
+
+	handler, err := New(coinSendingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin/batch", bytes.NewReader([]byte(validData)))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "not enough balance", *response.Errors)
+}
+
+func TestHandler_Handle_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	coinSendingMock := NewMockcoinSending(ctrl)
+
+	coinSendingMock.EXPECT().
+		SendBatch(gomock.Any(), int64(1234), gomock.Any()).
+		Return(assert.AnError)
+
+	handler, err := New(coinSendingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/sendCoin/batch", bytes.NewReader([]byte(validData)))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "internal server error", *response.Errors)
+}
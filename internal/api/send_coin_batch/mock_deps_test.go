@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package send_coin_batch -typed -destination mock_deps_test.go
+//
+
+// Package send_coin_batch is a generated GoMock package.
+package send_coin_batch
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcoinSending is a mock of coinSending interface.
+type MockcoinSending struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinSendingMockRecorder
+}
+
+// MockcoinSendingMockRecorder is the mock recorder for MockcoinSending.
+type MockcoinSendingMockRecorder struct {
+	mock *MockcoinSending
+}
+
+// NewMockcoinSending creates a new mock instance.
+func NewMockcoinSending(ctrl *gomock.Controller) *MockcoinSending {
+	mock := &MockcoinSending{ctrl: ctrl}
+	mock.recorder = &MockcoinSendingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinSending) EXPECT() *MockcoinSendingMockRecorder {
+	return m.recorder
+}
+
+// SendBatch mocks base method.
+func (m *MockcoinSending) SendBatch(ctx context.Context, employeeID int64, items []model.TransferItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendBatch", ctx, employeeID, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendBatch indicates an expected call of SendBatch.
+func (mr *MockcoinSendingMockRecorder) SendBatch(ctx, employeeID, items any) *MockcoinSendingSendBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendBatch", reflect.TypeOf((*MockcoinSending)(nil).SendBatch), ctx, employeeID, items)
+	return &MockcoinSendingSendBatchCall{Call: call}
+}
+
+// MockcoinSendingSendBatchCall wrap *gomock.Call
+type MockcoinSendingSendBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinSendingSendBatchCall) Return(arg0 error) *MockcoinSendingSendBatchCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinSendingSendBatchCall) Do(f func(context.Context, int64, []model.TransferItem) error) *MockcoinSendingSendBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinSendingSendBatchCall) DoAndReturn(f func(context.Context, int64, []model.TransferItem) error) *MockcoinSendingSendBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type statsCollecting interface {
+	Leaderboard(ctx context.Context, from, to time.Time, limit int) (model.Leaderboard, error)
+	SetHidden(ctx context.Context, employeeID int64, hidden bool) error
+}
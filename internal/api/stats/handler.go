@@ -0,0 +1,140 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const defaultLimit = 10
+
+type Handler struct {
+	statsCollecting statsCollecting
+	logger          internal.Logger
+}
+
+func New(statsCollecting statsCollecting, logger internal.Logger) (*Handler, error) {
+	if statsCollecting == nil {
+		return nil, errors.New("statsCollecting is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		statsCollecting: statsCollecting,
+		logger:          logger,
+	}, nil
+}
+
+func (h *Handler) HandleLeaderboard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := now
+
+	query := r.URL.Query()
+	var err error
+	if v := query.Get("from"); v != "" {
+		from, err = time.Parse(time.DateOnly, v)
+		if err != nil {
+			api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "from should be a date in YYYY-MM-DD format")
+			return
+		}
+	}
+	if v := query.Get("to"); v != "" {
+		to, err = time.Parse(time.DateOnly, v)
+		if err != nil {
+			api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "to should be a date in YYYY-MM-DD format")
+			return
+		}
+	}
+	limit := defaultLimit
+	if v := query.Get("limit"); v != "" {
+		limit, err = strconv.Atoi(v)
+		if err != nil {
+			api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "limit should be an integer")
+			return
+		}
+	}
+
+	// the API period includes the last day
+	leaderboard, err := h.statsCollecting.Leaderboard(ctx, from, to.AddDate(0, 0, 1), limit)
+	if err != nil {
+		if api_handler.DomainError(w, err) {
+			return
+		}
+
+		err = fmt.Errorf("statsCollecting.Leaderboard: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/stats/leaderboard internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.String("query", r.URL.RawQuery))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertLeaderboard(leaderboard))
+}
+
+func (h *Handler) HandleUpdatePrivacy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	var request api.StatsPrivacy
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	err := h.statsCollecting.SetHidden(ctx, tokenInfo.EmployeeID, request.Hidden)
+	if err != nil {
+		err = fmt.Errorf("statsCollecting.SetHidden: %w", err)
+		logging.FromContext(ctx, h.logger).Error("PUT /api/stats/privacy internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, request)
+}
+
+func convertLeaderboard(leaderboard model.Leaderboard) api.LeaderboardResponse {
+	res := api.LeaderboardResponse{
+		From:         leaderboard.From.Format(time.DateOnly),
+		To:           leaderboard.To.AddDate(0, 0, -1).Format(time.DateOnly),
+		TopReceivers: convertEmployees(leaderboard.TopReceivers),
+		TopGivers:    convertEmployees(leaderboard.TopGivers),
+		TopMerch:     make([]api.LeaderboardMerch, 0, len(leaderboard.TopMerch)),
+	}
+	for _, merch := range leaderboard.TopMerch {
+		res.TopMerch = append(res.TopMerch, api.LeaderboardMerch{
+			Type:     merch.MerchName,
+			Quantity: int(merch.Quantity),
+		})
+	}
+
+	return res
+}
+
+func convertEmployees(employees []model.LeaderboardEmployee) []api.LeaderboardEmployee {
+	res := make([]api.LeaderboardEmployee, 0, len(employees))
+	for _, employee := range employees {
+		res = append(res, api.LeaderboardEmployee{
+			User:   employee.Username,
+			Amount: int(employee.Amount),
+			Count:  int(employee.Count),
+		})
+	}
+
+	return res
+}
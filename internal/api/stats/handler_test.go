@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func newRequest(method, target string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+}
+
+func TestHandler_HandleLeaderboard_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statsCollectingMock := NewMockstatsCollecting(ctrl)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	statsCollectingMock.EXPECT().
+		Leaderboard(gomock.Any(), from, to, 3).
+		Return(model.Leaderboard{
+			From:         from,
+			To:           to,
+			TopReceivers: []model.LeaderboardEmployee{{Username: "test1", Amount: 300, Count: 2}},
+			TopGivers:    []model.LeaderboardEmployee{{Username: "test2", Amount: 300, Count: 2}},
+			TopMerch:     []model.LeaderboardMerch{{MerchName: "cup", Quantity: 5}},
+		}, nil)
+
+	handler, err := New(statsCollectingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleLeaderboard(w, newRequest(http.MethodGet, "/api/stats/leaderboard?from=2025-01-01&to=2025-01-31&limit=3", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `
+	{
+		"from": "2025-01-01",
+		"to": "2025-01-31",
+		"topReceivers": [{"user": "test1", "amount": 300, "count": 2}],
+		"topGivers": [{"user": "test2", "amount": 300, "count": 2}],
+		"topMerch": [{"type": "cup", "quantity": 5}]
+	}`, w.Body.String())
+}
+
+func TestHandler_HandleLeaderboard_ErrInvalidPeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statsCollectingMock := NewMockstatsCollecting(ctrl)
+
+	statsCollectingMock.EXPECT().
+		Leaderboard(gomock.Any(), gomock.Any(), gomock.Any(), defaultLimit).
+		Return(model.Leaderboard{}, model.ErrInvalidPeriod)
+
+	handler, err := New(statsCollectingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleLeaderboard(w, newRequest(http.MethodGet, "/api/stats/leaderboard?from=2025-02-01&to=2025-01-01", nil))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_HandleUpdatePrivacy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statsCollectingMock := NewMockstatsCollecting(ctrl)
+
+	statsCollectingMock.EXPECT().SetHidden(gomock.Any(), int64(1234), true).Return(nil)
+
+	handler, err := New(statsCollectingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleUpdatePrivacy(w, newRequest(http.MethodPut, "/api/stats/privacy", []byte(`{"hidden": true}`)))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"hidden": true}`, w.Body.String())
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package stats -typed -destination mock_deps_test.go
+//
+
+// Package stats is a generated GoMock package.
+package stats
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockstatsCollecting is a mock of statsCollecting interface.
+type MockstatsCollecting struct {
+	ctrl     *gomock.Controller
+	recorder *MockstatsCollectingMockRecorder
+}
+
+// MockstatsCollectingMockRecorder is the mock recorder for MockstatsCollecting.
+type MockstatsCollectingMockRecorder struct {
+	mock *MockstatsCollecting
+}
+
+// NewMockstatsCollecting creates a new mock instance.
+func NewMockstatsCollecting(ctrl *gomock.Controller) *MockstatsCollecting {
+	mock := &MockstatsCollecting{ctrl: ctrl}
+	mock.recorder = &MockstatsCollectingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstatsCollecting) EXPECT() *MockstatsCollectingMockRecorder {
+	return m.recorder
+}
+
+// Leaderboard mocks base method.
+func (m *MockstatsCollecting) Leaderboard(ctx context.Context, from, to time.Time, limit int) (model.Leaderboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leaderboard", ctx, from, to, limit)
+	ret0, _ := ret[0].(model.Leaderboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Leaderboard indicates an expected call of Leaderboard.
+func (mr *MockstatsCollectingMockRecorder) Leaderboard(ctx, from, to, limit any) *MockstatsCollectingLeaderboardCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leaderboard", reflect.TypeOf((*MockstatsCollecting)(nil).Leaderboard), ctx, from, to, limit)
+	return &MockstatsCollectingLeaderboardCall{Call: call}
+}
+
+// MockstatsCollectingLeaderboardCall wrap *gomock.Call
+type MockstatsCollectingLeaderboardCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsCollectingLeaderboardCall) Return(arg0 model.Leaderboard, arg1 error) *MockstatsCollectingLeaderboardCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsCollectingLeaderboardCall) Do(f func(context.Context, time.Time, time.Time, int) (model.Leaderboard, error)) *MockstatsCollectingLeaderboardCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsCollectingLeaderboardCall) DoAndReturn(f func(context.Context, time.Time, time.Time, int) (model.Leaderboard, error)) *MockstatsCollectingLeaderboardCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetHidden mocks base method.
+func (m *MockstatsCollecting) SetHidden(ctx context.Context, employeeID int64, hidden bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHidden", ctx, employeeID, hidden)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHidden indicates an expected call of SetHidden.
+func (mr *MockstatsCollectingMockRecorder) SetHidden(ctx, employeeID, hidden any) *MockstatsCollectingSetHiddenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHidden", reflect.TypeOf((*MockstatsCollecting)(nil).SetHidden), ctx, employeeID, hidden)
+	return &MockstatsCollectingSetHiddenCall{Call: call}
+}
+
+// MockstatsCollectingSetHiddenCall wrap *gomock.Call
+type MockstatsCollectingSetHiddenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsCollectingSetHiddenCall) Return(arg0 error) *MockstatsCollectingSetHiddenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsCollectingSetHiddenCall) Do(f func(context.Context, int64, bool) error) *MockstatsCollectingSetHiddenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsCollectingSetHiddenCall) DoAndReturn(f func(context.Context, int64, bool) error) *MockstatsCollectingSetHiddenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,18 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package transfer_requests
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type transferApproving interface {
+	List(ctx context.Context, senderID int64) ([]model.TransferRequest, error)
+	ListByStatus(ctx context.Context, status model.TransferRequestStatus) ([]model.TransferRequest, error)
+	Approve(ctx context.Context, approverID, requestID int64) (model.TransferRequest, error)
+	Reject(ctx context.Context, approverID, requestID int64) (model.TransferRequest, error)
+	Inbox(ctx context.Context, receiverID int64) ([]model.TransferRequest, error)
+	Accept(ctx context.Context, receiverID, requestID int64) (model.TransferRequest, error)
+	Decline(ctx context.Context, receiverID, requestID int64) (model.TransferRequest, error)
+}
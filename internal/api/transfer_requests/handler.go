@@ -0,0 +1,182 @@
+package transfer_requests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type Handler struct {
+	transferApproving transferApproving
+	logger            internal.Logger
+}
+
+func New(transferApproving transferApproving, logger internal.Logger) (*Handler, error) {
+	if transferApproving == nil {
+		return nil, errors.New("transferApproving is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		transferApproving: transferApproving,
+		logger:            logger,
+	}, nil
+}
+
+func (h *Handler) HandleList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	requests, err := h.transferApproving.List(ctx, tokenInfo.EmployeeID)
+	if err != nil {
+		err = fmt.Errorf("transferApproving.List: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/transferRequests internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertTransferRequests(requests))
+}
+
+func (h *Handler) HandleApprovalList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if !isApprover(tokenInfo.Role) {
+		api_handler.Forbidden(w, api.ErrorCodeForbidden, "approver role required")
+		return
+	}
+
+	status := model.TransferRequestStatus(r.URL.Query().Get("status"))
+
+	requests, err := h.transferApproving.ListByStatus(ctx, status)
+	if err != nil {
+		if api_handler.DomainError(w, err) {
+			return
+		}
+
+		err = fmt.Errorf("transferApproving.ListByStatus: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/approvals/transferRequests internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertTransferRequests(requests))
+}
+
+func (h *Handler) HandleApprove(w http.ResponseWriter, r *http.Request) {
+	if !isApprover(jwt.TokenInfoFromContext(r.Context()).Role) {
+		api_handler.Forbidden(w, api.ErrorCodeForbidden, "approver role required")
+		return
+	}
+
+	h.handleDecision(w, r, "POST /api/approvals/transferRequests/{id}/approve", "Approve",
+		h.transferApproving.Approve)
+}
+
+func (h *Handler) HandleReject(w http.ResponseWriter, r *http.Request) {
+	if !isApprover(jwt.TokenInfoFromContext(r.Context()).Role) {
+		api_handler.Forbidden(w, api.ErrorCodeForbidden, "approver role required")
+		return
+	}
+
+	h.handleDecision(w, r, "POST /api/approvals/transferRequests/{id}/reject", "Reject",
+		h.transferApproving.Reject)
+}
+
+func (h *Handler) HandleInbox(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	requests, err := h.transferApproving.Inbox(ctx, tokenInfo.EmployeeID)
+	if err != nil {
+		err = fmt.Errorf("transferApproving.Inbox: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/inbox internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertTransferRequests(requests))
+}
+
+func (h *Handler) HandleAccept(w http.ResponseWriter, r *http.Request) {
+	h.handleDecision(w, r, "POST /api/inbox/{id}/accept", "Accept", h.transferApproving.Accept)
+}
+
+func (h *Handler) HandleDecline(w http.ResponseWriter, r *http.Request) {
+	h.handleDecision(w, r, "POST /api/inbox/{id}/decline", "Decline", h.transferApproving.Decline)
+}
+
+func (h *Handler) handleDecision(
+	w http.ResponseWriter,
+	r *http.Request,
+	route string,
+	method string,
+	decide func(ctx context.Context, deciderID, requestID int64) (model.TransferRequest, error),
+) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	id, ok := api_handler.ParseID(w, r)
+	if !ok {
+		return
+	}
+
+	request, err := decide(ctx, tokenInfo.EmployeeID, id)
+	if err != nil {
+		if api_handler.DomainError(w, err) {
+			return
+		}
+
+		err = fmt.Errorf("transferApproving.%s: %w", method, err)
+		logging.FromContext(ctx, h.logger).Error(route+" internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Int64("id", id))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertTransferRequest(request))
+}
+
+// isApprover reports whether the role can decide on transfer requests, admins can do everything approvers can.
+func isApprover(role model.Role) bool {
+	return role == model.RoleApprover || role == model.RoleAdmin
+}
+
+func convertTransferRequests(requests []model.TransferRequest) api.TransferRequestsResponse {
+	res := make([]api.TransferRequest, 0, len(requests))
+	for _, request := range requests {
+		res = append(res, convertTransferRequest(request))
+	}
+	return api.TransferRequestsResponse{TransferRequests: res}
+}
+
+func convertTransferRequest(request model.TransferRequest) api.TransferRequest {
+	return api.TransferRequest{
+		Id:                request.ID,
+		Kind:              api.TransferRequestKind(request.Kind),
+		FromUser:          request.SenderUsername,
+		ToUser:            request.ReceiverUsername,
+		Amount:            int(request.Amount),
+		Status:            api.TransferRequestStatus(request.Status),
+		RequireAcceptance: request.RequireAcceptance,
+		ExpireTime:        request.ExpireTime,
+		DecidedBy:         api_handler.NonEmptyOrNil(request.DecidedByUsername),
+		CreateTime:        request.CreateTime,
+		DecideTime:        request.DecideTime,
+	}
+}
@@ -0,0 +1,429 @@
+package transfer_requests
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+var (
+	createTime = time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	expireTime = time.Date(2025, 2, 17, 12, 0, 0, 0, time.UTC)
+	decideTime = time.Date(2025, 2, 15, 12, 0, 0, 0, time.UTC)
+)
+
+func newRequest(method, target string, body []byte, role model.Role) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+		Role:       role,
+	}))
+}
+
+func testTransferRequest(status model.TransferRequestStatus) model.TransferRequest {
+	return model.TransferRequest{
+		ID:               7,
+		Kind:             model.TransferRequestKindApproval,
+		SenderID:         1234,
+		SenderUsername:   "test1",
+		ReceiverID:       1235,
+		ReceiverUsername: "test2",
+		Amount:           700,
+		Status:           status,
+		ExpireTime:       expireTime,
+		CreateTime:       createTime,
+	}
+}
+
+func TestHandler_HandleList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferApprovingMock := NewMocktransferApproving(ctrl)
+
+	transferApprovingMock.EXPECT().
+		List(gomock.Any(), int64(1234)).
+		Return([]model.TransferRequest{testTransferRequest(model.TransferRequestStatusPending)}, nil)
+
+	handler, err := New(transferApprovingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleList(w, newRequest(http.MethodGet, "/api/transferRequests", nil, model.RoleEmployee))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `
+	{
+		"transferRequests": [
+			{
+				"id": 7,
+				"kind": "approval",
+				"fromUser": "test1",
+				"toUser": "test2",
+				"amount": 700,
+				"status": "pending",
+				"requireAcceptance": false,
+				"expireTime": "2025-02-17T12:00:00Z",
+				"createTime": "2025-02-14T12:00:00Z"
+			}
+		]
+	}`, w.Body.String())
+}
+
+func TestHandler_HandleApprovalList(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       model.Role
+		target     string
+		prepare    func(m *MocktransferApproving)
+		wantStatus int
+	}{
+		{
+			name:   "approver.pending",
+			role:   model.RoleApprover,
+			target: "/api/approvals/transferRequests",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().ListByStatus(gomock.Any(), model.TransferRequestStatus("")).
+					Return([]model.TransferRequest{testTransferRequest(model.TransferRequestStatusPending)}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "admin.expired",
+			role:   model.RoleAdmin,
+			target: "/api/approvals/transferRequests?status=expired",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().ListByStatus(gomock.Any(), model.TransferRequestStatusExpired).Return(nil, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "invalid_status",
+			role:   model.RoleApprover,
+			target: "/api/approvals/transferRequests?status=lost",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().ListByStatus(gomock.Any(), model.TransferRequestStatus("lost")).
+					Return(nil, model.ErrInvalidTransferStatus)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "staff.forbidden",
+			role:       model.RoleStaff,
+			target:     "/api/approvals/transferRequests",
+			prepare:    func(_ *MocktransferApproving) {},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			transferApprovingMock := NewMocktransferApproving(ctrl)
+			tc.prepare(transferApprovingMock)
+
+			handler, err := New(transferApprovingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			handler.HandleApprovalList(w, newRequest(http.MethodGet, tc.target, nil, tc.role))
+
+			require.Equal(t, tc.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleApprove(t *testing.T) {
+	approved := testTransferRequest(model.TransferRequestStatusApproved)
+	approved.DecidedBy = 1234
+	approved.DecidedByUsername = "approver"
+	approved.DecideTime = &decideTime
+
+	testCases := []struct {
+		name       string
+		role       model.Role
+		id         string
+		prepare    func(m *MocktransferApproving)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "success",
+			role: model.RoleApprover,
+			id:   "7",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().Approve(gomock.Any(), int64(1234), int64(7)).Return(approved, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: `
+			{
+				"id": 7,
+				"kind": "approval",
+				"fromUser": "test1",
+				"toUser": "test2",
+				"amount": 700,
+				"status": "approved",
+				"requireAcceptance": false,
+				"expireTime": "2025-02-17T12:00:00Z",
+				"decidedBy": "approver",
+				"createTime": "2025-02-14T12:00:00Z",
+				"decideTime": "2025-02-15T12:00:00Z"
+			}`,
+		},
+		{
+			name: "not_found",
+			role: model.RoleApprover,
+			id:   "7",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().Approve(gomock.Any(), int64(1234), int64(7)).
+					Return(model.TransferRequest{}, model.ErrTransferRequestNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody: `{
+				"type": "urn:avito-shop:problem:TRANSFER_REQUEST_NOT_FOUND", "title": "Transfer request not found", "status": 404,
+				"code": "TRANSFER_REQUEST_NOT_FOUND", "detail": "transfer request not found", "errors": "transfer request not found"
+			}`,
+		},
+		{
+			name: "already_decided",
+			role: model.RoleAdmin,
+			id:   "7",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().Approve(gomock.Any(), int64(1234), int64(7)).
+					Return(model.TransferRequest{}, model.ErrTransferRequestDecided)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{
+				"type": "urn:avito-shop:problem:TRANSFER_REQUEST_DECIDED", "title": "Transfer request already decided", "status": 400,
+				"code": "TRANSFER_REQUEST_DECIDED", "detail": "transfer request is already decided", "errors": "transfer request is already decided"
+			}`,
+		},
+		{
+			name: "own_transfer",
+			role: model.RoleApprover,
+			id:   "7",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().Approve(gomock.Any(), int64(1234), int64(7)).
+					Return(model.TransferRequest{}, model.ErrSelfApprovalNotAllowed)
+			},
+			wantStatus: http.StatusForbidden,
+			wantBody: `{
+				"type": "urn:avito-shop:problem:SELF_APPROVAL_NOT_ALLOWED", "title": "Deciding on own transfer not allowed", "status": 403,
+				"code": "SELF_APPROVAL_NOT_ALLOWED", "detail": "deciding on your own transfer not allowed", "errors": "deciding on your own transfer not allowed"
+			}`,
+		},
+		{
+			name:       "invalid_id",
+			role:       model.RoleApprover,
+			id:         "seven",
+			prepare:    func(_ *MocktransferApproving) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{
+				"type": "urn:avito-shop:problem:VALIDATION_FAILED", "title": "Request is invalid", "status": 400,
+				"code": "VALIDATION_FAILED", "detail": "id should be an integer", "errors": "id should be an integer"
+			}`,
+		},
+		{
+			name:       "employee.forbidden",
+			role:       model.RoleEmployee,
+			id:         "7",
+			prepare:    func(_ *MocktransferApproving) {},
+			wantStatus: http.StatusForbidden,
+			wantBody: `{
+				"type": "urn:avito-shop:problem:FORBIDDEN", "title": "Access denied", "status": 403,
+				"code": "FORBIDDEN", "detail": "approver role required", "errors": "approver role required"
+			}`,
+		},
+		{
+			name: "internal_error",
+			role: model.RoleApprover,
+			id:   "7",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().Approve(gomock.Any(), int64(1234), int64(7)).
+					Return(model.TransferRequest{}, assert.AnError)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody: `{
+				"type": "urn:avito-shop:problem:INTERNAL_ERROR", "title": "Internal server error", "status": 500,
+				"code": "INTERNAL_ERROR", "detail": "internal server error", "errors": "internal server error"
+			}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			transferApprovingMock := NewMocktransferApproving(ctrl)
+			tc.prepare(transferApprovingMock)
+
+			handler, err := New(transferApprovingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := newRequest(http.MethodPost, "/api/approvals/transferRequests/"+tc.id+"/approve", nil, tc.role)
+			req.SetPathValue("id", tc.id)
+			w := httptest.NewRecorder()
+			handler.HandleApprove(w, req)
+
+			require.Equal(t, tc.wantStatus, w.Code)
+			require.JSONEq(t, tc.wantBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_HandleReject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferApprovingMock := NewMocktransferApproving(ctrl)
+
+	transferApprovingMock.EXPECT().
+		Reject(gomock.Any(), int64(1234), int64(7)).
+		Return(model.TransferRequest{}, model.ErrTransferRequestExpired)
+
+	handler, err := New(transferApprovingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodPost, "/api/approvals/transferRequests/7/reject", nil, model.RoleApprover)
+	req.SetPathValue("id", "7")
+	w := httptest.NewRecorder()
+	handler.HandleReject(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{
+		"type": "urn:avito-shop:problem:TRANSFER_REQUEST_EXPIRED", "title": "Transfer request expired", "status": 400,
+		"code": "TRANSFER_REQUEST_EXPIRED", "detail": "transfer request is expired", "errors": "transfer request is expired"
+	}`, w.Body.String())
+}
+
+func TestHandler_HandleInbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferApprovingMock := NewMocktransferApproving(ctrl)
+
+	incoming := testTransferRequest(model.TransferRequestStatusPending)
+	incoming.Kind = model.TransferRequestKindAcceptance
+	incoming.SenderUsername, incoming.ReceiverUsername = "test2", "test1"
+
+	transferApprovingMock.EXPECT().
+		Inbox(gomock.Any(), int64(1234)).
+		Return([]model.TransferRequest{incoming}, nil)
+
+	handler, err := New(transferApprovingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleInbox(w, newRequest(http.MethodGet, "/api/inbox", nil, model.RoleEmployee))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `
+	{
+		"transferRequests": [
+			{
+				"id": 7,
+				"kind": "acceptance",
+				"fromUser": "test2",
+				"toUser": "test1",
+				"amount": 700,
+				"status": "pending",
+				"requireAcceptance": false,
+				"expireTime": "2025-02-17T12:00:00Z",
+				"createTime": "2025-02-14T12:00:00Z"
+			}
+		]
+	}`, w.Body.String())
+}
+
+func TestHandler_HandleAccept(t *testing.T) {
+	testCases := []struct {
+		name       string
+		prepare    func(m *MocktransferApproving)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			prepare: func(m *MocktransferApproving) {
+				accepted := testTransferRequest(model.TransferRequestStatusAccepted)
+				accepted.Kind = model.TransferRequestKindAcceptance
+				m.EXPECT().Accept(gomock.Any(), int64(1234), int64(7)).Return(accepted, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "not_found",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().Accept(gomock.Any(), int64(1234), int64(7)).
+					Return(model.TransferRequest{}, model.ErrTransferRequestNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "expired",
+			prepare: func(m *MocktransferApproving) {
+				m.EXPECT().Accept(gomock.Any(), int64(1234), int64(7)).
+					Return(model.TransferRequest{}, model.ErrTransferRequestExpired)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			transferApprovingMock := NewMocktransferApproving(ctrl)
+			tc.prepare(transferApprovingMock)
+
+			handler, err := New(transferApprovingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			// any employee can decide on transfers sent to them
+			req := newRequest(http.MethodPost, "/api/inbox/7/accept", nil, model.RoleEmployee)
+			req.SetPathValue("id", "7")
+			w := httptest.NewRecorder()
+			handler.HandleAccept(w, req)
+
+			require.Equal(t, tc.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleDecline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transferApprovingMock := NewMocktransferApproving(ctrl)
+
+	declined := testTransferRequest(model.TransferRequestStatusDeclined)
+	declined.Kind = model.TransferRequestKindAcceptance
+	declined.DecidedBy = 1234
+	declined.DecidedByUsername = "test2"
+	declined.DecideTime = &decideTime
+	transferApprovingMock.EXPECT().Decline(gomock.Any(), int64(1234), int64(7)).Return(declined, nil)
+
+	handler, err := New(transferApprovingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodPost, "/api/inbox/7/decline", nil, model.RoleEmployee)
+	req.SetPathValue("id", "7")
+	w := httptest.NewRecorder()
+	handler.HandleDecline(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `
+	{
+		"id": 7,
+		"kind": "acceptance",
+		"fromUser": "test1",
+		"toUser": "test2",
+		"amount": 700,
+		"status": "declined",
+		"requireAcceptance": false,
+		"expireTime": "2025-02-17T12:00:00Z",
+		"decidedBy": "test2",
+		"createTime": "2025-02-14T12:00:00Z",
+		"decideTime": "2025-02-15T12:00:00Z"
+	}`, w.Body.String())
+}
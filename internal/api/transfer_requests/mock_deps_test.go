@@ -0,0 +1,314 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package transfer_requests -typed -destination mock_deps_test.go
+//
+
+// Package transfer_requests is a generated GoMock package.
+package transfer_requests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktransferApproving is a mock of transferApproving interface.
+type MocktransferApproving struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransferApprovingMockRecorder
+}
+
+// MocktransferApprovingMockRecorder is the mock recorder for MocktransferApproving.
+type MocktransferApprovingMockRecorder struct {
+	mock *MocktransferApproving
+}
+
+// NewMocktransferApproving creates a new mock instance.
+func NewMocktransferApproving(ctrl *gomock.Controller) *MocktransferApproving {
+	mock := &MocktransferApproving{ctrl: ctrl}
+	mock.recorder = &MocktransferApprovingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransferApproving) EXPECT() *MocktransferApprovingMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MocktransferApproving) Accept(ctx context.Context, receiverID, requestID int64) (model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, receiverID, requestID)
+	ret0, _ := ret[0].(model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MocktransferApprovingMockRecorder) Accept(ctx, receiverID, requestID any) *MocktransferApprovingAcceptCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MocktransferApproving)(nil).Accept), ctx, receiverID, requestID)
+	return &MocktransferApprovingAcceptCall{Call: call}
+}
+
+// MocktransferApprovingAcceptCall wrap *gomock.Call
+type MocktransferApprovingAcceptCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferApprovingAcceptCall) Return(arg0 model.TransferRequest, arg1 error) *MocktransferApprovingAcceptCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferApprovingAcceptCall) Do(f func(context.Context, int64, int64) (model.TransferRequest, error)) *MocktransferApprovingAcceptCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferApprovingAcceptCall) DoAndReturn(f func(context.Context, int64, int64) (model.TransferRequest, error)) *MocktransferApprovingAcceptCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Approve mocks base method.
+func (m *MocktransferApproving) Approve(ctx context.Context, approverID, requestID int64) (model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, approverID, requestID)
+	ret0, _ := ret[0].(model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MocktransferApprovingMockRecorder) Approve(ctx, approverID, requestID any) *MocktransferApprovingApproveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MocktransferApproving)(nil).Approve), ctx, approverID, requestID)
+	return &MocktransferApprovingApproveCall{Call: call}
+}
+
+// MocktransferApprovingApproveCall wrap *gomock.Call
+type MocktransferApprovingApproveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferApprovingApproveCall) Return(arg0 model.TransferRequest, arg1 error) *MocktransferApprovingApproveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferApprovingApproveCall) Do(f func(context.Context, int64, int64) (model.TransferRequest, error)) *MocktransferApprovingApproveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferApprovingApproveCall) DoAndReturn(f func(context.Context, int64, int64) (model.TransferRequest, error)) *MocktransferApprovingApproveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Decline mocks base method.
+func (m *MocktransferApproving) Decline(ctx context.Context, receiverID, requestID int64) (model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", ctx, receiverID, requestID)
+	ret0, _ := ret[0].(model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MocktransferApprovingMockRecorder) Decline(ctx, receiverID, requestID any) *MocktransferApprovingDeclineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MocktransferApproving)(nil).Decline), ctx, receiverID, requestID)
+	return &MocktransferApprovingDeclineCall{Call: call}
+}
+
+// MocktransferApprovingDeclineCall wrap *gomock.Call
+type MocktransferApprovingDeclineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferApprovingDeclineCall) Return(arg0 model.TransferRequest, arg1 error) *MocktransferApprovingDeclineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferApprovingDeclineCall) Do(f func(context.Context, int64, int64) (model.TransferRequest, error)) *MocktransferApprovingDeclineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferApprovingDeclineCall) DoAndReturn(f func(context.Context, int64, int64) (model.TransferRequest, error)) *MocktransferApprovingDeclineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Inbox mocks base method.
+func (m *MocktransferApproving) Inbox(ctx context.Context, receiverID int64) ([]model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inbox", ctx, receiverID)
+	ret0, _ := ret[0].([]model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Inbox indicates an expected call of Inbox.
+func (mr *MocktransferApprovingMockRecorder) Inbox(ctx, receiverID any) *MocktransferApprovingInboxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inbox", reflect.TypeOf((*MocktransferApproving)(nil).Inbox), ctx, receiverID)
+	return &MocktransferApprovingInboxCall{Call: call}
+}
+
+// MocktransferApprovingInboxCall wrap *gomock.Call
+type MocktransferApprovingInboxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferApprovingInboxCall) Return(arg0 []model.TransferRequest, arg1 error) *MocktransferApprovingInboxCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferApprovingInboxCall) Do(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferApprovingInboxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferApprovingInboxCall) DoAndReturn(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferApprovingInboxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MocktransferApproving) List(ctx context.Context, senderID int64) ([]model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, senderID)
+	ret0, _ := ret[0].([]model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MocktransferApprovingMockRecorder) List(ctx, senderID any) *MocktransferApprovingListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocktransferApproving)(nil).List), ctx, senderID)
+	return &MocktransferApprovingListCall{Call: call}
+}
+
+// MocktransferApprovingListCall wrap *gomock.Call
+type MocktransferApprovingListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferApprovingListCall) Return(arg0 []model.TransferRequest, arg1 error) *MocktransferApprovingListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferApprovingListCall) Do(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferApprovingListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferApprovingListCall) DoAndReturn(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferApprovingListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListByStatus mocks base method.
+func (m *MocktransferApproving) ListByStatus(ctx context.Context, status model.TransferRequestStatus) ([]model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByStatus", ctx, status)
+	ret0, _ := ret[0].([]model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByStatus indicates an expected call of ListByStatus.
+func (mr *MocktransferApprovingMockRecorder) ListByStatus(ctx, status any) *MocktransferApprovingListByStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByStatus", reflect.TypeOf((*MocktransferApproving)(nil).ListByStatus), ctx, status)
+	return &MocktransferApprovingListByStatusCall{Call: call}
+}
+
+// MocktransferApprovingListByStatusCall wrap *gomock.Call
+type MocktransferApprovingListByStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferApprovingListByStatusCall) Return(arg0 []model.TransferRequest, arg1 error) *MocktransferApprovingListByStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferApprovingListByStatusCall) Do(f func(context.Context, model.TransferRequestStatus) ([]model.TransferRequest, error)) *MocktransferApprovingListByStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferApprovingListByStatusCall) DoAndReturn(f func(context.Context, model.TransferRequestStatus) ([]model.TransferRequest, error)) *MocktransferApprovingListByStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reject mocks base method.
+func (m *MocktransferApproving) Reject(ctx context.Context, approverID, requestID int64) (model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, approverID, requestID)
+	ret0, _ := ret[0].(model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MocktransferApprovingMockRecorder) Reject(ctx, approverID, requestID any) *MocktransferApprovingRejectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MocktransferApproving)(nil).Reject), ctx, approverID, requestID)
+	return &MocktransferApprovingRejectCall{Call: call}
+}
+
+// MocktransferApprovingRejectCall wrap *gomock.Call
+type MocktransferApprovingRejectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferApprovingRejectCall) Return(arg0 model.TransferRequest, arg1 error) *MocktransferApprovingRejectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferApprovingRejectCall) Do(f func(context.Context, int64, int64) (model.TransferRequest, error)) *MocktransferApprovingRejectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferApprovingRejectCall) DoAndReturn(f func(context.Context, int64, int64) (model.TransferRequest, error)) *MocktransferApprovingRejectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
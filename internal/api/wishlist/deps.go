@@ -0,0 +1,14 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package wishlist
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type wishlistManaging interface {
+	List(ctx context.Context, employeeID int64) ([]model.WishlistItem, error)
+	Add(ctx context.Context, employeeID int64, merchName string) ([]model.WishlistItem, error)
+	Remove(ctx context.Context, employeeID int64, merchName string) ([]model.WishlistItem, error)
+}
@@ -0,0 +1,120 @@
+package wishlist
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type Handler struct {
+	wishlistManaging wishlistManaging
+	logger           internal.Logger
+}
+
+func New(wishlistManaging wishlistManaging, logger internal.Logger) (*Handler, error) {
+	if wishlistManaging == nil {
+		return nil, errors.New("wishlistManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		wishlistManaging: wishlistManaging,
+		logger:           logger,
+	}, nil
+}
+
+func (h *Handler) HandleList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	items, err := h.wishlistManaging.List(ctx, tokenInfo.EmployeeID)
+	if err != nil {
+		err = fmt.Errorf("wishlistManaging.List: %w", err)
+		logging.FromContext(ctx, h.logger).Error("GET /api/wishlist internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertWishlist(items))
+}
+
+func (h *Handler) HandleAdd(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	var request api.WishlistItemRequest
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	items, err := h.wishlistManaging.Add(ctx, tokenInfo.EmployeeID, request.Item)
+	if err != nil {
+		if api_handler.DomainError(w, err, api_handler.Merch(request.Item)...) {
+			return
+		}
+
+		err = fmt.Errorf("wishlistManaging.Add: %w", err)
+		logging.FromContext(ctx, h.logger).Error("POST /api/wishlist internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo),
+			zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertWishlist(items))
+}
+
+func (h *Handler) HandleRemove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	merchName := r.PathValue("item")
+	if merchName == "" {
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, "item is required")
+		return
+	}
+
+	items, err := h.wishlistManaging.Remove(ctx, tokenInfo.EmployeeID, merchName)
+	if err != nil {
+		if api_handler.DomainError(w, err, api_handler.Merch(merchName)...) {
+			return
+		}
+
+		err = fmt.Errorf("wishlistManaging.Remove: %w", err)
+		logging.FromContext(ctx, h.logger).Error("DELETE /api/wishlist/{item} internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.String("item", merchName))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertWishlist(items))
+}
+
+func convertWishlist(items []model.WishlistItem) api.WishlistResponse {
+	res := make([]api.WishlistItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, api.WishlistItem{
+			Item:         item.MerchName,
+			Price:        int(item.Price),
+			AddedPrice:   int(item.AddedPrice),
+			PriceDrop:    int(item.PriceDrop()),
+			Affordable:   item.Affordable(),
+			MissingCoins: int(item.MissingCoins),
+		})
+	}
+
+	return api.WishlistResponse{
+		Items: res,
+	}
+}
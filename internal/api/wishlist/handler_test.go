@@ -0,0 +1,86 @@
+package wishlist
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func newRequest(method, target string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		EmployeeID: 1234,
+	}))
+}
+
+func TestHandler_HandleList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	wishlistManagingMock := NewMockwishlistManaging(ctrl)
+
+	wishlistManagingMock.EXPECT().List(gomock.Any(), int64(1234)).Return([]model.WishlistItem{
+		{MerchID: 10, MerchName: "pink-hoody", Price: 500, AddedPrice: 500, MissingCoins: 200},
+		{MerchID: 6, MerchName: "hoody", Price: 250, AddedPrice: 300},
+	}, nil)
+
+	handler, err := New(wishlistManagingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleList(w, newRequest(http.MethodGet, "/api/wishlist", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.WishlistResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, api.WishlistResponse{Items: []api.WishlistItem{
+		{Item: "pink-hoody", Price: 500, AddedPrice: 500, PriceDrop: 0, Affordable: false, MissingCoins: 200},
+		{Item: "hoody", Price: 250, AddedPrice: 300, PriceDrop: 50, Affordable: true, MissingCoins: 0},
+	}}, response)
+}
+
+func TestHandler_HandleAdd_MerchNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	wishlistManagingMock := NewMockwishlistManaging(ctrl)
+
+	wishlistManagingMock.EXPECT().Add(gomock.Any(), int64(1234), "car").Return(nil, model.ErrMerchNotFound)
+
+	handler, err := New(wishlistManagingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleAdd(w, newRequest(http.MethodPost, "/api/wishlist", []byte(`{"item": "car"}`)))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, "no merch with name car", *response.Errors)
+}
+
+func TestHandler_HandleRemove_NotInWishlist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	wishlistManagingMock := NewMockwishlistManaging(ctrl)
+
+	wishlistManagingMock.EXPECT().Remove(gomock.Any(), int64(1234), "cup").Return(nil, model.ErrWishlistItemNotFound)
+
+	handler, err := New(wishlistManagingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := newRequest(http.MethodDelete, "/api/wishlist/cup", nil)
+	req.SetPathValue("item", "cup")
+	w := httptest.NewRecorder()
+	handler.HandleRemove(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
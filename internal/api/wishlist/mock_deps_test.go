@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package wishlist -typed -destination mock_deps_test.go
+//
+
+// Package wishlist is a generated GoMock package.
+package wishlist
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwishlistManaging is a mock of wishlistManaging interface.
+type MockwishlistManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockwishlistManagingMockRecorder
+}
+
+// MockwishlistManagingMockRecorder is the mock recorder for MockwishlistManaging.
+type MockwishlistManagingMockRecorder struct {
+	mock *MockwishlistManaging
+}
+
+// NewMockwishlistManaging creates a new mock instance.
+func NewMockwishlistManaging(ctrl *gomock.Controller) *MockwishlistManaging {
+	mock := &MockwishlistManaging{ctrl: ctrl}
+	mock.recorder = &MockwishlistManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwishlistManaging) EXPECT() *MockwishlistManagingMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockwishlistManaging) Add(ctx context.Context, employeeID int64, merchName string) ([]model.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, merchName)
+	ret0, _ := ret[0].([]model.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockwishlistManagingMockRecorder) Add(ctx, employeeID, merchName any) *MockwishlistManagingAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockwishlistManaging)(nil).Add), ctx, employeeID, merchName)
+	return &MockwishlistManagingAddCall{Call: call}
+}
+
+// MockwishlistManagingAddCall wrap *gomock.Call
+type MockwishlistManagingAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwishlistManagingAddCall) Return(arg0 []model.WishlistItem, arg1 error) *MockwishlistManagingAddCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwishlistManagingAddCall) Do(f func(context.Context, int64, string) ([]model.WishlistItem, error)) *MockwishlistManagingAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwishlistManagingAddCall) DoAndReturn(f func(context.Context, int64, string) ([]model.WishlistItem, error)) *MockwishlistManagingAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockwishlistManaging) List(ctx context.Context, employeeID int64) ([]model.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, employeeID)
+	ret0, _ := ret[0].([]model.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockwishlistManagingMockRecorder) List(ctx, employeeID any) *MockwishlistManagingListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockwishlistManaging)(nil).List), ctx, employeeID)
+	return &MockwishlistManagingListCall{Call: call}
+}
+
+// MockwishlistManagingListCall wrap *gomock.Call
+type MockwishlistManagingListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwishlistManagingListCall) Return(arg0 []model.WishlistItem, arg1 error) *MockwishlistManagingListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwishlistManagingListCall) Do(f func(context.Context, int64) ([]model.WishlistItem, error)) *MockwishlistManagingListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwishlistManagingListCall) DoAndReturn(f func(context.Context, int64) ([]model.WishlistItem, error)) *MockwishlistManagingListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Remove mocks base method.
+func (m *MockwishlistManaging) Remove(ctx context.Context, employeeID int64, merchName string) ([]model.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, employeeID, merchName)
+	ret0, _ := ret[0].([]model.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockwishlistManagingMockRecorder) Remove(ctx, employeeID, merchName any) *MockwishlistManagingRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockwishlistManaging)(nil).Remove), ctx, employeeID, merchName)
+	return &MockwishlistManagingRemoveCall{Call: call}
+}
+
+// MockwishlistManagingRemoveCall wrap *gomock.Call
+type MockwishlistManagingRemoveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwishlistManagingRemoveCall) Return(arg0 []model.WishlistItem, arg1 error) *MockwishlistManagingRemoveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwishlistManagingRemoveCall) Do(f func(context.Context, int64, string) ([]model.WishlistItem, error)) *MockwishlistManagingRemoveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwishlistManagingRemoveCall) DoAndReturn(f func(context.Context, int64, string) ([]model.WishlistItem, error)) *MockwishlistManagingRemoveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
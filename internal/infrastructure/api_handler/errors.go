@@ -0,0 +1,248 @@
+package api_handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// domainError is the response of a domain error
+type domainError struct {
+	err      error
+	status   int
+	grpcCode codes.Code
+	code     api.ErrorCode
+	message  string
+}
+
+// domainErrors is the one place domain errors get their HTTP status, gRPC code, error code and message,
+// so every HTTP and gRPC handler answers alike. The first matching entry wins.
+var domainErrors = []domainError{
+	{
+		model.ErrEmployeeNotFound, http.StatusBadRequest, codes.NotFound, api.ErrorCodeEmployeeNotFound,
+		"employee not found",
+	},
+	{
+		model.ErrWrongEmployeePassword, http.StatusUnauthorized, codes.Unauthenticated, api.ErrorCodeWrongPassword,
+		"wrong user password",
+	},
+
+	{model.ErrMerchNotFound, http.StatusBadRequest, codes.NotFound, api.ErrorCodeMerchNotFound, "merch not found"},
+	{
+		model.ErrVariantRequired, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeVariantRequired,
+		"size or color should be chosen",
+	},
+	{
+		model.ErrVariantNotFound, http.StatusBadRequest, codes.NotFound, api.ErrorCodeVariantNotFound,
+		"no such size or color",
+	},
+	{model.ErrOutOfStock, http.StatusBadRequest, codes.FailedPrecondition, api.ErrorCodeOutOfStock, "out of stock"},
+
+	{
+		model.ErrNotEnoughBalance, http.StatusBadRequest, codes.FailedPrecondition, api.ErrorCodeNotEnoughBalance,
+		"not enough balance",
+	},
+	{
+		model.ErrSendingCoinsToMyselfNotAllowed, http.StatusBadRequest, codes.InvalidArgument,
+		api.ErrorCodeSelfTransferNotAllowed, "sending coins to yourself not allowed",
+	},
+	{
+		model.ErrInvalidAmount, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeInvalidAmount,
+		"amount should be positive",
+	},
+
+	{
+		model.ErrAmountBelowMinimum, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeAmountBelowMinimum,
+		"amount is below the minimum transfer amount",
+	},
+	{
+		model.ErrAmountAboveMaximum, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeAmountAboveMaximum,
+		"amount is above the maximum transfer amount",
+	},
+	{
+		model.ErrDailyLimitExceeded, http.StatusBadRequest, codes.ResourceExhausted, api.ErrorCodeDailyLimitExceeded,
+		"daily transfer limit exceeded",
+	},
+	{
+		model.ErrMonthlyLimitExceeded, http.StatusBadRequest, codes.ResourceExhausted,
+		api.ErrorCodeMonthlyLimitExceeded, "monthly transfer limit exceeded",
+	},
+	{
+		model.ErrTransferCooldown, http.StatusBadRequest, codes.FailedPrecondition, api.ErrorCodeTransferCooldown,
+		"transfer to this employee is too soon, try again later",
+	},
+	{
+		model.ErrTransferBlocked, http.StatusForbidden, codes.PermissionDenied, api.ErrorCodeTransferBlocked,
+		"transfers to this employee are not allowed",
+	},
+
+	{
+		model.ErrApprovalRequired, http.StatusBadRequest, codes.FailedPrecondition, api.ErrorCodeApprovalRequired,
+		"amount needs approval, send it with /api/sendCoin",
+	},
+	{
+		model.ErrTransferRequestNotFound, http.StatusNotFound, codes.NotFound, api.ErrorCodeTransferRequestNotFound,
+		"transfer request not found",
+	},
+	{
+		model.ErrTransferRequestDecided, http.StatusBadRequest, codes.FailedPrecondition,
+		api.ErrorCodeTransferRequestDecided, "transfer request is already decided",
+	},
+	{
+		model.ErrTransferRequestExpired, http.StatusBadRequest, codes.FailedPrecondition,
+		api.ErrorCodeTransferRequestExpired, "transfer request is expired",
+	},
+	{
+		model.ErrInvalidTransferStatus, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeValidationFailed,
+		"status should be one of pending, approved, rejected, expired, accepted, declined",
+	},
+	{
+		model.ErrSelfApprovalNotAllowed, http.StatusForbidden, codes.PermissionDenied,
+		api.ErrorCodeSelfApprovalNotAllowed, "deciding on your own transfer not allowed",
+	},
+
+	{
+		model.ErrScheduledTransferNotFound, http.StatusNotFound, codes.NotFound, api.ErrorCodeScheduledTransferNotFound,
+		"scheduled transfer not found",
+	},
+	{
+		model.ErrInvalidSchedule, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeInvalidSchedule,
+		"invalid schedule",
+	},
+
+	{
+		model.ErrInvalidPeriod, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeInvalidPeriod,
+		"from should not be after to",
+	},
+	{
+		model.ErrInvalidExportFormat, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeValidationFailed,
+		"format should be csv or ndjson",
+	},
+
+	{model.ErrCartEmpty, http.StatusBadRequest, codes.FailedPrecondition, api.ErrorCodeCartEmpty, "cart is empty"},
+	{
+		model.ErrCartItemNotFound, http.StatusNotFound, codes.NotFound, api.ErrorCodeCartItemNotFound,
+		"item is not in the cart",
+	},
+	{
+		model.ErrInvalidQuantity, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeValidationFailed,
+		fmt.Sprintf("quantity should be from 1 to %d", model.MaxCartItemQuantity),
+	},
+	{
+		model.ErrWishlistItemNotFound, http.StatusNotFound, codes.NotFound, api.ErrorCodeWishlistItemNotFound,
+		"item is not in the wishlist",
+	},
+
+	{model.ErrOrderNotFound, http.StatusNotFound, codes.NotFound, api.ErrorCodeOrderNotFound, "order not found"},
+	{
+		model.ErrInvalidOrderStatus, http.StatusBadRequest, codes.InvalidArgument, api.ErrorCodeValidationFailed,
+		"status should be one of placed, ready_for_pickup, delivered, cancelled",
+	},
+	{
+		model.ErrOrderStatusTransition, http.StatusBadRequest, codes.FailedPrecondition,
+		api.ErrorCodeOrderStatusTransitionNotAllowed, "order can't be moved to this status",
+	},
+}
+
+// internalErrors are domain errors a client can't cause, they stay internal server errors and get logged.
+var internalErrors = []error{
+	model.ErrEmployeeAlreadyExists,
+	model.ErrAllowanceNotFound,
+	model.ErrInventoryNotFound,
+}
+
+// ErrorOverride changes the code or the message of a registered error for one handler,
+// e.g. to name the merch of the request. The status stays the registered one.
+type ErrorOverride struct {
+	Err error
+	// Code replaces the registered code unless empty
+	Code api.ErrorCode
+	// Message replaces the registered message unless empty
+	Message string
+}
+
+// Recipient is the override of handlers sending coins, an unknown employee there is the recipient.
+var Recipient = ErrorOverride{
+	Err:     model.ErrEmployeeNotFound,
+	Code:    api.ErrorCodeRecipientNotFound,
+	Message: "recipient not found",
+}
+
+// Merch names the merch of the request in the messages of merch errors.
+func Merch(merchName string) []ErrorOverride {
+	return []ErrorOverride{
+		WithMessage(model.ErrMerchNotFound, "no merch with name "+merchName),
+		WithMessage(model.ErrVariantRequired, "size or color of "+merchName+" should be chosen"),
+		WithMessage(model.ErrVariantNotFound, "no such size or color of "+merchName),
+	}
+}
+
+// WithMessage overrides the message of err.
+func WithMessage(err error, message string) ErrorOverride {
+	return ErrorOverride{Err: err, Message: message}
+}
+
+// DomainError writes the problem of a registered domain error and reports whether err is one.
+// Other errors are left to the caller to log and answer with InternalError.
+func DomainError(w http.ResponseWriter, err error, overrides ...ErrorOverride) bool {
+	status, code, message, ok := Describe(err, overrides...)
+	if !ok {
+		return false
+	}
+
+	Problem(w, status, code, message)
+	return true
+}
+
+// Describe returns the status, the code and the message of a registered domain error,
+// e.g. for the errors of the items of a batch.
+func Describe(err error, overrides ...ErrorOverride) (status int, code api.ErrorCode, message string, ok bool) {
+	d, ok := describe(err, overrides...)
+	if !ok {
+		return 0, "", "", false
+	}
+
+	return d.status, d.code, d.message, true
+}
+
+// GRPCStatus returns the gRPC status of a registered domain error and reports whether err is one.
+// Other errors are left to the caller to log and answer with codes.Internal.
+func GRPCStatus(err error, overrides ...ErrorOverride) (*grpcstatus.Status, bool) {
+	d, ok := describe(err, overrides...)
+	if !ok {
+		return nil, false
+	}
+
+	return grpcstatus.New(d.grpcCode, d.message), true
+}
+
+// describe returns the first registered entry matching err with overrides applied.
+func describe(err error, overrides ...ErrorOverride) (domainError, bool) {
+	for _, d := range domainErrors {
+		if !errors.Is(err, d.err) {
+			continue
+		}
+
+		for _, o := range overrides {
+			if !errors.Is(d.err, o.Err) {
+				continue
+			}
+			if o.Code != "" {
+				d.code = o.Code
+			}
+			if o.Message != "" {
+				d.message = o.Message
+			}
+		}
+
+		return d, true
+	}
+
+	return domainError{}, false
+}
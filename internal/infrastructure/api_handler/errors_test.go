@@ -0,0 +1,263 @@
+package api_handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// modelErrorNames returns the names of the exported Err variables of the model package.
+func modelErrorNames(t *testing.T) []string {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "../../model", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	var names []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					for _, name := range spec.(*ast.ValueSpec).Names {
+						if strings.HasPrefix(name.Name, "Err") {
+							names = append(names, name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+// registeredErrorNames returns the model errors named in the var declaration of this package.
+func registeredErrorNames(t *testing.T, varName string) map[string]bool {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "errors.go", nil, 0)
+	require.NoError(t, err)
+
+	names := make(map[string]bool)
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || spec.Names[0].Name != varName {
+			return true
+		}
+		ast.Inspect(spec, func(node ast.Node) bool {
+			if sel, ok := node.(*ast.SelectorExpr); ok {
+				if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "model" && strings.HasPrefix(sel.Sel.Name, "Err") {
+					names[sel.Sel.Name] = true
+				}
+			}
+			return true
+		})
+		return false
+	})
+	require.NotEmpty(t, names, varName)
+
+	return names
+}
+
+func TestDomainErrors_Coverage(t *testing.T) {
+	// a new domain error should get a response in domainErrors or be declared internal,
+	// otherwise a client mistake silently becomes a 500
+	registered := registeredErrorNames(t, "domainErrors")
+	internal := registeredErrorNames(t, "internalErrors")
+
+	names := modelErrorNames(t)
+	require.NotEmpty(t, names)
+	for _, name := range names {
+		assert.True(t, registered[name] || internal[name], "model.%s has no mapping in domainErrors or internalErrors", name)
+		assert.False(t, registered[name] && internal[name], "model.%s is both registered and internal", name)
+	}
+
+	for _, d := range domainErrors {
+		assert.NotEmpty(t, problemTitles[d.code], d.err.Error())
+		assert.NotEmpty(t, d.message, d.err.Error())
+		assert.NotContains(t, []codes.Code{codes.OK, codes.Unknown, codes.Internal}, d.grpcCode, d.err.Error())
+	}
+}
+
+func TestDomainErrors_GRPCCoverage(t *testing.T) {
+	// the gRPC server should answer domain errors from domainErrors too, not from its own errors.Is chains
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "../../api/grpc_server", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, pkgs)
+
+	for _, pkg := range pkgs {
+		for name, file := range pkg.Files {
+			ast.Inspect(file, func(node ast.Node) bool {
+				sel, ok := node.(*ast.SelectorExpr)
+				if ok {
+					if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == "model" && strings.HasPrefix(sel.Sel.Name, "Err") {
+						assert.Fail(t, "model error is mapped outside domainErrors", "%s: model.%s at %s",
+							name, sel.Sel.Name, fset.Position(sel.Pos()))
+					}
+				}
+				return true
+			})
+		}
+	}
+}
+
+func TestDomainError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		err         error
+		overrides   []ErrorOverride
+		wantStatus  int
+		wantCode    api.ErrorCode
+		wantMessage string
+	}{
+		{
+			name:        "registered",
+			err:         model.ErrNotEnoughBalance,
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    api.ErrorCodeNotEnoughBalance,
+			wantMessage: "not enough balance",
+		},
+		{
+			name:        "wrapped",
+			err:         fmt.Errorf("coinSending.Send: %w", model.ErrTransferBlocked),
+			wantStatus:  http.StatusForbidden,
+			wantCode:    api.ErrorCodeTransferBlocked,
+			wantMessage: "transfers to this employee are not allowed",
+		},
+		{
+			name:        "not_found",
+			err:         model.ErrOrderNotFound,
+			wantStatus:  http.StatusNotFound,
+			wantCode:    api.ErrorCodeOrderNotFound,
+			wantMessage: "order not found",
+		},
+		{
+			name:        "recipient",
+			err:         model.ErrEmployeeNotFound,
+			overrides:   []ErrorOverride{Recipient},
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    api.ErrorCodeRecipientNotFound,
+			wantMessage: "recipient not found",
+		},
+		{
+			name:        "merch",
+			err:         model.ErrVariantRequired,
+			overrides:   Merch("t-shirt"),
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    api.ErrorCodeVariantRequired,
+			wantMessage: "size or color of t-shirt should be chosen",
+		},
+		{
+			name:        "override_of_other_error",
+			err:         model.ErrEmployeeNotFound,
+			overrides:   Merch("t-shirt"),
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    api.ErrorCodeEmployeeNotFound,
+			wantMessage: "employee not found",
+		},
+		{
+			name: "internal",
+			err:  model.ErrEmployeeAlreadyExists,
+		},
+		{
+			name: "unknown",
+			err:  assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			ok := DomainError(w, tc.err, tc.overrides...)
+
+			if tc.wantStatus == 0 {
+				require.False(t, ok)
+				assert.Empty(t, w.Body.String())
+				return
+			}
+			require.True(t, ok)
+			require.Equal(t, tc.wantStatus, w.Code)
+			var problem api.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+			assert.Equal(t, tc.wantCode, problem.Code)
+			assert.Equal(t, tc.wantStatus, problem.Status)
+			require.NotNil(t, problem.Detail)
+			assert.Equal(t, tc.wantMessage, *problem.Detail)
+		})
+	}
+}
+
+func TestGRPCStatus(t *testing.T) {
+	testCases := []struct {
+		name        string
+		err         error
+		overrides   []ErrorOverride
+		wantCode    codes.Code
+		wantMessage string
+	}{
+		{
+			name:        "registered",
+			err:         fmt.Errorf("coinSending.Send: %w", model.ErrDailyLimitExceeded),
+			wantCode:    codes.ResourceExhausted,
+			wantMessage: "daily transfer limit exceeded",
+		},
+		{
+			name:        "recipient",
+			err:         model.ErrEmployeeNotFound,
+			overrides:   []ErrorOverride{Recipient},
+			wantCode:    codes.NotFound,
+			wantMessage: "recipient not found",
+		},
+		{
+			name:        "merch",
+			err:         model.ErrVariantNotFound,
+			overrides:   Merch("t-shirt"),
+			wantCode:    codes.NotFound,
+			wantMessage: "no such size or color of t-shirt",
+		},
+		{
+			name: "internal",
+			err:  model.ErrEmployeeAlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st, ok := GRPCStatus(tc.err, tc.overrides...)
+
+			if tc.wantCode == codes.OK {
+				require.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tc.wantCode, st.Code())
+			assert.Equal(t, tc.wantMessage, st.Message())
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package api_handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+)
+
+// RequestIDHeader is set on the response by the request middleware before handlers run,
+// problems repeat it so a client can report it
+const RequestIDHeader = "X-Request-ID"
+
+// problemContentType is the media type of RFC 7807 problem details
+const problemContentType = "application/problem+json"
+
+// problemTypePrefix makes the problem type URI of a code
+const problemTypePrefix = "urn:avito-shop:problem:"
+
+// problemTitles are the titles of the error codes, a title is the same for every problem with the code
+var problemTitles = map[api.ErrorCode]string{
+	api.ErrorCodeInternalError:                   "Internal server error",
+	api.ErrorCodeValidationFailed:                "Request is invalid",
+	api.ErrorCodeUnauthorized:                    "Authentication required",
+	api.ErrorCodeNotFound:                        "Not found",
+	api.ErrorCodeForbidden:                       "Access denied",
+	api.ErrorCodeWrongPassword:                   "Wrong password",
+	api.ErrorCodeEmployeeNotFound:                "Employee not found",
+	api.ErrorCodeRecipientNotFound:               "Recipient not found",
+	api.ErrorCodeSelfTransferNotAllowed:          "Sending coins to yourself not allowed",
+	api.ErrorCodeInvalidAmount:                   "Invalid amount",
+	api.ErrorCodeNotEnoughBalance:                "Not enough balance",
+	api.ErrorCodeAmountBelowMinimum:              "Amount below minimum",
+	api.ErrorCodeAmountAboveMaximum:              "Amount above maximum",
+	api.ErrorCodeDailyLimitExceeded:              "Daily transfer limit exceeded",
+	api.ErrorCodeMonthlyLimitExceeded:            "Monthly transfer limit exceeded",
+	api.ErrorCodeTransferCooldown:                "Transfer too soon",
+	api.ErrorCodeTransferBlocked:                 "Transfer blocked",
+	api.ErrorCodeApprovalRequired:                "Approval required",
+	api.ErrorCodeTransfersRejected:               "Transfers rejected",
+	api.ErrorCodeTransferRequestNotFound:         "Transfer request not found",
+	api.ErrorCodeTransferRequestDecided:          "Transfer request already decided",
+	api.ErrorCodeTransferRequestExpired:          "Transfer request expired",
+	api.ErrorCodeSelfApprovalNotAllowed:          "Deciding on own transfer not allowed",
+	api.ErrorCodeScheduledTransferNotFound:       "Scheduled transfer not found",
+	api.ErrorCodeInvalidSchedule:                 "Invalid schedule",
+	api.ErrorCodeMerchNotFound:                   "Merch not found",
+	api.ErrorCodeVariantRequired:                 "Merch variant required",
+	api.ErrorCodeVariantNotFound:                 "Merch variant not found",
+	api.ErrorCodeOutOfStock:                      "Out of stock",
+	api.ErrorCodeCartEmpty:                       "Cart is empty",
+	api.ErrorCodeCartItemNotFound:                "Cart item not found",
+	api.ErrorCodeCheckoutRejected:                "Checkout rejected",
+	api.ErrorCodeWishlistItemNotFound:            "Wishlist item not found",
+	api.ErrorCodeOrderNotFound:                   "Order not found",
+	api.ErrorCodeOrderStatusTransitionNotAllowed: "Order status transition not allowed",
+	api.ErrorCodeInvalidPeriod:                   "Invalid period",
+}
+
+// NewProblem returns the problem details of an error with the request id of w.
+func NewProblem(w http.ResponseWriter, status int, code api.ErrorCode, detail string) api.ErrorResponse {
+	problem := api.ErrorResponse{
+		Type:   problemTypePrefix + string(code),
+		Title:  problemTitles[code],
+		Status: status,
+		Code:   code,
+		Detail: &detail,
+		Errors: &detail,
+	}
+	if requestID := w.Header().Get(RequestIDHeader); requestID != "" {
+		problem.RequestId = &requestID
+	}
+
+	return problem
+}
+
+// WriteProblem writes problem details, problem is api.ErrorResponse or a response extending it.
+func WriteProblem(w http.ResponseWriter, status int, problem any) {
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
+
+// Problem writes the problem details of an error.
+func Problem(w http.ResponseWriter, status int, code api.ErrorCode, detail string) {
+	WriteProblem(w, status, NewProblem(w, status, code, detail))
+}
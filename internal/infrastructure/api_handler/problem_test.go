@@ -0,0 +1,49 @@
+package api_handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+)
+
+func TestProblemTitles(t *testing.T) {
+	spec, err := api.GetSwagger()
+	require.NoError(t, err)
+
+	// every code of the catalog in the spec has a title and there are no titles of unknown codes
+	codes := spec.Components.Schemas["ErrorCode"].Value.Enum
+	require.NotEmpty(t, codes)
+	for _, code := range codes {
+		assert.NotEmpty(t, problemTitles[api.ErrorCode(code.(string))], code)
+	}
+	assert.Len(t, problemTitles, len(codes))
+}
+
+func TestProblem(t *testing.T) {
+	w := httptest.NewRecorder()
+	w.Header().Set(RequestIDHeader, "trace-42")
+
+	Problem(w, http.StatusBadRequest, api.ErrorCodeNotEnoughBalance, "not enough balance")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"type": "urn:avito-shop:problem:NOT_ENOUGH_BALANCE",
+		"title": "Not enough balance",
+		"status": 400,
+		"code": "NOT_ENOUGH_BALANCE",
+		"detail": "not enough balance",
+		"requestId": "trace-42",
+		"errors": "not enough balance"
+	}`, w.Body.String())
+
+	var problem api.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, api.ErrorCodeNotEnoughBalance, problem.Code)
+}
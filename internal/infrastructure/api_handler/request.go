@@ -4,21 +4,34 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
 )
 
 func Parse[T any](r *http.Request, w http.ResponseWriter, t *T) (ok bool) {
 	bodyBytes, err := io.ReadAll(r.Body)
 	defer func() { _ = r.Body.Close() }()
 	if err != nil {
-		BadRequest(w, "could not read request body")
+		BadRequest(w, api.ErrorCodeValidationFailed, "could not read request body")
 		return false
 	}
 
 	err = json.Unmarshal(bodyBytes, t)
 	if err != nil {
-		BadRequest(w, "could not bind request body")
+		BadRequest(w, api.ErrorCodeValidationFailed, "could not bind request body")
 		return false
 	}
 
 	return true
 }
+
+// ParseID parses the id path value, answering bad request if it's not an integer.
+func ParseID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		BadRequest(w, api.ErrorCodeValidationFailed, "id should be an integer")
+		return 0, false
+	}
+	return id, true
+}
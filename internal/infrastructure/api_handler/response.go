@@ -8,27 +8,40 @@ import (
 )
 
 func InternalError(w http.ResponseWriter, description string) {
-	w.WriteHeader(http.StatusInternalServerError)
-	_ = json.NewEncoder(w).Encode(api.ErrorResponse{
-		Errors: &description,
-	})
+	Problem(w, http.StatusInternalServerError, api.ErrorCodeInternalError, description)
 }
 
-func BadRequest(w http.ResponseWriter, description string) {
-	w.WriteHeader(http.StatusBadRequest)
-	_ = json.NewEncoder(w).Encode(api.ErrorResponse{
-		Errors: &description,
-	})
+func BadRequest(w http.ResponseWriter, code api.ErrorCode, description string) {
+	Problem(w, http.StatusBadRequest, code, description)
 }
 
-func Unauthorized(w http.ResponseWriter, description string) {
-	w.WriteHeader(http.StatusUnauthorized)
-	_ = json.NewEncoder(w).Encode(api.ErrorResponse{
-		Errors: &description,
-	})
+func Unauthorized(w http.ResponseWriter, code api.ErrorCode, description string) {
+	Problem(w, http.StatusUnauthorized, code, description)
+}
+
+func Forbidden(w http.ResponseWriter, code api.ErrorCode, description string) {
+	Problem(w, http.StatusForbidden, code, description)
+}
+
+func NotFound(w http.ResponseWriter, code api.ErrorCode, description string) {
+	Problem(w, http.StatusNotFound, code, description)
 }
 
 func OK[T any](w http.ResponseWriter, t T) {
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(t)
 }
+
+// Accepted is for requests taken but not yet done, e.g. waiting for somebody's decision.
+func Accepted[T any](w http.ResponseWriter, t T) {
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(t)
+}
+
+// NonEmptyOrNil is for optional response fields, an empty string is left out.
+func NonEmptyOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
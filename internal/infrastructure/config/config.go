@@ -1,26 +1,135 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const (
+	StoragePostgres = "postgres"
+	// StorageMemory keeps everything in the server memory, data is lost on restart
+	StorageMemory = "memory"
+)
+
+var (
+	// queryExecModes are pgx query exec modes pg.NewPool accepts
+	queryExecModes = []string{"cache_statement", "cache_describe", "describe_exec", "exec", "simple_protocol"}
+	// infoQueryModes are info_collecting query modes
+	infoQueryModes = []string{"parallel", "batch", "single"}
 )
 
 type Config struct {
+	// Storage is postgres or memory, database settings are required for postgres only
+	Storage string `default:"postgres"`
+
+	// AppEnv is development or production, development enables human readable logs
+	AppEnv string `default:"production" envconfig:"APP_ENV"`
+
 	// database
-	DatabaseName     string `required:"true" split_words:"true"`
-	DatabaseHost     string `required:"true" split_words:"true"`
-	DatabasePort     int    `required:"true" split_words:"true"`
-	DatabaseUser     string `required:"true" split_words:"true"`
-	DatabasePassword string `required:"true" split_words:"true"`
+	DatabaseName     string `split_words:"true"`
+	DatabaseHost     string `split_words:"true"`
+	DatabasePort     int    `split_words:"true"`
+	DatabaseUser     string `split_words:"true"`
+	DatabasePassword string `secret:"true" split_words:"true"`
+	// connection pool, MaxConns should fit max_connections of the database divided by the number of replicas
+	DatabaseMaxConns          int32         `default:"30" split_words:"true"`
+	DatabaseMinConns          int32         `default:"0" split_words:"true"`
+	DatabaseMaxConnLifetime   time.Duration `default:"1h" split_words:"true"`
+	DatabaseMaxConnIdleTime   time.Duration `default:"30m" split_words:"true"`
+	DatabaseHealthCheckPeriod time.Duration `default:"1m" split_words:"true"`
+	// DatabaseQueryExecMode is cache_statement (prepared statements cached per connection), cache_describe,
+	// describe_exec, exec or simple_protocol, the last ones work behind PgBouncer in transaction mode
+	DatabaseQueryExecMode          string `default:"cache_statement" split_words:"true"`
+	DatabaseStatementCacheCapacity int    `default:"512" split_words:"true"`
 
 	// http server
-	ServerPort int `required:"true" split_words:"true"`
+	ServerPort              int           `required:"true" split_words:"true"`
+	ServerReadHeaderTimeout time.Duration `default:"1s" split_words:"true"`
+	ServerReadTimeout       time.Duration `default:"10s" split_words:"true"`
+	// ServerWriteTimeout limits writing a whole response, zero disables it, so long exports aren't cut
+	ServerWriteTimeout time.Duration `default:"0s" split_words:"true"`
+	ServerIdleTimeout  time.Duration `default:"2m" split_words:"true"`
+	// ServerShutdownTimeout is how long the servers and workers finish requests in flight on shutdown
+	ServerShutdownTimeout time.Duration `default:"10s" split_words:"true"`
+	// TLS of the HTTP and gRPC servers, enabled when both files are set. HTTP is served as HTTP/2 and HTTP/1.1.
+	// Changed files are reloaded every TLSReloadInterval and on SIGHUP.
+	TLSCertFile       string        `envconfig:"TLS_CERT_FILE"`
+	TLSKeyFile        string        `envconfig:"TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `default:"30s" envconfig:"TLS_RELOAD_INTERVAL"`
+	// TLSClientCAFile enables mTLS: client certificates are verified against its CAs,
+	// TLSClientAuth is optional (only certificates sent are verified) or require
+	TLSClientCAFile string `envconfig:"TLS_CLIENT_CA_FILE"`
+	TLSClientAuth   string `default:"optional" envconfig:"TLS_CLIENT_AUTH"`
+	// GRPCServerPort is the port of the gRPC API for internal services
+	GRPCServerPort int `default:"9090" envconfig:"GRPC_SERVER_PORT"`
+	// InfoQueryMode is how /api/info reads its data: parallel (a connection per query), batch (one round trip)
+	// or single (one SQL statement)
+	InfoQueryMode string `default:"parallel" split_words:"true"`
+
+	// auth, JWTSecret is required by the server but not by the export subcommand
+	JWTSecret   string        `secret:"true" envconfig:"JWT_SECRET"`
+	JWTTokenTTL time.Duration `default:"72h" envconfig:"JWT_TOKEN_TTL"`
+	// PasswordBcryptCost is the bcrypt cost of new password hashes, existing hashes keep their cost
+	PasswordBcryptCost int `default:"10" split_words:"true"`
+
+	// InitialCoins is the balance of a new employee
+	InitialCoins int64 `default:"1000" split_words:"true"`
+
+	// transfer policy, zero values disable a restriction
+	TransferMinAmount    int64         `default:"0" split_words:"true"`
+	TransferMaxAmount    int64         `default:"0" split_words:"true"`
+	TransferDailyLimit   int64         `default:"0" split_words:"true"`
+	TransferMonthlyLimit int64         `default:"0" split_words:"true"`
+	TransferCooldown     time.Duration `default:"0s" split_words:"true"`
+	// TransferBlockedPairs is a comma separated list of sender:receiver usernames
+	TransferBlockedPairs []string `split_words:"true"`
+
+	// MonthlyGivingBudget is the give-only coins every employee gets each month, zero disables the budget
+	MonthlyGivingBudget int64 `default:"0" split_words:"true"`
+
+	// TransferApprovalThreshold is the largest amount sent without approval, zero disables approvals
+	TransferApprovalThreshold int64 `default:"0" split_words:"true"`
+	// TransferApprovalTTL is how long a transfer waits for approval before the held coins go back to the sender
+	TransferApprovalTTL time.Duration `default:"72h" envconfig:"TRANSFER_APPROVAL_TTL"`
+	// TransferAcceptanceTTL is how long a transfer waits for the receiver before it is accepted automatically
+	TransferAcceptanceTTL time.Duration `default:"168h" envconfig:"TRANSFER_ACCEPTANCE_TTL"`
+
+	// workers
+	ScheduledTransferInterval time.Duration `default:"1m" split_words:"true"`
+	// CoinExpirySchedule is a cron expression (UTC) of the expired coins write off
+	CoinExpirySchedule string `default:"0 3 * * *" split_words:"true"`
+	// AllowanceInterval is how often the allowance worker checks whether the next period is due,
+	// the allowance schedule itself is managed by admins via API
+	AllowanceInterval time.Duration `default:"1m" split_words:"true"`
+	// StatsRefreshInterval is how often leaderboard aggregates are recalculated
+	StatsRefreshInterval time.Duration `default:"5m" split_words:"true"`
+	// TransferApprovalExpiryInterval is how often transfer requests nobody decided on are expired or accepted
+	TransferApprovalExpiryInterval time.Duration `default:"1m" split_words:"true"`
 }
 
+// Load reads the config from env vars, .env files of the project root and an optional YAML file,
+// see loadYAML, and validates it. It panics on invalid config.
 func Load() Config {
+	cfg, err := load()
+	if err != nil {
+		panic(fmt.Errorf("load config: %w", err))
+	}
+
+	return cfg
+}
+
+func load() (Config, error) {
 	prefix := ""
 	for range 5 {
 		if _, err := os.Stat(prefix + "go.mod"); err == nil {
@@ -31,17 +140,189 @@ func Load() Config {
 	if _, err := os.Stat(prefix + ".env"); err == nil {
 		err = godotenv.Load(prefix + ".env")
 		if err != nil {
-			panic(fmt.Errorf("load godotenv .env config: %w", err))
+			return Config{}, fmt.Errorf("load godotenv .env config: %w", err)
 		}
 	}
 	if _, err := os.Stat(prefix + ".env.override"); err == nil {
 		err = godotenv.Overload(prefix + ".env.override")
 		if err != nil {
-			panic(fmt.Errorf("load godotenv .env.override config: %w", err))
+			return Config{}, fmt.Errorf("load godotenv .env.override config: %w", err)
 		}
 	}
 
+	err := loadYAML(prefix)
+	if err != nil {
+		return Config{}, fmt.Errorf("loadYAML: %w", err)
+	}
+
 	var cfg Config
-	envconfig.MustProcess("", &cfg)
-	return cfg
+	err = envconfig.Process("", &cfg)
+	if err != nil {
+		return Config{}, fmt.Errorf("envconfig.Process: %w", err)
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return Config{}, fmt.Errorf("validate config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// Validate checks the values are usable, all problems are reported at once.
+func (c Config) Validate() error {
+	errs := []error{c.validateStorage()}
+
+	if c.DatabaseMaxConns <= 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_MAX_CONNS should be positive, got %d", c.DatabaseMaxConns))
+	}
+	if c.DatabaseMinConns < 0 || c.DatabaseMinConns > c.DatabaseMaxConns {
+		errs = append(errs, fmt.Errorf("DATABASE_MIN_CONNS should be from 0 to DATABASE_MAX_CONNS, got %d",
+			c.DatabaseMinConns))
+	}
+	if !slices.Contains(queryExecModes, c.DatabaseQueryExecMode) {
+		errs = append(errs, fmt.Errorf("DATABASE_QUERY_EXEC_MODE should be one of %s, got %q",
+			strings.Join(queryExecModes, ", "), c.DatabaseQueryExecMode))
+	}
+	if c.DatabaseStatementCacheCapacity < 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_STATEMENT_CACHE_CAPACITY should not be negative, got %d",
+			c.DatabaseStatementCacheCapacity))
+	}
+
+	for _, port := range []struct {
+		name  string
+		value int
+	}{
+		{"SERVER_PORT", c.ServerPort},
+		{"GRPC_SERVER_PORT", c.GRPCServerPort},
+	} {
+		if port.value <= 0 || port.value > 65535 {
+			errs = append(errs, fmt.Errorf("%s should be from 1 to 65535, got %d", port.name, port.value))
+		}
+	}
+	if c.ServerPort == c.GRPCServerPort {
+		errs = append(errs, fmt.Errorf("SERVER_PORT and GRPC_SERVER_PORT should differ, both are %d", c.ServerPort))
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		errs = append(errs, errors.New("TLS_CERT_FILE and TLS_KEY_FILE should be set together"))
+	}
+	if c.TLSClientCAFile != "" && c.TLSCertFile == "" {
+		errs = append(errs, errors.New("TLS_CLIENT_CA_FILE needs TLS_CERT_FILE and TLS_KEY_FILE"))
+	}
+	if c.TLSClientAuth != "optional" && c.TLSClientAuth != "require" {
+		errs = append(errs, fmt.Errorf("TLS_CLIENT_AUTH should be optional or require, got %q", c.TLSClientAuth))
+	}
+	if !slices.Contains(infoQueryModes, c.InfoQueryMode) {
+		errs = append(errs, fmt.Errorf("INFO_QUERY_MODE should be one of %s, got %q",
+			strings.Join(infoQueryModes, ", "), c.InfoQueryMode))
+	}
+
+	if c.PasswordBcryptCost < bcrypt.MinCost || c.PasswordBcryptCost > bcrypt.MaxCost {
+		errs = append(errs, fmt.Errorf("PASSWORD_BCRYPT_COST should be from %d to %d, got %d",
+			bcrypt.MinCost, bcrypt.MaxCost, c.PasswordBcryptCost))
+	}
+	if c.InitialCoins < 0 {
+		errs = append(errs, fmt.Errorf("INITIAL_COINS should not be negative, got %d", c.InitialCoins))
+	}
+	if c.MonthlyGivingBudget < 0 {
+		errs = append(errs, fmt.Errorf("MONTHLY_GIVING_BUDGET should not be negative, got %d", c.MonthlyGivingBudget))
+	}
+
+	// durations which can't be zero, the rest can't be negative
+	positive := map[string]time.Duration{
+		"SERVER_SHUTDOWN_TIMEOUT":           c.ServerShutdownTimeout,
+		"TLS_RELOAD_INTERVAL":               c.TLSReloadInterval,
+		"JWT_TOKEN_TTL":                     c.JWTTokenTTL,
+		"DATABASE_HEALTH_CHECK_PERIOD":      c.DatabaseHealthCheckPeriod,
+		"SCHEDULED_TRANSFER_INTERVAL":       c.ScheduledTransferInterval,
+		"ALLOWANCE_INTERVAL":                c.AllowanceInterval,
+		"STATS_REFRESH_INTERVAL":            c.StatsRefreshInterval,
+		"TRANSFER_APPROVAL_EXPIRY_INTERVAL": c.TransferApprovalExpiryInterval,
+	}
+	nonNegative := map[string]time.Duration{
+		"SERVER_READ_HEADER_TIMEOUT":  c.ServerReadHeaderTimeout,
+		"SERVER_READ_TIMEOUT":         c.ServerReadTimeout,
+		"SERVER_WRITE_TIMEOUT":        c.ServerWriteTimeout,
+		"SERVER_IDLE_TIMEOUT":         c.ServerIdleTimeout,
+		"DATABASE_MAX_CONN_LIFETIME":  c.DatabaseMaxConnLifetime,
+		"DATABASE_MAX_CONN_IDLE_TIME": c.DatabaseMaxConnIdleTime,
+		"TRANSFER_COOLDOWN":           c.TransferCooldown,
+	}
+	for _, name := range slices.Sorted(maps.Keys(positive)) {
+		if positive[name] <= 0 {
+			errs = append(errs, fmt.Errorf("%s should be positive, got %s", name, positive[name]))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(nonNegative)) {
+		if nonNegative[name] < 0 {
+			errs = append(errs, fmt.Errorf("%s should not be negative, got %s", name, nonNegative[name]))
+		}
+	}
+
+	_, err := c.TransferPolicy()
+	errs = append(errs, err)
+	_, err = c.TransferApproval()
+	errs = append(errs, err)
+
+	return errors.Join(errs...)
+}
+
+// TLSEnabled reports whether the servers serve TLS.
+func (c Config) TLSEnabled() bool {
+	return c.TLSCertFile != ""
+}
+
+func (c Config) validateStorage() error {
+	switch c.Storage {
+	case StorageMemory:
+		return nil
+	case StoragePostgres:
+		if c.DatabaseName == "" || c.DatabaseHost == "" || c.DatabasePort == 0 || c.DatabaseUser == "" ||
+			c.DatabasePassword == "" {
+			return errors.New("DATABASE_NAME, DATABASE_HOST, DATABASE_PORT, DATABASE_USER and DATABASE_PASSWORD " +
+				"are required for postgres storage")
+		}
+		return nil
+	default:
+		return fmt.Errorf("storage should be postgres or memory, got %q", c.Storage)
+	}
+}
+
+func (c Config) TransferPolicy() (model.TransferPolicy, error) {
+	blockedPairs := make([]model.BlockedTransferPair, 0, len(c.TransferBlockedPairs))
+	for _, pair := range c.TransferBlockedPairs {
+		sender, receiver, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || sender == "" || receiver == "" {
+			return model.TransferPolicy{}, fmt.Errorf("invalid blocked transfer pair %q, expected sender:receiver", pair)
+		}
+		blockedPairs = append(blockedPairs, model.BlockedTransferPair{
+			SenderUsername:   sender,
+			ReceiverUsername: receiver,
+		})
+	}
+
+	return model.TransferPolicy{
+		MinAmount:    c.TransferMinAmount,
+		MaxAmount:    c.TransferMaxAmount,
+		DailyLimit:   c.TransferDailyLimit,
+		MonthlyLimit: c.TransferMonthlyLimit,
+		Cooldown:     c.TransferCooldown,
+		BlockedPairs: blockedPairs,
+	}, nil
+}
+
+func (c Config) TransferApproval() (model.TransferApproval, error) {
+	if c.TransferApprovalThreshold > 0 && c.TransferApprovalTTL <= 0 {
+		return model.TransferApproval{}, fmt.Errorf("transfer approval ttl should be positive, got %s", c.TransferApprovalTTL)
+	}
+	if c.TransferAcceptanceTTL <= 0 {
+		return model.TransferApproval{}, fmt.Errorf("transfer acceptance ttl should be positive, got %s",
+			c.TransferAcceptanceTTL)
+	}
+
+	return model.TransferApproval{
+		Threshold:     c.TransferApprovalThreshold,
+		TTL:           c.TransferApprovalTTL,
+		AcceptanceTTL: c.TransferAcceptanceTTL,
+	}, nil
 }
@@ -0,0 +1,212 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validConfig(t *testing.T) Config {
+	t.Helper()
+
+	t.Setenv("SERVER_PORT", "8080")
+	t.Setenv("STORAGE", StorageMemory)
+
+	var cfg Config
+	require.NoError(t, envconfig.Process("", &cfg))
+
+	return cfg
+}
+
+func TestEnvKey(t *testing.T) {
+	cfgType := reflect.TypeFor[Config]()
+
+	for name, want := range map[string]string{
+		"Storage":                 "STORAGE",
+		"DatabaseMaxConnLifetime": "DATABASE_MAX_CONN_LIFETIME",
+		"GRPCServerPort":          "GRPC_SERVER_PORT",
+		"JWTTokenTTL":             "JWT_TOKEN_TTL",
+		"TransferApprovalTTL":     "TRANSFER_APPROVAL_TTL",
+		"PasswordBcryptCost":      "PASSWORD_BCRYPT_COST",
+	} {
+		structField, ok := cfgType.FieldByName(name)
+		require.True(t, ok, name)
+		assert.Equal(t, want, envKey(structField), name)
+	}
+}
+
+func TestReadYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+storage: memory
+server:
+  port: 8080
+  read-timeout: 5s
+database:
+  max_conns: 10
+transfer_blocked_pairs:
+  - alice:bob
+  - bob:carol
+`), 0o600))
+
+	values, err := readYAML(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"STORAGE":                "memory",
+		"SERVER_PORT":            "8080",
+		"SERVER_READ_TIMEOUT":    "5s",
+		"DATABASE_MAX_CONNS":     "10",
+		"TRANSFER_BLOCKED_PAIRS": "alice:bob,bob:carol",
+	}, values)
+}
+
+func TestReadYAML_UnknownKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+server:
+  port: 8080
+  read_timout: 5s
+databse:
+  host: localhost
+`), 0o600))
+
+	_, err := readYAML(path)
+	require.EqualError(t, err, "unknown keys in "+path+": DATABSE_HOST, SERVER_READ_TIMOUT")
+}
+
+func TestConfig_Values(t *testing.T) {
+	cfg := validConfig(t)
+	cfg.DatabasePassword = "password"
+	cfg.JWTSecret = ""
+	cfg.TransferBlockedPairs = []string{"alice:bob", "bob:carol"}
+
+	values := cfg.Values()
+
+	assert.Equal(t, redacted, values["DATABASE_PASSWORD"])
+	assert.Empty(t, values["JWT_SECRET"])
+	assert.Equal(t, "72h0m0s", values["JWT_TOKEN_TTL"])
+	assert.Equal(t, "alice:bob,bob:carol", values["TRANSFER_BLOCKED_PAIRS"])
+	assert.Equal(t, "1000", values["INITIAL_COINS"])
+	assert.Len(t, values, reflect.TypeFor[Config]().NumField())
+}
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		change  func(cfg *Config)
+		wantErr []string
+	}{
+		{
+			name:   "success.defaults",
+			change: func(_ *Config) {},
+		},
+		{
+			name: "error.postgres_without_database",
+			change: func(cfg *Config) {
+				cfg.Storage = StoragePostgres
+			},
+			wantErr: []string{"DATABASE_NAME, DATABASE_HOST, DATABASE_PORT, DATABASE_USER and DATABASE_PASSWORD " +
+				"are required for postgres storage"},
+		},
+		{
+			name: "error.pool",
+			change: func(cfg *Config) {
+				cfg.DatabaseMaxConns = 5
+				cfg.DatabaseMinConns = 10
+				cfg.DatabaseQueryExecMode = "prepared"
+			},
+			wantErr: []string{
+				"DATABASE_MIN_CONNS should be from 0 to DATABASE_MAX_CONNS, got 10",
+				`DATABASE_QUERY_EXEC_MODE should be one of cache_statement, cache_describe, describe_exec, exec, ` +
+					`simple_protocol, got "prepared"`,
+			},
+		},
+		{
+			name: "error.ports",
+			change: func(cfg *Config) {
+				cfg.ServerPort = 70000
+				cfg.GRPCServerPort = 70000
+			},
+			wantErr: []string{
+				"SERVER_PORT should be from 1 to 65535, got 70000",
+				"GRPC_SERVER_PORT should be from 1 to 65535, got 70000",
+				"SERVER_PORT and GRPC_SERVER_PORT should differ, both are 70000",
+			},
+		},
+		{
+			name: "error.tls",
+			change: func(cfg *Config) {
+				cfg.TLSKeyFile = "server.key"
+				cfg.TLSClientCAFile = "ca.pem"
+				cfg.TLSClientAuth = "always"
+			},
+			wantErr: []string{
+				"TLS_CERT_FILE and TLS_KEY_FILE should be set together",
+				"TLS_CLIENT_CA_FILE needs TLS_CERT_FILE and TLS_KEY_FILE",
+				`TLS_CLIENT_AUTH should be optional or require, got "always"`,
+			},
+		},
+		{
+			name: "error.durations",
+			change: func(cfg *Config) {
+				cfg.ServerReadTimeout = -time.Second
+				cfg.JWTTokenTTL = 0
+			},
+			wantErr: []string{
+				"JWT_TOKEN_TTL should be positive, got 0s",
+				"SERVER_READ_TIMEOUT should not be negative, got -1s",
+			},
+		},
+		{
+			name: "error.auth_and_economy",
+			change: func(cfg *Config) {
+				cfg.PasswordBcryptCost = 50
+				cfg.InitialCoins = -1
+				cfg.TransferBlockedPairs = []string{"alice"}
+			},
+			wantErr: []string{
+				"PASSWORD_BCRYPT_COST should be from 4 to 31, got 50",
+				"INITIAL_COINS should not be negative, got -1",
+				`invalid blocked transfer pair "alice", expected sender:receiver`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := validConfig(t)
+			tc.change(&cfg)
+
+			err := cfg.Validate()
+
+			if len(tc.wantErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, wantErr := range tc.wantErr {
+				assert.Contains(t, err.Error(), wantErr)
+			}
+		})
+	}
+}
+
+func TestReadYAML_Example(t *testing.T) {
+	// the example lists every setting, so it has to stay in sync with Config
+	values, err := readYAML("../../../config.example.yaml")
+	require.NoError(t, err)
+
+	for _, f := range fields(Config{}) {
+		if f.secret {
+			continue
+		}
+		assert.Contains(t, values, f.key)
+	}
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// redacted replaces values of secret fields
+const redacted = "<redacted>"
+
+var (
+	// the same as envconfig uses to split field names into words
+	wordsRegexp   = regexp.MustCompile("([^A-Z]+|[A-Z]+[^A-Z]+|[A-Z]+)")
+	acronymRegexp = regexp.MustCompile("([A-Z]+)([A-Z][^A-Z]+)")
+)
+
+type field struct {
+	key    string
+	value  reflect.Value
+	secret bool
+}
+
+// fields returns the fields of the config with their env var names.
+func fields(c Config) []field {
+	v := reflect.ValueOf(c)
+	t := v.Type()
+
+	res := make([]field, 0, t.NumField())
+	for i := range t.NumField() {
+		structField := t.Field(i)
+		res = append(res, field{
+			key:    envKey(structField),
+			value:  v.Field(i),
+			secret: structField.Tag.Get("secret") == "true",
+		})
+	}
+
+	return res
+}
+
+// envKey returns the env var name envconfig reads the field from.
+func envKey(structField reflect.StructField) string {
+	if key := structField.Tag.Get("envconfig"); key != "" {
+		return strings.ToUpper(key)
+	}
+	if structField.Tag.Get("split_words") != "true" {
+		return strings.ToUpper(structField.Name)
+	}
+
+	var words []string
+	for _, match := range wordsRegexp.FindAllString(structField.Name, -1) {
+		if m := acronymRegexp.FindStringSubmatch(match); len(m) == 3 {
+			words = append(words, m[1], m[2])
+		} else {
+			words = append(words, match)
+		}
+	}
+
+	return strings.ToUpper(strings.Join(words, "_"))
+}
+
+// Values returns the effective config by env var names, values of secrets are redacted.
+// An empty secret stays empty, so it's visible that it isn't set.
+func (c Config) Values() map[string]string {
+	values := make(map[string]string)
+	for _, f := range fields(c) {
+		value := formatValue(f.value)
+		if f.secret && value != "" {
+			value = redacted
+		}
+		values[f.key] = value
+	}
+
+	return values
+}
+
+func formatValue(v reflect.Value) string {
+	switch value := v.Interface().(type) {
+	case time.Duration:
+		return value.String()
+	case []string:
+		return strings.Join(value, ",")
+	default:
+		return fmt.Sprint(value)
+	}
+}
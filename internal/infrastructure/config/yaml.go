@@ -0,0 +1,102 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultYAMLFile is read from the project root when CONFIG_FILE isn't set and the file exists
+const defaultYAMLFile = "config.yaml"
+
+// loadYAML reads the YAML file of CONFIG_FILE or config.yaml of the project root and sets env vars of its values
+// unless they are set already, so env vars and .env files override the file. Nested keys are joined
+// with underscores into env var names: database.max_conns is DATABASE_MAX_CONNS.
+func loadYAML(prefix string) error {
+	path, ok := os.LookupEnv("CONFIG_FILE")
+	if !ok {
+		path = prefix + defaultYAMLFile
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+	}
+
+	values, err := readYAML(path)
+	if err != nil {
+		return fmt.Errorf("readYAML: %w", err)
+	}
+
+	for key, value := range values {
+		if _, ok = os.LookupEnv(key); ok {
+			continue
+		}
+		err = os.Setenv(key, value)
+		if err != nil {
+			return fmt.Errorf("os.Setenv: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readYAML returns the values of the YAML file by env var names, unknown keys are an error.
+func readYAML(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	var doc map[string]any
+	err = yaml.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("yaml.Unmarshal %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	flattenYAML("", doc, values)
+
+	known := make(map[string]bool)
+	for _, field := range fields(Config{}) {
+		known[field.key] = true
+	}
+	var unknown []string
+	for key := range values {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return nil, fmt.Errorf("unknown keys in %s: %s", path, strings.Join(unknown, ", "))
+	}
+
+	return values, nil
+}
+
+func flattenYAML(prefix string, node map[string]any, values map[string]string) {
+	for name, value := range node {
+		key := strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+		if prefix != "" {
+			key = prefix + "_" + key
+		}
+
+		switch value := value.(type) {
+		case map[string]any:
+			flattenYAML(key, value, values)
+		case []any:
+			items := make([]string, 0, len(value))
+			for _, item := range value {
+				items = append(items, fmt.Sprint(item))
+			}
+			values[key] = strings.Join(items, ",")
+		case nil:
+			values[key] = ""
+		default:
+			values[key] = fmt.Sprint(value)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// searchLimit bounds Next so that expressions which never match (e.g. "0 0 30 2 *") do not loop forever.
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+var (
+	ErrInvalidExpression = errors.New("invalid cron expression")
+	ErrNoNextTime        = errors.New("cron expression never matches")
+)
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+// Schedule is a parsed standard 5-field cron expression: "minute hour day-of-month month day-of-week".
+// Every field supports "*", single values, ranges "a-b", lists "a,b" and steps "*/n" or "a-b/n".
+// Day of week 0 is Sunday, 7 is accepted as Sunday too.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// as in classic cron: when both day of month and day of week are restricted,
+	// a day matches if either of them matches
+	domRestricted, dowRestricted bool
+}
+
+func Parse(expr string) (Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return Schedule{}, fmt.Errorf("%w: expected %d fields, got %d", ErrInvalidExpression, len(fields), len(parts))
+	}
+
+	sets := make([]uint64, len(fields))
+	for i, part := range parts {
+		f := fields[i]
+		if i == 4 {
+			// allow 7 as Sunday
+			f.max = 7
+		}
+
+		set, err := parseField(part, f)
+		if err != nil {
+			return Schedule{}, err
+		}
+		sets[i] = set
+	}
+
+	dow := sets[4]
+	if dow&(1<<7) != 0 {
+		dow = dow&^(1<<7) | 1
+	}
+
+	return Schedule{
+		minute:        sets[0],
+		hour:          sets[1],
+		dom:           sets[2],
+		month:         sets[3],
+		dow:           dow,
+		domRestricted: parts[2] != "*",
+		dowRestricted: parts[4] != "*",
+	}, nil
+}
+
+func parseField(s string, f field) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(s, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("%w: bad step %q in %s", ErrInvalidExpression, stepPart, f.name)
+			}
+		}
+
+		from, to := f.min, f.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			fromStr, toStr, _ := strings.Cut(rangePart, "-")
+			var err error
+			from, err = parseValue(fromStr, f)
+			if err != nil {
+				return 0, err
+			}
+			to, err = parseValue(toStr, f)
+			if err != nil {
+				return 0, err
+			}
+			if from > to {
+				return 0, fmt.Errorf("%w: bad range %q in %s", ErrInvalidExpression, rangePart, f.name)
+			}
+		default:
+			v, err := parseValue(rangePart, f)
+			if err != nil {
+				return 0, err
+			}
+			from = v
+			if !hasStep {
+				to = v
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	return set, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("%w: bad value %q in %s, expected %d-%d", ErrInvalidExpression, s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the first time strictly after t that matches the schedule, in t's location.
+func (s Schedule) Next(t time.Time) (time.Time, error) {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(searchLimit)
+
+	for t.Before(limit) {
+		switch {
+		case !has(s.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !has(s.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !has(s.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrNoNextTime
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := has(s.dom, t.Day())
+	dowMatch := has(s.dow, int(t.Weekday()))
+
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<uint(v)) != 0
+}
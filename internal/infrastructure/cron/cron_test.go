@@ -0,0 +1,102 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		expr string
+	}{
+		{name: "empty", expr: ""},
+		{name: "too_few_fields", expr: "* * * *"},
+		{name: "too_many_fields", expr: "* * * * * *"},
+		{name: "minute_out_of_range", expr: "60 * * * *"},
+		{name: "month_zero", expr: "0 0 1 0 *"},
+		{name: "bad_range", expr: "0 10-5 * * *"},
+		{name: "bad_step", expr: "*/0 * * * *"},
+		{name: "not_a_number", expr: "a * * * *"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.expr)
+			require.ErrorIs(t, err, ErrInvalidExpression)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// 2025-02-12 is Wednesday
+	from := time.Date(2025, 2, 12, 10, 30, 15, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{
+			name: "every_minute",
+			expr: "* * * * *",
+			want: time.Date(2025, 2, 12, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name: "every_15_minutes",
+			expr: "*/15 * * * *",
+			want: time.Date(2025, 2, 12, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "friday_at_noon",
+			expr: "0 12 * * 5",
+			want: time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday_as_7",
+			expr: "0 9 * * 7",
+			want: time.Date(2025, 2, 16, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "first_of_month",
+			expr: "0 0 1 * *",
+			want: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "workdays_list_and_range",
+			expr: "0,30 9-10 * * 1-5",
+			want: time.Date(2025, 2, 13, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day_of_month_or_day_of_week",
+			expr: "0 0 13 * 1",
+			want: time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap_day",
+			expr: "0 0 29 2 *",
+			want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Parse(tc.expr)
+			require.NoError(t, err)
+
+			next, err := s.Next(from)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, next)
+		})
+	}
+}
+
+func TestSchedule_Next_NeverMatches(t *testing.T) {
+	s, err := Parse("0 0 30 2 *")
+	require.NoError(t, err)
+
+	_, err = s.Next(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, ErrNoNextTime)
+}
@@ -3,7 +3,6 @@ package jwt
 import (
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -11,10 +10,6 @@ import (
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
-const (
-	tokenLifetime = time.Hour * 72
-)
-
 var (
 	ErrInvalidJWTToken           = errors.New("invalid JWT token")
 	ErrInvalidUserIDInJWTToken   = errors.New("invalid userID in JWT token")
@@ -22,27 +17,32 @@ var (
 )
 
 type Provider struct {
-	secret []byte
+	secret   []byte
+	tokenTTL time.Duration
 }
 
-func NewProviderFromEnv() (*Provider, error) {
-	secret := os.Getenv("JWT_SECRET")
+func NewProvider(secret string, tokenTTL time.Duration) (*Provider, error) {
 	if secret == "" {
-		return nil, errors.New("env JWT_SECRET is empty")
+		return nil, errors.New("JWT_SECRET is empty")
+	}
+	if tokenTTL <= 0 {
+		return nil, fmt.Errorf("token ttl should be positive, got %s", tokenTTL)
 	}
 
 	provider := &Provider{
-		secret: []byte(secret),
+		secret:   []byte(secret),
+		tokenTTL: tokenTTL,
 	}
 
 	return provider, nil
 }
 
-func (p *Provider) CreateToken(username string, userID int64) (string, error) {
+func (p *Provider) CreateToken(username string, userID int64, role model.Role) (string, error) {
 	claims := jwt.MapClaims{
 		"username": username,
 		"userID":   userID,
-		"exp":      time.Now().Add(tokenLifetime).Unix(),
+		"role":     string(role),
+		"exp":      time.Now().Add(p.tokenTTL).Unix(),
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
@@ -78,8 +78,15 @@ func (p *Provider) ParseToken(tokenStr string) (model.TokenInfo, error) {
 		return model.TokenInfo{}, ErrInvalidUsernameInJWTToken
 	}
 
+	// tokens issued before roles were introduced have no role claim
+	role, _ := claims["role"].(string)
+	if role == "" {
+		role = string(model.RoleEmployee)
+	}
+
 	return model.TokenInfo{
 		EmployeeID: int64(userID),
 		Username:   username,
+		Role:       model.Role(role),
 	}, nil
 }
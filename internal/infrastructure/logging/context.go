@@ -0,0 +1,35 @@
+package logging
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+)
+
+type loggerContextKey struct{}
+
+// ContextWithLogger returns ctx carrying the request-scoped logger.
+func ContextWithLogger(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// With adds fields to the logger of ctx, ctx without a logger is returned as is.
+func With(ctx context.Context, fields ...zap.Field) context.Context {
+	logger, ok := ctx.Value(loggerContextKey{}).(*zap.Logger)
+	if !ok {
+		return ctx
+	}
+	return ContextWithLogger(ctx, logger.With(fields...))
+}
+
+// FromContext returns the request-scoped logger of ctx or fallback when there is none,
+// e.g. in tests and calls not coming through the HTTP middleware.
+func FromContext(ctx context.Context, fallback internal.Logger) internal.Logger {
+	logger, ok := ctx.Value(loggerContextKey{}).(*zap.Logger)
+	if !ok {
+		return fallback
+	}
+	return logger
+}
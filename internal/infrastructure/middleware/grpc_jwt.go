@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+)
+
+// grpcAuthMetadataKey is the gRPC counterpart of the Authorization header, metadata keys are lowercase.
+const grpcAuthMetadataKey = "authorization"
+
+// UnaryAuthInterceptor checks the JWT token of every unary call except publicMethods
+// and puts the token info into the context, the same way as the HTTP auth middleware does.
+func UnaryAuthInterceptor(provider tokenProvider, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := publicMethodSet(publicMethods)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticateGRPC(ctx, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor is UnaryAuthInterceptor for streaming calls.
+func StreamAuthInterceptor(provider tokenProvider, publicMethods ...string) grpc.StreamServerInterceptor {
+	public := publicMethodSet(publicMethods)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticateGRPC(ss.Context(), provider)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticateGRPC(ctx context.Context, provider tokenProvider) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(grpcAuthMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, jwt.ErrNoAuthHeader.Error())
+	}
+
+	// HTTP clients send the bare token, gRPC clients usually prepend the scheme
+	tokenStr := strings.TrimPrefix(values[0], "Bearer ")
+
+	tokenInfo, err := provider.ParseToken(tokenStr)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return jwt.ContextWithTokenInfo(ctx, tokenInfo), nil
+}
+
+func publicMethodSet(methods []string) map[string]struct{} {
+	res := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		res[method] = struct{}{}
+	}
+	return res
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
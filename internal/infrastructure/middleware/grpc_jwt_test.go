@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type stubTokenProvider map[string]model.TokenInfo
+
+func (p stubTokenProvider) ParseToken(tokenStr string) (model.TokenInfo, error) {
+	tokenInfo, ok := p[tokenStr]
+	if !ok {
+		return model.TokenInfo{}, assert.AnError
+	}
+	return tokenInfo, nil
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	provider := stubTokenProvider{"valid": {EmployeeID: 1234, Username: "test1", Role: model.RoleEmployee}}
+	interceptor := UnaryAuthInterceptor(provider, "/shop/Public")
+
+	handler := func(ctx context.Context, _ any) (any, error) {
+		return jwt.TokenInfoFromContext(ctx), nil
+	}
+
+	testCases := []struct {
+		name     string
+		method   string
+		md       metadata.MD
+		wantRes  any
+		wantCode codes.Code
+	}{
+		{
+			name:     "bare_token",
+			method:   "/shop/Private",
+			md:       metadata.Pairs("authorization", "valid"),
+			wantRes:  model.TokenInfo{EmployeeID: 1234, Username: "test1", Role: model.RoleEmployee},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "bearer_token",
+			method:   "/shop/Private",
+			md:       metadata.Pairs("authorization", "Bearer valid"),
+			wantRes:  model.TokenInfo{EmployeeID: 1234, Username: "test1", Role: model.RoleEmployee},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "no_token",
+			method:   "/shop/Private",
+			md:       metadata.MD{},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "invalid_token",
+			method:   "/shop/Private",
+			md:       metadata.Pairs("authorization", "invalid"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "public_method",
+			method:   "/shop/Public",
+			md:       metadata.MD{},
+			wantRes:  model.TokenInfo{},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+
+			res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			require.Equal(t, tc.wantCode, status.Code(err))
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+)
+
+// grpcRequestIDMetadataKey is the gRPC counterpart of the X-Request-ID header, metadata keys are lowercase.
+const grpcRequestIDMetadataKey = "x-request-id"
+
+// UnaryRecoveryInterceptor is the outermost unary interceptor, the gRPC counterpart of Observe.
+// It puts the request-scoped logger into the context and turns a panic of a handler into codes.Internal.
+func UnaryRecoveryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res any, err error) {
+		ctx, requestLogger := observeGRPC(ctx, logger, info.FullMethod)
+		defer func() {
+			if panicErr := recover(); panicErr != nil {
+				requestLogger.Error("grpc handler panic", zap.Any("panic", panicErr), zap.Stack("stack"))
+				res, err = nil, status.Error(codes.Internal, "internal server error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecoveryInterceptor is UnaryRecoveryInterceptor for streaming calls.
+func StreamRecoveryInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx, requestLogger := observeGRPC(ss.Context(), logger, info.FullMethod)
+		defer func() {
+			if panicErr := recover(); panicErr != nil {
+				requestLogger.Error("grpc handler panic", zap.Any("panic", panicErr), zap.Stack("stack"))
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// observeGRPC assigns the request id the same way Observe does, sends it back in the header
+// and returns ctx carrying the request-scoped logger.
+func observeGRPC(ctx context.Context, logger *zap.Logger, method string) (context.Context, *zap.Logger) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	requestID := ""
+	if values := md.Get(grpcRequestIDMetadataKey); len(values) > 0 {
+		requestID = values[0]
+	}
+	if !validRequestID(requestID) {
+		requestID = newRequestID()
+	}
+	// fails only outside of a gRPC call, e.g. in tests
+	_ = grpc.SetHeader(ctx, metadata.Pairs(grpcRequestIDMetadataKey, requestID))
+
+	requestLogger := logger.With(zap.String("request_id", requestID), zap.String("method", method))
+	return logging.ContextWithLogger(ctx, requestLogger), requestLogger
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+)
+
+func TestUnaryRecoveryInterceptor_Panic(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	interceptor := UnaryRecoveryInterceptor(zap.New(core))
+
+	handler := func(context.Context, any) (any, error) {
+		panic("boom")
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "req-1"))
+	res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/shop/Private"}, handler)
+	require.Nil(t, res)
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	panicLogs := logs.FilterMessage("grpc handler panic").All()
+	require.Len(t, panicLogs, 1)
+	assert.Equal(t, "boom", panicLogs[0].ContextMap()["panic"])
+	assert.Equal(t, "req-1", panicLogs[0].ContextMap()["request_id"])
+	assert.Equal(t, "/shop/Private", panicLogs[0].ContextMap()["method"])
+	assert.NotEmpty(t, panicLogs[0].ContextMap()["stack"])
+}
+
+func TestUnaryRecoveryInterceptor_RequestLogger(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	interceptor := UnaryRecoveryInterceptor(zap.New(core))
+
+	handler := func(ctx context.Context, _ any) (any, error) {
+		logging.FromContext(ctx, zap.NewNop()).Info("handled")
+		return "ok", nil
+	}
+
+	res, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/shop/Private"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+
+	handledLogs := logs.FilterMessage("handled").All()
+	require.Len(t, handledLogs, 1)
+	// no incoming request id, a new one is assigned
+	assert.NotEmpty(t, handledLogs[0].ContextMap()["request_id"])
+	assert.Equal(t, "/shop/Private", handledLogs[0].ContextMap()["method"])
+}
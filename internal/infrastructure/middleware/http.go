@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+)
+
+// RequestIDHeader carries the request id, a valid incoming one is kept so a request can be traced across services
+const RequestIDHeader = api_handler.RequestIDHeader
+
+// maxRequestIDLength limits incoming request ids, longer ones are replaced
+const maxRequestIDLength = 128
+
+// requestDetails is filled by RequestDetails deeper in the chain, where the route and the employee are known,
+// and read by the access log of Observe.
+type requestDetails struct {
+	route      string
+	employeeID int64
+}
+
+type requestDetailsContextKey struct{}
+
+// Observe is the outermost HTTP middleware. It assigns the request id, puts the request-scoped logger
+// into the context, writes the access log and turns a panic of a handler into a JSON 500.
+func Observe(logger *zap.Logger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			requestID := r.Header.Get(RequestIDHeader)
+			if !validRequestID(requestID) {
+				requestID = newRequestID()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			requestLogger := logger.With(zap.String("request_id", requestID))
+			details := &requestDetails{}
+			ctx := logging.ContextWithLogger(r.Context(), requestLogger)
+			ctx = context.WithValue(ctx, requestDetailsContextKey{}, details)
+
+			req := r.WithContext(ctx)
+			rec := &responseRecorder{ResponseWriter: w}
+			aborted := true
+			defer func() {
+				// the route of the outer ServeMux is used when the request didn't get to RequestDetails,
+				// e.g. it's rejected by the auth middleware
+				route := details.route
+				if route == "" {
+					route = req.Pattern
+				}
+				status := rec.status
+				if status == 0 && !aborted {
+					// nothing is written, net/http sends 200
+					status = http.StatusOK
+				}
+
+				fields := []zap.Field{
+					zap.String("method", r.Method),
+					zap.String("route", route),
+					zap.String("path", r.URL.Path),
+					zap.Int("status", status),
+					zap.Int64("bytes", rec.bytes),
+					zap.Duration("latency", time.Since(start)),
+				}
+				if details.employeeID != 0 {
+					fields = append(fields, zap.Int64("employee_id", details.employeeID))
+				}
+				if aborted {
+					fields = append(fields, zap.Bool("aborted", true))
+				}
+				requestLogger.Info("http request", fields...)
+			}()
+
+			serveRecovered(rec, req, next, requestLogger)
+			aborted = false
+		})
+	}
+}
+
+// serveRecovered serves the request, a panic is logged and answered with a JSON 500 if nothing is sent yet.
+// http.ErrAbortHandler is passed on, it's the way to abort a response deliberately, e.g. a broken export.
+func serveRecovered(rec *responseRecorder, r *http.Request, next http.Handler, logger *zap.Logger) {
+	defer func() {
+		panicErr := recover()
+		if panicErr == nil {
+			return
+		}
+		if err, ok := panicErr.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+			panic(panicErr)
+		}
+
+		logger.Error("http handler panic", zap.Any("panic", panicErr), zap.Stack("stack"))
+		if rec.status != 0 {
+			// the response is partly sent, the only way to tell the client is to break the connection
+			panic(http.ErrAbortHandler)
+		}
+		api_handler.InternalError(rec, "internal server error")
+	}()
+
+	next.ServeHTTP(rec, r)
+}
+
+// RequestDetails records the matched route and the authenticated employee for the access log,
+// and adds the employee to the request-scoped logger. It wraps the ServeMux behind the auth middleware.
+func RequestDetails(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		details, _ := r.Context().Value(requestDetailsContextKey{}).(*requestDetails)
+
+		tokenInfo := jwt.TokenInfoFromContext(r.Context())
+		if tokenInfo.EmployeeID != 0 {
+			if details != nil {
+				details.employeeID = tokenInfo.EmployeeID
+			}
+			r = r.WithContext(logging.With(r.Context(), zap.Int64("employee_id", tokenInfo.EmployeeID)))
+		}
+
+		// ServeMux sets the pattern on the request it's given
+		defer func() {
+			if details != nil {
+				details.route = r.Pattern
+			}
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range requestID {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// responseRecorder remembers the status and the size of the response.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(p []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+
+	n, err := rec.ResponseWriter.Write(p)
+	rec.bytes += int64(n)
+	if err != nil {
+		return n, fmt.Errorf("ResponseWriter.Write: %w", err)
+	}
+
+	return n, nil
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to flush.
+func (rec *responseRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
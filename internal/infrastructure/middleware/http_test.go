@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/logging"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// newObservedHandler returns the handler chain of the server: Observe, a stand-in for the auth middleware
+// setting the token info, RequestDetails and the ServeMux of the routes.
+func newObservedHandler(t *testing.T, routes *http.ServeMux) (http.Handler, *observer.ObservedLogs) {
+	t.Helper()
+
+	core, logs := observer.New(zap.InfoLevel)
+
+	auth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenInfo := model.TokenInfo{EmployeeID: 1234, Username: "test1", Role: model.RoleEmployee}
+			next.ServeHTTP(w, r.WithContext(jwt.ContextWithTokenInfo(r.Context(), tokenInfo)))
+		})
+	}
+
+	m := http.NewServeMux()
+	m.Handle("/", auth(RequestDetails(routes)))
+
+	return Observe(zap.New(core))(m), logs
+}
+
+func TestObserve_AccessLog(t *testing.T) {
+	routes := http.NewServeMux()
+	routes.HandleFunc("GET /api/buy/{merchName}", func(w http.ResponseWriter, r *http.Request) {
+		logging.FromContext(r.Context(), zap.NewNop()).Info("buying")
+		w.WriteHeader(http.StatusOK)
+	})
+	handler, logs := newObservedHandler(t, routes)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/buy/pen", nil)
+	req.Header.Set(RequestIDHeader, "trace-42")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "trace-42", rec.Header().Get(RequestIDHeader))
+
+	// the handler logs with the request-scoped logger
+	handlerLogs := logs.FilterMessage("buying").All()
+	require.Len(t, handlerLogs, 1)
+	assert.Equal(t, "trace-42", handlerLogs[0].ContextMap()["request_id"])
+	assert.Equal(t, int64(1234), handlerLogs[0].ContextMap()["employee_id"])
+
+	accessLogs := logs.FilterMessage("http request").All()
+	require.Len(t, accessLogs, 1)
+	fields := accessLogs[0].ContextMap()
+	assert.Equal(t, "trace-42", fields["request_id"])
+	assert.Equal(t, "GET", fields["method"])
+	assert.Equal(t, "GET /api/buy/{merchName}", fields["route"])
+	assert.Equal(t, "/api/buy/pen", fields["path"])
+	assert.Equal(t, int64(http.StatusOK), fields["status"])
+	assert.Equal(t, int64(1234), fields["employee_id"])
+	assert.Contains(t, fields, "latency")
+	assert.NotContains(t, fields, "aborted")
+}
+
+func TestObserve_RequestID(t *testing.T) {
+	handler, _ := newObservedHandler(t, http.NewServeMux())
+
+	for name, requestID := range map[string]string{
+		"missing":   "",
+		"too_long":  strings.Repeat("a", maxRequestIDLength+1),
+		"non_ascii": "трасса",
+		"spaces":    "trace 42",
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/info", nil)
+			req.Header.Set(RequestIDHeader, requestID)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(RequestIDHeader)
+			assert.Len(t, got, 32)
+			assert.NotEqual(t, requestID, got)
+		})
+	}
+}
+
+func TestObserve_Panic(t *testing.T) {
+	routes := http.NewServeMux()
+	routes.HandleFunc("GET /api/info", func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})
+	handler, logs := newObservedHandler(t, routes)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/info", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+	var resp api.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, api.ErrorCodeInternalError, resp.Code)
+	require.NotNil(t, resp.Detail)
+	assert.Equal(t, "internal server error", *resp.Detail)
+	// the request id of the problem is the one of the response header
+	require.NotNil(t, resp.RequestId)
+	assert.Equal(t, rec.Header().Get(RequestIDHeader), *resp.RequestId)
+
+	panicLogs := logs.FilterMessage("http handler panic").All()
+	require.Len(t, panicLogs, 1)
+	assert.Equal(t, "boom", panicLogs[0].ContextMap()["panic"])
+	assert.NotEmpty(t, panicLogs[0].ContextMap()["stack"])
+
+	accessLogs := logs.FilterMessage("http request").All()
+	require.Len(t, accessLogs, 1)
+	assert.Equal(t, int64(http.StatusInternalServerError), accessLogs[0].ContextMap()["status"])
+}
+
+func TestObserve_PanicAfterWrite(t *testing.T) {
+	routes := http.NewServeMux()
+	routes.HandleFunc("GET /api/admin/export", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("id,amount\n"))
+		panic("boom")
+	})
+	handler, logs := newObservedHandler(t, routes)
+
+	// the response can't be replaced anymore, the connection is aborted
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/admin/export", nil))
+	})
+
+	accessLogs := logs.FilterMessage("http request").All()
+	require.Len(t, accessLogs, 1)
+	assert.Equal(t, true, accessLogs[0].ContextMap()["aborted"])
+}
+
+func TestObserve_ErrAbortHandler(t *testing.T) {
+	routes := http.NewServeMux()
+	routes.HandleFunc("GET /api/admin/export", func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	})
+	handler, logs := newObservedHandler(t, routes)
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/admin/export", nil))
+	})
+
+	// a deliberate abort isn't a handler failure
+	assert.Empty(t, logs.FilterMessage("http handler panic").All())
+	assert.Len(t, logs.FilterMessage("http request").All(), 1)
+}
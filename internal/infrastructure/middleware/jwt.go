@@ -8,6 +8,7 @@ import (
 	middleware "github.com/oapi-codegen/nethttp-middleware"
 
 	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/api_handler"
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/jwt"
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
@@ -26,6 +27,7 @@ func CreateNoAuthMiddleware() (func(next http.Handler) http.Handler, error) {
 		Options: openapi3filter.Options{
 			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
 		},
+		ErrorHandler:          validationErrorHandler,
 		SilenceServersWarning: true,
 	})
 
@@ -43,8 +45,24 @@ func CreateAuthMiddleware(provider tokenProvider) (func(next http.Handler) http.
 			Options: openapi3filter.Options{
 				AuthenticationFunc: jwt.NewAuthenticator(provider),
 			},
+			ErrorHandler:          validationErrorHandler,
 			SilenceServersWarning: true,
 		})
 
 	return validator, nil
 }
+
+// validationErrorHandler writes errors of the request validator as problem details instead of plain text.
+func validationErrorHandler(w http.ResponseWriter, message string, statusCode int) {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		api_handler.Unauthorized(w, api.ErrorCodeUnauthorized, message)
+	case http.StatusNotFound:
+		api_handler.NotFound(w, api.ErrorCodeNotFound, message)
+	case http.StatusBadRequest:
+		api_handler.BadRequest(w, api.ErrorCodeValidationFailed, message)
+	default:
+		// the message of a validator failure isn't meant for clients
+		api_handler.InternalError(w, "internal server error")
+	}
+}
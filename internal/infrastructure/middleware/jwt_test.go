@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestCreateAuthMiddleware_Problems(t *testing.T) {
+	provider := stubTokenProvider{"valid": {EmployeeID: 1234, Username: "test1", Role: model.RoleEmployee}}
+	authMW, err := CreateAuthMiddleware(provider)
+	require.NoError(t, err)
+
+	handler := authMW(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	testCases := []struct {
+		name       string
+		method     string
+		target     string
+		token      string
+		body       string
+		wantStatus int
+		wantCode   api.ErrorCode
+	}{
+		{
+			name:       "success",
+			method:     http.MethodGet,
+			target:     "/api/info",
+			token:      "valid",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "no_token",
+			method:     http.MethodGet,
+			target:     "/api/info",
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   api.ErrorCodeUnauthorized,
+		},
+		{
+			name:       "invalid_token",
+			method:     http.MethodGet,
+			target:     "/api/info",
+			token:      "invalid",
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   api.ErrorCodeUnauthorized,
+		},
+		{
+			name:       "invalid_body",
+			method:     http.MethodPost,
+			target:     "/api/sendCoin",
+			token:      "valid",
+			body:       `{"toUser": "test2", "amount": "ten"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.ErrorCodeValidationFailed,
+		},
+		{
+			name:       "unknown_route",
+			method:     http.MethodGet,
+			target:     "/api/unknown",
+			token:      "valid",
+			wantStatus: http.StatusNotFound,
+			wantCode:   api.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the validator matches the host of the servers of the spec
+			req := httptest.NewRequest(tc.method, "http://localhost:8080"+tc.target, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.token != "" {
+				req.Header.Set("Authorization", tc.token)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.wantStatus, w.Code)
+			if tc.wantStatus == http.StatusOK {
+				return
+			}
+			assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+			var problem api.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+			assert.Equal(t, tc.wantCode, problem.Code)
+			assert.Equal(t, tc.wantStatus, problem.Status)
+			require.NotNil(t, problem.Detail)
+			assert.NotEmpty(t, *problem.Detail)
+		})
+	}
+}
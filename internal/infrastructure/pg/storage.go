@@ -2,33 +2,57 @@ package pg
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
-	_ "github.com/jackc/pgx/v5/stdlib" // register "pgx" database/sql driver
-	"github.com/jmoiron/sqlx"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/config"
 )
 
-func NewDB(ctx context.Context, cfg config.Config) (*sqlx.DB, func(), error) {
+var queryExecModes = map[string]pgx.QueryExecMode{
+	"cache_statement": pgx.QueryExecModeCacheStatement,
+	"cache_describe":  pgx.QueryExecModeCacheDescribe,
+	"describe_exec":   pgx.QueryExecModeDescribeExec,
+	"exec":            pgx.QueryExecModeExec,
+	"simple_protocol": pgx.QueryExecModeSimpleProtocol,
+}
+
+// NewPool opens a pgx connection pool. Idle connections are checked every DatabaseHealthCheckPeriod
+// and broken ones are replaced, the pool keeps at least DatabaseMinConns connections open.
+func NewPool(ctx context.Context, cfg config.Config) (*pgxpool.Pool, func(), error) {
 	databaseURL := fmt.Sprintf("postgres://%s:%s@%s:%d/%s",
 		cfg.DatabaseUser, cfg.DatabasePassword, cfg.DatabaseHost,
 		cfg.DatabasePort, cfg.DatabaseName)
 
-	db, err := sql.Open("pgx", databaseURL)
+	poolCfg, err := pgxpool.ParseConfig(databaseURL)
 	if err != nil {
-		return nil, nil, fmt.Errorf("sql.Open: %w", err)
+		return nil, nil, fmt.Errorf("pgxpool.ParseConfig: %w", err)
+	}
+
+	queryExecMode, ok := queryExecModes[cfg.DatabaseQueryExecMode]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown query exec mode %q", cfg.DatabaseQueryExecMode)
 	}
 
-	db.SetMaxOpenConns(30)
+	poolCfg.MaxConns = cfg.DatabaseMaxConns
+	poolCfg.MinConns = cfg.DatabaseMinConns
+	poolCfg.MaxConnLifetime = cfg.DatabaseMaxConnLifetime
+	poolCfg.MaxConnIdleTime = cfg.DatabaseMaxConnIdleTime
+	poolCfg.HealthCheckPeriod = cfg.DatabaseHealthCheckPeriod
+	poolCfg.ConnConfig.DefaultQueryExecMode = queryExecMode
+	poolCfg.ConnConfig.StatementCacheCapacity = cfg.DatabaseStatementCacheCapacity
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pgxpool.NewWithConfig: %w", err)
+	}
 
-	err = db.PingContext(ctx)
+	err = pool.Ping(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("db.PingContext: %w", err)
+		pool.Close()
+		return nil, nil, fmt.Errorf("pool.Ping: %w", err)
 	}
 
-	return sqlx.NewDb(db, "pgx"), func() {
-		_ = db.Close()
-	}, nil
+	return pool, pool.Close, nil
 }
@@ -0,0 +1,55 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	// ClientAuthOptional verifies a client certificate if the client sends one, callers with a token only still work
+	ClientAuthOptional = "optional"
+	// ClientAuthRequire rejects connections without a valid client certificate
+	ClientAuthRequire = "require"
+)
+
+// New returns the config of the HTTP and gRPC servers serving the certificate of reloader.
+// HTTP/2 is negotiated with ALPN, HTTP/1.1 stays available. With clientCAFile client certificates
+// signed by its CAs are verified according to clientAuth (mTLS).
+func New(reloader *Reloader, clientCAFile, clientAuth string) (*tls.Config, error) {
+	if reloader == nil {
+		return nil, errors.New("reloader is nil")
+	}
+
+	cfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+		NextProtos:     []string{"h2", "http/1.1"},
+	}
+
+	if clientCAFile == "" {
+		return cfg, nil
+	}
+
+	switch clientAuth {
+	case ClientAuthOptional:
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("client auth should be %s or %s, got %q", ClientAuthOptional, ClientAuthRequire, clientAuth)
+	}
+
+	pem, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+	cfg.ClientCAs = x509.NewCertPool()
+	if !cfg.ClientCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates in %s", clientCAFile)
+	}
+
+	return cfg, nil
+}
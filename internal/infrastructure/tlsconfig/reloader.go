@@ -0,0 +1,118 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader keeps the server certificate loaded from files and replaces it when the files change,
+// so a renewed certificate is served without a restart. Connections opened before a reload keep the old one.
+type Reloader struct {
+	certFile string
+	keyFile  string
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	// versions of the files the certificate was loaded from
+	certVersion fileVersion
+	keyVersion  fileVersion
+}
+
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewReloader loads the certificate and its key, PEM encoded.
+func NewReloader(certFile, keyFile string) (*Reloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("certificate and key files are required")
+	}
+
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	err := r.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// GetCertificate is tls.Config.GetCertificate.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.cert, nil
+}
+
+// Reload loads the files again. The current certificate is kept when the new one is invalid,
+// e.g. when only one of the files is replaced yet.
+func (r *Reloader) Reload() error {
+	certVersion, err := statFile(r.certFile)
+	if err != nil {
+		return err
+	}
+	keyVersion, err := statFile(r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("tls.LoadX509KeyPair: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cert = &cert
+	r.certVersion = certVersion
+	r.keyVersion = keyVersion
+
+	return nil
+}
+
+// ReloadIfChanged reloads the certificate when either file was modified since the last successful load,
+// it reports whether the certificate was replaced. A failed reload is retried on the next call.
+func (r *Reloader) ReloadIfChanged() (bool, error) {
+	certVersion, err := statFile(r.certFile)
+	if err != nil {
+		return false, err
+	}
+	keyVersion, err := statFile(r.keyFile)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.RLock()
+	changed := certVersion != r.certVersion || keyVersion != r.keyVersion
+	r.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	err = r.Reload()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func statFile(name string) (fileVersion, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return fileVersion{}, fmt.Errorf("os.Stat: %w", err)
+	}
+
+	return fileVersion{modTime: info.ModTime(), size: info.Size()}, nil
+}
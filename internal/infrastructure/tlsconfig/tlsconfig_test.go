@@ -0,0 +1,212 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCert issues a certificate for localhost signed by parent, self-signed when parent is nil.
+func newTestCert(t *testing.T, commonName string, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) certPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+}
+
+func (c *testCert) write(t *testing.T, certFile, keyFile string) {
+	t.Helper()
+
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, c.certPEM(), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.cert.Raw}, PrivateKey: c.key}
+}
+
+func servedCommonName(t *testing.T, r *Reloader) string {
+	t.Helper()
+
+	cert, err := r.GetCertificate(nil)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+
+	return leaf.Subject.CommonName
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key")
+	newTestCert(t, "first", nil).write(t, certFile, keyFile)
+
+	r, err := NewReloader(certFile, keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, "first", servedCommonName(t, r))
+
+	reloaded, err := r.ReloadIfChanged()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	// a renewed certificate is picked up
+	newTestCert(t, "second", nil).write(t, certFile, keyFile)
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, future, future))
+
+	reloaded, err = r.ReloadIfChanged()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, "second", servedCommonName(t, r))
+
+	// only the certificate is replaced yet, the key doesn't match it
+	third := newTestCert(t, "third", nil)
+	require.NoError(t, os.WriteFile(certFile, third.certPEM(), 0o600))
+
+	require.Error(t, r.Reload())
+	assert.Equal(t, "second", servedCommonName(t, r))
+}
+
+func TestNewReloader_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewReloader("", "")
+	require.Error(t, err)
+
+	_, err = NewReloader(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, caFile := filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.pem")
+
+	ca := newTestCert(t, "ca", nil)
+	require.NoError(t, os.WriteFile(caFile, ca.certPEM(), 0o600))
+	newTestCert(t, "localhost", ca).write(t, certFile, keyFile)
+	client := newTestCert(t, "client", ca)
+
+	reloader, err := NewReloader(certFile, keyFile)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		clientAuth string
+		clientCert *testCert
+		wantErr    bool
+	}{
+		{name: "optional.without_client_cert", clientAuth: ClientAuthOptional},
+		{name: "optional.with_client_cert", clientAuth: ClientAuthOptional, clientCert: client},
+		{name: "require.with_client_cert", clientAuth: ClientAuthRequire, clientCert: client},
+		{name: "require.without_client_cert", clientAuth: ClientAuthRequire, wantErr: true},
+		{name: "require.untrusted_client_cert", clientAuth: ClientAuthRequire, clientCert: newTestCert(t, "other", nil), wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := New(reloader, caFile, tc.clientAuth)
+			require.NoError(t, err)
+
+			srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(r.Proto))
+			}))
+			srv.TLS = cfg
+			srv.EnableHTTP2 = true
+			srv.StartTLS()
+			defer srv.Close()
+
+			clientCfg := &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				RootCAs:    x509.NewCertPool(),
+				ServerName: "localhost",
+				NextProtos: []string{"h2"},
+			}
+			clientCfg.RootCAs.AddCert(ca.cert)
+			if tc.clientCert != nil {
+				clientCfg.Certificates = []tls.Certificate{tc.clientCert.tlsCertificate()}
+			}
+			httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: clientCfg, ForceAttemptHTTP2: true}}
+
+			resp, err := httpClient.Get(srv.URL)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, 2, resp.ProtoMajor)
+		})
+	}
+}
+
+func TestNew_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key")
+	newTestCert(t, "localhost", nil).write(t, certFile, keyFile)
+
+	reloader, err := NewReloader(certFile, keyFile)
+	require.NoError(t, err)
+
+	_, err = New(nil, "", "")
+	require.Error(t, err)
+
+	_, err = New(reloader, certFile, "always")
+	require.EqualError(t, err, `client auth should be optional or require, got "always"`)
+
+	_, err = New(reloader, keyFile, ClientAuthRequire)
+	require.EqualError(t, err, "no certificates in "+keyFile)
+}
@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-shop/internal"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/cron"
+)
+
+// Run calls job right away and then every interval until ctx is canceled.
+// Job errors are logged and don't stop the worker, the job is retried on the next tick.
+func Run(ctx context.Context, logger internal.Logger, name string, interval time.Duration, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logger.Info("starting worker", zap.String("worker", name), zap.Duration("interval", interval))
+
+	for {
+		err := job(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("worker job error", zap.String("worker", name), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("worker stopped", zap.String("worker", name))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunSchedule calls job at every time of schedule until ctx is canceled.
+// Job errors are logged and don't stop the worker, the job is retried at the next scheduled time.
+func RunSchedule(ctx context.Context, logger internal.Logger, name string, schedule cron.Schedule, job func(ctx context.Context) error) {
+	logger.Info("starting scheduled worker", zap.String("worker", name))
+
+	for {
+		next, err := schedule.Next(time.Now())
+		if err != nil {
+			logger.Error("worker schedule error", zap.String("worker", name), zap.Error(err))
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logger.Info("worker stopped", zap.String("worker", name))
+			return
+		case <-timer.C:
+		}
+
+		err = job(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("worker job error", zap.String("worker", name), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Allowance is the periodic top-up granted to every employee.
+type Allowance struct {
+	Amount         int64
+	CronExpression string
+	IsActive       bool
+	// NextGrantTime is the start of the next period to grant, nil when the allowance was never scheduled.
+	NextGrantTime *time.Time
+}
+
+type AllowanceParams struct {
+	Amount         int64
+	CronExpression string
+	IsActive       bool
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TransferItem struct {
+	ReceiverUsername string
+	Amount           int64
+}
+
+type BatchTransferItemError struct {
+	Index            int
+	ReceiverUsername string
+	Err              error
+}
+
+// BatchTransferError lists every rejected item of a batch transfer, nothing from the batch is applied.
+type BatchTransferError struct {
+	Items []BatchTransferItemError
+}
+
+func (e *BatchTransferError) Error() string {
+	parts := make([]string, 0, len(e.Items))
+	for _, item := range e.Items {
+		parts = append(parts, fmt.Sprintf("#%d %s: %s", item.Index, item.ReceiverUsername, item.Err))
+	}
+	return "batch transfer rejected: " + strings.Join(parts, "; ")
+}
+
+func (e *BatchTransferError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Items))
+	for _, item := range e.Items {
+		errs = append(errs, item.Err)
+	}
+	return errs
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxCartItemQuantity limits the quantity of one merch in the cart.
+const MaxCartItemQuantity = 100
+
+// CartItem is a merch variant saved in the employee cart, prices are taken from the catalog when the cart is read.
+type CartItem struct {
+	MerchID   int64
+	VariantID int64
+	Quantity  int64
+}
+
+type CartLine struct {
+	MerchID   int64
+	MerchName string
+	Variant   MerchVariant
+	Quantity  int64
+	Price     int64
+}
+
+func (l CartLine) Amount() int64 {
+	return l.Price * l.Quantity
+}
+
+type Cart struct {
+	Lines []CartLine
+	Total int64
+}
+
+// PriceCart prices cart items with the current merch and variant prices.
+// Items whose merch or variant is no longer sold are returned as line errors.
+func PriceCart(items []CartItem, merches []Merch, variants []MerchVariant) (Cart, []CheckoutLineError) {
+	merchByID := make(map[int64]Merch, len(merches))
+	for _, merch := range merches {
+		merchByID[merch.ID] = merch
+	}
+	variantByID := make(map[int64]MerchVariant, len(variants))
+	for _, variant := range variants {
+		variantByID[variant.ID] = variant
+	}
+
+	var (
+		cart       Cart
+		lineErrors []CheckoutLineError
+	)
+	for _, item := range items {
+		merch, ok := merchByID[item.MerchID]
+		if !ok {
+			lineErrors = append(lineErrors, CheckoutLineError{MerchID: item.MerchID, Err: ErrMerchNotFound})
+			continue
+		}
+
+		variant, ok := variantByID[item.VariantID]
+		if !ok || !variant.Active {
+			lineErrors = append(lineErrors, CheckoutLineError{
+				MerchID:   merch.ID,
+				MerchName: merch.Name,
+				Variant:   variant,
+				Err:       ErrVariantNotFound,
+			})
+			continue
+		}
+
+		line := CartLine{
+			MerchID:   merch.ID,
+			MerchName: merch.Name,
+			Variant:   variant,
+			Quantity:  item.Quantity,
+			Price:     variant.Price(merch),
+		}
+		cart.Lines = append(cart.Lines, line)
+		cart.Total += line.Amount()
+	}
+
+	return cart, lineErrors
+}
+
+type CheckoutLineError struct {
+	MerchID   int64
+	MerchName string
+	Variant   MerchVariant
+	Err       error
+}
+
+// CheckoutError lists every cart line that prevents the checkout, nothing from the cart is bought.
+type CheckoutError struct {
+	Lines []CheckoutLineError
+}
+
+func (e *CheckoutError) Error() string {
+	parts := make([]string, 0, len(e.Lines))
+	for _, line := range e.Lines {
+		parts = append(parts, fmt.Sprintf("%s (#%d): %s", line.MerchName, line.MerchID, line.Err))
+	}
+	return "checkout rejected: " + strings.Join(parts, "; ")
+}
+
+func (e *CheckoutError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Lines))
+	for _, line := range e.Lines {
+		errs = append(errs, line.Err)
+	}
+	return errs
+}
@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+// CoinLot is a portion of an employee balance received at once. Coins of a lot expire together at ExpireTime.
+type CoinLot struct {
+	ID           int64
+	EmployeeID   int64
+	Amount       int64
+	Remaining    int64
+	ReceivedTime time.Time
+	ExpireTime   time.Time
+}
+
+// CoinLotPart is an amount taken from a lot.
+type CoinLotPart struct {
+	LotID      int64
+	Amount     int64
+	ExpireTime time.Time
+}
+
+// TakeFIFO takes amount coins from lots in the given order and decreases their Remaining accordingly.
+// lots should be ordered from the earliest ExpireTime. ErrNotEnoughBalance is returned when lots are not enough.
+func TakeFIFO(lots []CoinLot, amount int64) ([]CoinLotPart, error) {
+	var parts []CoinLotPart
+	for i := range lots {
+		if amount == 0 {
+			break
+		}
+		if lots[i].Remaining == 0 {
+			continue
+		}
+
+		taken := min(lots[i].Remaining, amount)
+		lots[i].Remaining -= taken
+		amount -= taken
+
+		parts = append(parts, CoinLotPart{
+			LotID:      lots[i].ID,
+			Amount:     taken,
+			ExpireTime: lots[i].ExpireTime,
+		})
+	}
+
+	if amount > 0 {
+		return nil, ErrNotEnoughBalance
+	}
+
+	return parts, nil
+}
+
+// SpendableBalance is the part of balance that can be spent: coins of lots expired but not written off yet
+// by the coin expiry worker are still in balance. activeLots should be the lots not expired at the moment.
+func SpendableBalance(balance int64, activeLots []CoinLot) int64 {
+	var active int64
+	for _, lot := range activeLots {
+		active += lot.Remaining
+	}
+	return min(balance, active)
+}
+
+// ExpiringCoins is an amount of coins that expire at ExpireTime.
+type ExpiringCoins struct {
+	Amount     int64
+	ExpireTime time.Time
+}
+
+// CoinLifetimeMonths is how long granted coins stay spendable.
+const CoinLifetimeMonths = 12
+
+// CoinLotExpireTime returns when coins granted at receivedTime expire.
+func CoinLotExpireTime(receivedTime time.Time) time.Time {
+	return receivedTime.AddDate(0, CoinLifetimeMonths, 0)
+}
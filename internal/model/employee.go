@@ -1,8 +1,23 @@
 package model
 
+import "time"
+
+type Role string
+
+const (
+	RoleEmployee Role = "employee"
+	RoleStaff    Role = "staff"
+	RoleApprover Role = "approver"
+	RoleAdmin    Role = "admin"
+)
+
 type Employee struct {
 	ID       int64
 	Username string
 	Password string
 	Balance  int64
+	Role     Role
+	// GivingBudget is the budget left in GivingBudgetPeriod, see GivingBudget.Available
+	GivingBudget       int64
+	GivingBudgetPeriod *time.Time
 }
@@ -0,0 +1,13 @@
+package model
+
+// EmployeeDirectoryEntry is what colleagues see about an employee when choosing a recipient.
+type EmployeeDirectoryEntry struct {
+	Username    string
+	DisplayName string
+}
+
+// EmployeeDirectoryPage is a page of search results, HasMore reports whether the next page exists.
+type EmployeeDirectoryPage struct {
+	Employees []EmployeeDirectoryEntry
+	HasMore   bool
+}
@@ -1,8 +1,35 @@
 package model
 
+// CoinHistory is how sent and received coins are shown in EmployeeInfo.
+type CoinHistory string
+
+const (
+	// CoinHistoryList shows every transfer
+	CoinHistoryList CoinHistory = "list"
+	// CoinHistoryByCounterparty shows a transfer per counterparty and direction with the summed amount
+	CoinHistoryByCounterparty CoinHistory = "byCounterparty"
+)
+
 type EmployeeInfo struct {
 	Coins                int64
+	GivingBudget         int64
 	Inventory            []Inventory
 	ReceivedTransactions []Transaction
 	SentTransactions     []Transaction
+	// PendingSent and PendingReceived are held transfers waiting for a decision,
+	// their coins are taken from the sender balance but not counted in transactions yet.
+	PendingSent     []TransferRequest
+	PendingReceived []TransferRequest
+	ExpiringSoon    []ExpiringCoins
+}
+
+// EmployeeInfoData is what EmployeeInfo is built from.
+type EmployeeInfoData struct {
+	Employee Employee
+	// Transactions are summed per counterparty with CoinHistoryByCounterparty
+	Transactions []Transaction
+	Inventory    []Inventory
+	// ActiveCoinLots are ordered by expire time
+	ActiveCoinLots  []CoinLot
+	PendingRequests []TransferRequest
 }
@@ -7,8 +7,47 @@ var (
 	ErrWrongEmployeePassword = errors.New("wrong employee password")
 	ErrEmployeeAlreadyExists = errors.New("employee already exists")
 
-	ErrMerchNotFound = errors.New("merch not found")
+	ErrMerchNotFound   = errors.New("merch not found")
+	ErrVariantRequired = errors.New("merch variant should be chosen")
+	ErrVariantNotFound = errors.New("merch variant not found")
+	ErrOutOfStock      = errors.New("merch variant is out of stock")
 
 	ErrNotEnoughBalance               = errors.New("not enough balance")
 	ErrSendingCoinsToMyselfNotAllowed = errors.New("sending coins to myself not allowed")
+	ErrInvalidAmount                  = errors.New("amount should be positive")
+
+	ErrAmountBelowMinimum   = errors.New("transfer amount is below minimum")
+	ErrAmountAboveMaximum   = errors.New("transfer amount is above maximum")
+	ErrDailyLimitExceeded   = errors.New("daily transfer limit exceeded")
+	ErrMonthlyLimitExceeded = errors.New("monthly transfer limit exceeded")
+	ErrTransferCooldown     = errors.New("transfer to this employee is too soon")
+	ErrTransferBlocked      = errors.New("transfer between these employees is blocked")
+
+	ErrApprovalRequired        = errors.New("transfer above approval threshold should be sent alone")
+	ErrTransferRequestNotFound = errors.New("transfer request not found")
+	ErrTransferRequestDecided  = errors.New("transfer request is already decided")
+	ErrTransferRequestExpired  = errors.New("transfer request is expired")
+	ErrInvalidTransferStatus   = errors.New("invalid transfer request status")
+	ErrSelfApprovalNotAllowed  = errors.New("approving own transfer request not allowed")
+
+	ErrScheduledTransferNotFound = errors.New("scheduled transfer not found")
+	ErrInvalidSchedule           = errors.New("invalid schedule")
+
+	ErrAllowanceNotFound = errors.New("allowance not found")
+
+	ErrInvalidPeriod = errors.New("invalid period")
+
+	ErrInvalidExportFormat = errors.New("invalid export format")
+
+	ErrCartEmpty        = errors.New("cart is empty")
+	ErrCartItemNotFound = errors.New("cart item not found")
+	ErrInvalidQuantity  = errors.New("invalid quantity")
+
+	ErrWishlistItemNotFound = errors.New("wishlist item not found")
+
+	ErrInventoryNotFound = errors.New("inventory not found")
+
+	ErrOrderNotFound         = errors.New("order not found")
+	ErrInvalidOrderStatus    = errors.New("invalid order status")
+	ErrOrderStatusTransition = errors.New("order can't be moved to this status")
 )
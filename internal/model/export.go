@@ -0,0 +1,51 @@
+package model
+
+import "time"
+
+type ExportRowKind string
+
+const (
+	ExportRowKindTransfer ExportRowKind = "transfer"
+	ExportRowKindPurchase ExportRowKind = "purchase"
+	// ExportRowKindRefund gives coins of a cancelled order back, the purchase row stays in the export
+	ExportRowKindRefund ExportRowKind = "refund"
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV    ExportFormat = "csv"
+	ExportFormatNDJSON ExportFormat = "ndjson"
+)
+
+type ExportParams struct {
+	Format ExportFormat
+	From   *time.Time
+	// To is exclusive
+	To *time.Time
+	// EmployeeUsername limits the export to rows where the employee is a participant, empty exports everyone
+	EmployeeUsername string
+}
+
+// ExportFilter limits exported rows, nil fields don't filter.
+type ExportFilter struct {
+	From *time.Time
+	// To is exclusive
+	To         *time.Time
+	EmployeeID *int64
+}
+
+// ExportRow is a transfer, a purchase or a refund in the finance export.
+type ExportRow struct {
+	Kind ExportRowKind
+	ID   int64
+	Time time.Time
+	// FromUser is the sender of a transfer or the buyer, empty for refunds
+	FromUser string
+	// ToUser is the receiver of a transfer or the refunded buyer, empty for purchases
+	ToUser string
+	// MerchName and Quantity are set for purchases only
+	MerchName string
+	Quantity  int64
+	Amount    int64
+}
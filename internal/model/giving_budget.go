@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// GivingBudget is the give-only coins refilled at the start of every calendar month (UTC).
+// They can only be sent to colleagues, received coins land in the spendable balance.
+type GivingBudget struct {
+	// MonthlyAmount is the budget every employee gets for a month, zero disables the budget.
+	MonthlyAmount int64
+}
+
+// Period returns the start of the budget period containing now.
+func (b GivingBudget) Period(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Available returns the budget the employee can still give in the period containing now.
+// A budget stored for a previous period is refilled lazily, so no background job is needed.
+func (b GivingBudget) Available(employee Employee, now time.Time) int64 {
+	if employee.GivingBudgetPeriod == nil || !employee.GivingBudgetPeriod.Equal(b.Period(now)) {
+		return b.MonthlyAmount
+	}
+	return employee.GivingBudget
+}
@@ -3,6 +3,9 @@ package model
 type Inventory struct {
 	EmployeeID int64
 	MerchID    int64
+	VariantID  int64
 	Quantity   int64
 	MerchName  string
+	Size       string
+	Color      string
 }
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type Leaderboard struct {
+	// From and To are UTC days, To is exclusive
+	From         time.Time
+	To           time.Time
+	TopReceivers []LeaderboardEmployee
+	TopGivers    []LeaderboardEmployee
+	TopMerch     []LeaderboardMerch
+}
+
+type LeaderboardEmployee struct {
+	Username string
+	Amount   int64
+	// Count is the number of transfers
+	Count int64
+}
+
+type LeaderboardMerch struct {
+	MerchName string
+	Quantity  int64
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type LedgerEntryKind string
+
+const (
+	LedgerEntryKindExpiry    LedgerEntryKind = "expiry"
+	LedgerEntryKindAllowance LedgerEntryKind = "allowance"
+	LedgerEntryKindRefund    LedgerEntryKind = "refund"
+)
+
+// LedgerEntry is a balance change which is not a transfer between employees or a purchase.
+type LedgerEntry struct {
+	ID         int64
+	EmployeeID int64
+	// Amount is negative when coins are taken from the employee.
+	Amount    int64
+	Kind      LedgerEntryKind
+	CoinLotID *int64
+	// PeriodTime is set for periodic grants, an employee gets at most one entry of a kind per period.
+	PeriodTime *time.Time
+	CreateTime time.Time
+}
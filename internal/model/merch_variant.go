@@ -0,0 +1,52 @@
+package model
+
+// MerchVariant is a sellable option of merch. Merch without options has a single variant with empty Size and Color.
+type MerchVariant struct {
+	ID         int64
+	MerchID    int64
+	Size       string
+	Color      string
+	PriceDelta int64
+	// Stock is how many items are left, nil is unlimited
+	Stock  *int64
+	Active bool
+}
+
+// Price returns the variant price of merch.
+func (v MerchVariant) Price(merch Merch) int64 {
+	return merch.Price + v.PriceDelta
+}
+
+// InStock reports whether quantity items of the variant can be sold.
+func (v MerchVariant) InStock(quantity int64) bool {
+	return v.Stock == nil || *v.Stock >= quantity
+}
+
+// VariantOptions chooses a merch variant, empty options choose the only variant of merch without options.
+type VariantOptions struct {
+	Size  string
+	Color string
+}
+
+// SelectVariant finds the active variant matching options.
+// It returns ErrVariantRequired when options are empty and merch has several variants to choose from.
+func SelectVariant(variants []MerchVariant, options VariantOptions) (MerchVariant, error) {
+	active := make([]MerchVariant, 0, len(variants))
+	for _, variant := range variants {
+		if variant.Active {
+			active = append(active, variant)
+		}
+	}
+
+	if options == (VariantOptions{}) && len(active) > 1 {
+		return MerchVariant{}, ErrVariantRequired
+	}
+
+	for _, variant := range active {
+		if options == (VariantOptions{}) || (variant.Size == options.Size && variant.Color == options.Color) {
+			return variant, nil
+		}
+	}
+
+	return MerchVariant{}, ErrVariantNotFound
+}
@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+type OrderStatus string
+
+const (
+	OrderStatusPlaced         OrderStatus = "placed"
+	OrderStatusReadyForPickup OrderStatus = "ready_for_pickup"
+	OrderStatusDelivered      OrderStatus = "delivered"
+	OrderStatusCancelled      OrderStatus = "cancelled"
+)
+
+// orderTransitions lists statuses an order can be moved to, delivered and cancelled orders are final.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPlaced:         {OrderStatusReadyForPickup, OrderStatusCancelled},
+	OrderStatusReadyForPickup: {OrderStatusDelivered},
+}
+
+// OpenOrderStatuses are statuses of orders staff still has to work on.
+var OpenOrderStatuses = []OrderStatus{OrderStatusPlaced, OrderStatusReadyForPickup}
+
+func (s OrderStatus) Valid() bool {
+	switch s {
+	case OrderStatusPlaced, OrderStatusReadyForPickup, OrderStatusDelivered, OrderStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s OrderStatus) CanMoveTo(next OrderStatus) bool {
+	for _, status := range orderTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Order is a purchased merch to be handed over by staff. Every purchase creates an order.
+type Order struct {
+	ID               int64
+	EmployeeID       int64
+	EmployeeUsername string
+	MerchID          int64
+	MerchName        string
+	VariantID        int64
+	Size             string
+	Color            string
+	Quantity         int64
+	// Price is the price of one item at the purchase time, it is refunded on cancellation.
+	Price      int64
+	Status     OrderStatus
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+func (o Order) Amount() int64 {
+	return o.Price * o.Quantity
+}
@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+type ScheduledTransferRunStatus string
+
+const (
+	ScheduledTransferRunStatusSuccess ScheduledTransferRunStatus = "success"
+	ScheduledTransferRunStatusFailed  ScheduledTransferRunStatus = "failed"
+)
+
+// ScheduledTransfer is either a one-off transfer (empty CronExpression, runs once at NextRunTime)
+// or a recurring one (NextRunTime is recalculated from CronExpression after every run).
+type ScheduledTransfer struct {
+	ID               int64
+	OwnerID          int64
+	ReceiverID       int64
+	ReceiverUsername string
+	Amount           int64
+	CronExpression   string
+	NextRunTime      *time.Time
+	IsActive         bool
+}
+
+type ScheduledTransferRun struct {
+	ID                  int64
+	ScheduledTransferID int64
+	RunTime             time.Time
+	Status              ScheduledTransferRunStatus
+	Error               string
+}
+
+// ScheduledTransferParams describes a scheduled transfer as the owner sets it up.
+// Exactly one of RunAt and CronExpression should be set.
+type ScheduledTransferParams struct {
+	ReceiverUsername string
+	Amount           int64
+	RunAt            *time.Time
+	CronExpression   string
+	IsActive         bool
+}
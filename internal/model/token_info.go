@@ -3,4 +3,5 @@ package model
 type TokenInfo struct {
 	EmployeeID int64
 	Username   string
+	Role       Role
 }
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// TransferPolicy configures restrictions applied to every coin transfer. Zero values disable a restriction.
+type TransferPolicy struct {
+	MinAmount    int64
+	MaxAmount    int64
+	DailyLimit   int64
+	MonthlyLimit int64
+	// Cooldown is the minimal pause between two transfers from the same sender to the same receiver.
+	Cooldown     time.Duration
+	BlockedPairs []BlockedTransferPair
+}
+
+// BlockedTransferPair forbids transfers from SenderUsername to ReceiverUsername.
+type BlockedTransferPair struct {
+	SenderUsername   string
+	ReceiverUsername string
+}
+
+// PolicyTransfer is a single transfer checked against TransferPolicy.
+type PolicyTransfer struct {
+	SenderID         int64
+	SenderUsername   string
+	ReceiverID       int64
+	ReceiverUsername string
+	Amount           int64
+}
+
+// TransferItemError is the rejection of one transfer of a sending operation, Index is its position there.
+// It wraps the domain error, e.g. ErrDailyLimitExceeded, so errors.Is works as for the plain error.
+type TransferItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *TransferItemError) Error() string {
+	return fmt.Sprintf("transfer #%d: %s", e.Index, e.Err)
+}
+
+func (e *TransferItemError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,72 @@
+package model
+
+import "time"
+
+type TransferRequestStatus string
+
+const (
+	TransferRequestStatusPending  TransferRequestStatus = "pending"
+	TransferRequestStatusApproved TransferRequestStatus = "approved"
+	TransferRequestStatusRejected TransferRequestStatus = "rejected"
+	TransferRequestStatusExpired  TransferRequestStatus = "expired"
+	TransferRequestStatusAccepted TransferRequestStatus = "accepted"
+	TransferRequestStatusDeclined TransferRequestStatus = "declined"
+)
+
+func (s TransferRequestStatus) Valid() bool {
+	switch s {
+	case TransferRequestStatusPending, TransferRequestStatusApproved, TransferRequestStatusRejected,
+		TransferRequestStatusExpired, TransferRequestStatusAccepted, TransferRequestStatusDeclined:
+		return true
+	default:
+		return false
+	}
+}
+
+// TransferRequestKind tells who decides on a transfer request.
+type TransferRequestKind string
+
+const (
+	// TransferRequestKindApproval waits for an approver: approved, rejected or expired.
+	TransferRequestKindApproval TransferRequestKind = "approval"
+	// TransferRequestKindAcceptance waits for the receiver: accepted, declined or accepted automatically on expiry.
+	TransferRequestKindAcceptance TransferRequestKind = "acceptance"
+)
+
+// TransferApproval decides which transfers wait for a decision instead of being executed at once.
+type TransferApproval struct {
+	// Threshold is the largest amount sent without approval, zero disables approvals.
+	Threshold int64
+	// TTL is how long a request waits for a decision, after that the held coins go back to the sender.
+	TTL time.Duration
+	// AcceptanceTTL is how long a transfer waits for the receiver, after that it is accepted automatically.
+	AcceptanceTTL time.Duration
+}
+
+// Required reports whether a transfer of amount coins should be approved first.
+func (a TransferApproval) Required(amount int64) bool {
+	return a.Threshold > 0 && amount > a.Threshold
+}
+
+// TransferRequest is a transfer waiting for an approver or the receiver. Amount is held from the sender lots
+// until the request is approved or accepted (held coins go to the receiver), rejected, declined or expired
+// (held coins go back to the sender).
+type TransferRequest struct {
+	ID               int64
+	Kind             TransferRequestKind
+	SenderID         int64
+	SenderUsername   string
+	ReceiverID       int64
+	ReceiverUsername string
+	Amount           int64
+	Status           TransferRequestStatus
+	// RequireAcceptance is set for an approval request sent in acceptance mode: once approved,
+	// the transfer waits for the receiver in a new acceptance request.
+	RequireAcceptance bool
+	ExpireTime        time.Time
+	// DecidedBy is the approver or the receiver who decided on the request, zero otherwise
+	DecidedBy         int64
+	DecidedByUsername string
+	CreateTime        time.Time
+	DecideTime        *time.Time
+}
@@ -0,0 +1,29 @@
+package model
+
+// WishlistItem is a merch the employee saves up for, annotated for the current balance.
+type WishlistItem struct {
+	MerchID   int64
+	MerchName string
+	Price     int64
+	// AddedPrice is the merch price when it was added to the wishlist
+	AddedPrice int64
+	// MissingCoins is how many coins the employee still needs, zero when the item is affordable
+	MissingCoins int64
+}
+
+func (i WishlistItem) Affordable() bool {
+	return i.MissingCoins == 0
+}
+
+// PriceDrop is how much cheaper the merch became since it was added to the wishlist.
+func (i WishlistItem) PriceDrop() int64 {
+	return max(i.AddedPrice-i.Price, 0)
+}
+
+// AnnotateWishlist fills MissingCoins of every item for the balance.
+func AnnotateWishlist(items []WishlistItem, balance int64) []WishlistItem {
+	for i := range items {
+		items[i].MissingCoins = max(items[i].Price-balance, 0)
+	}
+	return items
+}
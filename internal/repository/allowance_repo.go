@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type AllowanceRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewAllowanceRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*AllowanceRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &AllowanceRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *AllowanceRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *AllowanceRepository) Get(ctx context.Context) (*model.Allowance, error) {
+	q := "SELECT amount, cron_expression, is_active, next_grant_time FROM allowance WHERE id = 1"
+
+	allowance, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Allowance], q)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrAllowanceNotFound
+		}
+		return nil, fmt.Errorf("getRow: %w", err)
+	}
+
+	return &model.Allowance{
+		Amount:         allowance.Amount,
+		CronExpression: allowance.CronExpression,
+		IsActive:       allowance.IsActive,
+		NextGrantTime:  allowance.NextGrantTime,
+	}, nil
+}
+
+func (r *AllowanceRepository) Update(ctx context.Context, allowance model.Allowance) error {
+	q := `UPDATE allowance
+		SET amount = $1, cron_expression = $2, is_active = $3, next_grant_time = $4, update_time = now()
+		WHERE id = 1`
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q,
+		allowance.Amount, allowance.CronExpression, allowance.IsActive, allowance.NextGrantTime)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrAllowanceNotFound)
+}
+
+// AdvanceNextGrantTime moves next_grant_time from periodTime to next and reports whether it was moved.
+// It isn't moved when the period was already advanced by another replica or changed by an admin.
+func (r *AllowanceRepository) AdvanceNextGrantTime(ctx context.Context, periodTime, next time.Time) (bool, error) {
+	q := "UPDATE allowance SET next_grant_time = $2 WHERE id = 1 AND next_grant_time = $1"
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, periodTime, next)
+	if err != nil {
+		return false, fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type CartRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewCartRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*CartRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &CartRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *CartRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *CartRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.CartItem, error) {
+	q := "SELECT merch_id, variant_id, quantity FROM cart_item WHERE employee_id = $1 ORDER BY create_time, variant_id"
+
+	items, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[CartItem], q, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.CartItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, model.CartItem{
+			MerchID:   item.MerchID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	return res, nil
+}
+
+// Add puts quantity of merch variant into the cart, quantities of the same variant are summed up.
+func (r *CartRepository) Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error {
+	q := `INSERT INTO cart_item (employee_id, merch_id, variant_id, quantity)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (employee_id, variant_id) DO UPDATE SET
+			quantity = cart_item.quantity + excluded.quantity`
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, merchID, variantID, quantity)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CartRepository) Remove(ctx context.Context, employeeID, variantID int64) error {
+	q := "DELETE FROM cart_item WHERE employee_id = $1 AND variant_id = $2"
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, variantID)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrCartItemNotFound)
+}
+
+func (r *CartRepository) Clear(ctx context.Context, employeeID int64) error {
+	q := "DELETE FROM cart_item WHERE employee_id = $1"
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, employeeID)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
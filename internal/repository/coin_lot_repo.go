@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// selectActiveLots selects not expired at $2 lots of the employee $1 with remaining coins, the earliest expiring first
+const selectActiveLots = `SELECT id, employee_id, amount, remaining, received_time, expire_time
+		FROM coin_lot
+		WHERE employee_id = $1 AND remaining > 0 AND expire_time > $2
+		ORDER BY expire_time, id`
+
+type CoinLotRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewCoinLotRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*CoinLotRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &CoinLotRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *CoinLotRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *CoinLotRepository) Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error {
+	q := "INSERT INTO coin_lot (employee_id, amount, remaining, expire_time) VALUES ($1, $2, $2, $3)"
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, amount, expireTime)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetActive returns not expired lots with remaining coins, the earliest expiring first.
+func (r *CoinLotRepository) GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	return r.selectLots(ctx, selectActiveLots, employeeID, now)
+}
+
+// GetExpired returns expired lots which still have remaining coins.
+func (r *CoinLotRepository) GetExpired(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	q := `SELECT id, employee_id, amount, remaining, received_time, expire_time
+		FROM coin_lot
+		WHERE employee_id = $1 AND remaining > 0 AND expire_time <= $2
+		ORDER BY expire_time, id`
+
+	return r.selectLots(ctx, q, employeeID, now)
+}
+
+// GetEmployeesWithExpired returns up to limit employees who have expired lots with remaining coins.
+func (r *CoinLotRepository) GetEmployeesWithExpired(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	q := `SELECT DISTINCT employee_id
+		FROM coin_lot
+		WHERE remaining > 0 AND expire_time <= $1
+		ORDER BY employee_id
+		LIMIT $2`
+
+	employeeIDs, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowTo[int64], q, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	return employeeIDs, nil
+}
+
+func (r *CoinLotRepository) Decrease(ctx context.Context, lotID, amount int64) error {
+	q := "UPDATE coin_lot SET remaining = remaining - $2 WHERE id = $1"
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, lotID, amount)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CoinLotRepository) selectLots(ctx context.Context, q string, args ...any) ([]model.CoinLot, error) {
+	lots, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[CoinLot], q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	return convertCoinLots(lots), nil
+}
+
+func convertCoinLots(lots []CoinLot) []model.CoinLot {
+	res := make([]model.CoinLot, 0, len(lots))
+	for _, lot := range lots {
+		res = append(res, model.CoinLot{
+			ID:           lot.ID,
+			EmployeeID:   lot.EmployeeID,
+			Amount:       lot.Amount,
+			Remaining:    lot.Remaining,
+			ReceivedTime: lot.ReceivedTime,
+			ExpireTime:   lot.ExpireTime,
+		})
+	}
+
+	return res
+}
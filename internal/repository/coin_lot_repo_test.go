@@ -0,0 +1,56 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CoinLot(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewCoinLotRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	const employeeID = 390296
+	now := time.Now().UTC().Truncate(time.Second)
+
+	_, err = db.Exec(ctx, `DELETE FROM coin_lot where employee_id = $1`, employeeID)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Add(ctx, employeeID, 100, now.Add(48*time.Hour)))
+	require.NoError(t, repo.Add(ctx, employeeID, 200, now.Add(24*time.Hour)))
+	require.NoError(t, repo.Add(ctx, employeeID, 300, now.Add(-time.Hour)))
+
+	active, err := repo.GetActive(ctx, employeeID, now)
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	require.Equal(t, int64(200), active[0].Remaining)
+	require.Equal(t, int64(100), active[1].Remaining)
+	require.True(t, active[0].ExpireTime.Equal(now.Add(24*time.Hour)))
+
+	require.NoError(t, repo.Decrease(ctx, active[0].ID, 200))
+	active, err = repo.GetActive(ctx, employeeID, now)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, int64(100), active[0].Remaining)
+
+	expired, err := repo.GetExpired(ctx, employeeID, now)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, int64(300), expired[0].Remaining)
+
+	employeeIDs, err := repo.GetEmployeesWithExpired(ctx, now, 100_000)
+	require.NoError(t, err)
+	require.Contains(t, employeeIDs, int64(employeeID))
+
+	require.NoError(t, repo.Decrease(ctx, expired[0].ID, 300))
+	expired, err = repo.GetExpired(ctx, employeeID, now)
+	require.NoError(t, err)
+	require.Empty(t, expired)
+}
@@ -2,22 +2,26 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
-	"github.com/jmoiron/sqlx"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
+const selectEmployeeByID = "SELECT id, username, password, balance, role, giving_budget, giving_budget_period FROM employee WHERE id = $1"
+
 type EmployeeRepository struct {
-	db     *sqlx.DB
-	getter *trmsqlx.CtxGetter
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
 }
 
-func NewEmployeeRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*EmployeeRepository, error) {
+func NewEmployeeRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*EmployeeRepository, error) {
 	if db == nil {
 		return nil, errors.New("db is nil")
 	}
@@ -31,50 +35,53 @@ func NewEmployeeRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*EmployeeRep
 	}, nil
 }
 
-func (r *EmployeeRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+func (r *EmployeeRepository) trOrDB(ctx context.Context) trmpgx.Tr {
 	return r.getter.DefaultTrOrDB(ctx, r.db)
 }
 
 func (r *EmployeeRepository) GetByUsername(ctx context.Context, username string) (*model.Employee, error) {
-	var employee Employee
-
-	q := "SELECT id, username, password, balance FROM employee WHERE username = $1"
+	q := "SELECT id, username, password, balance, role, giving_budget, giving_budget_period FROM employee WHERE username = $1"
 
-	err := r.trOrDB(ctx).GetContext(ctx, &employee, q, username)
+	employee, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Employee], q, username)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, model.ErrEmployeeNotFound
 		}
-		return nil, fmt.Errorf("db.GetContext: %w", err)
+		return nil, fmt.Errorf("getRow: %w", err)
 	}
 
-	return &model.Employee{
-		ID:       employee.ID,
-		Username: employee.Username,
-		Password: employee.Password,
-		Balance:  employee.Balance,
-	}, nil
+	res := convertEmployee(employee)
+	return &res, nil
 }
 
-func (r *EmployeeRepository) GetByID(ctx context.Context, employeeID int64) (*model.Employee, error) {
-	var employee Employee
+// GetByUsernames returns employees found by usernames, unknown usernames are skipped.
+func (r *EmployeeRepository) GetByUsernames(ctx context.Context, usernames []string) ([]model.Employee, error) {
+	q := "SELECT id, username, password, balance, role, giving_budget, giving_budget_period FROM employee WHERE username = ANY($1)"
+
+	employees, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Employee], q, usernames)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
 
-	q := "SELECT id, username, password, balance FROM employee WHERE id = $1"
+	res := make([]model.Employee, 0, len(employees))
+	for _, employee := range employees {
+		res = append(res, convertEmployee(employee))
+	}
+
+	return res, nil
+}
 
-	err := r.trOrDB(ctx).GetContext(ctx, &employee, q, employeeID)
+func (r *EmployeeRepository) GetByID(ctx context.Context, employeeID int64) (*model.Employee, error) {
+	employee, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Employee], selectEmployeeByID, employeeID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, model.ErrEmployeeNotFound
 		}
-		return nil, fmt.Errorf("db.GetContext: %w", err)
+		return nil, fmt.Errorf("getRow: %w", err)
 	}
 
-	return &model.Employee{
-		ID:       employee.ID,
-		Username: employee.Username,
-		Password: employee.Password,
-		Balance:  employee.Balance,
-	}, nil
+	res := convertEmployee(employee)
+	return &res, nil
 }
 
 func (r *EmployeeRepository) Create(ctx context.Context, username, passwordHash string, balance int64) (*model.Employee, error) {
@@ -84,12 +91,12 @@ func (r *EmployeeRepository) Create(ctx context.Context, username, passwordHash
 		"RETURNING ID"
 
 	var ID int64
-	err := r.trOrDB(ctx).GetContext(ctx, &ID, q, username, passwordHash, balance)
+	err := r.trOrDB(ctx).QueryRow(ctx, q, username, passwordHash, balance).Scan(&ID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, model.ErrEmployeeAlreadyExists
 		}
-		return nil, fmt.Errorf("db.GetContext: %w", err)
+		return nil, fmt.Errorf("db.QueryRow: %w", err)
 	}
 
 	return &model.Employee{
@@ -97,37 +104,104 @@ func (r *EmployeeRepository) Create(ctx context.Context, username, passwordHash
 		Username: username,
 		Password: passwordHash,
 		Balance:  balance,
+		Role:     model.RoleEmployee,
 	}, nil
 }
 
 func (r *EmployeeRepository) GetByIDWithLock(ctx context.Context, employeeID int64) (*model.Employee, error) {
-	var employee Employee
-
-	q := "SELECT id, username, password, balance FROM employee WHERE id = $1 FOR NO KEY UPDATE"
+	q := "SELECT id, username, password, balance, role, giving_budget, giving_budget_period FROM employee WHERE id = $1 FOR NO KEY UPDATE"
 
-	err := r.trOrDB(ctx).GetContext(ctx, &employee, q, employeeID)
+	employee, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Employee], q, employeeID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, model.ErrEmployeeNotFound
 		}
-		return nil, fmt.Errorf("db.GetContext: %w", err)
+		return nil, fmt.Errorf("getRow: %w", err)
 	}
 
-	return &model.Employee{
-		ID:       employee.ID,
-		Username: employee.Username,
-		Password: employee.Password,
-		Balance:  employee.Balance,
-	}, nil
+	res := convertEmployee(employee)
+	return &res, nil
 }
 
 func (r *EmployeeRepository) IncreaseBalance(ctx context.Context, employeeID, amount int64) error {
 	q := "UPDATE employee SET balance = balance + $2 WHERE id = $1"
 
-	_, err := r.trOrDB(ctx).ExecContext(ctx, q, employeeID, amount)
+	_, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, amount)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// SetGivingBudget stores the budget left for the period, it's called with the employee locked.
+func (r *EmployeeRepository) SetGivingBudget(ctx context.Context, employeeID, budget int64, period time.Time) error {
+	q := "UPDATE employee SET giving_budget = $2, giving_budget_period = $3 WHERE id = $1"
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, budget, period)
 	if err != nil {
-		return fmt.Errorf("db.ExecContext: %w", err)
+		return fmt.Errorf("db.Exec: %w", err)
 	}
 
 	return nil
 }
+
+func (r *EmployeeRepository) SetHiddenFromStats(ctx context.Context, employeeID int64, hidden bool) error {
+	q := "UPDATE employee SET hidden_from_stats = $2 WHERE id = $1"
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, hidden)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrEmployeeNotFound)
+}
+
+// Search finds employees whose username or display name starts with query or is similar to it (pg_trgm),
+// prefix matches go first. An empty query lists everyone by username.
+func (r *EmployeeRepository) Search(ctx context.Context, query string, limit, offset int) ([]model.EmployeeDirectoryEntry, error) {
+	q := `SELECT username, display_name
+		FROM employee
+		WHERE $1 = ''
+			OR username ILIKE $2 || '%' OR display_name ILIKE $2 || '%'
+			OR username % $1 OR display_name % $1
+		ORDER BY (username ILIKE $2 || '%' OR display_name ILIKE $2 || '%') DESC,
+			greatest(similarity(username, $1), similarity(display_name, $1)) DESC,
+			username
+		LIMIT $3 OFFSET $4`
+
+	entries, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[EmployeeDirectoryEntry], q, query, escapeLike(query), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.EmployeeDirectoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, model.EmployeeDirectoryEntry{
+			Username:    entry.Username,
+			DisplayName: entry.DisplayName,
+		})
+	}
+
+	return res, nil
+}
+
+func convertEmployee(employee Employee) model.Employee {
+	return model.Employee{
+		ID:       employee.ID,
+		Username: employee.Username,
+		Password: employee.Password,
+		Balance:  employee.Balance,
+		Role:     model.Role(employee.Role),
+
+		GivingBudget:       employee.GivingBudget,
+		GivingBudgetPeriod: employee.GivingBudgetPeriod,
+	}
+}
+
+// escapeLike escapes LIKE wildcards, so user input is matched literally.
+func escapeLike(s string) string {
+	return likeReplacer.Replace(s)
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
@@ -6,7 +6,8 @@ import (
 	"context"
 	"testing"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
@@ -14,7 +15,7 @@ import (
 
 func Test_GetByUsername(t *testing.T) {
 	db := setUp(t)
-	repo, err := NewEmployeeRepository(db, trmsqlx.DefaultCtxGetter)
+	repo, err := NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
 	require.NoError(t, err)
 
 	type args struct {
@@ -31,9 +32,9 @@ func Test_GetByUsername(t *testing.T) {
 		{
 			name: "found",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where username = $1`, "get-by-username-1")
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where username = $1`, "get-by-username-1")
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO employee (username, password, balance)
+				_, err = db.Exec(context.Background(), `INSERT INTO employee (username, password, balance)
 					VALUES ($1, $2, $3)`, "get-by-username-1", "password", 500)
 				require.NoError(t, err)
 			},
@@ -44,13 +45,14 @@ func Test_GetByUsername(t *testing.T) {
 				Username: "get-by-username-1",
 				Password: "password",
 				Balance:  500,
+				Role:     model.RoleEmployee,
 			},
 			wantErr: nil,
 		},
 		{
 			name: "not_found",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where username = $1`, "get-by-username-2")
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where username = $1`, "get-by-username-2")
 				require.NoError(t, err)
 			},
 			args: args{
@@ -77,9 +79,35 @@ func Test_GetByUsername(t *testing.T) {
 	}
 }
 
+func Test_GetByUsernames(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	for _, username := range []string{"get-by-usernames-1", "get-by-usernames-2", "get-by-usernames-3"} {
+		_, err = db.Exec(context.Background(), `DELETE FROM employee where username = $1`, username)
+		require.NoError(t, err)
+	}
+	_, err = db.Exec(context.Background(), `INSERT INTO employee (username, password, balance)
+		VALUES ($1, $2, $3), ($4, $5, $6)`,
+		"get-by-usernames-1", "password", 500, "get-by-usernames-2", "password", 700)
+	require.NoError(t, err)
+
+	res, err := repo.GetByUsernames(context.Background(),
+		[]string{"get-by-usernames-2", "get-by-usernames-3", "get-by-usernames-1"})
+	require.NoError(t, err)
+
+	require.Len(t, res, 2)
+	balances := map[string]int64{}
+	for _, employee := range res {
+		balances[employee.Username] = employee.Balance
+	}
+	require.Equal(t, map[string]int64{"get-by-usernames-1": 500, "get-by-usernames-2": 700}, balances)
+}
+
 func Test_GetByID(t *testing.T) {
 	db := setUp(t)
-	repo, err := NewEmployeeRepository(db, trmsqlx.DefaultCtxGetter)
+	repo, err := NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
 	require.NoError(t, err)
 
 	const ID = 46875401
@@ -98,9 +126,9 @@ func Test_GetByID(t *testing.T) {
 		{
 			name: "found",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where username = $1`, "get-by-username-100")
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where username = $1`, "get-by-username-100")
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO employee (id, username, password, balance)
+				_, err = db.Exec(context.Background(), `INSERT INTO employee (id, username, password, balance)
 					VALUES ($1, $2, $3, $4)`, ID, "get-by-username-100", "password", 500)
 				require.NoError(t, err)
 			},
@@ -112,13 +140,14 @@ func Test_GetByID(t *testing.T) {
 				Username: "get-by-username-100",
 				Password: "password",
 				Balance:  500,
+				Role:     model.RoleEmployee,
 			},
 			wantErr: nil,
 		},
 		{
 			name: "not_found",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where id = $1`, ID+1)
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where id = $1`, ID+1)
 				require.NoError(t, err)
 			},
 			args: args{
@@ -144,7 +173,7 @@ func Test_GetByID(t *testing.T) {
 
 func Test_Create(t *testing.T) {
 	db := setUp(t)
-	repo, err := NewEmployeeRepository(db, trmsqlx.DefaultCtxGetter)
+	repo, err := NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
 	require.NoError(t, err)
 
 	const (
@@ -168,7 +197,7 @@ func Test_Create(t *testing.T) {
 		{
 			name: "found",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where username = $1`, "get-by-username-3")
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where username = $1`, "get-by-username-3")
 				require.NoError(t, err)
 			},
 			args: args{
@@ -180,15 +209,16 @@ func Test_Create(t *testing.T) {
 				Username: "get-by-username-3",
 				Password: "password",
 				Balance:  500,
+				Role:     model.RoleEmployee,
 			},
 			wantErr: nil,
 		},
 		{
 			name: "AlreadyExists",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where id = $1`, ID)
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where id = $1`, ID)
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO employee (id, username, password, balance)
+				_, err = db.Exec(context.Background(), `INSERT INTO employee (id, username, password, balance)
 					VALUES ($1, $2, $3, $4)`, ID, username, "password", 500)
 				require.NoError(t, err)
 			},
@@ -221,7 +251,7 @@ func Test_Create(t *testing.T) {
 
 func Test_GetByIDWithLock(t *testing.T) {
 	db := setUp(t)
-	repo, err := NewEmployeeRepository(db, trmsqlx.DefaultCtxGetter)
+	repo, err := NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
 	require.NoError(t, err)
 
 	const ID = 46863401
@@ -240,9 +270,9 @@ func Test_GetByIDWithLock(t *testing.T) {
 		{
 			name: "found",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where username = $1`, "get-by-username-5")
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where username = $1`, "get-by-username-5")
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO employee (id, username, password, balance)
+				_, err = db.Exec(context.Background(), `INSERT INTO employee (id, username, password, balance)
 					VALUES ($1, $2, $3, $4)`, ID, "get-by-username-5", "password", 500)
 				require.NoError(t, err)
 			},
@@ -254,13 +284,14 @@ func Test_GetByIDWithLock(t *testing.T) {
 				Username: "get-by-username-5",
 				Password: "password",
 				Balance:  500,
+				Role:     model.RoleEmployee,
 			},
 			wantErr: nil,
 		},
 		{
 			name: "not_found",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where id = $1`, ID+1)
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where id = $1`, ID+1)
 				require.NoError(t, err)
 			},
 			args: args{
@@ -286,7 +317,7 @@ func Test_GetByIDWithLock(t *testing.T) {
 
 func Test_IncreaseBalance(t *testing.T) {
 	db := setUp(t)
-	repo, err := NewEmployeeRepository(db, trmsqlx.DefaultCtxGetter)
+	repo, err := NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
 	require.NoError(t, err)
 
 	const ID = 46825600
@@ -306,9 +337,9 @@ func Test_IncreaseBalance(t *testing.T) {
 		{
 			name: "found",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM employee where username = $1`, "get-by-username-6")
+				_, err = db.Exec(context.Background(), `DELETE FROM employee where username = $1`, "get-by-username-6")
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO employee (id, username, password, balance)
+				_, err = db.Exec(context.Background(), `INSERT INTO employee (id, username, password, balance)
 					VALUES ($1, $2, $3, $4)`, ID, "get-by-username-6", "password", 1000)
 				require.NoError(t, err)
 			},
@@ -318,7 +349,8 @@ func Test_IncreaseBalance(t *testing.T) {
 			},
 			check: func(t *testing.T) {
 				var employee Employee
-				err = db.Get(&employee, "SELECT id, username, password, balance FROM employee WHERE username = $1", "get-by-username-6")
+				employee, err = getRow(context.Background(), db, pgx.RowToStructByNameLax[Employee],
+					"SELECT id, username, password, balance FROM employee WHERE username = $1", "get-by-username-6")
 				require.NoError(t, err)
 
 				require.Equal(t, Employee{
@@ -344,3 +376,34 @@ func Test_IncreaseBalance(t *testing.T) {
 		})
 	}
 }
+
+func Test_Search(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewEmployeeRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	for _, username := range []string{"search-zebra-1", "search-zebra-2", "search-zerba-3"} {
+		_, err = db.Exec(context.Background(), `DELETE FROM employee where username = $1`, username)
+		require.NoError(t, err)
+	}
+	_, err = db.Exec(context.Background(), `INSERT INTO employee (username, display_name, password, balance)
+		VALUES ('search-zebra-1', 'Zebra One', 'password', 0),
+			('search-zebra-2', '', 'password', 0),
+			('search-zerba-3', '', 'password', 0)`)
+	require.NoError(t, err)
+
+	res, err := repo.Search(context.Background(), "search-zebra", 10, 0)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(res), 3)
+	// prefix matches go before similar ones
+	require.Equal(t, []model.EmployeeDirectoryEntry{
+		{Username: "search-zebra-1", DisplayName: "Zebra One"},
+		{Username: "search-zebra-2"},
+		{Username: "search-zerba-3"},
+	}, res[:3])
+
+	// wildcards are matched literally
+	res, err = repo.Search(context.Background(), "%", 10, 0)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
@@ -1,10 +1,16 @@
 package repository
 
+import "time"
+
 type Employee struct {
 	ID       int64  `db:"id"`
 	Username string `db:"username"`
 	Password string `db:"password"`
 	Balance  int64  `db:"balance"`
+	Role     string `db:"role"`
+
+	GivingBudget       int64      `db:"giving_budget"`
+	GivingBudgetPeriod *time.Time `db:"giving_budget_period"`
 }
 
 type Merch struct {
@@ -13,17 +19,159 @@ type Merch struct {
 	Price int64  `db:"price"`
 }
 
+type MerchVariant struct {
+	ID         int64  `db:"id"`
+	MerchID    int64  `db:"merch_id"`
+	Size       string `db:"size"`
+	Color      string `db:"color"`
+	PriceDelta int64  `db:"price_delta"`
+	Stock      *int64 `db:"stock"`
+	Active     bool   `db:"active"`
+}
+
 type EmployeeTransaction struct {
-	ID                     int64  `db:"id"`
-	IsSender               bool   `db:"is_sender"`
-	CounterpartyEmployeeID int64  `db:"counterparty_employee_id"`
-	CounterpartyUsername   string `db:"counterparty_username"`
-	Amount                 int64  `db:"amount"`
+	ID                     int64  `db:"id" json:"id"`
+	IsSender               bool   `db:"is_sender" json:"is_sender"`
+	CounterpartyEmployeeID int64  `db:"counterparty_employee_id" json:"counterparty_employee_id"`
+	CounterpartyUsername   string `db:"counterparty_username" json:"counterparty_username"`
+	Amount                 int64  `db:"amount" json:"amount"`
 }
 
 type InventoryWithMerchName struct {
-	EmployeeID int64  `db:"employee_id"`
+	EmployeeID int64  `db:"employee_id" json:"employee_id"`
+	MerchID    int64  `db:"merch_id" json:"merch_id"`
+	VariantID  int64  `db:"variant_id" json:"variant_id"`
+	Quantity   int64  `db:"quantity" json:"quantity"`
+	MerchName  string `db:"merch_name" json:"merch_name"`
+	Size       string `db:"size" json:"size"`
+	Color      string `db:"color" json:"color"`
+}
+
+type CartItem struct {
+	MerchID   int64 `db:"merch_id"`
+	VariantID int64 `db:"variant_id"`
+	Quantity  int64 `db:"quantity"`
+}
+
+type WishlistItem struct {
 	MerchID    int64  `db:"merch_id"`
-	Quantity   int64  `db:"quantity"`
 	MerchName  string `db:"merch_name"`
+	Price      int64  `db:"price"`
+	AddedPrice int64  `db:"added_price"`
+}
+
+type ScheduledTransfer struct {
+	ID               int64      `db:"id"`
+	OwnerID          int64      `db:"owner_id"`
+	ReceiverID       int64      `db:"receiver_id"`
+	ReceiverUsername string     `db:"receiver_username"`
+	Amount           int64      `db:"amount"`
+	CronExpression   string     `db:"cron_expression"`
+	NextRunTime      *time.Time `db:"next_run_time"`
+	IsActive         bool       `db:"is_active"`
+}
+
+type ScheduledTransferRun struct {
+	ID                  int64     `db:"id"`
+	ScheduledTransferID int64     `db:"scheduled_transfer_id"`
+	RunTime             time.Time `db:"run_time"`
+	Status              string    `db:"status"`
+	Error               string    `db:"error"`
+}
+
+type CoinLot struct {
+	ID           int64     `db:"id" json:"id"`
+	EmployeeID   int64     `db:"employee_id" json:"employee_id"`
+	Amount       int64     `db:"amount" json:"amount"`
+	Remaining    int64     `db:"remaining" json:"remaining"`
+	ReceivedTime time.Time `db:"received_time" json:"received_time"`
+	ExpireTime   time.Time `db:"expire_time" json:"expire_time"`
+}
+
+type Allowance struct {
+	Amount         int64      `db:"amount"`
+	CronExpression string     `db:"cron_expression"`
+	IsActive       bool       `db:"is_active"`
+	NextGrantTime  *time.Time `db:"next_grant_time"`
+}
+
+type LeaderboardEmployee struct {
+	Username string `db:"username"`
+	Amount   int64  `db:"amount"`
+	Count    int64  `db:"count"`
+}
+
+type LeaderboardMerch struct {
+	MerchName string `db:"merch_name"`
+	Quantity  int64  `db:"quantity"`
+}
+
+type ExportRow struct {
+	Kind      string    `db:"kind"`
+	ID        int64     `db:"id"`
+	Time      time.Time `db:"event_time"`
+	FromUser  string    `db:"from_user"`
+	ToUser    string    `db:"to_user"`
+	MerchName string    `db:"merch_name"`
+	Quantity  int64     `db:"quantity"`
+	Amount    int64     `db:"amount"`
+}
+
+type EmployeeDirectoryEntry struct {
+	Username    string `db:"username"`
+	DisplayName string `db:"display_name"`
+}
+
+type Order struct {
+	ID               int64     `db:"id"`
+	EmployeeID       int64     `db:"employee_id"`
+	EmployeeUsername string    `db:"employee_username"`
+	MerchID          int64     `db:"merch_id"`
+	MerchName        string    `db:"merch_name"`
+	VariantID        int64     `db:"variant_id"`
+	Size             string    `db:"size"`
+	Color            string    `db:"color"`
+	Quantity         int64     `db:"quantity"`
+	Price            int64     `db:"price"`
+	Status           string    `db:"status"`
+	CreateTime       time.Time `db:"create_time"`
+	UpdateTime       time.Time `db:"update_time"`
+}
+
+type TransferRequest struct {
+	ID                int64      `db:"id" json:"id"`
+	Kind              string     `db:"kind" json:"kind"`
+	SenderID          int64      `db:"sender_id" json:"sender_id"`
+	SenderUsername    string     `db:"sender_username" json:"sender_username"`
+	ReceiverID        int64      `db:"receiver_id" json:"receiver_id"`
+	ReceiverUsername  string     `db:"receiver_username" json:"receiver_username"`
+	Amount            int64      `db:"amount" json:"amount"`
+	Status            string     `db:"status" json:"status"`
+	RequireAcceptance bool       `db:"require_acceptance" json:"require_acceptance"`
+	ExpireTime        time.Time  `db:"expire_time" json:"expire_time"`
+	DecidedBy         *int64     `db:"decided_by" json:"decided_by"`
+	DecidedByUsername string     `db:"decided_by_username" json:"decided_by_username"`
+	CreateTime        time.Time  `db:"create_time" json:"create_time"`
+	DecideTime        *time.Time `db:"decide_time" json:"decide_time"`
+}
+
+type TransferRequestLot struct {
+	CoinLotID  int64     `db:"coin_lot_id"`
+	Amount     int64     `db:"amount"`
+	ExpireTime time.Time `db:"expire_time"`
+}
+
+type OrderLot struct {
+	CoinLotID  int64     `db:"coin_lot_id"`
+	Amount     int64     `db:"amount"`
+	ExpireTime time.Time `db:"expire_time"`
+}
+
+// EmployeeInfo is the row of the single /api/info query, lists are aggregated into json columns
+type EmployeeInfo struct {
+	Employee
+	Transactions    []EmployeeTransaction    `db:"transactions"`
+	Inventory       []InventoryWithMerchName `db:"inventory"`
+	ActiveCoinLots  []CoinLot                `db:"active_coin_lots"`
+	PendingRequests []TransferRequest        `db:"pending_requests"`
 }
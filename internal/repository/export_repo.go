@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type ExportRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewExportRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*ExportRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &ExportRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *ExportRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Stream calls fn for every transfer, purchase and refund matching filter ordered by time.
+// Rows are read from the connection one by one, so the whole export is never held in memory.
+func (r *ExportRepository) Stream(ctx context.Context, filter model.ExportFilter, fn func(row model.ExportRow) error) error {
+	q := `SELECT kind, id, event_time, from_user, to_user, merch_name, quantity, amount
+		FROM (
+			SELECT 'transfer' as kind, t.id, t.transaction_time as event_time,
+				s.username as from_user, r.username as to_user, '' as merch_name, 0 as quantity, t.amount
+			FROM transaction t
+			INNER JOIN employee s on s.id = t.sender_id
+			INNER JOIN employee r on r.id = t.receiver_id
+			WHERE ($1::timestamptz IS NULL OR t.transaction_time >= $1)
+				AND ($2::timestamptz IS NULL OR t.transaction_time < $2)
+				AND ($3::integer IS NULL OR t.sender_id = $3 OR t.receiver_id = $3)
+			UNION ALL
+			SELECT 'purchase' as kind, p.id, p.purchase_time as event_time,
+				e.username as from_user, '' as to_user, m.name as merch_name, p.quantity, p.price * p.quantity as amount
+			FROM purchase p
+			INNER JOIN employee e on e.id = p.employee_id
+			INNER JOIN merch m on m.id = p.merch_id
+			WHERE ($1::timestamptz IS NULL OR p.purchase_time >= $1)
+				AND ($2::timestamptz IS NULL OR p.purchase_time < $2)
+				AND ($3::integer IS NULL OR p.employee_id = $3)
+			UNION ALL
+			SELECT 'refund' as kind, l.id, l.create_time as event_time,
+				'' as from_user, e.username as to_user, '' as merch_name, 0 as quantity, l.amount
+			FROM ledger_entry l
+			INNER JOIN employee e on e.id = l.employee_id
+			WHERE l.kind = $4
+				AND ($1::timestamptz IS NULL OR l.create_time >= $1)
+				AND ($2::timestamptz IS NULL OR l.create_time < $2)
+				AND ($3::integer IS NULL OR l.employee_id = $3)
+		) ledger
+		ORDER BY event_time, kind, id`
+
+	rows, err := r.trOrDB(ctx).Query(ctx, q, filter.From, filter.To, filter.EmployeeID, model.LedgerEntryKindRefund)
+	if err != nil {
+		return fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row ExportRow
+		row, err = pgx.RowToStructByNameLax[ExportRow](rows)
+		if err != nil {
+			return fmt.Errorf("pgx.RowToStructByNameLax: %w", err)
+		}
+
+		err = fn(model.ExportRow{
+			Kind:      model.ExportRowKind(row.Kind),
+			ID:        row.ID,
+			Time:      row.Time,
+			FromUser:  row.FromUser,
+			ToUser:    row.ToUser,
+			MerchName: row.MerchName,
+			Quantity:  row.Quantity,
+			Amount:    row.Amount,
+		})
+		if err != nil {
+			return fmt.Errorf("fn: %w", err)
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func Test_Export_Stream(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewExportRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	const senderID, receiverID = 390311, 390312
+	day := time.Date(2001, 3, 4, 0, 0, 0, 0, time.UTC) // far from other tests data
+
+	for _, id := range []int64{senderID, receiverID} {
+		_, err = db.Exec(ctx, `DELETE FROM employee where id = $1`, id)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `DELETE FROM transaction where sender_id = $1 or receiver_id = $1`, id)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `DELETE FROM purchase where employee_id = $1`, id)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `DELETE FROM ledger_entry where employee_id = $1`, id)
+		require.NoError(t, err)
+	}
+	_, err = db.Exec(ctx, `INSERT INTO employee (id, username, password, balance)
+		VALUES ($1, 'export-sender', 'password', 0), ($2, 'export-receiver', 'password', 0)`, senderID, receiverID)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO transaction (sender_id, receiver_id, amount, transaction_time)
+		VALUES ($1, $2, 100, $3)`, senderID, receiverID, day.Add(time.Hour))
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO purchase (employee_id, merch_id, variant_id, quantity, price, purchase_time)
+		SELECT $1, id, id, 1, price, $2 FROM merch WHERE name = 'cup'`, receiverID, day.Add(2*time.Hour))
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO ledger_entry (employee_id, amount, kind, create_time)
+		VALUES ($1, 20, 'refund', $2), ($1, 200, 'allowance', $2)`, receiverID, day.Add(3*time.Hour))
+	require.NoError(t, err)
+
+	from, to := day, day.AddDate(0, 0, 1)
+	employeeID := int64(receiverID)
+
+	var rows []model.ExportRow
+	err = repo.Stream(ctx, model.ExportFilter{From: &from, To: &to, EmployeeID: &employeeID}, func(row model.ExportRow) error {
+		row.ID = 0 // can't validate id, because it's autoincrement
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, rows, 3)
+	require.Equal(t, model.ExportRowKindTransfer, rows[0].Kind)
+	require.Equal(t, "export-sender", rows[0].FromUser)
+	require.Equal(t, "export-receiver", rows[0].ToUser)
+	require.Equal(t, int64(100), rows[0].Amount)
+	require.Equal(t, model.ExportRowKindPurchase, rows[1].Kind)
+	require.Equal(t, "cup", rows[1].MerchName)
+	require.Equal(t, int64(20), rows[1].Amount)
+	require.Equal(t, model.ExportRowKindRefund, rows[2].Kind)
+	require.Empty(t, rows[2].FromUser)
+	require.Equal(t, "export-receiver", rows[2].ToUser)
+	require.Equal(t, int64(20), rows[2].Amount)
+}
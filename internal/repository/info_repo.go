@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// selectEmployeeInfo selects the employee $1 with the lists of the other repositories aggregated by json_agg:
+// transactions, inventory, coin lots active at $2 and transfer requests in status $3.
+// Every list keeps the order of its own repository.
+const selectEmployeeInfo = `SELECT
+		(SELECT COALESCE(json_agg(et ORDER BY et.id), '[]') FROM (` + selectEmployeeTransactions + `) et) as transactions,
+		` + employeeInfoColumns
+
+// selectEmployeeInfoByCounterparty is selectEmployeeInfo with transactions summed per counterparty.
+const selectEmployeeInfoByCounterparty = `SELECT
+		(SELECT COALESCE(json_agg(et ORDER BY et.amount DESC, et.counterparty_employee_id, et.is_sender), '[]')
+			FROM (` + selectCounterpartyTotals + `) et
+		) as transactions,
+		` + employeeInfoColumns
+
+// employeeInfoColumns are the columns of selectEmployeeInfo besides transactions.
+const employeeInfoColumns = `e.id, e.username, e.password, e.balance, e.role, e.giving_budget, e.giving_budget_period,
+		(SELECT COALESCE(json_agg(ei ORDER BY ei.create_time, ei.variant_id), '[]')
+			FROM (
+				SELECT i.employee_id, i.merch_id, i.variant_id, i.quantity, merch.name as merch_name, v.size, v.color, i.create_time
+				FROM inventory i
+				INNER JOIN merch on merch.id = i.merch_id
+				INNER JOIN merch_variant v on v.id = i.variant_id
+				WHERE i.employee_id = e.id
+			) ei
+		) as inventory,
+		(SELECT COALESCE(json_agg(l ORDER BY l.expire_time, l.id), '[]')
+			FROM coin_lot l
+			WHERE l.employee_id = e.id AND l.remaining > 0 AND l.expire_time > $2
+		) as active_coin_lots,
+		(SELECT COALESCE(json_agg(req ORDER BY req.create_time, req.id), '[]')
+			FROM (` + selectTransferRequests + `
+				WHERE tr.status = $3 AND (tr.sender_id = e.id OR tr.receiver_id = e.id)
+			) req
+		) as pending_requests
+	FROM employee e
+	WHERE e.id = $1`
+
+// InfoRepository reads everything /api/info shows at once, so a call takes a single connection
+// and a single round trip: GetByEmployee sends the queries of the other repositories as one pgx batch,
+// GetByEmployeeSingleQuery builds the whole result in one SQL statement.
+type InfoRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewInfoRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*InfoRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &InfoRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *InfoRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *InfoRepository) GetByEmployee(
+	ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory,
+) (model.EmployeeInfoData, error) {
+	selectTransactions := selectEmployeeTransactions
+	if history == model.CoinHistoryByCounterparty {
+		selectTransactions = selectCounterpartyTotals
+	}
+
+	var (
+		employee     Employee
+		transactions []EmployeeTransaction
+		inventories  []InventoryWithMerchName
+		lots         []CoinLot
+		requests     []TransferRequest
+	)
+
+	batch := &pgx.Batch{}
+	batch.Queue(selectEmployeeByID, employeeID).Query(func(rows pgx.Rows) (err error) {
+		employee, err = pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[Employee])
+		return err
+	})
+	batch.Queue(selectTransactions, employeeID).Query(func(rows pgx.Rows) (err error) {
+		transactions, err = pgx.CollectRows(rows, pgx.RowToStructByNameLax[EmployeeTransaction])
+		return err
+	})
+	batch.Queue(selectEmployeeInventory, employeeID).Query(func(rows pgx.Rows) (err error) {
+		inventories, err = pgx.CollectRows(rows, pgx.RowToStructByNameLax[InventoryWithMerchName])
+		return err
+	})
+	batch.Queue(selectActiveLots, employeeID, now).Query(func(rows pgx.Rows) (err error) {
+		lots, err = pgx.CollectRows(rows, pgx.RowToStructByNameLax[CoinLot])
+		return err
+	})
+	batch.Queue(selectPendingTransferRequests, employeeID, model.TransferRequestStatusPending).Query(func(rows pgx.Rows) (err error) {
+		requests, err = pgx.CollectRows(rows, pgx.RowToStructByNameLax[TransferRequest])
+		return err
+	})
+
+	err := r.trOrDB(ctx).SendBatch(ctx, batch).Close()
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.EmployeeInfoData{}, model.ErrEmployeeNotFound
+		}
+		return model.EmployeeInfoData{}, fmt.Errorf("batch.Close: %w", err)
+	}
+
+	return model.EmployeeInfoData{
+		Employee:        convertEmployee(employee),
+		Transactions:    convertTransactions(transactions),
+		Inventory:       convertInventories(inventories),
+		ActiveCoinLots:  convertCoinLots(lots),
+		PendingRequests: convertTransferRequests(requests),
+	}, nil
+}
+
+func (r *InfoRepository) GetByEmployeeSingleQuery(
+	ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory,
+) (model.EmployeeInfoData, error) {
+	q := selectEmployeeInfo
+	if history == model.CoinHistoryByCounterparty {
+		q = selectEmployeeInfoByCounterparty
+	}
+
+	info, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[EmployeeInfo],
+		q, employeeID, now, model.TransferRequestStatusPending)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.EmployeeInfoData{}, model.ErrEmployeeNotFound
+		}
+		return model.EmployeeInfoData{}, fmt.Errorf("getRow: %w", err)
+	}
+
+	return model.EmployeeInfoData{
+		Employee:        convertEmployee(info.Employee),
+		Transactions:    convertTransactions(info.Transactions),
+		Inventory:       convertInventories(info.Inventory),
+		ActiveCoinLots:  convertCoinLots(info.ActiveCoinLots),
+		PendingRequests: convertTransferRequests(info.PendingRequests),
+	}, nil
+}
@@ -0,0 +1,195 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const (
+	infoEmployeeID     = 390330
+	infoCounterpartyID = 390331
+)
+
+// insertInfoFixture recreates an employee with a transaction of each direction, inventory and coin lots.
+func insertInfoFixture(t testing.TB, db *pgxpool.Pool, now time.Time) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	for _, id := range []int64{infoEmployeeID, infoCounterpartyID} {
+		_, err := db.Exec(ctx, `DELETE FROM transaction where sender_id = $1 or receiver_id = $1`, id)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `DELETE FROM inventory where employee_id = $1`, id)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `DELETE FROM coin_lot where employee_id = $1`, id)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `DELETE FROM employee where id = $1`, id)
+		require.NoError(t, err)
+	}
+	_, err := db.Exec(ctx, `INSERT INTO employee (id, username, password, balance) VALUES
+		($1, 'info-owner', 'password', 900),
+		($2, 'info-counterparty', 'password', 0)`, infoEmployeeID, infoCounterpartyID)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO transaction (sender_id, receiver_id, amount) VALUES ($1, $2, 100), ($2, $1, 30), ($1, $2, 50)`,
+		infoEmployeeID, infoCounterpartyID)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO inventory (employee_id, merch_id, variant_id, quantity) VALUES ($1, 2, 2, 3)`,
+		infoEmployeeID)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO coin_lot (employee_id, amount, remaining, expire_time) VALUES
+		($1, 500, 500, $2), ($1, 400, 400, $3), ($1, 100, 100, $4)`,
+		infoEmployeeID, now.Add(48*time.Hour), now.Add(24*time.Hour), now.Add(-time.Hour))
+	require.NoError(t, err)
+}
+
+func Test_Info(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewInfoRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	insertInfoFixture(t, db, now)
+
+	data, err := repo.GetByEmployee(ctx, infoEmployeeID, now, model.CoinHistoryList)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(900), data.Employee.Balance)
+	require.Equal(t, []model.Transaction{
+		{IsSender: true, CounterpartyEmployeeID: infoCounterpartyID, CounterpartyUsername: "info-counterparty", Amount: 100},
+		{IsSender: false, CounterpartyEmployeeID: infoCounterpartyID, CounterpartyUsername: "info-counterparty", Amount: 30},
+		{IsSender: true, CounterpartyEmployeeID: infoCounterpartyID, CounterpartyUsername: "info-counterparty", Amount: 50},
+	}, data.Transactions)
+	require.Equal(t, []model.Inventory{
+		{EmployeeID: infoEmployeeID, MerchID: 2, VariantID: 2, Quantity: 3, MerchName: "cup"},
+	}, data.Inventory)
+	require.Len(t, data.ActiveCoinLots, 2)
+	require.Equal(t, int64(400), data.ActiveCoinLots[0].Remaining)
+	require.Equal(t, int64(500), data.ActiveCoinLots[1].Remaining)
+	require.Empty(t, data.PendingRequests)
+
+	single, err := repo.GetByEmployeeSingleQuery(ctx, infoEmployeeID, now, model.CoinHistoryList)
+	require.NoError(t, err)
+
+	require.Equal(t, data.Employee, single.Employee)
+	require.Equal(t, data.Transactions, single.Transactions)
+	require.Equal(t, data.Inventory, single.Inventory)
+	require.Len(t, single.ActiveCoinLots, 2)
+	for i, lot := range single.ActiveCoinLots {
+		// times decoded from json keep the offset of the session time zone
+		require.Equal(t, data.ActiveCoinLots[i].ID, lot.ID)
+		require.Equal(t, data.ActiveCoinLots[i].Remaining, lot.Remaining)
+		require.True(t, data.ActiveCoinLots[i].ExpireTime.Equal(lot.ExpireTime))
+	}
+	require.Empty(t, single.PendingRequests)
+
+	// transfers to the same counterparty are summed, the largest first
+	wantTotals := []model.Transaction{
+		{IsSender: true, CounterpartyEmployeeID: infoCounterpartyID, CounterpartyUsername: "info-counterparty", Amount: 150},
+		{IsSender: false, CounterpartyEmployeeID: infoCounterpartyID, CounterpartyUsername: "info-counterparty", Amount: 30},
+	}
+	data, err = repo.GetByEmployee(ctx, infoEmployeeID, now, model.CoinHistoryByCounterparty)
+	require.NoError(t, err)
+	require.Equal(t, wantTotals, data.Transactions)
+
+	single, err = repo.GetByEmployeeSingleQuery(ctx, infoEmployeeID, now, model.CoinHistoryByCounterparty)
+	require.NoError(t, err)
+	require.Equal(t, wantTotals, single.Transactions)
+
+	_, err = repo.GetByEmployee(ctx, 390339, now, model.CoinHistoryList)
+	require.ErrorIs(t, err, model.ErrEmployeeNotFound)
+
+	_, err = repo.GetByEmployeeSingleQuery(ctx, 390339, now, model.CoinHistoryList)
+	require.ErrorIs(t, err, model.ErrEmployeeNotFound)
+}
+
+// BenchmarkInfo compares the ways /api/info reads its data, run it with make bench-info.
+func BenchmarkInfo(b *testing.B) {
+	db := setUp(b)
+	now := time.Now().UTC().Truncate(time.Second)
+	insertInfoFixture(b, db, now)
+
+	getter := trmpgx.DefaultCtxGetter
+	infoRepo, err := NewInfoRepository(db, getter)
+	require.NoError(b, err)
+	employeeRepo, err := NewEmployeeRepository(db, getter)
+	require.NoError(b, err)
+	transactionRepo, err := NewTransactionRepository(db, getter)
+	require.NoError(b, err)
+	inventoryRepo, err := NewInventoryRepository(db, getter)
+	require.NoError(b, err)
+	coinLotRepo, err := NewCoinLotRepository(db, getter)
+	require.NoError(b, err)
+	transferRequestRepo, err := NewTransferRequestRepository(db, getter)
+	require.NoError(b, err)
+
+	// parallel is the fan-out of info_collecting: a query per repository, each on its own connection
+	parallel := func(ctx context.Context) error {
+		eg, ctx := errgroup.WithContext(ctx)
+		eg.Go(func() (err error) {
+			_, err = employeeRepo.GetByID(ctx, infoEmployeeID)
+			return err
+		})
+		eg.Go(func() (err error) {
+			_, err = transactionRepo.GetByEmployee(ctx, infoEmployeeID)
+			return err
+		})
+		eg.Go(func() (err error) {
+			_, err = inventoryRepo.GetByEmployee(ctx, infoEmployeeID)
+			return err
+		})
+		eg.Go(func() (err error) {
+			_, err = coinLotRepo.GetActive(ctx, infoEmployeeID, now)
+			return err
+		})
+		eg.Go(func() (err error) {
+			_, err = transferRequestRepo.GetPendingByEmployee(ctx, infoEmployeeID)
+			return err
+		})
+		return eg.Wait()
+	}
+	batch := func(ctx context.Context) (err error) {
+		_, err = infoRepo.GetByEmployee(ctx, infoEmployeeID, now, model.CoinHistoryList)
+		return err
+	}
+	single := func(ctx context.Context) (err error) {
+		_, err = infoRepo.GetByEmployeeSingleQuery(ctx, infoEmployeeID, now, model.CoinHistoryList)
+		return err
+	}
+
+	for _, bc := range []struct {
+		name string
+		run  func(ctx context.Context) error
+	}{
+		{name: "parallel", run: parallel},
+		{name: "batch", run: batch},
+		{name: "single", run: single},
+	} {
+		// sequential calls show the latency of a request, concurrent ones show how the pool copes with load
+		b.Run(bc.name+"/sequential", func(b *testing.B) {
+			ctx := context.Background()
+			for range b.N {
+				require.NoError(b, bc.run(ctx))
+			}
+		})
+		b.Run(bc.name+"/concurrent", func(b *testing.B) {
+			b.SetParallelism(4)
+			b.RunParallel(func(pb *testing.PB) {
+				ctx := context.Background()
+				for pb.Next() {
+					require.NoError(b, bc.run(ctx))
+				}
+			})
+		})
+	}
+}
@@ -5,18 +5,27 @@ import (
 	"errors"
 	"fmt"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
-	"github.com/jmoiron/sqlx"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
+// selectEmployeeInventory selects merch the employee $1 owns in the order it was bought
+const selectEmployeeInventory = `SELECT i.employee_id, i.merch_id, i.variant_id, i.quantity, merch.name as merch_name, v.size, v.color
+		FROM inventory i
+		INNER JOIN merch on merch.id = i.merch_id
+		INNER JOIN merch_variant v on v.id = i.variant_id
+		WHERE employee_id = $1
+		ORDER BY i.create_time, i.variant_id`
+
 type InventoryRepository struct {
-	db     *sqlx.DB
-	getter *trmsqlx.CtxGetter
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
 }
 
-func NewInventoryRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*InventoryRepository, error) {
+func NewInventoryRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*InventoryRepository, error) {
 	if db == nil {
 		return nil, errors.New("db is nil")
 	}
@@ -30,47 +39,85 @@ func NewInventoryRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*InventoryR
 	}, nil
 }
 
-func (r *InventoryRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+func (r *InventoryRepository) trOrDB(ctx context.Context) trmpgx.Tr {
 	return r.getter.DefaultTrOrDB(ctx, r.db)
 }
 
 func (r *InventoryRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.Inventory, error) {
-	var inventories []InventoryWithMerchName
+	inventories, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[InventoryWithMerchName],
+		selectEmployeeInventory, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
 
-	q := `SELECT i.employee_id, i.merch_id, i.quantity, merch.name as merch_name
-		FROM inventory i
-		INNER JOIN merch on merch.id = i.merch_id
-		WHERE employee_id = $1
-		ORDER BY i.create_time`
+	return convertInventories(inventories), nil
+}
+
+func (r *InventoryRepository) Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error {
+	q := `INSERT INTO inventory (employee_id, merch_id, variant_id, quantity)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (employee_id, variant_id) DO UPDATE SET
+			quantity = inventory.quantity + excluded.quantity`
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, merchID, variantID, quantity)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// Remove takes quantity items of merch variant back from the employee, rows left empty are deleted.
+func (r *InventoryRepository) Remove(ctx context.Context, employeeID, variantID, quantity int64) error {
+	q := `UPDATE inventory SET quantity = quantity - $3
+		WHERE employee_id = $1 AND variant_id = $2 AND quantity >= $3`
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, variantID, quantity)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
 
-	err := r.trOrDB(ctx).SelectContext(ctx, &inventories, q, employeeID)
+	err = checkAffected(res, model.ErrInventoryNotFound)
 	if err != nil {
-		return nil, fmt.Errorf("db.SelectContext: %w", err)
+		return err
 	}
 
+	q = "DELETE FROM inventory WHERE employee_id = $1 AND variant_id = $2 AND quantity = 0"
+
+	_, err = r.trOrDB(ctx).Exec(ctx, q, employeeID, variantID)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// AddPurchase logs a purchase of the order for statistics, inventory keeps only totals. price is the price of one item.
+func (r *InventoryRepository) AddPurchase(ctx context.Context, employeeID, merchID, variantID, quantity, price, orderID int64) error {
+	q := `INSERT INTO purchase (employee_id, merch_id, variant_id, quantity, price, order_id)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, merchID, variantID, quantity, price, orderID)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func convertInventories(inventories []InventoryWithMerchName) []model.Inventory {
 	res := make([]model.Inventory, 0, len(inventories))
 	for _, inventory := range inventories {
 		res = append(res, model.Inventory{
 			EmployeeID: inventory.EmployeeID,
 			MerchID:    inventory.MerchID,
+			VariantID:  inventory.VariantID,
 			Quantity:   inventory.Quantity,
 			MerchName:  inventory.MerchName,
+			Size:       inventory.Size,
+			Color:      inventory.Color,
 		})
 	}
 
-	return res, nil
-}
-
-func (r *InventoryRepository) AddOne(ctx context.Context, employeeID, merchID int64) error {
-	q := `INSERT INTO inventory (employee_id, merch_id, quantity)
-		VALUES ($1, $2, 1)
-		ON CONFLICT (employee_id, merch_id) DO UPDATE SET
-			quantity = inventory.quantity + 1`
-
-	_, err := r.trOrDB(ctx).ExecContext(ctx, q, employeeID, merchID)
-	if err != nil {
-		return fmt.Errorf("db.ExecContext: %w", err)
-	}
-
-	return nil
+	return res
 }
@@ -6,7 +6,8 @@ import (
 	"context"
 	"testing"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
@@ -14,7 +15,7 @@ import (
 
 func Test_GetByEmployee(t *testing.T) {
 	db := setUp(t)
-	repo, err := NewInventoryRepository(db, trmsqlx.DefaultCtxGetter)
+	repo, err := NewInventoryRepository(db, trmpgx.DefaultCtxGetter)
 	require.NoError(t, err)
 
 	type args struct {
@@ -31,18 +32,18 @@ func Test_GetByEmployee(t *testing.T) {
 		{
 			name: "get",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM inventory where employee_id = $1`, 390294)
+				_, err = db.Exec(context.Background(), `DELETE FROM inventory where employee_id = $1`, 390294)
 				require.NoError(t, err)
-				_, err = db.Exec(`DELETE FROM inventory where employee_id = $1`, 390295)
+				_, err = db.Exec(context.Background(), `DELETE FROM inventory where employee_id = $1`, 390295)
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO inventory (employee_id, merch_id, quantity)
-					VALUES ($1, $2, 1)`, 390294, 1)
+				_, err = db.Exec(context.Background(), `INSERT INTO inventory (employee_id, merch_id, variant_id, quantity)
+					VALUES ($1, $2, $2, 1)`, 390294, 1)
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO inventory (employee_id, merch_id, quantity)
-					VALUES ($1, $2, 1)`, 390294, 2)
+				_, err = db.Exec(context.Background(), `INSERT INTO inventory (employee_id, merch_id, variant_id, quantity)
+					VALUES ($1, $2, $2, 1)`, 390294, 2)
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO inventory (employee_id, merch_id, quantity)
-					VALUES ($1, $2, 1)`, 390295, 2)
+				_, err = db.Exec(context.Background(), `INSERT INTO inventory (employee_id, merch_id, variant_id, quantity)
+					VALUES ($1, $2, $2, 1)`, 390295, 2)
 				require.NoError(t, err)
 			},
 			args: args{
@@ -88,9 +89,9 @@ func Test_GetByEmployee(t *testing.T) {
 	}
 }
 
-func Test_AddOne(t *testing.T) {
+func Test_Add(t *testing.T) {
 	db := setUp(t)
-	repo, err := NewInventoryRepository(db, trmsqlx.DefaultCtxGetter)
+	repo, err := NewInventoryRepository(db, trmpgx.DefaultCtxGetter)
 	require.NoError(t, err)
 
 	type args struct {
@@ -108,18 +109,18 @@ func Test_AddOne(t *testing.T) {
 		{
 			name: "get",
 			prepare: func(t *testing.T) {
-				_, err = db.Exec(`DELETE FROM inventory where employee_id = $1`, 390294)
+				_, err = db.Exec(context.Background(), `DELETE FROM inventory where employee_id = $1`, 390294)
 				require.NoError(t, err)
-				_, err = db.Exec(`DELETE FROM inventory where employee_id = $1`, 390295)
+				_, err = db.Exec(context.Background(), `DELETE FROM inventory where employee_id = $1`, 390295)
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO inventory (employee_id, merch_id, quantity)
-					VALUES ($1, $2, 1)`, 390294, 1)
+				_, err = db.Exec(context.Background(), `INSERT INTO inventory (employee_id, merch_id, variant_id, quantity)
+					VALUES ($1, $2, $2, 1)`, 390294, 1)
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO inventory (employee_id, merch_id, quantity)
-					VALUES ($1, $2, 1)`, 390294, 2)
+				_, err = db.Exec(context.Background(), `INSERT INTO inventory (employee_id, merch_id, variant_id, quantity)
+					VALUES ($1, $2, $2, 1)`, 390294, 2)
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO inventory (employee_id, merch_id, quantity)
-					VALUES ($1, $2, 1)`, 390295, 2)
+				_, err = db.Exec(context.Background(), `INSERT INTO inventory (employee_id, merch_id, variant_id, quantity)
+					VALUES ($1, $2, $2, 1)`, 390295, 2)
 				require.NoError(t, err)
 			},
 			args: args{
@@ -128,7 +129,8 @@ func Test_AddOne(t *testing.T) {
 			},
 			check: func(t *testing.T) {
 				var inventory InventoryWithMerchName
-				err = db.Get(&inventory, "SELECT employee_id, merch_id, quantity, merch_name FROM inventory WHERE employee_id = $1 AND merch_id = $2", 390294, 1)
+				inventory, err = getRow(context.Background(), db, pgx.RowToStructByNameLax[InventoryWithMerchName],
+					"SELECT employee_id, merch_id, quantity, merch_name FROM inventory WHERE employee_id = $1 AND merch_id = $2", 390294, 1)
 				require.NoError(t, err)
 
 				require.Equal(t, InventoryWithMerchName{
@@ -154,7 +156,7 @@ func Test_AddOne(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.prepare(t)
 
-			err := repo.AddOne(context.Background(), tc.args.employeeID, tc.args.merchID)
+			err := repo.Add(context.Background(), tc.args.employeeID, tc.args.merchID, tc.args.merchID, 1)
 
 			require.ErrorIs(t, err, tc.wantErr)
 
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type LedgerEntryRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewLedgerEntryRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*LedgerEntryRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &LedgerEntryRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *LedgerEntryRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *LedgerEntryRepository) Add(ctx context.Context, entry model.LedgerEntry) error {
+	q := `INSERT INTO ledger_entry (employee_id, amount, kind, coin_lot_id, period_time)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q,
+		entry.EmployeeID, entry.Amount, string(entry.Kind), entry.CoinLotID, entry.PeriodTime)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// AddOnce adds a periodic entry and reports whether it was added,
+// false means the employee already has an entry of this kind for entry.PeriodTime.
+func (r *LedgerEntryRepository) AddOnce(ctx context.Context, entry model.LedgerEntry) (bool, error) {
+	q := `INSERT INTO ledger_entry (employee_id, amount, kind, coin_lot_id, period_time)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING
+		RETURNING id`
+
+	var id int64
+	err := r.trOrDB(ctx).QueryRow(ctx, q,
+		entry.EmployeeID, entry.Amount, string(entry.Kind), entry.CoinLotID, entry.PeriodTime).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("db.QueryRow: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetEmployeesWithoutEntry returns up to limit employees who have no entry of kind for periodTime.
+func (r *LedgerEntryRepository) GetEmployeesWithoutEntry(
+	ctx context.Context,
+	kind model.LedgerEntryKind,
+	periodTime time.Time,
+	limit int,
+) ([]int64, error) {
+	q := `SELECT e.id
+		FROM employee e
+		WHERE NOT EXISTS (
+			SELECT 1 FROM ledger_entry l WHERE l.employee_id = e.id AND l.kind = $1 AND l.period_time = $2
+		)
+		ORDER BY e.id
+		LIMIT $3`
+
+	employeeIDs, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowTo[int64], q, string(kind), periodTime, limit)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	return employeeIDs, nil
+}
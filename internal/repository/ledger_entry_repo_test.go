@@ -0,0 +1,55 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func Test_LedgerEntry_AddOnce(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewLedgerEntryRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	const employeeID = 390297
+	periodTime := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err = db.Exec(ctx, `DELETE FROM ledger_entry where employee_id = $1`, employeeID)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `DELETE FROM employee where id = $1`, employeeID)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO employee (id, username, password, balance)
+		VALUES ($1, $2, $3, $4)`, employeeID, "ledger-entry-add-once", "password", 0)
+	require.NoError(t, err)
+
+	employeeIDs, err := repo.GetEmployeesWithoutEntry(ctx, model.LedgerEntryKindAllowance, periodTime, 100_000)
+	require.NoError(t, err)
+	require.Contains(t, employeeIDs, int64(employeeID))
+
+	entry := model.LedgerEntry{
+		EmployeeID: employeeID,
+		Amount:     200,
+		Kind:       model.LedgerEntryKindAllowance,
+		PeriodTime: &periodTime,
+	}
+
+	added, err := repo.AddOnce(ctx, entry)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	added, err = repo.AddOnce(ctx, entry)
+	require.NoError(t, err)
+	require.False(t, added)
+
+	employeeIDs, err = repo.GetEmployeesWithoutEntry(ctx, model.LedgerEntryKindAllowance, periodTime, 100_000)
+	require.NoError(t, err)
+	require.NotContains(t, employeeIDs, int64(employeeID))
+}
@@ -3,30 +3,23 @@
 package repository
 
 import (
-	"database/sql"
-	"fmt"
+	"context"
 	"testing"
 
-	_ "github.com/jackc/pgx/v5/stdlib" // register "pgx" database/sql driver
-	"github.com/jmoiron/sqlx"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
 
 	"github.com/inna-maikut/avito-shop/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/pg"
 )
 
-func setUp(t *testing.T) *sqlx.DB {
+func setUp(t testing.TB) *pgxpool.Pool {
 	cfg := config.Load()
 
-	databaseURL := fmt.Sprintf("postgres://%s:%s@%s:%d/%s",
-		cfg.DatabaseUser, cfg.DatabasePassword, cfg.DatabaseHost,
-		cfg.DatabasePort, cfg.DatabaseName)
-
-	db, err := sql.Open("pgx", databaseURL)
+	db, closeDB, err := pg.NewPool(context.Background(), cfg)
 	require.NoError(t, err)
 
-	t.Cleanup(func() {
-		_ = db.Close()
-	})
+	t.Cleanup(closeDB)
 
-	return sqlx.NewDb(db, "pgx")
+	return db
 }
@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type AllowanceRepository struct {
+	storage *Storage
+}
+
+func NewAllowanceRepository(storage *Storage) (*AllowanceRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &AllowanceRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *AllowanceRepository) Get(ctx context.Context) (*model.Allowance, error) {
+	var (
+		a     allowance
+		found bool
+	)
+	r.storage.read(ctx, func() {
+		a, found = r.storage.allowances[allowanceID]
+	})
+	if !found {
+		return nil, model.ErrAllowanceNotFound
+	}
+
+	return &model.Allowance{
+		Amount:         a.amount,
+		CronExpression: a.cronExpression,
+		IsActive:       a.isActive,
+		NextGrantTime:  a.nextGrantTime,
+	}, nil
+}
+
+func (r *AllowanceRepository) Update(ctx context.Context, allowance model.Allowance) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableAllowance, allowanceID)
+		if err != nil {
+			return err
+		}
+
+		a, ok := r.storage.allowances[allowanceID]
+		if !ok {
+			return model.ErrAllowanceNotFound
+		}
+
+		a.amount = allowance.Amount
+		a.cronExpression = allowance.CronExpression
+		a.isActive = allowance.IsActive
+		a.nextGrantTime = allowance.NextGrantTime
+		a.updateTime = r.storage.now()
+		return put(t, tableAllowance, r.storage.allowances, allowanceID, a)
+	})
+}
+
+// AdvanceNextGrantTime moves next_grant_time from periodTime to next and reports whether it was moved.
+// It isn't moved when the period was already advanced by another replica or changed by an admin.
+func (r *AllowanceRepository) AdvanceNextGrantTime(ctx context.Context, periodTime, next time.Time) (bool, error) {
+	var advanced bool
+	err := r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableAllowance, allowanceID)
+		if err != nil {
+			return err
+		}
+
+		a, ok := r.storage.allowances[allowanceID]
+		if !ok || a.nextGrantTime == nil || !a.nextGrantTime.Equal(periodTime) {
+			return nil
+		}
+
+		a.nextGrantTime = &next
+		advanced = true
+		return put(t, tableAllowance, r.storage.allowances, allowanceID, a)
+	})
+
+	return advanced, err
+}
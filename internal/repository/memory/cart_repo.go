@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type CartRepository struct {
+	storage *Storage
+}
+
+func NewCartRepository(storage *Storage) (*CartRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &CartRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *CartRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.CartItem, error) {
+	var items []cartItem
+	r.storage.read(ctx, func() {
+		for _, item := range r.storage.cartItems {
+			if item.employeeID == employeeID {
+				items = append(items, item)
+			}
+		}
+	})
+	slices.SortFunc(items, func(a, b cartItem) int {
+		return cmp.Or(a.createTime.Compare(b.createTime), cmp.Compare(a.variantID, b.variantID))
+	})
+
+	res := make([]model.CartItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, model.CartItem{
+			MerchID:   item.merchID,
+			VariantID: item.variantID,
+			Quantity:  item.quantity,
+		})
+	}
+
+	return res, nil
+}
+
+// Add puts quantity of merch variant into the cart, quantities of the same variant are summed up.
+func (r *CartRepository) Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		key := inventoryKey{employeeID: employeeID, variantID: variantID}
+
+		err := t.lock(tableCartItem, key)
+		if err != nil {
+			return err
+		}
+
+		item, ok := r.storage.cartItems[key]
+		if !ok {
+			item = cartItem{
+				employeeID: employeeID,
+				merchID:    merchID,
+				variantID:  variantID,
+				createTime: r.storage.now(),
+			}
+		}
+		item.quantity += quantity
+
+		return put(t, tableCartItem, r.storage.cartItems, key, item)
+	})
+}
+
+func (r *CartRepository) Remove(ctx context.Context, employeeID, variantID int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		key := inventoryKey{employeeID: employeeID, variantID: variantID}
+
+		removed, err := remove(t, tableCartItem, r.storage.cartItems, key)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return model.ErrCartItemNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *CartRepository) Clear(ctx context.Context, employeeID int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		// keys are collected first, waiting for a lock lets other transactions change the cart
+		var keys []inventoryKey
+		for key := range r.storage.cartItems {
+			if key.employeeID == employeeID {
+				keys = append(keys, key)
+			}
+		}
+
+		for _, key := range keys {
+			_, err := remove(t, tableCartItem, r.storage.cartItems, key)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type CoinLotRepository struct {
+	storage *Storage
+}
+
+func NewCoinLotRepository(storage *Storage) (*CoinLotRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &CoinLotRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *CoinLotRepository) Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		lot := coinLot{
+			id:           r.storage.nextID(tableCoinLot),
+			employeeID:   employeeID,
+			amount:       amount,
+			remaining:    amount,
+			receivedTime: r.storage.now(),
+			expireTime:   expireTime,
+		}
+
+		return put(t, tableCoinLot, r.storage.coinLots, lot.id, lot)
+	})
+}
+
+// GetActive returns not expired lots with remaining coins, the earliest expiring first.
+func (r *CoinLotRepository) GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	return r.selectLots(ctx, func(lot coinLot) bool {
+		return lot.employeeID == employeeID && lot.remaining > 0 && lot.expireTime.After(now)
+	}), nil
+}
+
+// GetExpired returns expired lots which still have remaining coins.
+func (r *CoinLotRepository) GetExpired(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	return r.selectLots(ctx, func(lot coinLot) bool {
+		return lot.employeeID == employeeID && lot.remaining > 0 && !lot.expireTime.After(now)
+	}), nil
+}
+
+// GetEmployeesWithExpired returns up to limit employees who have expired lots with remaining coins.
+func (r *CoinLotRepository) GetEmployeesWithExpired(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	var employeeIDs []int64
+	r.storage.read(ctx, func() {
+		for _, lot := range r.storage.coinLots {
+			if lot.remaining > 0 && !lot.expireTime.After(now) && !slices.Contains(employeeIDs, lot.employeeID) {
+				employeeIDs = append(employeeIDs, lot.employeeID)
+			}
+		}
+	})
+	slices.Sort(employeeIDs)
+
+	return page(employeeIDs, limit, 0), nil
+}
+
+func (r *CoinLotRepository) Decrease(ctx context.Context, lotID, amount int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableCoinLot, lotID)
+		if err != nil {
+			return err
+		}
+
+		lot, ok := r.storage.coinLots[lotID]
+		if !ok {
+			return nil
+		}
+
+		lot.remaining -= amount
+		return put(t, tableCoinLot, r.storage.coinLots, lotID, lot)
+	})
+}
+
+// selectLots returns lots matching the filter ordered by expire time.
+func (r *CoinLotRepository) selectLots(ctx context.Context, filter func(lot coinLot) bool) []model.CoinLot {
+	var lots []coinLot
+	r.storage.read(ctx, func() {
+		for _, lot := range r.storage.coinLots {
+			if filter(lot) {
+				lots = append(lots, lot)
+			}
+		}
+	})
+	slices.SortFunc(lots, func(a, b coinLot) int {
+		return cmp.Or(a.expireTime.Compare(b.expireTime), cmp.Compare(a.id, b.id))
+	})
+
+	res := make([]model.CoinLot, 0, len(lots))
+	for _, lot := range lots {
+		res = append(res, model.CoinLot{
+			ID:           lot.id,
+			EmployeeID:   lot.employeeID,
+			Amount:       lot.amount,
+			Remaining:    lot.remaining,
+			ReceivedTime: lot.receivedTime,
+			ExpireTime:   lot.expireTime,
+		})
+	}
+
+	return res
+}
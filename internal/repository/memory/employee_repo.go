@@ -0,0 +1,292 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// similarityThreshold is the default pg_trgm.similarity_threshold used by the % operator
+const similarityThreshold = 0.3
+
+type EmployeeRepository struct {
+	storage *Storage
+}
+
+func NewEmployeeRepository(storage *Storage) (*EmployeeRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &EmployeeRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *EmployeeRepository) GetByUsername(ctx context.Context, username string) (*model.Employee, error) {
+	var (
+		res   *model.Employee
+		found bool
+	)
+	r.storage.read(ctx, func() {
+		for _, e := range r.storage.employees {
+			if e.username == username {
+				res, found = convertEmployee(e), true
+				return
+			}
+		}
+	})
+	if !found {
+		return nil, model.ErrEmployeeNotFound
+	}
+
+	return res, nil
+}
+
+// GetByUsernames returns employees found by usernames, unknown usernames are skipped.
+func (r *EmployeeRepository) GetByUsernames(ctx context.Context, usernames []string) ([]model.Employee, error) {
+	res := make([]model.Employee, 0, len(usernames))
+	r.storage.read(ctx, func() {
+		for _, e := range sortedByID(r.storage.employees) {
+			if slices.Contains(usernames, e.username) {
+				res = append(res, *convertEmployee(e))
+			}
+		}
+	})
+
+	return res, nil
+}
+
+func (r *EmployeeRepository) GetByID(ctx context.Context, employeeID int64) (*model.Employee, error) {
+	var (
+		e     employee
+		found bool
+	)
+	r.storage.read(ctx, func() {
+		e, found = r.storage.employees[employeeID]
+	})
+	if !found {
+		return nil, model.ErrEmployeeNotFound
+	}
+
+	return convertEmployee(e), nil
+}
+
+func (r *EmployeeRepository) Create(ctx context.Context, username, passwordHash string, balance int64) (*model.Employee, error) {
+	var res *model.Employee
+	err := r.storage.write(ctx, func(t *tx) error {
+		// waits for a concurrent sign up of the same username like the unique index does
+		err := t.lock(lockEmployeeUsername, username)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range r.storage.employees {
+			if e.username == username {
+				return model.ErrEmployeeAlreadyExists
+			}
+		}
+
+		e := employee{
+			id:         r.storage.nextID(tableEmployee),
+			username:   username,
+			password:   passwordHash,
+			balance:    balance,
+			role:       string(model.RoleEmployee),
+			createTime: r.storage.now(),
+		}
+		err = put(t, tableEmployee, r.storage.employees, e.id, e)
+		if err != nil {
+			return err
+		}
+
+		res = convertEmployee(e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *EmployeeRepository) GetByIDWithLock(ctx context.Context, employeeID int64) (*model.Employee, error) {
+	var res *model.Employee
+	err := r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableEmployee, employeeID)
+		if err != nil {
+			return err
+		}
+
+		e, ok := r.storage.employees[employeeID]
+		if !ok {
+			return model.ErrEmployeeNotFound
+		}
+
+		res = convertEmployee(e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *EmployeeRepository) IncreaseBalance(ctx context.Context, employeeID, amount int64) error {
+	_, err := r.update(ctx, employeeID, func(e *employee) {
+		e.balance += amount
+	})
+
+	return err
+}
+
+// SetGivingBudget stores the budget left for the period, it's called with the employee locked.
+func (r *EmployeeRepository) SetGivingBudget(ctx context.Context, employeeID, budget int64, period time.Time) error {
+	_, err := r.update(ctx, employeeID, func(e *employee) {
+		e.givingBudget = budget
+		e.givingBudgetPeriod = &period
+	})
+
+	return err
+}
+
+func (r *EmployeeRepository) SetHiddenFromStats(ctx context.Context, employeeID int64, hidden bool) error {
+	updated, err := r.update(ctx, employeeID, func(e *employee) {
+		e.hiddenFromStats = hidden
+	})
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return model.ErrEmployeeNotFound
+	}
+
+	return nil
+}
+
+// Search finds employees whose username or display name starts with query or is similar to it
+// the way pg_trgm does, prefix matches go first. An empty query lists everyone by username.
+func (r *EmployeeRepository) Search(ctx context.Context, query string, limit, offset int) ([]model.EmployeeDirectoryEntry, error) {
+	type match struct {
+		employee   employee
+		prefix     bool
+		similarity float64
+	}
+
+	var matches []match
+	r.storage.read(ctx, func() {
+		lowerQuery := strings.ToLower(query)
+		for _, e := range r.storage.employees {
+			m := match{
+				employee: e,
+				prefix: strings.HasPrefix(strings.ToLower(e.username), lowerQuery) ||
+					strings.HasPrefix(strings.ToLower(e.displayName), lowerQuery),
+				similarity: max(similarity(e.username, query), similarity(e.displayName, query)),
+			}
+			if query == "" || m.prefix || m.similarity >= similarityThreshold {
+				matches = append(matches, m)
+			}
+		}
+	})
+
+	slices.SortFunc(matches, func(a, b match) int {
+		switch {
+		case a.prefix != b.prefix && a.prefix:
+			return -1
+		case a.prefix != b.prefix:
+			return 1
+		case a.similarity > b.similarity:
+			return -1
+		case a.similarity < b.similarity:
+			return 1
+		default:
+			return strings.Compare(a.employee.username, b.employee.username)
+		}
+	})
+
+	res := make([]model.EmployeeDirectoryEntry, 0, limit)
+	for _, m := range page(matches, limit, offset) {
+		res = append(res, model.EmployeeDirectoryEntry{
+			Username:    m.employee.username,
+			DisplayName: m.employee.displayName,
+		})
+	}
+
+	return res, nil
+}
+
+// update changes the employee row like UPDATE does and reports whether the employee exists.
+func (r *EmployeeRepository) update(ctx context.Context, employeeID int64, fn func(e *employee)) (bool, error) {
+	var updated bool
+	err := r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableEmployee, employeeID)
+		if err != nil {
+			return err
+		}
+
+		e, ok := r.storage.employees[employeeID]
+		if !ok {
+			return nil
+		}
+
+		fn(&e)
+		updated = true
+		return put(t, tableEmployee, r.storage.employees, employeeID, e)
+	})
+
+	return updated, err
+}
+
+func convertEmployee(e employee) *model.Employee {
+	return &model.Employee{
+		ID:       e.id,
+		Username: e.username,
+		Password: e.password,
+		Balance:  e.balance,
+		Role:     model.Role(e.role),
+
+		GivingBudget:       e.givingBudget,
+		GivingBudgetPeriod: e.givingBudgetPeriod,
+	}
+}
+
+// similarity returns the share of trigrams a and b have in common, like pg_trgm similarity.
+func similarity(a, b string) float64 {
+	trigramsA, trigramsB := trigrams(a), trigrams(b)
+	if len(trigramsA) == 0 || len(trigramsB) == 0 {
+		return 0
+	}
+
+	var common int
+	for trigram := range trigramsA {
+		if _, ok := trigramsB[trigram]; ok {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(trigramsA)+len(trigramsB)-common)
+}
+
+// trigrams splits s into lower case words, pads every word with two spaces in front and one after
+// and returns the set of their three-character substrings.
+func trigrams(s string) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	res := make(map[string]struct{})
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			res[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+
+	return res
+}
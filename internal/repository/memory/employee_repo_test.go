@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestEmployeeRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	_, _, employeeRepo := newTestRepos(t)
+
+	employee, err := employeeRepo.Create(ctx, "alice", "hash", 1000)
+	require.NoError(t, err)
+	assert.Equal(t, model.RoleEmployee, employee.Role)
+
+	_, err = employeeRepo.Create(ctx, "alice", "other", 1000)
+	require.ErrorIs(t, err, model.ErrEmployeeAlreadyExists)
+
+	found, err := employeeRepo.GetByUsername(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, employee, found)
+}
+
+func TestEmployeeRepository_Search(t *testing.T) {
+	ctx := context.Background()
+	_, _, employeeRepo := newTestRepos(t)
+
+	for _, username := range []string{"bob", "alexander", "alex", "alexey", "alice"} {
+		_, err := employeeRepo.Create(ctx, username, "hash", 1000)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		limit  int
+		offset int
+		want   []string
+	}{
+		{
+			name:  "empty query lists everyone",
+			query: "",
+			limit: 10,
+			want:  []string{"alex", "alexander", "alexey", "alice", "bob"},
+		},
+		{
+			name:  "prefix case insensitive",
+			query: "ALEX",
+			limit: 10,
+			want:  []string{"alex", "alexey", "alexander"},
+		},
+		{
+			name:  "similar",
+			query: "alexandr",
+			limit: 10,
+			want:  []string{"alexander", "alex", "alexey"},
+		},
+		{
+			name:   "page",
+			query:  "",
+			limit:  2,
+			offset: 3,
+			want:   []string{"alice", "bob"},
+		},
+		{
+			name:  "nothing similar",
+			query: "zoe",
+			limit: 10,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := employeeRepo.Search(ctx, tt.query, tt.limit, tt.offset)
+			require.NoError(t, err)
+
+			var usernames []string
+			for _, entry := range entries {
+				usernames = append(usernames, entry.Username)
+			}
+			assert.Equal(t, tt.want, usernames)
+		})
+	}
+}
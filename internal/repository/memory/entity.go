@@ -0,0 +1,199 @@
+package memory
+
+import "time"
+
+// rows mirror the tables of migrations/init.sql, nullable columns are pointers
+
+type employee struct {
+	id                 int64
+	username           string
+	displayName        string
+	password           string
+	balance            int64
+	role               string
+	givingBudget       int64
+	givingBudgetPeriod *time.Time
+	hiddenFromStats    bool
+	createTime         time.Time
+}
+
+type merch struct {
+	id         int64
+	name       string
+	price      int64
+	createTime time.Time
+}
+
+type merchVariant struct {
+	id         int64
+	merchID    int64
+	size       string
+	color      string
+	priceDelta int64
+	stock      *int64
+	active     bool
+	createTime time.Time
+}
+
+// inventoryKey is the primary key of inventory and cart items
+type inventoryKey struct {
+	employeeID int64
+	variantID  int64
+}
+
+type inventoryItem struct {
+	employeeID int64
+	merchID    int64
+	variantID  int64
+	quantity   int64
+	createTime time.Time
+}
+
+type purchase struct {
+	id           int64
+	employeeID   int64
+	merchID      int64
+	variantID    int64
+	quantity     int64
+	price        int64
+	orderID      int64
+	purchaseTime time.Time
+}
+
+type order struct {
+	id         int64
+	employeeID int64
+	merchID    int64
+	variantID  int64
+	quantity   int64
+	price      int64
+	status     string
+	createTime time.Time
+	updateTime time.Time
+}
+
+type cartItem struct {
+	employeeID int64
+	merchID    int64
+	variantID  int64
+	quantity   int64
+	createTime time.Time
+}
+
+type wishlistKey struct {
+	employeeID int64
+	merchID    int64
+}
+
+type wishlistItem struct {
+	employeeID int64
+	merchID    int64
+	addedPrice int64
+	createTime time.Time
+}
+
+type transaction struct {
+	id              int64
+	senderID        int64
+	receiverID      int64
+	amount          int64
+	transactionTime time.Time
+}
+
+type scheduledTransfer struct {
+	id             int64
+	ownerID        int64
+	receiverID     int64
+	amount         int64
+	cronExpression string
+	nextRunTime    *time.Time
+	isActive       bool
+	createTime     time.Time
+}
+
+type scheduledTransferRun struct {
+	id                  int64
+	scheduledTransferID int64
+	runTime             time.Time
+	status              string
+	errorText           string
+}
+
+type coinLot struct {
+	id           int64
+	employeeID   int64
+	amount       int64
+	remaining    int64
+	receivedTime time.Time
+	expireTime   time.Time
+}
+
+type transferRequest struct {
+	id                int64
+	kind              string
+	senderID          int64
+	receiverID        int64
+	amount            int64
+	status            string
+	requireAcceptance bool
+	expireTime        time.Time
+	decidedBy         *int64
+	createTime        time.Time
+	decideTime        *time.Time
+}
+
+// transferRequestLot has no primary key in the database, id keeps the insertion order
+type transferRequestLot struct {
+	id                int64
+	transferRequestID int64
+	coinLotID         int64
+	amount            int64
+	expireTime        time.Time
+}
+
+// orderLot has no primary key in the database, id keeps the insertion order
+type orderLot struct {
+	id         int64
+	orderID    int64
+	coinLotID  int64
+	amount     int64
+	expireTime time.Time
+}
+
+type ledgerEntry struct {
+	id         int64
+	employeeID int64
+	amount     int64
+	kind       string
+	coinLotID  *int64
+	periodTime *time.Time
+	createTime time.Time
+}
+
+// allowanceID is the id of the single allowance row
+const allowanceID = 1
+
+type allowance struct {
+	amount         int64
+	cronExpression string
+	isActive       bool
+	nextGrantTime  *time.Time
+	updateTime     time.Time
+}
+
+// employeeStatsDaily and merchStatsDaily are rows of the leaderboard materialized views
+
+type employeeStatsDaily struct {
+	day            time.Time
+	employeeID     int64
+	receivedAmount int64
+	receivedCount  int64
+	sentAmount     int64
+	sentCount      int64
+}
+
+type merchStatsDaily struct {
+	day      time.Time
+	merchID  int64
+	quantity int64
+}
@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type ExportRepository struct {
+	storage *Storage
+}
+
+func NewExportRepository(storage *Storage) (*ExportRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &ExportRepository{
+		storage: storage,
+	}, nil
+}
+
+// Stream calls fn for every transfer, purchase and refund matching filter ordered by time.
+// Rows are collected first and fn is called with the storage unlocked, so a slow reader doesn't block writes.
+func (r *ExportRepository) Stream(ctx context.Context, filter model.ExportFilter, fn func(row model.ExportRow) error) error {
+	var rows []model.ExportRow
+	r.storage.read(ctx, func() {
+		for _, t := range r.storage.transactions {
+			if !inPeriod(filter, t.transactionTime) ||
+				(filter.EmployeeID != nil && t.senderID != *filter.EmployeeID && t.receiverID != *filter.EmployeeID) {
+				continue
+			}
+
+			sender, okSender := r.storage.employees[t.senderID]
+			receiver, okReceiver := r.storage.employees[t.receiverID]
+			if !okSender || !okReceiver {
+				continue
+			}
+
+			rows = append(rows, model.ExportRow{
+				Kind:     model.ExportRowKindTransfer,
+				ID:       t.id,
+				Time:     t.transactionTime,
+				FromUser: sender.username,
+				ToUser:   receiver.username,
+				Amount:   t.amount,
+			})
+		}
+
+		for _, p := range r.storage.purchases {
+			if !inPeriod(filter, p.purchaseTime) || (filter.EmployeeID != nil && p.employeeID != *filter.EmployeeID) {
+				continue
+			}
+
+			buyer, okBuyer := r.storage.employees[p.employeeID]
+			m, okMerch := r.storage.merches[p.merchID]
+			if !okBuyer || !okMerch {
+				continue
+			}
+
+			rows = append(rows, model.ExportRow{
+				Kind:      model.ExportRowKindPurchase,
+				ID:        p.id,
+				Time:      p.purchaseTime,
+				FromUser:  buyer.username,
+				MerchName: m.name,
+				Quantity:  p.quantity,
+				Amount:    p.price * p.quantity,
+			})
+		}
+
+		for _, l := range r.storage.ledgerEntries {
+			if l.kind != string(model.LedgerEntryKindRefund) || !inPeriod(filter, l.createTime) ||
+				(filter.EmployeeID != nil && l.employeeID != *filter.EmployeeID) {
+				continue
+			}
+
+			buyer, ok := r.storage.employees[l.employeeID]
+			if !ok {
+				continue
+			}
+
+			rows = append(rows, model.ExportRow{
+				Kind:   model.ExportRowKindRefund,
+				ID:     l.id,
+				Time:   l.createTime,
+				ToUser: buyer.username,
+				Amount: l.amount,
+			})
+		}
+	})
+
+	slices.SortFunc(rows, func(a, b model.ExportRow) int {
+		return cmp.Or(a.Time.Compare(b.Time), strings.Compare(string(a.Kind), string(b.Kind)), cmp.Compare(a.ID, b.ID))
+	})
+
+	for _, row := range rows {
+		err := ctx.Err()
+		if err != nil {
+			return fmt.Errorf("ctx.Err: %w", err)
+		}
+
+		err = fn(row)
+		if err != nil {
+			return fmt.Errorf("fn: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// inPeriod reports whether t is within the filter period, To is exclusive.
+func inPeriod(filter model.ExportFilter, t time.Time) bool {
+	return (filter.From == nil || !t.Before(*filter.From)) && (filter.To == nil || t.Before(*filter.To))
+}
@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// InfoRepository reads everything /api/info shows. There are no round trips to save in memory,
+// so it just calls the other repositories one by one.
+type InfoRepository struct {
+	employeeRepo        *EmployeeRepository
+	transactionRepo     *TransactionRepository
+	inventoryRepo       *InventoryRepository
+	coinLotRepo         *CoinLotRepository
+	transferRequestRepo *TransferRequestRepository
+}
+
+func NewInfoRepository(storage *Storage) (*InfoRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &InfoRepository{
+		employeeRepo:        &EmployeeRepository{storage: storage},
+		transactionRepo:     &TransactionRepository{storage: storage},
+		inventoryRepo:       &InventoryRepository{storage: storage},
+		coinLotRepo:         &CoinLotRepository{storage: storage},
+		transferRequestRepo: &TransferRequestRepository{storage: storage},
+	}, nil
+}
+
+func (r *InfoRepository) GetByEmployee(
+	ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory,
+) (model.EmployeeInfoData, error) {
+	employee, err := r.employeeRepo.GetByID(ctx, employeeID)
+	if err != nil {
+		return model.EmployeeInfoData{}, fmt.Errorf("employeeRepo.GetByID: %w", err)
+	}
+
+	var transactions []model.Transaction
+	if history == model.CoinHistoryByCounterparty {
+		transactions, err = r.transactionRepo.GetTotalsByEmployee(ctx, employeeID)
+		if err != nil {
+			return model.EmployeeInfoData{}, fmt.Errorf("transactionRepo.GetTotalsByEmployee: %w", err)
+		}
+	} else {
+		transactions, err = r.transactionRepo.GetByEmployee(ctx, employeeID)
+		if err != nil {
+			return model.EmployeeInfoData{}, fmt.Errorf("transactionRepo.GetByEmployee: %w", err)
+		}
+	}
+
+	inventories, err := r.inventoryRepo.GetByEmployee(ctx, employeeID)
+	if err != nil {
+		return model.EmployeeInfoData{}, fmt.Errorf("inventoryRepo.GetByEmployee: %w", err)
+	}
+
+	lots, err := r.coinLotRepo.GetActive(ctx, employeeID, now)
+	if err != nil {
+		return model.EmployeeInfoData{}, fmt.Errorf("coinLotRepo.GetActive: %w", err)
+	}
+
+	requests, err := r.transferRequestRepo.GetPendingByEmployee(ctx, employeeID)
+	if err != nil {
+		return model.EmployeeInfoData{}, fmt.Errorf("transferRequestRepo.GetPendingByEmployee: %w", err)
+	}
+
+	return model.EmployeeInfoData{
+		Employee:        *employee,
+		Transactions:    transactions,
+		Inventory:       inventories,
+		ActiveCoinLots:  lots,
+		PendingRequests: requests,
+	}, nil
+}
+
+// GetByEmployeeSingleQuery is GetByEmployee, the storage is read the same way whatever the query mode is.
+func (r *InfoRepository) GetByEmployeeSingleQuery(
+	ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory,
+) (model.EmployeeInfoData, error) {
+	return r.GetByEmployee(ctx, employeeID, now, history)
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type InventoryRepository struct {
+	storage *Storage
+}
+
+func NewInventoryRepository(storage *Storage) (*InventoryRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &InventoryRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *InventoryRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.Inventory, error) {
+	var items []inventoryItem
+	res := make([]model.Inventory, 0)
+	r.storage.read(ctx, func() {
+		for _, item := range r.storage.inventory {
+			if item.employeeID == employeeID {
+				items = append(items, item)
+			}
+		}
+		slices.SortFunc(items, func(a, b inventoryItem) int {
+			return cmp.Or(a.createTime.Compare(b.createTime), cmp.Compare(a.variantID, b.variantID))
+		})
+
+		for _, item := range items {
+			m, okMerch := r.storage.merches[item.merchID]
+			v, okVariant := r.storage.merchVariants[item.variantID]
+			if !okMerch || !okVariant {
+				continue
+			}
+			res = append(res, model.Inventory{
+				EmployeeID: item.employeeID,
+				MerchID:    item.merchID,
+				VariantID:  item.variantID,
+				Quantity:   item.quantity,
+				MerchName:  m.name,
+				Size:       v.size,
+				Color:      v.color,
+			})
+		}
+	})
+
+	return res, nil
+}
+
+func (r *InventoryRepository) Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		key := inventoryKey{employeeID: employeeID, variantID: variantID}
+
+		err := t.lock(tableInventory, key)
+		if err != nil {
+			return err
+		}
+
+		item, ok := r.storage.inventory[key]
+		if !ok {
+			item = inventoryItem{
+				employeeID: employeeID,
+				merchID:    merchID,
+				variantID:  variantID,
+				createTime: r.storage.now(),
+			}
+		}
+		item.quantity += quantity
+
+		return put(t, tableInventory, r.storage.inventory, key, item)
+	})
+}
+
+// Remove takes quantity items of merch variant back from the employee, rows left empty are deleted.
+func (r *InventoryRepository) Remove(ctx context.Context, employeeID, variantID, quantity int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		key := inventoryKey{employeeID: employeeID, variantID: variantID}
+
+		err := t.lock(tableInventory, key)
+		if err != nil {
+			return err
+		}
+
+		item, ok := r.storage.inventory[key]
+		if !ok || item.quantity < quantity {
+			return model.ErrInventoryNotFound
+		}
+
+		item.quantity -= quantity
+		if item.quantity == 0 {
+			_, err = remove(t, tableInventory, r.storage.inventory, key)
+			return err
+		}
+
+		return put(t, tableInventory, r.storage.inventory, key, item)
+	})
+}
+
+// AddPurchase logs a purchase of the order for statistics, inventory keeps only totals. price is the price of one item.
+func (r *InventoryRepository) AddPurchase(ctx context.Context, employeeID, merchID, variantID, quantity, price, orderID int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		p := purchase{
+			id:           r.storage.nextID(tablePurchase),
+			employeeID:   employeeID,
+			merchID:      merchID,
+			variantID:    variantID,
+			quantity:     quantity,
+			price:        price,
+			orderID:      orderID,
+			purchaseTime: r.storage.now(),
+		}
+
+		return put(t, tablePurchase, r.storage.purchases, p.id, p)
+	})
+}
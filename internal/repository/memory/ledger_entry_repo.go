@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// errDuplicatePeriod mirrors the violation of the unique index ledger_entry_period
+var errDuplicatePeriod = errors.New("duplicate ledger entry for the period")
+
+type LedgerEntryRepository struct {
+	storage *Storage
+}
+
+func NewLedgerEntryRepository(storage *Storage) (*LedgerEntryRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &LedgerEntryRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *LedgerEntryRepository) Add(ctx context.Context, entry model.LedgerEntry) error {
+	added, err := r.AddOnce(ctx, entry)
+	if err != nil {
+		return err
+	}
+	if !added {
+		return fmt.Errorf("employee %d, kind %s: %w", entry.EmployeeID, entry.Kind, errDuplicatePeriod)
+	}
+
+	return nil
+}
+
+// AddOnce adds a periodic entry and reports whether it was added,
+// false means the employee already has an entry of this kind for entry.PeriodTime.
+func (r *LedgerEntryRepository) AddOnce(ctx context.Context, entry model.LedgerEntry) (bool, error) {
+	var added bool
+	err := r.storage.write(ctx, func(t *tx) error {
+		if entry.PeriodTime != nil {
+			// waits for a concurrent entry of the same period like the unique index does
+			err := t.lock(tableLedgerEntry, periodKey{
+				employeeID: entry.EmployeeID,
+				kind:       string(entry.Kind),
+				periodTime: entry.PeriodTime.UnixNano(),
+			})
+			if err != nil {
+				return err
+			}
+
+			if r.hasEntry(entry.EmployeeID, entry.Kind, *entry.PeriodTime) {
+				return nil
+			}
+		}
+
+		row := ledgerEntry{
+			id:         r.storage.nextID(tableLedgerEntry),
+			employeeID: entry.EmployeeID,
+			amount:     entry.Amount,
+			kind:       string(entry.Kind),
+			coinLotID:  entry.CoinLotID,
+			periodTime: entry.PeriodTime,
+			createTime: r.storage.now(),
+		}
+		added = true
+		return put(t, tableLedgerEntry, r.storage.ledgerEntries, row.id, row)
+	})
+
+	return added, err
+}
+
+// GetEmployeesWithoutEntry returns up to limit employees who have no entry of kind for periodTime.
+func (r *LedgerEntryRepository) GetEmployeesWithoutEntry(
+	ctx context.Context,
+	kind model.LedgerEntryKind,
+	periodTime time.Time,
+	limit int,
+) ([]int64, error) {
+	employeeIDs := make([]int64, 0, limit)
+	r.storage.read(ctx, func() {
+		for _, e := range sortedByID(r.storage.employees) {
+			if len(employeeIDs) == limit {
+				return
+			}
+			if !r.hasEntry(e.id, kind, periodTime) {
+				employeeIDs = append(employeeIDs, e.id)
+			}
+		}
+	})
+
+	return employeeIDs, nil
+}
+
+// hasEntry reports whether the employee has an entry of kind for periodTime, it should be called with mu held.
+func (r *LedgerEntryRepository) hasEntry(employeeID int64, kind model.LedgerEntryKind, periodTime time.Time) bool {
+	for _, entry := range r.storage.ledgerEntries {
+		if entry.employeeID == employeeID && entry.kind == string(kind) &&
+			entry.periodTime != nil && entry.periodTime.Equal(periodTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// periodKey locks the unique key of a periodic entry, time.Time isn't comparable by the instant
+type periodKey struct {
+	employeeID int64
+	kind       string
+	periodTime int64
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type MerchRepository struct {
+	storage *Storage
+}
+
+func NewMerchRepository(storage *Storage) (*MerchRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &MerchRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *MerchRepository) GetByName(ctx context.Context, name string) (*model.Merch, error) {
+	var (
+		res   model.Merch
+		found bool
+	)
+	r.storage.read(ctx, func() {
+		for _, m := range r.storage.merches {
+			if m.name == name {
+				res, found = convertMerch(m), true
+				return
+			}
+		}
+	})
+	if !found {
+		return nil, model.ErrMerchNotFound
+	}
+
+	return &res, nil
+}
+
+func (r *MerchRepository) GetByIDs(ctx context.Context, merchIDs []int64) ([]model.Merch, error) {
+	res := make([]model.Merch, 0, len(merchIDs))
+	r.storage.read(ctx, func() {
+		for _, m := range sortedByID(r.storage.merches) {
+			if slices.Contains(merchIDs, m.id) {
+				res = append(res, convertMerch(m))
+			}
+		}
+	})
+
+	return res, nil
+}
+
+func convertMerch(m merch) model.Merch {
+	return model.Merch{
+		ID:    m.id,
+		Name:  m.name,
+		Price: m.price,
+	}
+}
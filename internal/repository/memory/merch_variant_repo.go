@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type MerchVariantRepository struct {
+	storage *Storage
+}
+
+func NewMerchVariantRepository(storage *Storage) (*MerchVariantRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &MerchVariantRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *MerchVariantRepository) GetByMerch(ctx context.Context, merchID int64) ([]model.MerchVariant, error) {
+	res := make([]model.MerchVariant, 0)
+	r.storage.read(ctx, func() {
+		for _, v := range sortedByID(r.storage.merchVariants) {
+			if v.merchID == merchID {
+				res = append(res, convertMerchVariant(v))
+			}
+		}
+	})
+
+	return res, nil
+}
+
+func (r *MerchVariantRepository) GetByIDs(ctx context.Context, variantIDs []int64) ([]model.MerchVariant, error) {
+	res := make([]model.MerchVariant, 0, len(variantIDs))
+	r.storage.read(ctx, func() {
+		for _, v := range sortedByID(r.storage.merchVariants) {
+			if slices.Contains(variantIDs, v.id) {
+				res = append(res, convertMerchVariant(v))
+			}
+		}
+	})
+
+	return res, nil
+}
+
+// DecreaseStock takes quantity items of the variant from stock, variants with unlimited stock are left as is.
+// It returns model.ErrOutOfStock when less than quantity items are left.
+func (r *MerchVariantRepository) DecreaseStock(ctx context.Context, variantID, quantity int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableMerchVariant, variantID)
+		if err != nil {
+			return err
+		}
+
+		v, ok := r.storage.merchVariants[variantID]
+		if !ok || (v.stock != nil && *v.stock < quantity) {
+			return model.ErrOutOfStock
+		}
+		if v.stock == nil {
+			return nil
+		}
+
+		stock := *v.stock - quantity
+		v.stock = &stock
+		return put(t, tableMerchVariant, r.storage.merchVariants, variantID, v)
+	})
+}
+
+// IncreaseStock returns quantity items of the variant to stock, variants with unlimited stock are left as is.
+func (r *MerchVariantRepository) IncreaseStock(ctx context.Context, variantID, quantity int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableMerchVariant, variantID)
+		if err != nil {
+			return err
+		}
+
+		v, ok := r.storage.merchVariants[variantID]
+		if !ok || v.stock == nil {
+			return nil
+		}
+
+		stock := *v.stock + quantity
+		v.stock = &stock
+		return put(t, tableMerchVariant, r.storage.merchVariants, variantID, v)
+	})
+}
+
+func convertMerchVariant(v merchVariant) model.MerchVariant {
+	return model.MerchVariant{
+		ID:         v.id,
+		MerchID:    v.merchID,
+		Size:       v.size,
+		Color:      v.color,
+		PriceDelta: v.priceDelta,
+		Stock:      v.stock,
+		Active:     v.active,
+	}
+}
@@ -0,0 +1,213 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type OrderRepository struct {
+	storage *Storage
+}
+
+func NewOrderRepository(storage *Storage) (*OrderRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &OrderRepository{
+		storage: storage,
+	}, nil
+}
+
+// Add places an order for quantity items of merch variant bought for price each and returns its id.
+func (r *OrderRepository) Add(ctx context.Context, employeeID, merchID, variantID, quantity, price int64) (int64, error) {
+	var orderID int64
+	err := r.storage.write(ctx, func(t *tx) error {
+		now := r.storage.now()
+		o := order{
+			id:         r.storage.nextID(tableOrder),
+			employeeID: employeeID,
+			merchID:    merchID,
+			variantID:  variantID,
+			quantity:   quantity,
+			price:      price,
+			status:     string(model.OrderStatusPlaced),
+			createTime: now,
+			updateTime: now,
+		}
+		orderID = o.id
+
+		return put(t, tableOrder, r.storage.orders, o.id, o)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return orderID, nil
+}
+
+// AddLots remembers coins the order was paid with.
+func (r *OrderRepository) AddLots(ctx context.Context, orderID int64, parts []model.CoinLotPart) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		for _, part := range parts {
+			lot := orderLot{
+				id:         r.storage.nextID(tableOrderLot),
+				orderID:    orderID,
+				coinLotID:  part.LotID,
+				amount:     part.Amount,
+				expireTime: part.ExpireTime,
+			}
+
+			err := put(t, tableOrderLot, r.storage.orderLots, lot.id, lot)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetLots returns coins the order was paid with, the earliest expire time first.
+func (r *OrderRepository) GetLots(ctx context.Context, orderID int64) ([]model.CoinLotPart, error) {
+	var lots []orderLot
+	r.storage.read(ctx, func() {
+		for _, lot := range r.storage.orderLots {
+			if lot.orderID == orderID {
+				lots = append(lots, lot)
+			}
+		}
+	})
+	slices.SortFunc(lots, func(a, b orderLot) int {
+		return cmp.Or(a.expireTime.Compare(b.expireTime), cmp.Compare(a.coinLotID, b.coinLotID))
+	})
+
+	res := make([]model.CoinLotPart, 0, len(lots))
+	for _, lot := range lots {
+		res = append(res, model.CoinLotPart{
+			LotID:      lot.coinLotID,
+			Amount:     lot.amount,
+			ExpireTime: lot.expireTime,
+		})
+	}
+
+	return res, nil
+}
+
+// GetByEmployee returns the employee orders, the latest first.
+func (r *OrderRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.Order, error) {
+	orders := r.selectOrders(ctx, func(o order) bool {
+		return o.employeeID == employeeID
+	})
+	slices.Reverse(orders)
+
+	return orders, nil
+}
+
+// GetByStatuses returns orders in any of statuses, the oldest first.
+func (r *OrderRepository) GetByStatuses(ctx context.Context, statuses []model.OrderStatus) ([]model.Order, error) {
+	return r.selectOrders(ctx, func(o order) bool {
+		return slices.Contains(statuses, model.OrderStatus(o.status))
+	}), nil
+}
+
+func (r *OrderRepository) GetByIDWithLock(ctx context.Context, orderID int64) (*model.Order, error) {
+	var res model.Order
+	err := r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableOrder, orderID)
+		if err != nil {
+			return err
+		}
+
+		o, ok := r.storage.orders[orderID]
+		if !ok {
+			return model.ErrOrderNotFound
+		}
+
+		res, ok = r.convertOrder(o)
+		if !ok {
+			return model.ErrOrderNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *OrderRepository) UpdateStatus(ctx context.Context, orderID int64, status model.OrderStatus, updateTime time.Time) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableOrder, orderID)
+		if err != nil {
+			return err
+		}
+
+		o, ok := r.storage.orders[orderID]
+		if !ok {
+			return model.ErrOrderNotFound
+		}
+
+		o.status = string(status)
+		o.updateTime = updateTime
+		return put(t, tableOrder, r.storage.orders, orderID, o)
+	})
+}
+
+// selectOrders returns orders matching the filter, the oldest first.
+func (r *OrderRepository) selectOrders(ctx context.Context, filter func(o order) bool) []model.Order {
+	var orders []order
+	res := make([]model.Order, 0)
+	r.storage.read(ctx, func() {
+		for _, o := range r.storage.orders {
+			if filter(o) {
+				orders = append(orders, o)
+			}
+		}
+		slices.SortFunc(orders, func(a, b order) int {
+			return cmp.Or(a.createTime.Compare(b.createTime), cmp.Compare(a.id, b.id))
+		})
+
+		for _, o := range orders {
+			if converted, ok := r.convertOrder(o); ok {
+				res = append(res, converted)
+			}
+		}
+	})
+
+	return res
+}
+
+// convertOrder joins the order with its employee, merch and variant, it should be called with mu held.
+// It returns false when one of them doesn't exist like INNER JOIN does.
+func (r *OrderRepository) convertOrder(o order) (model.Order, bool) {
+	e, okEmployee := r.storage.employees[o.employeeID]
+	m, okMerch := r.storage.merches[o.merchID]
+	v, okVariant := r.storage.merchVariants[o.variantID]
+	if !okEmployee || !okMerch || !okVariant {
+		return model.Order{}, false
+	}
+
+	return model.Order{
+		ID:               o.id,
+		EmployeeID:       o.employeeID,
+		EmployeeUsername: e.username,
+		MerchID:          o.merchID,
+		MerchName:        m.name,
+		VariantID:        o.variantID,
+		Size:             v.size,
+		Color:            v.color,
+		Quantity:         o.quantity,
+		Price:            o.price,
+		Status:           model.OrderStatus(o.status),
+		CreateTime:       o.createTime,
+		UpdateTime:       o.updateTime,
+	}, true
+}
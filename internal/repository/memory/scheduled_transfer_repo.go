@@ -0,0 +1,190 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type ScheduledTransferRepository struct {
+	storage *Storage
+}
+
+func NewScheduledTransferRepository(storage *Storage) (*ScheduledTransferRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &ScheduledTransferRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *ScheduledTransferRepository) Create(ctx context.Context, st model.ScheduledTransfer) (*model.ScheduledTransfer, error) {
+	err := r.storage.write(ctx, func(t *tx) error {
+		st.ID = r.storage.nextID(tableScheduledTransfer)
+
+		return put(t, tableScheduledTransfer, r.storage.scheduledTransfers, st.ID, scheduledTransfer{
+			id:             st.ID,
+			ownerID:        st.OwnerID,
+			receiverID:     st.ReceiverID,
+			amount:         st.Amount,
+			cronExpression: st.CronExpression,
+			nextRunTime:    st.NextRunTime,
+			isActive:       st.IsActive,
+			createTime:     r.storage.now(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &st, nil
+}
+
+func (r *ScheduledTransferRepository) GetByID(ctx context.Context, id int64) (*model.ScheduledTransfer, error) {
+	var (
+		res   *model.ScheduledTransfer
+		found bool
+	)
+	r.storage.read(ctx, func() {
+		st, ok := r.storage.scheduledTransfers[id]
+		if ok {
+			res, found = r.convertScheduledTransfer(st)
+		}
+	})
+	if !found {
+		return nil, model.ErrScheduledTransferNotFound
+	}
+
+	return res, nil
+}
+
+func (r *ScheduledTransferRepository) GetByOwner(ctx context.Context, ownerID int64) ([]model.ScheduledTransfer, error) {
+	res := make([]model.ScheduledTransfer, 0)
+	r.storage.read(ctx, func() {
+		for _, st := range sortedByID(r.storage.scheduledTransfers) {
+			if st.ownerID != ownerID {
+				continue
+			}
+			if converted, ok := r.convertScheduledTransfer(st); ok {
+				res = append(res, *converted)
+			}
+		}
+	})
+
+	return res, nil
+}
+
+// GetDueWithLock returns one active scheduled transfer with next_run_time not after now and locks it.
+// Transfers already locked by other transactions are skipped, like SKIP LOCKED does.
+func (r *ScheduledTransferRepository) GetDueWithLock(ctx context.Context, now time.Time) (*model.ScheduledTransfer, error) {
+	var res *model.ScheduledTransfer
+	err := r.storage.write(ctx, func(t *tx) error {
+		var due []scheduledTransfer
+		for _, st := range r.storage.scheduledTransfers {
+			if st.isActive && st.nextRunTime != nil && !st.nextRunTime.After(now) {
+				due = append(due, st)
+			}
+		}
+		slices.SortFunc(due, func(a, b scheduledTransfer) int {
+			return cmp.Or(a.nextRunTime.Compare(*b.nextRunTime), cmp.Compare(a.id, b.id))
+		})
+
+		for _, st := range due {
+			converted, ok := r.convertScheduledTransfer(st)
+			if !ok || !t.tryLock(tableScheduledTransfer, st.id) {
+				continue
+			}
+
+			res = converted
+			return nil
+		}
+
+		return model.ErrScheduledTransferNotFound
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *ScheduledTransferRepository) Update(ctx context.Context, st model.ScheduledTransfer) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableScheduledTransfer, st.ID)
+		if err != nil {
+			return err
+		}
+
+		row, ok := r.storage.scheduledTransfers[st.ID]
+		if !ok || row.ownerID != st.OwnerID {
+			return model.ErrScheduledTransferNotFound
+		}
+
+		row.receiverID = st.ReceiverID
+		row.amount = st.Amount
+		row.cronExpression = st.CronExpression
+		row.nextRunTime = st.NextRunTime
+		row.isActive = st.IsActive
+		return put(t, tableScheduledTransfer, r.storage.scheduledTransfers, st.ID, row)
+	})
+}
+
+func (r *ScheduledTransferRepository) SetNextRunTime(ctx context.Context, id int64, nextRunTime *time.Time, isActive bool) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableScheduledTransfer, id)
+		if err != nil {
+			return err
+		}
+
+		row, ok := r.storage.scheduledTransfers[id]
+		if !ok {
+			return nil
+		}
+
+		row.nextRunTime = nextRunTime
+		row.isActive = isActive
+		return put(t, tableScheduledTransfer, r.storage.scheduledTransfers, id, row)
+	})
+}
+
+func (r *ScheduledTransferRepository) Delete(ctx context.Context, id, ownerID int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableScheduledTransfer, id)
+		if err != nil {
+			return err
+		}
+
+		row, ok := r.storage.scheduledTransfers[id]
+		if !ok || row.ownerID != ownerID {
+			return model.ErrScheduledTransferNotFound
+		}
+
+		_, err = remove(t, tableScheduledTransfer, r.storage.scheduledTransfers, id)
+		return err
+	})
+}
+
+// convertScheduledTransfer joins the transfer with its receiver, it should be called with mu held.
+func (r *ScheduledTransferRepository) convertScheduledTransfer(st scheduledTransfer) (*model.ScheduledTransfer, bool) {
+	receiver, ok := r.storage.employees[st.receiverID]
+	if !ok {
+		return nil, false
+	}
+
+	return &model.ScheduledTransfer{
+		ID:               st.id,
+		OwnerID:          st.ownerID,
+		ReceiverID:       st.receiverID,
+		ReceiverUsername: receiver.username,
+		Amount:           st.amount,
+		CronExpression:   st.cronExpression,
+		NextRunTime:      st.nextRunTime,
+		IsActive:         st.isActive,
+	}, true
+}
@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type ScheduledTransferRunRepository struct {
+	storage *Storage
+}
+
+func NewScheduledTransferRunRepository(storage *Storage) (*ScheduledTransferRunRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &ScheduledTransferRunRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *ScheduledTransferRunRepository) Add(ctx context.Context, run model.ScheduledTransferRun) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		row := scheduledTransferRun{
+			id:                  r.storage.nextID(tableScheduledTransferRun),
+			scheduledTransferID: run.ScheduledTransferID,
+			runTime:             run.RunTime,
+			status:              string(run.Status),
+			errorText:           run.Error,
+		}
+
+		return put(t, tableScheduledTransferRun, r.storage.scheduledTransferRuns, row.id, row)
+	})
+}
+
+// GetLastByScheduledTransfer returns up to limit latest runs, newest first.
+func (r *ScheduledTransferRunRepository) GetLastByScheduledTransfer(
+	ctx context.Context,
+	scheduledTransferID int64,
+	limit int,
+) ([]model.ScheduledTransferRun, error) {
+	var runs []scheduledTransferRun
+	r.storage.read(ctx, func() {
+		for _, run := range sortedByID(r.storage.scheduledTransferRuns) {
+			if run.scheduledTransferID == scheduledTransferID {
+				runs = append(runs, run)
+			}
+		}
+	})
+	slices.Reverse(runs)
+
+	res := make([]model.ScheduledTransferRun, 0, len(runs))
+	for _, run := range page(runs, limit, 0) {
+		res = append(res, model.ScheduledTransferRun{
+			ID:                  run.id,
+			ScheduledTransferID: run.scheduledTransferID,
+			RunTime:             run.runTime,
+			Status:              model.ScheduledTransferRunStatus(run.status),
+			Error:               run.errorText,
+		})
+	}
+
+	return res, nil
+}
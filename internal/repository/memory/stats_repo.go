@@ -0,0 +1,171 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// StatsRepository reads leaderboards from aggregates by UTC day like the materialized views do,
+// so results lag behind until the next Refresh.
+type StatsRepository struct {
+	storage *Storage
+}
+
+func NewStatsRepository(storage *Storage) (*StatsRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &StatsRepository{
+		storage: storage,
+	}, nil
+}
+
+// GetTopReceivers returns employees who received the most coins from day from to day to (exclusive).
+// Employees hidden from stats are skipped.
+func (r *StatsRepository) GetTopReceivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error) {
+	return r.getTopEmployees(ctx, from, to, limit, func(s employeeStatsDaily) (int64, int64) {
+		return s.receivedAmount, s.receivedCount
+	}), nil
+}
+
+// GetTopGivers returns employees who sent the most coins from day from to day to (exclusive).
+// Employees hidden from stats are skipped.
+func (r *StatsRepository) GetTopGivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error) {
+	return r.getTopEmployees(ctx, from, to, limit, func(s employeeStatsDaily) (int64, int64) {
+		return s.sentAmount, s.sentCount
+	}), nil
+}
+
+func (r *StatsRepository) getTopEmployees(
+	ctx context.Context,
+	from, to time.Time,
+	limit int,
+	amountAndCount func(s employeeStatsDaily) (int64, int64),
+) []model.LeaderboardEmployee {
+	byUsername := make(map[string]model.LeaderboardEmployee)
+	r.storage.read(ctx, func() {
+		for _, s := range r.storage.employeeStats {
+			amount, count := amountAndCount(s)
+			if s.day.Before(from) || !s.day.Before(to) || count == 0 {
+				continue
+			}
+
+			e, ok := r.storage.employees[s.employeeID]
+			if !ok || e.hiddenFromStats {
+				continue
+			}
+
+			entry := byUsername[e.username]
+			entry.Username = e.username
+			entry.Amount += amount
+			entry.Count += count
+			byUsername[e.username] = entry
+		}
+	})
+
+	res := make([]model.LeaderboardEmployee, 0, len(byUsername))
+	for _, entry := range byUsername {
+		res = append(res, entry)
+	}
+	slices.SortFunc(res, func(a, b model.LeaderboardEmployee) int {
+		return cmp.Or(cmp.Compare(b.Amount, a.Amount), strings.Compare(a.Username, b.Username))
+	})
+
+	return page(res, limit, 0)
+}
+
+// GetTopMerch returns the most purchased merch from day from to day to (exclusive).
+func (r *StatsRepository) GetTopMerch(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardMerch, error) {
+	byName := make(map[string]model.LeaderboardMerch)
+	r.storage.read(ctx, func() {
+		for _, s := range r.storage.merchStats {
+			if s.day.Before(from) || !s.day.Before(to) {
+				continue
+			}
+
+			m, ok := r.storage.merches[s.merchID]
+			if !ok {
+				continue
+			}
+
+			entry := byName[m.name]
+			entry.MerchName = m.name
+			entry.Quantity += s.quantity
+			byName[m.name] = entry
+		}
+	})
+
+	res := make([]model.LeaderboardMerch, 0, len(byName))
+	for _, entry := range byName {
+		res = append(res, entry)
+	}
+	slices.SortFunc(res, func(a, b model.LeaderboardMerch) int {
+		return cmp.Or(cmp.Compare(b.Quantity, a.Quantity), strings.Compare(a.MerchName, b.MerchName))
+	})
+
+	return page(res, limit, 0), nil
+}
+
+// Refresh recalculates the aggregates from transfers and purchases of orders not cancelled.
+func (r *StatsRepository) Refresh(ctx context.Context) error {
+	r.storage.read(ctx, func() {
+		type employeeDay struct {
+			day        time.Time
+			employeeID int64
+		}
+		employeeStats := make(map[employeeDay]employeeStatsDaily)
+		for _, t := range r.storage.transactions {
+			day := utcDay(t.transactionTime)
+
+			received := employeeStats[employeeDay{day: day, employeeID: t.receiverID}]
+			received.day, received.employeeID = day, t.receiverID
+			received.receivedAmount += t.amount
+			received.receivedCount++
+			employeeStats[employeeDay{day: day, employeeID: t.receiverID}] = received
+
+			sent := employeeStats[employeeDay{day: day, employeeID: t.senderID}]
+			sent.day, sent.employeeID = day, t.senderID
+			sent.sentAmount += t.amount
+			sent.sentCount++
+			employeeStats[employeeDay{day: day, employeeID: t.senderID}] = sent
+		}
+
+		type merchDay struct {
+			day     time.Time
+			merchID int64
+		}
+		merchStats := make(map[merchDay]merchStatsDaily)
+		for _, p := range r.storage.purchases {
+			// merch of cancelled orders is given back, so it isn't counted as sold
+			if o, ok := r.storage.orders[p.orderID]; ok && o.status == string(model.OrderStatusCancelled) {
+				continue
+			}
+
+			key := merchDay{day: utcDay(p.purchaseTime), merchID: p.merchID}
+
+			s := merchStats[key]
+			s.day, s.merchID = key.day, p.merchID
+			s.quantity += p.quantity
+			merchStats[key] = s
+		}
+
+		r.storage.employeeStats = slices.Collect(maps.Values(employeeStats))
+		r.storage.merchStats = slices.Collect(maps.Values(merchStats))
+	})
+
+	return nil
+}
+
+// utcDay truncates t to the start of its UTC day like (t at time zone 'UTC')::date.
+func utcDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestStatsRepository_GetTopMerch_SkipsCancelledOrders(t *testing.T) {
+	ctx := context.Background()
+	s, _, employeeRepo := newTestRepos(t)
+
+	inventoryRepo, err := NewInventoryRepository(s)
+	require.NoError(t, err)
+	orderRepo, err := NewOrderRepository(s)
+	require.NoError(t, err)
+	statsRepo, err := NewStatsRepository(s)
+	require.NoError(t, err)
+
+	alice, err := employeeRepo.Create(ctx, "alice", "hash", 0)
+	require.NoError(t, err)
+
+	// cup and pen have only the default variant with id = merch id
+	const cupID, penID = 2, 4
+	for _, purchase := range []struct{ merchID, quantity int64 }{
+		{cupID, 2},
+		{penID, 3},
+		{cupID, 1},
+	} {
+		var orderID int64
+		orderID, err = orderRepo.Add(ctx, alice.ID, purchase.merchID, purchase.merchID, purchase.quantity, 10)
+		require.NoError(t, err)
+		require.NoError(t, inventoryRepo.AddPurchase(ctx, alice.ID, purchase.merchID, purchase.merchID,
+			purchase.quantity, 10, orderID))
+
+		if purchase.merchID == penID {
+			require.NoError(t, orderRepo.UpdateStatus(ctx, orderID, model.OrderStatusCancelled, time.Now()))
+		}
+	}
+
+	require.NoError(t, statsRepo.Refresh(ctx))
+
+	today := utcDay(time.Now())
+	top, err := statsRepo.GetTopMerch(ctx, today, today.AddDate(0, 0, 1), 10)
+	require.NoError(t, err)
+	require.Equal(t, []model.LeaderboardMerch{{MerchName: "cup", Quantity: 3}}, top)
+}
@@ -0,0 +1,443 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ErrDeadlock is returned by a write that would wait for a row locked by a transaction
+// which itself waits for the writing one. Like in Postgres the transaction should be rolled back and retried.
+var ErrDeadlock = errors.New("deadlock detected")
+
+// table names, they name row locks and id sequences
+const (
+	tableEmployee             = "employee"
+	tableMerch                = "merch"
+	tableMerchVariant         = "merch_variant"
+	tableInventory            = "inventory"
+	tablePurchase             = "purchase"
+	tableOrder                = "merch_order"
+	tableOrderLot             = "merch_order_lot"
+	tableCartItem             = "cart_item"
+	tableWishlistItem         = "wishlist_item"
+	tableTransaction          = "transaction"
+	tableScheduledTransfer    = "scheduled_transfer"
+	tableScheduledTransferRun = "scheduled_transfer_run"
+	tableCoinLot              = "coin_lot"
+	tableTransferRequest      = "transfer_request"
+	tableTransferRequestLot   = "transfer_request_lot"
+	tableLedgerEntry          = "ledger_entry"
+	tableAllowance            = "allowance"
+
+	// lock of a unique username, so concurrent sign ups of the same employee wait for each other
+	lockEmployeeUsername = "employee_username"
+)
+
+// Storage keeps the tables of migrations/init.sql in memory, so the service runs without Postgres.
+//
+// Every change is made in a transaction: either one of TrManager or an implicit one around a single
+// repository call. Changes are undone when the transaction is rolled back. A written row is locked
+// till the end of its transaction, writes of other transactions to the row wait for the lock
+// and ErrDeadlock is returned instead of waiting forever. Reads don't take locks and see committed rows
+// and the changes of their own transaction, like READ COMMITTED, rows meant to be changed should be read
+// with the WithLock methods.
+type Storage struct {
+	mu sync.Mutex
+	// cond is signalled whenever row locks are released
+	cond  *sync.Cond
+	locks map[lockKey]*tx
+	// active are transactions not ended yet, their changes are hidden from reads of other transactions
+	active map[*tx]struct{}
+	// seq is the last id of every table, like Postgres sequences it isn't rolled back
+	seq map[string]int64
+	now func() time.Time
+
+	employees             map[int64]employee
+	merches               map[int64]merch
+	merchVariants         map[int64]merchVariant
+	inventory             map[inventoryKey]inventoryItem
+	purchases             map[int64]purchase
+	orders                map[int64]order
+	orderLots             map[int64]orderLot
+	cartItems             map[inventoryKey]cartItem
+	wishlistItems         map[wishlistKey]wishlistItem
+	transactions          map[int64]transaction
+	scheduledTransfers    map[int64]scheduledTransfer
+	scheduledTransferRuns map[int64]scheduledTransferRun
+	coinLots              map[int64]coinLot
+	transferRequests      map[int64]transferRequest
+	transferRequestLots   map[int64]transferRequestLot
+	ledgerEntries         map[int64]ledgerEntry
+	allowances            map[int64]allowance
+
+	// leaderboard aggregates as of the last StatsRepository.Refresh
+	employeeStats []employeeStatsDaily
+	merchStats    []merchStatsDaily
+}
+
+// NewStorage returns a storage with the merch catalog and the allowance settings of migrations/init.sql.
+func NewStorage() *Storage {
+	s := &Storage{
+		locks:  make(map[lockKey]*tx),
+		active: make(map[*tx]struct{}),
+		seq:    make(map[string]int64),
+		now:    time.Now,
+
+		employees:             make(map[int64]employee),
+		merches:               make(map[int64]merch),
+		merchVariants:         make(map[int64]merchVariant),
+		inventory:             make(map[inventoryKey]inventoryItem),
+		purchases:             make(map[int64]purchase),
+		orders:                make(map[int64]order),
+		orderLots:             make(map[int64]orderLot),
+		cartItems:             make(map[inventoryKey]cartItem),
+		wishlistItems:         make(map[wishlistKey]wishlistItem),
+		transactions:          make(map[int64]transaction),
+		scheduledTransfers:    make(map[int64]scheduledTransfer),
+		scheduledTransferRuns: make(map[int64]scheduledTransferRun),
+		coinLots:              make(map[int64]coinLot),
+		transferRequests:      make(map[int64]transferRequest),
+		transferRequestLots:   make(map[int64]transferRequestLot),
+		ledgerEntries:         make(map[int64]ledgerEntry),
+		allowances:            make(map[int64]allowance),
+	}
+	s.cond = sync.NewCond(&s.mu)
+
+	s.seed()
+
+	return s
+}
+
+// seed fills the storage with the rows inserted by migrations/init.sql.
+func (s *Storage) seed() {
+	now := s.now()
+
+	merches := []struct {
+		name  string
+		price int64
+	}{
+		{"t-shirt", 80},
+		{"cup", 20},
+		{"book", 50},
+		{"pen", 10},
+		{"powerbank", 200},
+		{"hoody", 300},
+		{"umbrella", 200},
+		{"socks", 10},
+		{"wallet", 50},
+		{"pink-hoody", 500},
+	}
+	merchIDs := make(map[string]int64, len(merches))
+	for _, m := range merches {
+		id := s.nextID(tableMerch)
+		merchIDs[m.name] = id
+		s.merches[id] = merch{id: id, name: m.name, price: m.price, createTime: now}
+	}
+
+	// every merch has a default variant with the merch id, merch with sizes keeps it inactive
+	s.seq[tableMerchVariant] = s.seq[tableMerch]
+	for id, m := range s.merches {
+		s.merchVariants[id] = merchVariant{
+			id:         id,
+			merchID:    id,
+			active:     m.name != "t-shirt" && m.name != "hoody",
+			createTime: now,
+		}
+	}
+
+	sizes := []string{"S", "M", "L", "XL", "XXL"}
+	addVariant := func(merchID int64, size, color string) {
+		var priceDelta int64
+		if size == "XXL" {
+			priceDelta = 10
+		}
+		id := s.nextID(tableMerchVariant)
+		s.merchVariants[id] = merchVariant{
+			id:         id,
+			merchID:    merchID,
+			size:       size,
+			color:      color,
+			priceDelta: priceDelta,
+			active:     true,
+			createTime: now,
+		}
+	}
+	for _, size := range sizes {
+		for _, color := range []string{"white", "black"} {
+			addVariant(merchIDs["t-shirt"], size, color)
+		}
+	}
+	for _, size := range sizes {
+		addVariant(merchIDs["hoody"], size, "")
+	}
+
+	utcNow := now.UTC()
+	nextGrantTime := time.Date(utcNow.Year(), utcNow.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+	s.allowances[allowanceID] = allowance{
+		amount:         200,
+		cronExpression: "0 0 1 * *",
+		isActive:       true,
+		nextGrantTime:  &nextGrantTime,
+		updateTime:     now,
+	}
+}
+
+// nextID returns the next id of the table, it should be called with mu held.
+func (s *Storage) nextID(table string) int64 {
+	s.seq[table]++
+	return s.seq[table]
+}
+
+// read runs fn with the storage locked. fn sees committed rows and the changes of the transaction of ctx:
+// changes of other transactions are undone for the time of fn and redone after it, like READ COMMITTED.
+func (s *Storage) read(ctx context.Context, fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	own, _ := s.txFromContext(ctx)
+	var hidden []*tx
+	for t := range s.active {
+		if t != own && len(t.changes) > 0 {
+			t.hide()
+			hidden = append(hidden, t)
+		}
+	}
+	// other transactions write other rows because of row locks, so their changes are independent
+	defer func() {
+		for _, t := range hidden {
+			t.show()
+		}
+	}()
+
+	fn()
+}
+
+// write runs fn with the storage locked in the transaction of ctx. Outside of a transaction fn runs in its own one,
+// committed right after fn like a single statement. Changes of a failed fn are undone.
+func (s *Storage) write(ctx context.Context, fn func(t *tx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.txFromContext(ctx)
+	if !ok {
+		t = s.begin(ctx)
+		defer s.end(t)
+	}
+
+	mark := len(t.changes)
+	err := fn(t)
+	if err != nil {
+		t.rollbackTo(mark)
+		return err
+	}
+
+	return nil
+}
+
+type txKey struct{}
+
+func (s *Storage) txFromContext(ctx context.Context) (*tx, bool) {
+	t, ok := ctx.Value(txKey{}).(*tx)
+	return t, ok && t.storage == s
+}
+
+// begin starts a transaction, it should be called with mu held.
+func (s *Storage) begin(ctx context.Context) *tx {
+	t := &tx{storage: s, ctx: ctx}
+	s.active[t] = struct{}{}
+	return t
+}
+
+// end releases row locks of the transaction and wakes up transactions waiting for them,
+// it should be called with mu held.
+func (s *Storage) end(t *tx) {
+	for _, key := range t.locks {
+		delete(s.locks, key)
+	}
+	t.locks = nil
+	t.changes = nil
+	delete(s.active, t)
+
+	s.cond.Broadcast()
+}
+
+// wakeUp lets transactions waiting for locks check whether their context is done.
+func (s *Storage) wakeUp() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cond.Broadcast()
+}
+
+type lockKey struct {
+	table string
+	key   any
+}
+
+// tx is a storage transaction, its fields are guarded by Storage.mu.
+type tx struct {
+	storage *Storage
+	// ctx cancels waiting for row locks
+	ctx context.Context
+	// changes are undone in reverse order on rollback
+	changes []change
+	locks   []lockKey
+	// waitsFor is the transaction holding the lock this one waits for, nil when it isn't waiting
+	waitsFor *tx
+}
+
+// lock takes the row lock of the table key till the end of the transaction, waiting for another
+// transaction to release it. It returns ErrDeadlock when the other transaction waits for this one.
+func (t *tx) lock(table string, key any) error {
+	s := t.storage
+	k := lockKey{table: table, key: key}
+
+	for {
+		owner, ok := s.locks[k]
+		if !ok {
+			s.locks[k] = t
+			t.locks = append(t.locks, k)
+			return nil
+		}
+		if owner == t {
+			return nil
+		}
+
+		for waiting := owner; waiting != nil; waiting = waiting.waitsFor {
+			if waiting == t {
+				return fmt.Errorf("lock %s: %w", table, ErrDeadlock)
+			}
+		}
+
+		err := t.ctx.Err()
+		if err != nil {
+			return fmt.Errorf("lock %s: %w", table, err)
+		}
+
+		t.waitsFor = owner
+		stop := context.AfterFunc(t.ctx, s.wakeUp)
+		s.cond.Wait()
+		stop()
+		t.waitsFor = nil
+	}
+}
+
+// tryLock takes the row lock if nobody holds it, like SKIP LOCKED.
+func (t *tx) tryLock(table string, key any) bool {
+	k := lockKey{table: table, key: key}
+
+	owner, ok := t.storage.locks[k]
+	if ok {
+		return owner == t
+	}
+
+	t.storage.locks[k] = t
+	t.locks = append(t.locks, k)
+	return true
+}
+
+// change is a row change of a transaction.
+type change struct {
+	// undo restores the previous row
+	undo func()
+	// redo makes the change again after undo
+	redo func()
+}
+
+// rollbackTo undoes changes made after the first mark ones.
+func (t *tx) rollbackTo(mark int) {
+	for i := len(t.changes) - 1; i >= mark; i-- {
+		t.changes[i].undo()
+	}
+	t.changes = t.changes[:mark]
+}
+
+// hide undoes all changes of the transaction keeping them, so the tables show committed rows.
+func (t *tx) hide() {
+	for i := len(t.changes) - 1; i >= 0; i-- {
+		t.changes[i].undo()
+	}
+}
+
+// show redoes the changes undone by hide.
+func (t *tx) show() {
+	for _, c := range t.changes {
+		c.redo()
+	}
+}
+
+// put locks the row and stores it, the previous row is restored on rollback.
+func put[K comparable, V any](t *tx, table string, rows map[K]V, key K, row V) error {
+	err := t.lock(table, key)
+	if err != nil {
+		return err
+	}
+
+	old, existed := rows[key]
+	rows[key] = row
+	t.changes = append(t.changes, change{
+		undo: func() {
+			if existed {
+				rows[key] = old
+			} else {
+				delete(rows, key)
+			}
+		},
+		redo: func() {
+			rows[key] = row
+		},
+	})
+
+	return nil
+}
+
+// remove locks the row and deletes it, the row is restored on rollback. It reports whether the row existed.
+func remove[K comparable, V any](t *tx, table string, rows map[K]V, key K) (bool, error) {
+	err := t.lock(table, key)
+	if err != nil {
+		return false, err
+	}
+
+	old, existed := rows[key]
+	if !existed {
+		return false, nil
+	}
+
+	delete(rows, key)
+	t.changes = append(t.changes, change{
+		undo: func() {
+			rows[key] = old
+		},
+		redo: func() {
+			delete(rows, key)
+		},
+	})
+
+	return true, nil
+}
+
+// sortedByID returns rows ordered by id, which is the insertion order of serial ids.
+func sortedByID[V any](rows map[int64]V) []V {
+	res := make([]V, 0, len(rows))
+	for _, id := range slices.Sorted(maps.Keys(rows)) {
+		res = append(res, rows[id])
+	}
+	return res
+}
+
+// page applies LIMIT and OFFSET to the ordered items.
+func page[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return nil
+	}
+	items = items[offset:]
+	if limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
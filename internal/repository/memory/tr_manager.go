@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"errors"
+)
+
+// TrManager runs functions in storage transactions, it's the in-memory counterpart of the trm manager.
+// The transaction is rolled back when fn returns an error or panics and committed otherwise.
+type TrManager struct {
+	storage *Storage
+	// nested opens a savepoint when Do is called inside a transaction instead of joining it
+	nested bool
+}
+
+func NewTrManager(storage *Storage) (*TrManager, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &TrManager{
+		storage: storage,
+	}, nil
+}
+
+// NewNestedTrManager returns a manager which opens a savepoint when called inside a transaction,
+// so a failed fn undoes only its own changes, like trm.PropagationNested.
+func NewNestedTrManager(storage *Storage) (*TrManager, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &TrManager{
+		storage: storage,
+		nested:  true,
+	}, nil
+}
+
+func (m *TrManager) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	s := m.storage
+
+	t, ok := s.txFromContext(ctx)
+	if ok {
+		if !m.nested {
+			return fn(ctx)
+		}
+		return m.savepoint(ctx, t, fn)
+	}
+
+	s.mu.Lock()
+	t = s.begin(ctx)
+	s.mu.Unlock()
+	defer func() {
+		panicErr := recover()
+
+		s.mu.Lock()
+		if err != nil || panicErr != nil {
+			t.rollbackTo(0)
+		}
+		s.end(t)
+		s.mu.Unlock()
+
+		if panicErr != nil {
+			panic(panicErr)
+		}
+	}()
+
+	return fn(context.WithValue(ctx, txKey{}, t))
+}
+
+// savepoint runs fn in the transaction and undoes changes of fn only when it fails.
+// Row locks taken by fn are kept till the end of the transaction, like in Postgres.
+func (m *TrManager) savepoint(ctx context.Context, t *tx, fn func(ctx context.Context) error) (err error) {
+	s := m.storage
+
+	s.mu.Lock()
+	mark := len(t.changes)
+	s.mu.Unlock()
+
+	defer func() {
+		panicErr := recover()
+
+		if err != nil || panicErr != nil {
+			s.mu.Lock()
+			t.rollbackTo(mark)
+			s.mu.Unlock()
+		}
+
+		if panicErr != nil {
+			panic(panicErr)
+		}
+	}()
+
+	return fn(ctx)
+}
@@ -0,0 +1,311 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func newTestRepos(t *testing.T) (*Storage, *TrManager, *EmployeeRepository) {
+	t.Helper()
+
+	s := NewStorage()
+
+	trManager, err := NewTrManager(s)
+	require.NoError(t, err)
+
+	employeeRepo, err := NewEmployeeRepository(s)
+	require.NoError(t, err)
+
+	return s, trManager, employeeRepo
+}
+
+func getBalance(t *testing.T, employeeRepo *EmployeeRepository, employeeID int64) int64 {
+	t.Helper()
+
+	employee, err := employeeRepo.GetByID(context.Background(), employeeID)
+	require.NoError(t, err)
+
+	return employee.Balance
+}
+
+func TestTrManager_Do_Commit(t *testing.T) {
+	ctx := context.Background()
+	_, trManager, employeeRepo := newTestRepos(t)
+
+	var employeeID int64
+	err := trManager.Do(ctx, func(ctx context.Context) error {
+		employee, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+		if err != nil {
+			return err
+		}
+		employeeID = employee.ID
+
+		return employeeRepo.IncreaseBalance(ctx, employeeID, 50)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(150), getBalance(t, employeeRepo, employeeID))
+}
+
+func TestTrManager_Do_Rollback(t *testing.T) {
+	ctx := context.Background()
+	_, trManager, employeeRepo := newTestRepos(t)
+
+	employee, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+	require.NoError(t, err)
+
+	err = trManager.Do(ctx, func(ctx context.Context) error {
+		_, err := employeeRepo.Create(ctx, "bob", "hash", 100)
+		if err != nil {
+			return err
+		}
+
+		err = employeeRepo.IncreaseBalance(ctx, employee.ID, 50)
+		if err != nil {
+			return err
+		}
+
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	assert.Equal(t, int64(100), getBalance(t, employeeRepo, employee.ID))
+	_, err = employeeRepo.GetByUsername(ctx, "bob")
+	require.ErrorIs(t, err, model.ErrEmployeeNotFound)
+}
+
+func TestTrManager_Do_ReadsSeeCommittedRows(t *testing.T) {
+	ctx := context.Background()
+	_, trManager, employeeRepo := newTestRepos(t)
+
+	alice, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+	require.NoError(t, err)
+
+	err = trManager.Do(ctx, func(txCtx context.Context) error {
+		require.NoError(t, employeeRepo.IncreaseBalance(txCtx, alice.ID, 50))
+		_, err := employeeRepo.Create(txCtx, "bob", "hash", 10)
+		require.NoError(t, err)
+
+		// ctx is outside of the transaction like another connection, it doesn't see uncommitted rows
+		assert.Equal(t, int64(100), getBalance(t, employeeRepo, alice.ID))
+		_, err = employeeRepo.GetByUsername(ctx, "bob")
+		require.ErrorIs(t, err, model.ErrEmployeeNotFound)
+
+		// the transaction sees its own changes, also after the read of another one
+		employee, err := employeeRepo.GetByID(txCtx, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(150), employee.Balance)
+		_, err = employeeRepo.GetByUsername(txCtx, "bob")
+		require.NoError(t, err)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(150), getBalance(t, employeeRepo, alice.ID))
+	_, err = employeeRepo.GetByUsername(ctx, "bob")
+	require.NoError(t, err)
+}
+
+func TestTrManager_Do_RollbackOnPanic(t *testing.T) {
+	ctx := context.Background()
+	_, trManager, employeeRepo := newTestRepos(t)
+
+	employee, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+	require.NoError(t, err)
+
+	require.PanicsWithValue(t, "boom", func() {
+		_ = trManager.Do(ctx, func(ctx context.Context) error {
+			err := employeeRepo.IncreaseBalance(ctx, employee.ID, 50)
+			if err != nil {
+				return err
+			}
+
+			panic("boom")
+		})
+	})
+
+	assert.Equal(t, int64(100), getBalance(t, employeeRepo, employee.ID))
+
+	// the lock is released, so an update outside of the transaction doesn't wait
+	require.NoError(t, employeeRepo.IncreaseBalance(ctx, employee.ID, 1))
+}
+
+func TestTrManager_Do_Nested(t *testing.T) {
+	ctx := context.Background()
+	s, trManager, employeeRepo := newTestRepos(t)
+
+	nestedTrManager, err := NewNestedTrManager(s)
+	require.NoError(t, err)
+
+	employee, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+	require.NoError(t, err)
+
+	err = trManager.Do(ctx, func(ctx context.Context) error {
+		err := employeeRepo.IncreaseBalance(ctx, employee.ID, 10)
+		if err != nil {
+			return err
+		}
+
+		// a failed savepoint undoes its own changes only
+		err = nestedTrManager.Do(ctx, func(ctx context.Context) error {
+			err := employeeRepo.IncreaseBalance(ctx, employee.ID, 20)
+			if err != nil {
+				return err
+			}
+			return assert.AnError
+		})
+		require.ErrorIs(t, err, assert.AnError)
+
+		return nestedTrManager.Do(ctx, func(ctx context.Context) error {
+			return employeeRepo.IncreaseBalance(ctx, employee.ID, 30)
+		})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(140), getBalance(t, employeeRepo, employee.ID))
+}
+
+func TestTrManager_Do_JoinsTransaction(t *testing.T) {
+	ctx := context.Background()
+	_, trManager, employeeRepo := newTestRepos(t)
+
+	employee, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+	require.NoError(t, err)
+
+	err = trManager.Do(ctx, func(ctx context.Context) error {
+		err := trManager.Do(ctx, func(ctx context.Context) error {
+			return employeeRepo.IncreaseBalance(ctx, employee.ID, 10)
+		})
+		if err != nil {
+			return err
+		}
+
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	// the inner call joined the outer transaction and was rolled back with it
+	assert.Equal(t, int64(100), getBalance(t, employeeRepo, employee.ID))
+}
+
+func TestTrManager_Do_LockWaitsForCommit(t *testing.T) {
+	ctx := context.Background()
+	_, trManager, employeeRepo := newTestRepos(t)
+
+	employee, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+	require.NoError(t, err)
+
+	locked := make(chan struct{})
+	release := make(chan struct{})
+	firstDone := make(chan error)
+	go func() {
+		firstDone <- trManager.Do(ctx, func(ctx context.Context) error {
+			_, err := employeeRepo.GetByIDWithLock(ctx, employee.ID)
+			if err != nil {
+				return err
+			}
+			close(locked)
+			<-release
+
+			return employeeRepo.IncreaseBalance(ctx, employee.ID, -100)
+		})
+	}()
+	<-locked
+
+	secondDone := make(chan error)
+	go func() {
+		secondDone <- trManager.Do(ctx, func(ctx context.Context) error {
+			employee, err := employeeRepo.GetByIDWithLock(ctx, employee.ID)
+			if err != nil {
+				return err
+			}
+			if employee.Balance < 100 {
+				return model.ErrNotEnoughBalance
+			}
+
+			return employeeRepo.IncreaseBalance(ctx, employee.ID, -100)
+		})
+	}()
+
+	select {
+	case err = <-secondDone:
+		t.Fatalf("second transaction didn't wait for the lock: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-firstDone)
+	require.ErrorIs(t, <-secondDone, model.ErrNotEnoughBalance)
+
+	assert.Equal(t, int64(0), getBalance(t, employeeRepo, employee.ID))
+}
+
+func TestTrManager_Do_Deadlock(t *testing.T) {
+	ctx := context.Background()
+	_, trManager, employeeRepo := newTestRepos(t)
+
+	alice, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+	require.NoError(t, err)
+	bob, err := employeeRepo.Create(ctx, "bob", "hash", 100)
+	require.NoError(t, err)
+
+	var ready sync.WaitGroup
+	ready.Add(2)
+	lockBoth := func(firstID, secondID int64) error {
+		return trManager.Do(ctx, func(ctx context.Context) error {
+			err := employeeRepo.IncreaseBalance(ctx, firstID, 1)
+			if err != nil {
+				return err
+			}
+			ready.Done()
+			ready.Wait()
+
+			return employeeRepo.IncreaseBalance(ctx, secondID, 1)
+		})
+	}
+
+	errs := make(chan error, 2)
+	go func() { errs <- lockBoth(alice.ID, bob.ID) }()
+	go func() { errs <- lockBoth(bob.ID, alice.ID) }()
+
+	// one of the transactions is rolled back, the other one commits
+	err1, err2 := <-errs, <-errs
+	assert.True(t, errors.Is(err1, ErrDeadlock) != errors.Is(err2, ErrDeadlock), "errors: %v, %v", err1, err2)
+	assert.True(t, err1 == nil || err2 == nil, "errors: %v, %v", err1, err2)
+
+	assert.Equal(t, int64(202), getBalance(t, employeeRepo, alice.ID)+getBalance(t, employeeRepo, bob.ID))
+}
+
+func TestTrManager_Do_LockWaitCanceled(t *testing.T) {
+	ctx := context.Background()
+	_, trManager, employeeRepo := newTestRepos(t)
+
+	employee, err := employeeRepo.Create(ctx, "alice", "hash", 100)
+	require.NoError(t, err)
+
+	err = trManager.Do(ctx, func(txCtx context.Context) error {
+		_, err := employeeRepo.GetByIDWithLock(txCtx, employee.ID)
+		require.NoError(t, err)
+
+		waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		// an update outside of the transaction waits for the lock till the context is done
+		err = employeeRepo.IncreaseBalance(waitCtx, employee.ID, 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(100), getBalance(t, employeeRepo, employee.ID))
+}
@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type TransactionRepository struct {
+	storage *Storage
+}
+
+func NewTransactionRepository(storage *Storage) (*TransactionRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &TransactionRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *TransactionRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error) {
+	res := make([]model.Transaction, 0)
+	r.storage.read(ctx, func() {
+		for _, t := range sortedByID(r.storage.transactions) {
+			isSender := t.senderID == employeeID
+			if !isSender && t.receiverID != employeeID {
+				continue
+			}
+
+			counterpartyID := t.senderID
+			if isSender {
+				counterpartyID = t.receiverID
+			}
+			counterparty, ok := r.storage.employees[counterpartyID]
+			if !ok {
+				continue
+			}
+
+			res = append(res, model.Transaction{
+				IsSender:               isSender,
+				CounterpartyEmployeeID: counterpartyID,
+				CounterpartyUsername:   counterparty.username,
+				Amount:                 t.amount,
+			})
+		}
+	})
+
+	return res, nil
+}
+
+// GetTotalsByEmployee returns a transaction per counterparty and direction with the summed amount, the largest first.
+func (r *TransactionRepository) GetTotalsByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error) {
+	transactions, err := r.GetByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	type totalKey struct {
+		isSender       bool
+		counterpartyID int64
+	}
+	totals := make(map[totalKey]int, len(transactions))
+	res := make([]model.Transaction, 0)
+	for _, t := range transactions {
+		key := totalKey{isSender: t.IsSender, counterpartyID: t.CounterpartyEmployeeID}
+		i, ok := totals[key]
+		if !ok {
+			totals[key] = len(res)
+			res = append(res, t)
+			continue
+		}
+		res[i].Amount += t.Amount
+	}
+
+	// like ORDER BY is_sender, received ones go before sent ones
+	sentLast := func(t model.Transaction) int {
+		if t.IsSender {
+			return 1
+		}
+		return 0
+	}
+	slices.SortFunc(res, func(a, b model.Transaction) int {
+		return cmp.Or(
+			cmp.Compare(b.Amount, a.Amount),
+			cmp.Compare(a.CounterpartyEmployeeID, b.CounterpartyEmployeeID),
+			cmp.Compare(sentLast(a), sentLast(b)),
+		)
+	})
+
+	return res, nil
+}
+
+func (r *TransactionRepository) Add(ctx context.Context, senderID, receiverID, amount int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		row := transaction{
+			id:              r.storage.nextID(tableTransaction),
+			senderID:        senderID,
+			receiverID:      receiverID,
+			amount:          amount,
+			transactionTime: r.storage.now(),
+		}
+
+		return put(t, tableTransaction, r.storage.transactions, row.id, row)
+	})
+}
+
+// GetSentAmountSince sums transactions the sender made since the time and coins held by pending transfer requests
+// of the sender. Held coins count whatever the request create time, they are sent when the request is settled.
+func (r *TransactionRepository) GetSentAmountSince(ctx context.Context, senderID int64, since time.Time) (int64, error) {
+	var amount int64
+	r.storage.read(ctx, func() {
+		for _, t := range r.storage.transactions {
+			if t.senderID == senderID && !t.transactionTime.Before(since) {
+				amount += t.amount
+			}
+		}
+		for _, request := range r.storage.transferRequests {
+			if request.senderID == senderID && request.status == string(model.TransferRequestStatusPending) {
+				amount += request.amount
+			}
+		}
+	})
+
+	return amount, nil
+}
+
+// GetLastSentTime returns the time of the last transaction to the receiver or of the last pending transfer request
+// to the receiver, whichever is later, nil if there is none.
+func (r *TransactionRepository) GetLastSentTime(ctx context.Context, senderID, receiverID int64) (*time.Time, error) {
+	var lastSentTime *time.Time
+	sent := func(sentTime time.Time) {
+		if lastSentTime == nil || sentTime.After(*lastSentTime) {
+			lastSentTime = &sentTime
+		}
+	}
+
+	r.storage.read(ctx, func() {
+		for _, t := range r.storage.transactions {
+			if t.senderID == senderID && t.receiverID == receiverID {
+				sent(t.transactionTime)
+			}
+		}
+		for _, request := range r.storage.transferRequests {
+			if request.senderID == senderID && request.receiverID == receiverID &&
+				request.status == string(model.TransferRequestStatusPending) {
+				sent(request.createTime)
+			}
+		}
+	})
+
+	return lastSentTime, nil
+}
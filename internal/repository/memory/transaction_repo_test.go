@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestTransactionRepository_GetTotalsByEmployee(t *testing.T) {
+	ctx := context.Background()
+	s, _, employeeRepo := newTestRepos(t)
+
+	transactionRepo, err := NewTransactionRepository(s)
+	require.NoError(t, err)
+
+	alice, err := employeeRepo.Create(ctx, "alice", "hash", 0)
+	require.NoError(t, err)
+	bob, err := employeeRepo.Create(ctx, "bob", "hash", 0)
+	require.NoError(t, err)
+	carol, err := employeeRepo.Create(ctx, "carol", "hash", 0)
+	require.NoError(t, err)
+
+	for _, transfer := range []struct{ senderID, receiverID, amount int64 }{
+		{alice.ID, bob.ID, 10},
+		{alice.ID, carol.ID, 30},
+		{bob.ID, alice.ID, 20},
+		{alice.ID, bob.ID, 10},
+		{carol.ID, bob.ID, 100},
+	} {
+		require.NoError(t, transactionRepo.Add(ctx, transfer.senderID, transfer.receiverID, transfer.amount))
+	}
+
+	totals, err := transactionRepo.GetTotalsByEmployee(ctx, alice.ID)
+	require.NoError(t, err)
+
+	require.Equal(t, []model.Transaction{
+		{IsSender: true, CounterpartyEmployeeID: carol.ID, CounterpartyUsername: "carol", Amount: 30},
+		{IsSender: false, CounterpartyEmployeeID: bob.ID, CounterpartyUsername: "bob", Amount: 20},
+		{IsSender: true, CounterpartyEmployeeID: bob.ID, CounterpartyUsername: "bob", Amount: 20},
+	}, totals)
+}
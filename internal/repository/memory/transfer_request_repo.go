@@ -0,0 +1,281 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type TransferRequestRepository struct {
+	storage *Storage
+}
+
+func NewTransferRequestRepository(storage *Storage) (*TransferRequestRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &TransferRequestRepository{
+		storage: storage,
+	}, nil
+}
+
+// Create saves a pending request, ID of the returned request is filled by the storage.
+func (r *TransferRequestRepository) Create(ctx context.Context, request model.TransferRequest) (*model.TransferRequest, error) {
+	err := r.storage.write(ctx, func(t *tx) error {
+		request.ID = r.storage.nextID(tableTransferRequest)
+
+		return put(t, tableTransferRequest, r.storage.transferRequests, request.ID, transferRequest{
+			id:                request.ID,
+			kind:              string(request.Kind),
+			senderID:          request.SenderID,
+			receiverID:        request.ReceiverID,
+			amount:            request.Amount,
+			status:            string(request.Status),
+			requireAcceptance: request.RequireAcceptance,
+			expireTime:        request.ExpireTime,
+			createTime:        request.CreateTime,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// AddLots remembers coins held by the request.
+func (r *TransferRequestRepository) AddLots(ctx context.Context, requestID int64, parts []model.CoinLotPart) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		for _, part := range parts {
+			lot := transferRequestLot{
+				id:                r.storage.nextID(tableTransferRequestLot),
+				transferRequestID: requestID,
+				coinLotID:         part.LotID,
+				amount:            part.Amount,
+				expireTime:        part.ExpireTime,
+			}
+
+			err := put(t, tableTransferRequestLot, r.storage.transferRequestLots, lot.id, lot)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// MoveLots hands coins held by one request over to another one.
+func (r *TransferRequestRepository) MoveLots(ctx context.Context, fromRequestID, toRequestID int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		for _, lot := range sortedByID(r.storage.transferRequestLots) {
+			if lot.transferRequestID != fromRequestID {
+				continue
+			}
+
+			lot.transferRequestID = toRequestID
+			err := put(t, tableTransferRequestLot, r.storage.transferRequestLots, lot.id, lot)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetLots returns coins held by the request, the earliest expire time first.
+func (r *TransferRequestRepository) GetLots(ctx context.Context, requestID int64) ([]model.CoinLotPart, error) {
+	var lots []transferRequestLot
+	r.storage.read(ctx, func() {
+		for _, lot := range r.storage.transferRequestLots {
+			if lot.transferRequestID == requestID {
+				lots = append(lots, lot)
+			}
+		}
+	})
+	slices.SortFunc(lots, func(a, b transferRequestLot) int {
+		return cmp.Or(a.expireTime.Compare(b.expireTime), cmp.Compare(a.coinLotID, b.coinLotID))
+	})
+
+	res := make([]model.CoinLotPart, 0, len(lots))
+	for _, lot := range lots {
+		res = append(res, model.CoinLotPart{
+			LotID:      lot.coinLotID,
+			Amount:     lot.amount,
+			ExpireTime: lot.expireTime,
+		})
+	}
+
+	return res, nil
+}
+
+// GetBySender returns requests of the sender, the latest first.
+func (r *TransferRequestRepository) GetBySender(ctx context.Context, senderID int64) ([]model.TransferRequest, error) {
+	requests := r.selectTransferRequests(ctx, func(request transferRequest) bool {
+		return request.senderID == senderID
+	})
+	slices.Reverse(requests)
+
+	return requests, nil
+}
+
+// GetByStatus returns requests of the kind in status, the oldest first.
+func (r *TransferRequestRepository) GetByStatus(
+	ctx context.Context,
+	kind model.TransferRequestKind,
+	status model.TransferRequestStatus,
+) ([]model.TransferRequest, error) {
+	return r.selectTransferRequests(ctx, func(request transferRequest) bool {
+		return request.kind == string(kind) && request.status == string(status)
+	}), nil
+}
+
+// GetPendingByEmployee returns pending requests the employee sends or receives, the oldest first.
+func (r *TransferRequestRepository) GetPendingByEmployee(ctx context.Context, employeeID int64) ([]model.TransferRequest, error) {
+	return r.selectTransferRequests(ctx, func(request transferRequest) bool {
+		return request.status == string(model.TransferRequestStatusPending) &&
+			(request.senderID == employeeID || request.receiverID == employeeID)
+	}), nil
+}
+
+// GetExpiredIDs returns pending requests nobody decided on till now.
+func (r *TransferRequestRepository) GetExpiredIDs(ctx context.Context, now time.Time) ([]int64, error) {
+	var expired []transferRequest
+	r.storage.read(ctx, func() {
+		for _, request := range r.storage.transferRequests {
+			if request.status == string(model.TransferRequestStatusPending) && !request.expireTime.After(now) {
+				expired = append(expired, request)
+			}
+		}
+	})
+	slices.SortFunc(expired, func(a, b transferRequest) int {
+		return cmp.Or(a.expireTime.Compare(b.expireTime), cmp.Compare(a.id, b.id))
+	})
+
+	ids := make([]int64, 0, len(expired))
+	for _, request := range expired {
+		ids = append(ids, request.id)
+	}
+
+	return ids, nil
+}
+
+func (r *TransferRequestRepository) GetByIDWithLock(ctx context.Context, requestID int64) (*model.TransferRequest, error) {
+	var res model.TransferRequest
+	err := r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableTransferRequest, requestID)
+		if err != nil {
+			return err
+		}
+
+		request, ok := r.storage.transferRequests[requestID]
+		if !ok {
+			return model.ErrTransferRequestNotFound
+		}
+
+		res, ok = r.convertTransferRequest(request)
+		if !ok {
+			return model.ErrTransferRequestNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Decide sets the final status of the request, decidedBy is zero when nobody decided till the expire time.
+func (r *TransferRequestRepository) Decide(
+	ctx context.Context,
+	requestID int64,
+	status model.TransferRequestStatus,
+	decidedBy int64,
+	decideTime time.Time,
+) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		err := t.lock(tableTransferRequest, requestID)
+		if err != nil {
+			return err
+		}
+
+		request, ok := r.storage.transferRequests[requestID]
+		if !ok {
+			return model.ErrTransferRequestNotFound
+		}
+
+		request.status = string(status)
+		request.decidedBy = nil
+		if decidedBy != 0 {
+			request.decidedBy = &decidedBy
+		}
+		request.decideTime = &decideTime
+		return put(t, tableTransferRequest, r.storage.transferRequests, requestID, request)
+	})
+}
+
+// selectTransferRequests returns requests matching the filter, the oldest first.
+func (r *TransferRequestRepository) selectTransferRequests(ctx context.Context, filter func(request transferRequest) bool) []model.TransferRequest {
+	var requests []transferRequest
+	res := make([]model.TransferRequest, 0)
+	r.storage.read(ctx, func() {
+		for _, request := range r.storage.transferRequests {
+			if filter(request) {
+				requests = append(requests, request)
+			}
+		}
+		slices.SortFunc(requests, func(a, b transferRequest) int {
+			return cmp.Or(a.createTime.Compare(b.createTime), cmp.Compare(a.id, b.id))
+		})
+
+		for _, request := range requests {
+			if converted, ok := r.convertTransferRequest(request); ok {
+				res = append(res, converted)
+			}
+		}
+	})
+
+	return res
+}
+
+// convertTransferRequest joins the request with the sender, the receiver and the decider,
+// it should be called with mu held. It returns false when the sender or the receiver doesn't exist.
+func (r *TransferRequestRepository) convertTransferRequest(request transferRequest) (model.TransferRequest, bool) {
+	sender, okSender := r.storage.employees[request.senderID]
+	receiver, okReceiver := r.storage.employees[request.receiverID]
+	if !okSender || !okReceiver {
+		return model.TransferRequest{}, false
+	}
+
+	var decidedBy int64
+	var decidedByUsername string
+	if request.decidedBy != nil {
+		decidedBy = *request.decidedBy
+		decidedByUsername = r.storage.employees[decidedBy].username
+	}
+
+	return model.TransferRequest{
+		ID:                request.id,
+		Kind:              model.TransferRequestKind(request.kind),
+		SenderID:          request.senderID,
+		SenderUsername:    sender.username,
+		ReceiverID:        request.receiverID,
+		ReceiverUsername:  receiver.username,
+		Amount:            request.amount,
+		Status:            model.TransferRequestStatus(request.status),
+		RequireAcceptance: request.requireAcceptance,
+		ExpireTime:        request.expireTime,
+		DecidedBy:         decidedBy,
+		DecidedByUsername: decidedByUsername,
+		CreateTime:        request.createTime,
+		DecideTime:        request.decideTime,
+	}, true
+}
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type WishlistRepository struct {
+	storage *Storage
+}
+
+func NewWishlistRepository(storage *Storage) (*WishlistRepository, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+
+	return &WishlistRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *WishlistRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.WishlistItem, error) {
+	res := make([]model.WishlistItem, 0)
+	r.storage.read(ctx, func() {
+		var items []wishlistItem
+		for _, item := range r.storage.wishlistItems {
+			if item.employeeID == employeeID {
+				items = append(items, item)
+			}
+		}
+		slices.SortFunc(items, func(a, b wishlistItem) int {
+			return cmp.Or(a.createTime.Compare(b.createTime), cmp.Compare(a.merchID, b.merchID))
+		})
+
+		for _, item := range items {
+			m, ok := r.storage.merches[item.merchID]
+			if !ok {
+				continue
+			}
+			res = append(res, model.WishlistItem{
+				MerchID:    item.merchID,
+				MerchName:  m.name,
+				Price:      m.price,
+				AddedPrice: item.addedPrice,
+			})
+		}
+	})
+
+	return res, nil
+}
+
+// Add saves merch to the wishlist, adding it again keeps the price it was added with.
+func (r *WishlistRepository) Add(ctx context.Context, employeeID, merchID, price int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		key := wishlistKey{employeeID: employeeID, merchID: merchID}
+
+		err := t.lock(tableWishlistItem, key)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := r.storage.wishlistItems[key]; ok {
+			return nil
+		}
+
+		return put(t, tableWishlistItem, r.storage.wishlistItems, key, wishlistItem{
+			employeeID: employeeID,
+			merchID:    merchID,
+			addedPrice: price,
+			createTime: r.storage.now(),
+		})
+	})
+}
+
+func (r *WishlistRepository) Remove(ctx context.Context, employeeID, merchID int64) error {
+	return r.storage.write(ctx, func(t *tx) error {
+		key := wishlistKey{employeeID: employeeID, merchID: merchID}
+
+		removed, err := remove(t, tableWishlistItem, r.storage.wishlistItems, key)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return model.ErrWishlistItemNotFound
+		}
+
+		return nil
+	})
+}
@@ -2,22 +2,22 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
-	"github.com/jmoiron/sqlx"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
 type MerchRepository struct {
-	db     *sqlx.DB
-	getter *trmsqlx.CtxGetter
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
 }
 
-func NewMerchRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*MerchRepository, error) {
+func NewMerchRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*MerchRepository, error) {
 	if db == nil {
 		return nil, errors.New("db is nil")
 	}
@@ -31,21 +31,19 @@ func NewMerchRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*MerchRepositor
 	}, nil
 }
 
-func (r *MerchRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+func (r *MerchRepository) trOrDB(ctx context.Context) trmpgx.Tr {
 	return r.getter.DefaultTrOrDB(ctx, r.db)
 }
 
 func (r *MerchRepository) GetByName(ctx context.Context, name string) (*model.Merch, error) {
-	var merch Merch
-
 	q := "SELECT id, name, price FROM merch WHERE name = $1"
 
-	err := r.trOrDB(ctx).GetContext(ctx, &merch, q, name)
+	merch, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Merch], q, name)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, model.ErrMerchNotFound
 		}
-		return nil, fmt.Errorf("db.GetContext: %w", err)
+		return nil, fmt.Errorf("getRow: %w", err)
 	}
 
 	return &model.Merch{
@@ -54,3 +52,23 @@ func (r *MerchRepository) GetByName(ctx context.Context, name string) (*model.Me
 		Price: merch.Price,
 	}, nil
 }
+
+func (r *MerchRepository) GetByIDs(ctx context.Context, merchIDs []int64) ([]model.Merch, error) {
+	q := "SELECT id, name, price FROM merch WHERE id = ANY($1)"
+
+	merches, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Merch], q, merchIDs)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.Merch, 0, len(merches))
+	for _, merch := range merches {
+		res = append(res, model.Merch{
+			ID:    merch.ID,
+			Name:  merch.Name,
+			Price: merch.Price,
+		})
+	}
+
+	return res, nil
+}
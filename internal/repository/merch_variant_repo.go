@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type MerchVariantRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewMerchVariantRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*MerchVariantRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &MerchVariantRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *MerchVariantRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *MerchVariantRepository) GetByMerch(ctx context.Context, merchID int64) ([]model.MerchVariant, error) {
+	q := `SELECT id, merch_id, size, color, price_delta, stock, active
+		FROM merch_variant
+		WHERE merch_id = $1
+		ORDER BY id`
+
+	variants, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[MerchVariant], q, merchID)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	return convertMerchVariants(variants), nil
+}
+
+func (r *MerchVariantRepository) GetByIDs(ctx context.Context, variantIDs []int64) ([]model.MerchVariant, error) {
+	q := `SELECT id, merch_id, size, color, price_delta, stock, active
+		FROM merch_variant
+		WHERE id = ANY($1)`
+
+	variants, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[MerchVariant], q, variantIDs)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	return convertMerchVariants(variants), nil
+}
+
+// DecreaseStock takes quantity items of the variant from stock, variants with unlimited stock are left as is.
+// It returns model.ErrOutOfStock when less than quantity items are left.
+func (r *MerchVariantRepository) DecreaseStock(ctx context.Context, variantID, quantity int64) error {
+	q := `UPDATE merch_variant SET stock = stock - $2
+		WHERE id = $1 AND (stock IS NULL OR stock >= $2)`
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, variantID, quantity)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrOutOfStock)
+}
+
+// IncreaseStock returns quantity items of the variant to stock, variants with unlimited stock are left as is.
+func (r *MerchVariantRepository) IncreaseStock(ctx context.Context, variantID, quantity int64) error {
+	q := "UPDATE merch_variant SET stock = stock + $2 WHERE id = $1 AND stock IS NOT NULL"
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, variantID, quantity)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func convertMerchVariants(variants []MerchVariant) []model.MerchVariant {
+	res := make([]model.MerchVariant, 0, len(variants))
+	for _, variant := range variants {
+		res = append(res, model.MerchVariant{
+			ID:         variant.ID,
+			MerchID:    variant.MerchID,
+			Size:       variant.Size,
+			Color:      variant.Color,
+			PriceDelta: variant.PriceDelta,
+			Stock:      variant.Stock,
+			Active:     variant.Active,
+		})
+	}
+	return res
+}
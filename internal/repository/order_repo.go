@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const selectOrders = `SELECT o.id, o.employee_id, e.username as employee_username, o.merch_id, merch.name as merch_name,
+		o.variant_id, v.size, v.color, o.quantity, o.price, o.status, o.create_time, o.update_time
+	FROM merch_order o
+	INNER JOIN employee e on e.id = o.employee_id
+	INNER JOIN merch on merch.id = o.merch_id
+	INNER JOIN merch_variant v on v.id = o.variant_id`
+
+type OrderRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewOrderRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*OrderRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &OrderRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *OrderRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Add places an order for quantity items of merch variant bought for price each and returns its id.
+func (r *OrderRepository) Add(ctx context.Context, employeeID, merchID, variantID, quantity, price int64) (int64, error) {
+	q := `INSERT INTO merch_order (employee_id, merch_id, variant_id, quantity, price)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`
+
+	var orderID int64
+	err := r.trOrDB(ctx).QueryRow(ctx, q, employeeID, merchID, variantID, quantity, price).Scan(&orderID)
+	if err != nil {
+		return 0, fmt.Errorf("db.QueryRow: %w", err)
+	}
+
+	return orderID, nil
+}
+
+// AddLots remembers coins the order was paid with.
+func (r *OrderRepository) AddLots(ctx context.Context, orderID int64, parts []model.CoinLotPart) error {
+	q := `INSERT INTO merch_order_lot (order_id, coin_lot_id, amount, expire_time)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, part := range parts {
+		_, err := r.trOrDB(ctx).Exec(ctx, q, orderID, part.LotID, part.Amount, part.ExpireTime)
+		if err != nil {
+			return fmt.Errorf("db.Exec: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetLots returns coins the order was paid with, the earliest expire time first.
+func (r *OrderRepository) GetLots(ctx context.Context, orderID int64) ([]model.CoinLotPart, error) {
+	q := `SELECT coin_lot_id, amount, expire_time
+		FROM merch_order_lot
+		WHERE order_id = $1
+		ORDER BY expire_time, coin_lot_id`
+
+	lots, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[OrderLot], q, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.CoinLotPart, 0, len(lots))
+	for _, lot := range lots {
+		res = append(res, model.CoinLotPart{
+			LotID:      lot.CoinLotID,
+			Amount:     lot.Amount,
+			ExpireTime: lot.ExpireTime,
+		})
+	}
+	return res, nil
+}
+
+// GetByEmployee returns the employee orders, the latest first.
+func (r *OrderRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.Order, error) {
+	q := selectOrders + `
+		WHERE o.employee_id = $1
+		ORDER BY o.create_time DESC, o.id DESC`
+
+	return r.selectOrders(ctx, q, employeeID)
+}
+
+// GetByStatuses returns orders in any of statuses, the oldest first.
+func (r *OrderRepository) GetByStatuses(ctx context.Context, statuses []model.OrderStatus) ([]model.Order, error) {
+	statusStrings := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		statusStrings = append(statusStrings, string(status))
+	}
+
+	q := selectOrders + `
+		WHERE o.status = ANY($1)
+		ORDER BY o.create_time, o.id`
+
+	return r.selectOrders(ctx, q, statusStrings)
+}
+
+func (r *OrderRepository) GetByIDWithLock(ctx context.Context, orderID int64) (*model.Order, error) {
+	q := selectOrders + `
+		WHERE o.id = $1
+		FOR NO KEY UPDATE OF o`
+
+	order, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Order], q, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("getRow: %w", err)
+	}
+
+	res := convertOrder(order)
+	return &res, nil
+}
+
+func (r *OrderRepository) UpdateStatus(ctx context.Context, orderID int64, status model.OrderStatus, updateTime time.Time) error {
+	q := "UPDATE merch_order SET status = $2, update_time = $3 WHERE id = $1"
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, orderID, status, updateTime)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrOrderNotFound)
+}
+
+func (r *OrderRepository) selectOrders(ctx context.Context, q string, args ...any) ([]model.Order, error) {
+	orders, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[Order], q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.Order, 0, len(orders))
+	for _, order := range orders {
+		res = append(res, convertOrder(order))
+	}
+	return res, nil
+}
+
+func convertOrder(order Order) model.Order {
+	return model.Order{
+		ID:               order.ID,
+		EmployeeID:       order.EmployeeID,
+		EmployeeUsername: order.EmployeeUsername,
+		MerchID:          order.MerchID,
+		MerchName:        order.MerchName,
+		VariantID:        order.VariantID,
+		Size:             order.Size,
+		Color:            order.Color,
+		Quantity:         order.Quantity,
+		Price:            order.Price,
+		Status:           model.OrderStatus(order.Status),
+		CreateTime:       order.CreateTime,
+		UpdateTime:       order.UpdateTime,
+	}
+}
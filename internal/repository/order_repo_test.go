@@ -0,0 +1,91 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func Test_Order(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewOrderRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	const employeeID = 390320
+
+	_, err = db.Exec(context.Background(), `DELETE FROM employee where id = $1`, employeeID)
+	require.NoError(t, err)
+	_, err = db.Exec(context.Background(),
+		`DELETE FROM merch_order_lot where order_id IN (SELECT id FROM merch_order where employee_id = $1)`, employeeID)
+	require.NoError(t, err)
+	_, err = db.Exec(context.Background(), `DELETE FROM merch_order where employee_id = $1`, employeeID)
+	require.NoError(t, err)
+	_, err = db.Exec(context.Background(), `INSERT INTO employee (id, username, password, balance) VALUES ($1, 'order-owner', 'password', 0)`,
+		employeeID)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// cup has only the default variant with id = merch id
+	orderID, err := repo.Add(ctx, employeeID, 2, 2, 3, 20)
+	require.NoError(t, err)
+
+	orders, err := repo.GetByEmployee(ctx, employeeID)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	order := orders[0]
+	require.Equal(t, orderID, order.ID)
+	require.Equal(t, "order-owner", order.EmployeeUsername)
+	require.Equal(t, "cup", order.MerchName)
+	require.Equal(t, int64(3), order.Quantity)
+	require.Equal(t, int64(60), order.Amount())
+	require.Equal(t, model.OrderStatusPlaced, order.Status)
+
+	updateTime := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.UpdateStatus(ctx, order.ID, model.OrderStatusReadyForPickup, updateTime))
+
+	locked, err := repo.GetByIDWithLock(ctx, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.OrderStatusReadyForPickup, locked.Status)
+	require.True(t, updateTime.Equal(locked.UpdateTime))
+
+	open, err := repo.GetByStatuses(ctx, model.OpenOrderStatuses)
+	require.NoError(t, err)
+	require.Contains(t, orderIDs(open), order.ID)
+
+	delivered, err := repo.GetByStatuses(ctx, []model.OrderStatus{model.OrderStatusDelivered})
+	require.NoError(t, err)
+	require.NotContains(t, orderIDs(delivered), order.ID)
+
+	expireTime := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	parts := []model.CoinLotPart{
+		{LotID: 2, Amount: 40, ExpireTime: expireTime.AddDate(0, 1, 0)},
+		{LotID: 1, Amount: 20, ExpireTime: expireTime},
+	}
+	require.NoError(t, repo.AddLots(ctx, order.ID, parts))
+
+	lots, err := repo.GetLots(ctx, order.ID)
+	require.NoError(t, err)
+	require.Len(t, lots, 2)
+	require.Equal(t, int64(1), lots[0].LotID)
+	require.True(t, expireTime.Equal(lots[0].ExpireTime))
+	require.Equal(t, int64(40), lots[1].Amount)
+
+	_, err = repo.GetByIDWithLock(ctx, -1)
+	require.ErrorIs(t, err, model.ErrOrderNotFound)
+}
+
+func orderIDs(orders []model.Order) []int64 {
+	ids := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+	return ids
+}
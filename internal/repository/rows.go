@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// selectRows runs the query and scans every row with scan, e.g. pgx.RowToStructByNameLax for entities
+// (struct fields without a column are left zero) or pgx.RowTo for a single column.
+func selectRows[T any](ctx context.Context, tr trmpgx.Tr, scan pgx.RowToFunc[T], q string, args ...any) ([]T, error) {
+	rows, err := tr.Query(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("tr.Query: %w", err)
+	}
+
+	res, err := pgx.CollectRows(rows, scan)
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	return res, nil
+}
+
+// getRow runs the query and scans its first row with scan, pgx.ErrNoRows is returned when there are no rows.
+func getRow[T any](ctx context.Context, tr trmpgx.Tr, scan pgx.RowToFunc[T], q string, args ...any) (T, error) {
+	rows, err := tr.Query(ctx, q, args...)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("tr.Query: %w", err)
+	}
+
+	res, err := pgx.CollectOneRow(rows, scan)
+	if err != nil {
+		return res, fmt.Errorf("pgx.CollectOneRow: %w", err)
+	}
+
+	return res, nil
+}
+
+// checkAffected returns notFoundErr if the statement didn't touch any row.
+func checkAffected(tag pgconn.CommandTag, notFoundErr error) error {
+	if tag.RowsAffected() == 0 {
+		return notFoundErr
+	}
+
+	return nil
+}
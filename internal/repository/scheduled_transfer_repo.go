@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const scheduledTransferColumns = `st.id, st.owner_id, st.receiver_id, e.username as receiver_username, st.amount,
+	st.cron_expression, st.next_run_time, st.is_active`
+
+type ScheduledTransferRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewScheduledTransferRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*ScheduledTransferRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &ScheduledTransferRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *ScheduledTransferRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *ScheduledTransferRepository) Create(ctx context.Context, st model.ScheduledTransfer) (*model.ScheduledTransfer, error) {
+	q := `INSERT INTO scheduled_transfer (owner_id, receiver_id, amount, cron_expression, next_run_time, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`
+
+	err := r.trOrDB(ctx).QueryRow(ctx, q,
+		st.OwnerID, st.ReceiverID, st.Amount, st.CronExpression, st.NextRunTime, st.IsActive).Scan(&st.ID)
+	if err != nil {
+		return nil, fmt.Errorf("db.QueryRow: %w", err)
+	}
+
+	return &st, nil
+}
+
+func (r *ScheduledTransferRepository) GetByID(ctx context.Context, id int64) (*model.ScheduledTransfer, error) {
+	q := `SELECT ` + scheduledTransferColumns + `
+		FROM scheduled_transfer st
+		INNER JOIN employee e on e.id = st.receiver_id
+		WHERE st.id = $1`
+
+	st, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[ScheduledTransfer], q, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrScheduledTransferNotFound
+		}
+		return nil, fmt.Errorf("getRow: %w", err)
+	}
+
+	return convertScheduledTransfer(st), nil
+}
+
+func (r *ScheduledTransferRepository) GetByOwner(ctx context.Context, ownerID int64) ([]model.ScheduledTransfer, error) {
+	q := `SELECT ` + scheduledTransferColumns + `
+		FROM scheduled_transfer st
+		INNER JOIN employee e on e.id = st.receiver_id
+		WHERE st.owner_id = $1
+		ORDER BY st.id`
+
+	scheduledTransfers, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[ScheduledTransfer], q, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.ScheduledTransfer, 0, len(scheduledTransfers))
+	for _, st := range scheduledTransfers {
+		res = append(res, *convertScheduledTransfer(st))
+	}
+
+	return res, nil
+}
+
+// GetDueWithLock returns one active scheduled transfer with next_run_time not after now and locks it.
+// Rows already locked by other server replicas are skipped, so every due run is executed by exactly one replica.
+func (r *ScheduledTransferRepository) GetDueWithLock(ctx context.Context, now time.Time) (*model.ScheduledTransfer, error) {
+	q := `SELECT ` + scheduledTransferColumns + `
+		FROM scheduled_transfer st
+		INNER JOIN employee e on e.id = st.receiver_id
+		WHERE st.is_active AND st.next_run_time <= $1
+		ORDER BY st.next_run_time
+		LIMIT 1
+		FOR UPDATE OF st SKIP LOCKED`
+
+	st, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[ScheduledTransfer], q, now)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrScheduledTransferNotFound
+		}
+		return nil, fmt.Errorf("getRow: %w", err)
+	}
+
+	return convertScheduledTransfer(st), nil
+}
+
+func (r *ScheduledTransferRepository) Update(ctx context.Context, st model.ScheduledTransfer) error {
+	q := `UPDATE scheduled_transfer SET
+			receiver_id = $3, amount = $4, cron_expression = $5, next_run_time = $6, is_active = $7
+		WHERE id = $1 AND owner_id = $2`
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q,
+		st.ID, st.OwnerID, st.ReceiverID, st.Amount, st.CronExpression, st.NextRunTime, st.IsActive)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrScheduledTransferNotFound)
+}
+
+func (r *ScheduledTransferRepository) SetNextRunTime(ctx context.Context, id int64, nextRunTime *time.Time, isActive bool) error {
+	q := "UPDATE scheduled_transfer SET next_run_time = $2, is_active = $3 WHERE id = $1"
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, id, nextRunTime, isActive)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ScheduledTransferRepository) Delete(ctx context.Context, id, ownerID int64) error {
+	q := "DELETE FROM scheduled_transfer WHERE id = $1 AND owner_id = $2"
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrScheduledTransferNotFound)
+}
+
+func convertScheduledTransfer(st ScheduledTransfer) *model.ScheduledTransfer {
+	return &model.ScheduledTransfer{
+		ID:               st.ID,
+		OwnerID:          st.OwnerID,
+		ReceiverID:       st.ReceiverID,
+		ReceiverUsername: st.ReceiverUsername,
+		Amount:           st.Amount,
+		CronExpression:   st.CronExpression,
+		NextRunTime:      st.NextRunTime,
+		IsActive:         st.IsActive,
+	}
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type ScheduledTransferRunRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewScheduledTransferRunRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*ScheduledTransferRunRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &ScheduledTransferRunRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *ScheduledTransferRunRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *ScheduledTransferRunRepository) Add(ctx context.Context, run model.ScheduledTransferRun) error {
+	q := `INSERT INTO scheduled_transfer_run (scheduled_transfer_id, run_time, status, error)
+		VALUES ($1, $2, $3, $4)`
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, run.ScheduledTransferID, run.RunTime, string(run.Status), run.Error)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetLastByScheduledTransfer returns up to limit latest runs, newest first.
+func (r *ScheduledTransferRunRepository) GetLastByScheduledTransfer(
+	ctx context.Context,
+	scheduledTransferID int64,
+	limit int,
+) ([]model.ScheduledTransferRun, error) {
+	q := `SELECT id, scheduled_transfer_id, run_time, status, error
+		FROM scheduled_transfer_run
+		WHERE scheduled_transfer_id = $1
+		ORDER BY id DESC
+		LIMIT $2`
+
+	runs, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[ScheduledTransferRun], q, scheduledTransferID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.ScheduledTransferRun, 0, len(runs))
+	for _, run := range runs {
+		res = append(res, model.ScheduledTransferRun{
+			ID:                  run.ID,
+			ScheduledTransferID: run.ScheduledTransferID,
+			RunTime:             run.RunTime,
+			Status:              model.ScheduledTransferRunStatus(run.Status),
+			Error:               run.Error,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// StatsRepository reads leaderboards from materialized views aggregated by UTC day,
+// so results lag behind until the next Refresh.
+type StatsRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewStatsRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*StatsRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &StatsRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *StatsRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// GetTopReceivers returns employees who received the most coins from day from to day to (exclusive).
+// Employees hidden from stats are skipped.
+func (r *StatsRepository) GetTopReceivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error) {
+	q := `SELECT e.username, sum(s.received_amount) as amount, sum(s.received_count) as count
+		FROM employee_transfer_stats_daily s
+		INNER JOIN employee e on e.id = s.employee_id
+		WHERE s.day >= $1 AND s.day < $2 AND s.received_count > 0 AND NOT e.hidden_from_stats
+		GROUP BY e.username
+		ORDER BY amount DESC, e.username
+		LIMIT $3`
+
+	return r.getTopEmployees(ctx, q, from, to, limit)
+}
+
+// GetTopGivers returns employees who sent the most coins from day from to day to (exclusive).
+// Employees hidden from stats are skipped.
+func (r *StatsRepository) GetTopGivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error) {
+	q := `SELECT e.username, sum(s.sent_amount) as amount, sum(s.sent_count) as count
+		FROM employee_transfer_stats_daily s
+		INNER JOIN employee e on e.id = s.employee_id
+		WHERE s.day >= $1 AND s.day < $2 AND s.sent_count > 0 AND NOT e.hidden_from_stats
+		GROUP BY e.username
+		ORDER BY amount DESC, e.username
+		LIMIT $3`
+
+	return r.getTopEmployees(ctx, q, from, to, limit)
+}
+
+func (r *StatsRepository) getTopEmployees(
+	ctx context.Context,
+	q string,
+	from, to time.Time,
+	limit int,
+) ([]model.LeaderboardEmployee, error) {
+	entries, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[LeaderboardEmployee], q, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.LeaderboardEmployee, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, model.LeaderboardEmployee{
+			Username: entry.Username,
+			Amount:   entry.Amount,
+			Count:    entry.Count,
+		})
+	}
+
+	return res, nil
+}
+
+// GetTopMerch returns the most purchased merch from day from to day to (exclusive).
+func (r *StatsRepository) GetTopMerch(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardMerch, error) {
+	q := `SELECT m.name as merch_name, sum(s.quantity) as quantity
+		FROM merch_stats_daily s
+		INNER JOIN merch m on m.id = s.merch_id
+		WHERE s.day >= $1 AND s.day < $2
+		GROUP BY m.name
+		ORDER BY quantity DESC, m.name
+		LIMIT $3`
+
+	entries, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[LeaderboardMerch], q, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.LeaderboardMerch, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, model.LeaderboardMerch{
+			MerchName: entry.MerchName,
+			Quantity:  entry.Quantity,
+		})
+	}
+
+	return res, nil
+}
+
+// Refresh recalculates the aggregates without blocking readers.
+func (r *StatsRepository) Refresh(ctx context.Context) error {
+	queries := []string{
+		"REFRESH MATERIALIZED VIEW CONCURRENTLY employee_transfer_stats_daily",
+		"REFRESH MATERIALIZED VIEW CONCURRENTLY merch_stats_daily",
+	}
+	for _, q := range queries {
+		_, err := r.trOrDB(ctx).Exec(ctx, q)
+		if err != nil {
+			return fmt.Errorf("db.Exec: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func Test_Stats(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewStatsRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	const senderID, receiverID, hiddenID = 390301, 390302, 390303
+	day := time.Date(2001, 2, 3, 0, 0, 0, 0, time.UTC) // far from other tests data
+
+	for _, id := range []int64{senderID, receiverID, hiddenID} {
+		_, err = db.Exec(ctx, `DELETE FROM employee where id = $1`, id)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `DELETE FROM transaction where sender_id = $1 or receiver_id = $1`, id)
+		require.NoError(t, err)
+	}
+	_, err = db.Exec(ctx, `INSERT INTO employee (id, username, password, balance, hidden_from_stats)
+		VALUES ($1, 'stats-sender', 'password', 0, false),
+			($2, 'stats-receiver', 'password', 0, false),
+			($3, 'stats-hidden', 'password', 0, true)`, senderID, receiverID, hiddenID)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO transaction (sender_id, receiver_id, amount, transaction_time)
+		VALUES ($1, $2, 100, $4), ($1, $2, 50, $4), ($1, $3, 500, $4)`,
+		senderID, receiverID, hiddenID, day.Add(time.Hour))
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Refresh(ctx))
+
+	receivers, err := repo.GetTopReceivers(ctx, day, day.AddDate(0, 0, 1), 10)
+	require.NoError(t, err)
+	require.Equal(t, []model.LeaderboardEmployee{{Username: "stats-receiver", Amount: 150, Count: 2}}, receivers)
+
+	givers, err := repo.GetTopGivers(ctx, day, day.AddDate(0, 0, 1), 10)
+	require.NoError(t, err)
+	require.Equal(t, []model.LeaderboardEmployee{{Username: "stats-sender", Amount: 650, Count: 3}}, givers)
+
+	receivers, err = repo.GetTopReceivers(ctx, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2), 10)
+	require.NoError(t, err)
+	require.Empty(t, receivers)
+}
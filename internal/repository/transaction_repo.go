@@ -4,19 +4,49 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
-	"github.com/jmoiron/sqlx"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
+// selectEmployeeTransactions selects transactions the employee $1 sent or received
+const selectEmployeeTransactions = `SELECT t.id, true as is_sender, t.receiver_id as counterparty_employee_id, e.username as counterparty_username, t.amount
+		FROM transaction t
+		INNER JOIN employee e on e.id = t.receiver_id
+		WHERE t.sender_id = $1
+		UNION
+		SELECT t.id, false as is_sender, t.sender_id as counterparty_employee_id, e.username as counterparty_username, t.amount
+		FROM transaction t
+		INNER JOIN employee e on e.id = t.sender_id
+		WHERE t.receiver_id = $1
+		ORDER BY id
+	`
+
+// selectCounterpartyTotals sums transactions the employee $1 sent or received per counterparty, the largest first
+const selectCounterpartyTotals = `SELECT true as is_sender, t.receiver_id as counterparty_employee_id, e.username as counterparty_username, SUM(t.amount) as amount
+		FROM transaction t
+		INNER JOIN employee e on e.id = t.receiver_id
+		WHERE t.sender_id = $1
+		GROUP BY t.receiver_id, e.username
+		UNION ALL
+		SELECT false as is_sender, t.sender_id as counterparty_employee_id, e.username as counterparty_username, SUM(t.amount) as amount
+		FROM transaction t
+		INNER JOIN employee e on e.id = t.sender_id
+		WHERE t.receiver_id = $1
+		GROUP BY t.sender_id, e.username
+		ORDER BY amount DESC, counterparty_employee_id, is_sender
+	`
+
 type TransactionRepository struct {
-	db     *sqlx.DB
-	getter *trmsqlx.CtxGetter
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
 }
 
-func NewTransactionRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*TransactionRepository, error) {
+func NewTransactionRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*TransactionRepository, error) {
 	if db == nil {
 		return nil, errors.New("db is nil")
 	}
@@ -30,30 +60,78 @@ func NewTransactionRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*Transact
 	}, nil
 }
 
-func (r *TransactionRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+func (r *TransactionRepository) trOrDB(ctx context.Context) trmpgx.Tr {
 	return r.getter.DefaultTrOrDB(ctx, r.db)
 }
 
 func (r *TransactionRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error) {
-	var transactions []EmployeeTransaction
+	transactions, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[EmployeeTransaction],
+		selectEmployeeTransactions, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
 
-	q := `SELECT t.id, true as is_sender, t.receiver_id as counterparty_employee_id, e.username as counterparty_username, t.amount
-		FROM transaction t
-		INNER JOIN employee e on e.id = t.receiver_id
-		WHERE t.sender_id = $1
-		UNION
-		SELECT t.id, false as is_sender, t.sender_id as counterparty_employee_id, e.username as counterparty_username, t.amount
-		FROM transaction t
-		INNER JOIN employee e on e.id = t.sender_id
-		WHERE t.receiver_id = $1
-		ORDER BY id
-	`
+	return convertTransactions(transactions), nil
+}
 
-	err := r.trOrDB(ctx).SelectContext(ctx, &transactions, q, employeeID)
+// GetTotalsByEmployee returns a transaction per counterparty and direction with the summed amount.
+func (r *TransactionRepository) GetTotalsByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error) {
+	transactions, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[EmployeeTransaction],
+		selectCounterpartyTotals, employeeID)
 	if err != nil {
-		return nil, fmt.Errorf("db.SelectContext: %w", err)
+		return nil, fmt.Errorf("selectRows: %w", err)
 	}
 
+	return convertTransactions(transactions), nil
+}
+
+func (r *TransactionRepository) Add(ctx context.Context, senderID, receiverID, amount int64) error {
+	q := "INSERT INTO transaction (sender_id, receiver_id, amount) VALUES ($1, $2, $3)"
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, senderID, receiverID, amount)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetSentAmountSince sums transactions the sender made since the time and coins held by pending transfer requests
+// of the sender. Held coins count whatever the request create time, they are sent when the request is settled.
+func (r *TransactionRepository) GetSentAmountSince(ctx context.Context, senderID int64, since time.Time) (int64, error) {
+	q := `SELECT coalesce(sum(amount), 0) FROM (
+			SELECT amount FROM transaction WHERE sender_id = $1 AND transaction_time >= $2
+			UNION ALL
+			SELECT amount FROM transfer_request WHERE sender_id = $1 AND status = $3
+		) sent`
+
+	amount, err := getRow(ctx, r.trOrDB(ctx), pgx.RowTo[int64], q, senderID, since, model.TransferRequestStatusPending)
+	if err != nil {
+		return 0, fmt.Errorf("getRow: %w", err)
+	}
+
+	return amount, nil
+}
+
+// GetLastSentTime returns the time of the last transaction to the receiver or of the last pending transfer request
+// to the receiver, whichever is later, nil if there is none.
+func (r *TransactionRepository) GetLastSentTime(ctx context.Context, senderID, receiverID int64) (*time.Time, error) {
+	q := `SELECT max(sent_time) FROM (
+			SELECT max(transaction_time) AS sent_time FROM transaction WHERE sender_id = $1 AND receiver_id = $2
+			UNION ALL
+			SELECT max(create_time) FROM transfer_request WHERE sender_id = $1 AND receiver_id = $2 AND status = $3
+		) sent`
+
+	lastSentTime, err := getRow(ctx, r.trOrDB(ctx), pgx.RowTo[*time.Time], q, senderID, receiverID,
+		model.TransferRequestStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("getRow: %w", err)
+	}
+
+	return lastSentTime, nil
+}
+
+func convertTransactions(transactions []EmployeeTransaction) []model.Transaction {
 	res := make([]model.Transaction, 0, len(transactions))
 	for _, transaction := range transactions {
 		res = append(res, model.Transaction{
@@ -64,16 +142,5 @@ func (r *TransactionRepository) GetByEmployee(ctx context.Context, employeeID in
 		})
 	}
 
-	return res, nil
-}
-
-func (r *TransactionRepository) Add(ctx context.Context, senderID, receiverID, amount int64) error {
-	q := "INSERT INTO transaction (sender_id, receiver_id, amount) VALUES ($1, $2, $3)"
-
-	_, err := r.trOrDB(ctx).ExecContext(ctx, q, senderID, receiverID, amount)
-	if err != nil {
-		return fmt.Errorf("db.ExecContext: %w", err)
-	}
-
-	return nil
+	return res
 }
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const selectTransferRequests = `SELECT tr.id, tr.kind, tr.sender_id, s.username as sender_username,
+		tr.receiver_id, r.username as receiver_username, tr.amount, tr.status, tr.require_acceptance, tr.expire_time,
+		tr.decided_by, COALESCE(d.username, '') as decided_by_username, tr.create_time, tr.decide_time
+	FROM transfer_request tr
+	INNER JOIN employee s on s.id = tr.sender_id
+	INNER JOIN employee r on r.id = tr.receiver_id
+	LEFT JOIN employee d on d.id = tr.decided_by`
+
+// selectPendingTransferRequests selects requests in status $2 the employee $1 sends or receives, the oldest first
+const selectPendingTransferRequests = selectTransferRequests + `
+		WHERE tr.status = $2 AND (tr.sender_id = $1 OR tr.receiver_id = $1)
+		ORDER BY tr.create_time, tr.id`
+
+type TransferRequestRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewTransferRequestRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*TransferRequestRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &TransferRequestRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *TransferRequestRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Create saves a pending request, ID of the returned request is filled by the database.
+func (r *TransferRequestRepository) Create(ctx context.Context, request model.TransferRequest) (*model.TransferRequest, error) {
+	q := `INSERT INTO transfer_request
+			(kind, sender_id, receiver_id, amount, status, require_acceptance, expire_time, create_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id`
+
+	err := r.trOrDB(ctx).QueryRow(ctx, q, request.Kind, request.SenderID, request.ReceiverID,
+		request.Amount, request.Status, request.RequireAcceptance, request.ExpireTime, request.CreateTime).Scan(&request.ID)
+	if err != nil {
+		return nil, fmt.Errorf("db.QueryRow: %w", err)
+	}
+
+	return &request, nil
+}
+
+// AddLots remembers coins held by the request.
+func (r *TransferRequestRepository) AddLots(ctx context.Context, requestID int64, parts []model.CoinLotPart) error {
+	q := `INSERT INTO transfer_request_lot (transfer_request_id, coin_lot_id, amount, expire_time)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, part := range parts {
+		_, err := r.trOrDB(ctx).Exec(ctx, q, requestID, part.LotID, part.Amount, part.ExpireTime)
+		if err != nil {
+			return fmt.Errorf("db.Exec: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// MoveLots hands coins held by one request over to another one.
+func (r *TransferRequestRepository) MoveLots(ctx context.Context, fromRequestID, toRequestID int64) error {
+	q := "UPDATE transfer_request_lot SET transfer_request_id = $2 WHERE transfer_request_id = $1"
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, fromRequestID, toRequestID)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetLots returns coins held by the request, the earliest expire time first.
+func (r *TransferRequestRepository) GetLots(ctx context.Context, requestID int64) ([]model.CoinLotPart, error) {
+	q := `SELECT coin_lot_id, amount, expire_time
+		FROM transfer_request_lot
+		WHERE transfer_request_id = $1
+		ORDER BY expire_time, coin_lot_id`
+
+	lots, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[TransferRequestLot], q, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.CoinLotPart, 0, len(lots))
+	for _, lot := range lots {
+		res = append(res, model.CoinLotPart{
+			LotID:      lot.CoinLotID,
+			Amount:     lot.Amount,
+			ExpireTime: lot.ExpireTime,
+		})
+	}
+	return res, nil
+}
+
+// GetBySender returns requests of the sender, the latest first.
+func (r *TransferRequestRepository) GetBySender(ctx context.Context, senderID int64) ([]model.TransferRequest, error) {
+	q := selectTransferRequests + `
+		WHERE tr.sender_id = $1
+		ORDER BY tr.create_time DESC, tr.id DESC`
+
+	return r.selectTransferRequests(ctx, q, senderID)
+}
+
+// GetByStatus returns requests of the kind in status, the oldest first.
+func (r *TransferRequestRepository) GetByStatus(
+	ctx context.Context,
+	kind model.TransferRequestKind,
+	status model.TransferRequestStatus,
+) ([]model.TransferRequest, error) {
+	q := selectTransferRequests + `
+		WHERE tr.kind = $1 AND tr.status = $2
+		ORDER BY tr.create_time, tr.id`
+
+	return r.selectTransferRequests(ctx, q, kind, status)
+}
+
+// GetPendingByEmployee returns pending requests the employee sends or receives, the oldest first.
+func (r *TransferRequestRepository) GetPendingByEmployee(ctx context.Context, employeeID int64) ([]model.TransferRequest, error) {
+	return r.selectTransferRequests(ctx, selectPendingTransferRequests, employeeID, model.TransferRequestStatusPending)
+}
+
+// GetExpiredIDs returns pending requests nobody decided on till now.
+func (r *TransferRequestRepository) GetExpiredIDs(ctx context.Context, now time.Time) ([]int64, error) {
+	q := `SELECT id FROM transfer_request
+		WHERE status = $1 AND expire_time <= $2
+		ORDER BY expire_time, id`
+
+	ids, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowTo[int64], q, model.TransferRequestStatusPending, now)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *TransferRequestRepository) GetByIDWithLock(ctx context.Context, requestID int64) (*model.TransferRequest, error) {
+	q := selectTransferRequests + `
+		WHERE tr.id = $1
+		FOR NO KEY UPDATE OF tr`
+
+	request, err := getRow(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[TransferRequest], q, requestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrTransferRequestNotFound
+		}
+		return nil, fmt.Errorf("getRow: %w", err)
+	}
+
+	res := convertTransferRequest(request)
+	return &res, nil
+}
+
+// Decide sets the final status of the request, decidedBy is zero when nobody decided till the expire time.
+func (r *TransferRequestRepository) Decide(
+	ctx context.Context,
+	requestID int64,
+	status model.TransferRequestStatus,
+	decidedBy int64,
+	decideTime time.Time,
+) error {
+	q := "UPDATE transfer_request SET status = $2, decided_by = NULLIF($3, 0), decide_time = $4 WHERE id = $1"
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, requestID, status, decidedBy, decideTime)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrTransferRequestNotFound)
+}
+
+func (r *TransferRequestRepository) selectTransferRequests(ctx context.Context, q string, args ...any) ([]model.TransferRequest, error) {
+	requests, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[TransferRequest], q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	return convertTransferRequests(requests), nil
+}
+
+func convertTransferRequests(requests []TransferRequest) []model.TransferRequest {
+	res := make([]model.TransferRequest, 0, len(requests))
+	for _, request := range requests {
+		res = append(res, convertTransferRequest(request))
+	}
+	return res
+}
+
+func convertTransferRequest(request TransferRequest) model.TransferRequest {
+	var decidedBy int64
+	if request.DecidedBy != nil {
+		decidedBy = *request.DecidedBy
+	}
+
+	return model.TransferRequest{
+		ID:                request.ID,
+		Kind:              model.TransferRequestKind(request.Kind),
+		SenderID:          request.SenderID,
+		SenderUsername:    request.SenderUsername,
+		ReceiverID:        request.ReceiverID,
+		ReceiverUsername:  request.ReceiverUsername,
+		Amount:            request.Amount,
+		Status:            model.TransferRequestStatus(request.Status),
+		RequireAcceptance: request.RequireAcceptance,
+		ExpireTime:        request.ExpireTime,
+		DecidedBy:         decidedBy,
+		DecidedByUsername: request.DecidedByUsername,
+		CreateTime:        request.CreateTime,
+		DecideTime:        request.DecideTime,
+	}
+}
@@ -0,0 +1,166 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func Test_TransferRequest(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewTransferRequestRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	const (
+		senderID   = 390321
+		receiverID = 390322
+		approverID = 390323
+	)
+
+	_, err = db.Exec(context.Background(), `DELETE FROM transfer_request_lot where transfer_request_id in
+		(select id from transfer_request where sender_id = $1)`, senderID)
+	require.NoError(t, err)
+	_, err = db.Exec(context.Background(), `DELETE FROM transfer_request where sender_id = $1`, senderID)
+	require.NoError(t, err)
+	_, err = db.Exec(context.Background(), `DELETE FROM employee where id in ($1, $2, $3)`, senderID, receiverID, approverID)
+	require.NoError(t, err)
+	_, err = db.Exec(context.Background(), `INSERT INTO employee (id, username, password, balance) VALUES
+		($1, 'request-sender', 'password', 0),
+		($2, 'request-receiver', 'password', 0),
+		($3, 'request-approver', 'password', 0)`, senderID, receiverID, approverID)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	expireTime := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	laterExpireTime := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	request, err := repo.Create(ctx, model.TransferRequest{
+		Kind:              model.TransferRequestKindApproval,
+		SenderID:          senderID,
+		ReceiverID:        receiverID,
+		Amount:            700,
+		Status:            model.TransferRequestStatusPending,
+		RequireAcceptance: true,
+		ExpireTime:        now.Add(time.Hour),
+		CreateTime:        now,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, request.ID)
+
+	parts := []model.CoinLotPart{
+		{LotID: 2, Amount: 300, ExpireTime: laterExpireTime},
+		{LotID: 1, Amount: 400, ExpireTime: expireTime},
+	}
+	require.NoError(t, repo.AddLots(ctx, request.ID, parts))
+
+	lots, err := repo.GetLots(ctx, request.ID)
+	require.NoError(t, err)
+	require.Len(t, lots, 2)
+	require.Equal(t, int64(1), lots[0].LotID)
+	require.True(t, expireTime.Equal(lots[0].ExpireTime))
+	require.Equal(t, int64(300), lots[1].Amount)
+
+	expiredIDs, err := repo.GetExpiredIDs(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Contains(t, expiredIDs, request.ID)
+
+	expiredIDs, err = repo.GetExpiredIDs(ctx, now)
+	require.NoError(t, err)
+	require.NotContains(t, expiredIDs, request.ID)
+
+	requests, err := repo.GetBySender(ctx, senderID)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, "request-sender", requests[0].SenderUsername)
+	require.Equal(t, "request-receiver", requests[0].ReceiverUsername)
+	require.Equal(t, model.TransferRequestStatusPending, requests[0].Status)
+	require.Equal(t, model.TransferRequestKindApproval, requests[0].Kind)
+	require.True(t, requests[0].RequireAcceptance)
+	require.Zero(t, requests[0].DecidedBy)
+	require.Empty(t, requests[0].DecidedByUsername)
+	require.Nil(t, requests[0].DecideTime)
+
+	require.NoError(t, repo.Decide(ctx, request.ID, model.TransferRequestStatusApproved, approverID, now))
+
+	locked, err := repo.GetByIDWithLock(ctx, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.TransferRequestStatusApproved, locked.Status)
+	require.Equal(t, int64(approverID), locked.DecidedBy)
+	require.Equal(t, "request-approver", locked.DecidedByUsername)
+	require.True(t, now.Equal(*locked.DecideTime))
+
+	pending, err := repo.GetByStatus(ctx, model.TransferRequestKindApproval, model.TransferRequestStatusPending)
+	require.NoError(t, err)
+	for _, p := range pending {
+		require.NotEqual(t, request.ID, p.ID)
+	}
+
+	acceptance, err := repo.Create(ctx, model.TransferRequest{
+		Kind:       model.TransferRequestKindAcceptance,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		Amount:     700,
+		Status:     model.TransferRequestStatusPending,
+		ExpireTime: now.Add(time.Hour),
+		CreateTime: now,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.MoveLots(ctx, request.ID, acceptance.ID))
+
+	lots, err = repo.GetLots(ctx, request.ID)
+	require.NoError(t, err)
+	require.Empty(t, lots)
+	lots, err = repo.GetLots(ctx, acceptance.ID)
+	require.NoError(t, err)
+	require.Len(t, lots, 2)
+
+	for _, employeeID := range []int64{senderID, receiverID} {
+		pending, err = repo.GetPendingByEmployee(ctx, employeeID)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, acceptance.ID, pending[0].ID)
+		require.Equal(t, model.TransferRequestKindAcceptance, pending[0].Kind)
+	}
+
+	pending, err = repo.GetByStatus(ctx, model.TransferRequestKindApproval, model.TransferRequestStatusPending)
+	require.NoError(t, err)
+	for _, p := range pending {
+		require.NotEqual(t, acceptance.ID, p.ID)
+	}
+
+	// coins held by the pending request count as sent for transfer policies
+	transactionRepo, err := NewTransactionRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	sent, err := transactionRepo.GetSentAmountSince(ctx, senderID, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(700), sent)
+	lastSentTime, err := transactionRepo.GetLastSentTime(ctx, senderID, receiverID)
+	require.NoError(t, err)
+	require.NotNil(t, lastSentTime)
+	require.True(t, now.Equal(*lastSentTime))
+
+	require.NoError(t, repo.Decide(ctx, acceptance.ID, model.TransferRequestStatusDeclined, receiverID, now))
+
+	sent, err = transactionRepo.GetSentAmountSince(ctx, senderID, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, sent)
+	lastSentTime, err = transactionRepo.GetLastSentTime(ctx, senderID, receiverID)
+	require.NoError(t, err)
+	require.Nil(t, lastSentTime)
+
+	_, err = repo.GetByIDWithLock(ctx, -1)
+	require.ErrorIs(t, err, model.ErrTransferRequestNotFound)
+
+	err = repo.Decide(ctx, -1, model.TransferRequestStatusExpired, 0, now)
+	require.ErrorIs(t, err, model.ErrTransferRequestNotFound)
+}
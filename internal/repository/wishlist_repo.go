@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type WishlistRepository struct {
+	db     *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewWishlistRepository(db *pgxpool.Pool, getter *trmpgx.CtxGetter) (*WishlistRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &WishlistRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *WishlistRepository) trOrDB(ctx context.Context) trmpgx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *WishlistRepository) GetByEmployee(ctx context.Context, employeeID int64) ([]model.WishlistItem, error) {
+	q := `SELECT w.merch_id, merch.name as merch_name, merch.price, w.added_price
+		FROM wishlist_item w
+		INNER JOIN merch on merch.id = w.merch_id
+		WHERE w.employee_id = $1
+		ORDER BY w.create_time, w.merch_id`
+
+	items, err := selectRows(ctx, r.trOrDB(ctx), pgx.RowToStructByNameLax[WishlistItem], q, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("selectRows: %w", err)
+	}
+
+	res := make([]model.WishlistItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, model.WishlistItem{
+			MerchID:    item.MerchID,
+			MerchName:  item.MerchName,
+			Price:      item.Price,
+			AddedPrice: item.AddedPrice,
+		})
+	}
+
+	return res, nil
+}
+
+// Add saves merch to the wishlist, adding it again keeps the price it was added with.
+func (r *WishlistRepository) Add(ctx context.Context, employeeID, merchID, price int64) error {
+	q := `INSERT INTO wishlist_item (employee_id, merch_id, added_price)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (employee_id, merch_id) DO NOTHING`
+
+	_, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, merchID, price)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WishlistRepository) Remove(ctx context.Context, employeeID, merchID int64) error {
+	q := "DELETE FROM wishlist_item WHERE employee_id = $1 AND merch_id = $2"
+
+	res, err := r.trOrDB(ctx).Exec(ctx, q, employeeID, merchID)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return checkAffected(res, model.ErrWishlistItemNotFound)
+}
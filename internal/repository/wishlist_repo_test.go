@@ -0,0 +1,41 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func Test_Wishlist(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewWishlistRepository(db, trmpgx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	const employeeID = 390310
+
+	_, err = db.Exec(context.Background(), `DELETE FROM wishlist_item where employee_id = $1`, employeeID)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// t-shirt was more expensive when added, cup is added twice and keeps the first price
+	require.NoError(t, repo.Add(ctx, employeeID, 1, 100))
+	require.NoError(t, repo.Add(ctx, employeeID, 2, 20))
+	require.NoError(t, repo.Add(ctx, employeeID, 2, 30))
+
+	res, err := repo.GetByEmployee(ctx, employeeID)
+	require.NoError(t, err)
+	require.Equal(t, []model.WishlistItem{
+		{MerchID: 1, MerchName: "t-shirt", Price: 80, AddedPrice: 100},
+		{MerchID: 2, MerchName: "cup", Price: 20, AddedPrice: 20},
+	}, res)
+
+	require.NoError(t, repo.Remove(ctx, employeeID, 1))
+	require.ErrorIs(t, repo.Remove(ctx, employeeID, 1), model.ErrWishlistItemNotFound)
+}
@@ -0,0 +1,31 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package allowance_granting
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type employeeRepo interface {
+	IncreaseBalance(ctx context.Context, employeeID, amount int64) error
+}
+
+type coinLotRepo interface {
+	Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error
+}
+
+type ledgerEntryRepo interface {
+	AddOnce(ctx context.Context, entry model.LedgerEntry) (bool, error)
+	GetEmployeesWithoutEntry(ctx context.Context, kind model.LedgerEntryKind, periodTime time.Time, limit int) ([]int64, error)
+}
+
+type allowanceRepo interface {
+	Get(ctx context.Context) (*model.Allowance, error)
+	AdvanceNextGrantTime(ctx context.Context, periodTime, next time.Time) (bool, error)
+}
@@ -0,0 +1,146 @@
+package allowance_granting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/cron"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const employeesBatchSize = 100
+
+type UseCase struct {
+	trManager       trManager
+	employeeRepo    employeeRepo
+	coinLotRepo     coinLotRepo
+	ledgerEntryRepo ledgerEntryRepo
+	allowanceRepo   allowanceRepo
+}
+
+func New(
+	trManager trManager,
+	employeeRepo employeeRepo,
+	coinLotRepo coinLotRepo,
+	ledgerEntryRepo ledgerEntryRepo,
+	allowanceRepo allowanceRepo,
+) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
+	if ledgerEntryRepo == nil {
+		return nil, errors.New("ledgerEntryRepo is nil")
+	}
+	if allowanceRepo == nil {
+		return nil, errors.New("allowanceRepo is nil")
+	}
+
+	return &UseCase{
+		trManager:       trManager,
+		employeeRepo:    employeeRepo,
+		coinLotRepo:     coinLotRepo,
+		ledgerEntryRepo: ledgerEntryRepo,
+		allowanceRepo:   allowanceRepo,
+	}, nil
+}
+
+// GrantDue grants the allowance for the due period to every employee who hasn't got it yet
+// and returns the number of employees granted. A period is granted at most once per employee:
+// the ledger entry keyed by the period is inserted first, so re-runs and concurrent replicas skip it.
+// Periods missed while the service was down are not granted retroactively.
+func (uc *UseCase) GrantDue(ctx context.Context, now time.Time) (int, error) {
+	allowance, err := uc.allowanceRepo.Get(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("allowanceRepo.Get: %w", err)
+	}
+
+	if !allowance.IsActive || allowance.NextGrantTime == nil || allowance.NextGrantTime.After(now) {
+		return 0, nil
+	}
+	periodTime := *allowance.NextGrantTime
+
+	var granted int
+	for {
+		employeeIDs, err := uc.ledgerEntryRepo.GetEmployeesWithoutEntry(ctx, model.LedgerEntryKindAllowance,
+			periodTime, employeesBatchSize)
+		if err != nil {
+			return granted, fmt.Errorf("ledgerEntryRepo.GetEmployeesWithoutEntry: %w", err)
+		}
+
+		for _, employeeID := range employeeIDs {
+			ok, err := uc.grantEmployee(ctx, employeeID, allowance.Amount, periodTime)
+			if err != nil {
+				return granted, fmt.Errorf("grantEmployee: %w", err)
+			}
+			if ok {
+				granted++
+			}
+		}
+
+		if len(employeeIDs) < employeesBatchSize {
+			break
+		}
+	}
+
+	schedule, err := cron.Parse(allowance.CronExpression)
+	if err != nil {
+		return granted, fmt.Errorf("cron.Parse: %w", err)
+	}
+
+	next, err := schedule.Next(now)
+	if err != nil {
+		return granted, fmt.Errorf("schedule.Next: %w", err)
+	}
+
+	// not advanced means another replica or an admin has already moved the period, both are fine
+	_, err = uc.allowanceRepo.AdvanceNextGrantTime(ctx, periodTime, next)
+	if err != nil {
+		return granted, fmt.Errorf("allowanceRepo.AdvanceNextGrantTime: %w", err)
+	}
+
+	return granted, nil
+}
+
+func (uc *UseCase) grantEmployee(ctx context.Context, employeeID, amount int64, periodTime time.Time) (bool, error) {
+	var granted bool
+	err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		granted, err = uc.ledgerEntryRepo.AddOnce(ctx, model.LedgerEntry{
+			EmployeeID: employeeID,
+			Amount:     amount,
+			Kind:       model.LedgerEntryKindAllowance,
+			PeriodTime: &periodTime,
+		})
+		if err != nil {
+			return fmt.Errorf("ledgerEntryRepo.AddOnce: %w", err)
+		}
+		if !granted {
+			return nil
+		}
+
+		err = uc.employeeRepo.IncreaseBalance(ctx, employeeID, amount)
+		if err != nil {
+			return fmt.Errorf("employeeRepo.IncreaseBalance: %w", err)
+		}
+
+		err = uc.coinLotRepo.Add(ctx, employeeID, amount, model.CoinLotExpireTime(periodTime))
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.Add: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return granted, nil
+}
@@ -0,0 +1,161 @@
+package allowance_granting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_GrantDue(t *testing.T) {
+	type mocks struct {
+		trManager       *MocktrManager
+		employeeRepo    *MockemployeeRepo
+		coinLotRepo     *MockcoinLotRepo
+		ledgerEntryRepo *MockledgerEntryRepo
+		allowanceRepo   *MockallowanceRepo
+	}
+
+	now := time.Date(2025, 3, 1, 0, 1, 0, 0, time.UTC)
+	periodTime := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	nextPeriodTime := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	futureTime := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	expireTime := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	dueAllowance := &model.Allowance{
+		Amount:         200,
+		CronExpression: "0 0 1 * *",
+		IsActive:       true,
+		NextGrantTime:  &periodTime,
+	}
+
+	doTr := func(ctx context.Context, do func(context.Context) error) error {
+		return do(ctx)
+	}
+
+	ledgerEntry := func(employeeID int64) model.LedgerEntry {
+		return model.LedgerEntry{
+			EmployeeID: employeeID,
+			Amount:     200,
+			Kind:       model.LedgerEntryKindAllowance,
+			PeriodTime: &periodTime,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		prepare     func(m *mocks)
+		wantGranted int
+		wantErr     error
+	}{
+		{
+			name: "success.paused",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(&model.Allowance{
+					Amount:         200,
+					CronExpression: "0 0 1 * *",
+					IsActive:       false,
+					NextGrantTime:  &periodTime,
+				}, nil)
+			},
+			wantGranted: 0,
+			wantErr:     nil,
+		},
+		{
+			name: "success.not_due",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(&model.Allowance{
+					Amount:         200,
+					CronExpression: "0 0 1 * *",
+					IsActive:       true,
+					NextGrantTime:  &futureTime,
+				}, nil)
+			},
+			wantGranted: 0,
+			wantErr:     nil,
+		},
+		{
+			name: "success.granted",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(dueAllowance, nil)
+				m.ledgerEntryRepo.EXPECT().
+					GetEmployeesWithoutEntry(gomock.Any(), model.LedgerEntryKindAllowance, periodTime, employeesBatchSize).
+					Return([]int64{100, 200}, nil)
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr).Times(2)
+
+				m.ledgerEntryRepo.EXPECT().AddOnce(gomock.Any(), ledgerEntry(100)).Return(true, nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(200)).Return(nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(200), expireTime).Return(nil)
+
+				// granted by another replica in the meantime
+				m.ledgerEntryRepo.EXPECT().AddOnce(gomock.Any(), ledgerEntry(200)).Return(false, nil)
+
+				m.allowanceRepo.EXPECT().AdvanceNextGrantTime(gomock.Any(), periodTime, nextPeriodTime).Return(true, nil)
+			},
+			wantGranted: 1,
+			wantErr:     nil,
+		},
+		{
+			name: "success.already_advanced",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(dueAllowance, nil)
+				m.ledgerEntryRepo.EXPECT().
+					GetEmployeesWithoutEntry(gomock.Any(), model.LedgerEntryKindAllowance, periodTime, employeesBatchSize).
+					Return(nil, nil)
+				m.allowanceRepo.EXPECT().AdvanceNextGrantTime(gomock.Any(), periodTime, nextPeriodTime).Return(false, nil)
+			},
+			wantGranted: 0,
+			wantErr:     nil,
+		},
+		{
+			name: "error.allowance_repo.get",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantGranted: 0,
+			wantErr:     assert.AnError,
+		},
+		{
+			name: "error.employee_repo.increase_balance",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(dueAllowance, nil)
+				m.ledgerEntryRepo.EXPECT().
+					GetEmployeesWithoutEntry(gomock.Any(), model.LedgerEntryKindAllowance, periodTime, employeesBatchSize).
+					Return([]int64{100}, nil)
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				m.ledgerEntryRepo.EXPECT().AddOnce(gomock.Any(), ledgerEntry(100)).Return(true, nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(200)).Return(assert.AnError)
+			},
+			wantGranted: 0,
+			wantErr:     assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:       NewMocktrManager(ctrl),
+				employeeRepo:    NewMockemployeeRepo(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
+				ledgerEntryRepo: NewMockledgerEntryRepo(ctrl),
+				allowanceRepo:   NewMockallowanceRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.employeeRepo, m.coinLotRepo, m.ledgerEntryRepo, m.allowanceRepo)
+			require.NoError(t, err)
+
+			granted, err := uc.GrantDue(context.Background(), now)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantGranted, granted)
+		})
+	}
+}
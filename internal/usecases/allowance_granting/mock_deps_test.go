@@ -0,0 +1,404 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package allowance_granting -typed -destination mock_deps_test.go
+//
+
+// Package allowance_granting is a generated GoMock package.
+package allowance_granting
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// IncreaseBalance mocks base method.
+func (m *MockemployeeRepo) IncreaseBalance(ctx context.Context, employeeID, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseBalance", ctx, employeeID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseBalance indicates an expected call of IncreaseBalance.
+func (mr *MockemployeeRepoMockRecorder) IncreaseBalance(ctx, employeeID, amount any) *MockemployeeRepoIncreaseBalanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseBalance", reflect.TypeOf((*MockemployeeRepo)(nil).IncreaseBalance), ctx, employeeID, amount)
+	return &MockemployeeRepoIncreaseBalanceCall{Call: call}
+}
+
+// MockemployeeRepoIncreaseBalanceCall wrap *gomock.Call
+type MockemployeeRepoIncreaseBalanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoIncreaseBalanceCall) Return(arg0 error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoIncreaseBalanceCall) Do(f func(context.Context, int64, int64) error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoIncreaseBalanceCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockcoinLotRepo) Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, amount, expireTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockcoinLotRepoMockRecorder) Add(ctx, employeeID, amount, expireTime any) *MockcoinLotRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockcoinLotRepo)(nil).Add), ctx, employeeID, amount, expireTime)
+	return &MockcoinLotRepoAddCall{Call: call}
+}
+
+// MockcoinLotRepoAddCall wrap *gomock.Call
+type MockcoinLotRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoAddCall) Return(arg0 error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoAddCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockledgerEntryRepo is a mock of ledgerEntryRepo interface.
+type MockledgerEntryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockledgerEntryRepoMockRecorder
+}
+
+// MockledgerEntryRepoMockRecorder is the mock recorder for MockledgerEntryRepo.
+type MockledgerEntryRepoMockRecorder struct {
+	mock *MockledgerEntryRepo
+}
+
+// NewMockledgerEntryRepo creates a new mock instance.
+func NewMockledgerEntryRepo(ctrl *gomock.Controller) *MockledgerEntryRepo {
+	mock := &MockledgerEntryRepo{ctrl: ctrl}
+	mock.recorder = &MockledgerEntryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockledgerEntryRepo) EXPECT() *MockledgerEntryRepoMockRecorder {
+	return m.recorder
+}
+
+// AddOnce mocks base method.
+func (m *MockledgerEntryRepo) AddOnce(ctx context.Context, entry model.LedgerEntry) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOnce", ctx, entry)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOnce indicates an expected call of AddOnce.
+func (mr *MockledgerEntryRepoMockRecorder) AddOnce(ctx, entry any) *MockledgerEntryRepoAddOnceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOnce", reflect.TypeOf((*MockledgerEntryRepo)(nil).AddOnce), ctx, entry)
+	return &MockledgerEntryRepoAddOnceCall{Call: call}
+}
+
+// MockledgerEntryRepoAddOnceCall wrap *gomock.Call
+type MockledgerEntryRepoAddOnceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockledgerEntryRepoAddOnceCall) Return(arg0 bool, arg1 error) *MockledgerEntryRepoAddOnceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockledgerEntryRepoAddOnceCall) Do(f func(context.Context, model.LedgerEntry) (bool, error)) *MockledgerEntryRepoAddOnceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockledgerEntryRepoAddOnceCall) DoAndReturn(f func(context.Context, model.LedgerEntry) (bool, error)) *MockledgerEntryRepoAddOnceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetEmployeesWithoutEntry mocks base method.
+func (m *MockledgerEntryRepo) GetEmployeesWithoutEntry(ctx context.Context, kind model.LedgerEntryKind, periodTime time.Time, limit int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeesWithoutEntry", ctx, kind, periodTime, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeesWithoutEntry indicates an expected call of GetEmployeesWithoutEntry.
+func (mr *MockledgerEntryRepoMockRecorder) GetEmployeesWithoutEntry(ctx, kind, periodTime, limit any) *MockledgerEntryRepoGetEmployeesWithoutEntryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeesWithoutEntry", reflect.TypeOf((*MockledgerEntryRepo)(nil).GetEmployeesWithoutEntry), ctx, kind, periodTime, limit)
+	return &MockledgerEntryRepoGetEmployeesWithoutEntryCall{Call: call}
+}
+
+// MockledgerEntryRepoGetEmployeesWithoutEntryCall wrap *gomock.Call
+type MockledgerEntryRepoGetEmployeesWithoutEntryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockledgerEntryRepoGetEmployeesWithoutEntryCall) Return(arg0 []int64, arg1 error) *MockledgerEntryRepoGetEmployeesWithoutEntryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockledgerEntryRepoGetEmployeesWithoutEntryCall) Do(f func(context.Context, model.LedgerEntryKind, time.Time, int) ([]int64, error)) *MockledgerEntryRepoGetEmployeesWithoutEntryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockledgerEntryRepoGetEmployeesWithoutEntryCall) DoAndReturn(f func(context.Context, model.LedgerEntryKind, time.Time, int) ([]int64, error)) *MockledgerEntryRepoGetEmployeesWithoutEntryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockallowanceRepo is a mock of allowanceRepo interface.
+type MockallowanceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockallowanceRepoMockRecorder
+}
+
+// MockallowanceRepoMockRecorder is the mock recorder for MockallowanceRepo.
+type MockallowanceRepoMockRecorder struct {
+	mock *MockallowanceRepo
+}
+
+// NewMockallowanceRepo creates a new mock instance.
+func NewMockallowanceRepo(ctrl *gomock.Controller) *MockallowanceRepo {
+	mock := &MockallowanceRepo{ctrl: ctrl}
+	mock.recorder = &MockallowanceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockallowanceRepo) EXPECT() *MockallowanceRepoMockRecorder {
+	return m.recorder
+}
+
+// AdvanceNextGrantTime mocks base method.
+func (m *MockallowanceRepo) AdvanceNextGrantTime(ctx context.Context, periodTime, next time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceNextGrantTime", ctx, periodTime, next)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceNextGrantTime indicates an expected call of AdvanceNextGrantTime.
+func (mr *MockallowanceRepoMockRecorder) AdvanceNextGrantTime(ctx, periodTime, next any) *MockallowanceRepoAdvanceNextGrantTimeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceNextGrantTime", reflect.TypeOf((*MockallowanceRepo)(nil).AdvanceNextGrantTime), ctx, periodTime, next)
+	return &MockallowanceRepoAdvanceNextGrantTimeCall{Call: call}
+}
+
+// MockallowanceRepoAdvanceNextGrantTimeCall wrap *gomock.Call
+type MockallowanceRepoAdvanceNextGrantTimeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockallowanceRepoAdvanceNextGrantTimeCall) Return(arg0 bool, arg1 error) *MockallowanceRepoAdvanceNextGrantTimeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockallowanceRepoAdvanceNextGrantTimeCall) Do(f func(context.Context, time.Time, time.Time) (bool, error)) *MockallowanceRepoAdvanceNextGrantTimeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockallowanceRepoAdvanceNextGrantTimeCall) DoAndReturn(f func(context.Context, time.Time, time.Time) (bool, error)) *MockallowanceRepoAdvanceNextGrantTimeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockallowanceRepo) Get(ctx context.Context) (*model.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*model.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockallowanceRepoMockRecorder) Get(ctx any) *MockallowanceRepoGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockallowanceRepo)(nil).Get), ctx)
+	return &MockallowanceRepoGetCall{Call: call}
+}
+
+// MockallowanceRepoGetCall wrap *gomock.Call
+type MockallowanceRepoGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockallowanceRepoGetCall) Return(arg0 *model.Allowance, arg1 error) *MockallowanceRepoGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockallowanceRepoGetCall) Do(f func(context.Context) (*model.Allowance, error)) *MockallowanceRepoGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockallowanceRepoGetCall) DoAndReturn(f func(context.Context) (*model.Allowance, error)) *MockallowanceRepoGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
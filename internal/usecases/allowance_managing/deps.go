@@ -0,0 +1,13 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package allowance_managing
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type allowanceRepo interface {
+	Get(ctx context.Context) (*model.Allowance, error)
+	Update(ctx context.Context, allowance model.Allowance) error
+}
@@ -0,0 +1,77 @@
+package allowance_managing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/cron"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type UseCase struct {
+	allowanceRepo allowanceRepo
+	now           func() time.Time
+}
+
+func New(allowanceRepo allowanceRepo) (*UseCase, error) {
+	if allowanceRepo == nil {
+		return nil, errors.New("allowanceRepo is nil")
+	}
+
+	return &UseCase{
+		allowanceRepo: allowanceRepo,
+		now:           time.Now,
+	}, nil
+}
+
+func (uc *UseCase) Get(ctx context.Context) (*model.Allowance, error) {
+	allowance, err := uc.allowanceRepo.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("allowanceRepo.Get: %w", err)
+	}
+
+	return allowance, nil
+}
+
+// Update changes the allowance settings. The next grant time is kept unless the schedule changes
+// or a paused allowance is resumed, then it is recalculated from now, so paused periods are skipped.
+func (uc *UseCase) Update(ctx context.Context, params model.AllowanceParams) (*model.Allowance, error) {
+	if params.Amount <= 0 {
+		return nil, model.ErrInvalidAmount
+	}
+
+	schedule, err := cron.Parse(params.CronExpression)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", model.ErrInvalidSchedule, err)
+	}
+
+	current, err := uc.allowanceRepo.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("allowanceRepo.Get: %w", err)
+	}
+
+	allowance := model.Allowance{
+		Amount:         params.Amount,
+		CronExpression: params.CronExpression,
+		IsActive:       params.IsActive,
+		NextGrantTime:  current.NextGrantTime,
+	}
+
+	resumed := params.IsActive && !current.IsActive
+	if resumed || current.NextGrantTime == nil || params.CronExpression != current.CronExpression {
+		next, err := schedule.Next(uc.now())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", model.ErrInvalidSchedule, err)
+		}
+		allowance.NextGrantTime = &next
+	}
+
+	err = uc.allowanceRepo.Update(ctx, allowance)
+	if err != nil {
+		return nil, fmt.Errorf("allowanceRepo.Update: %w", err)
+	}
+
+	return &allowance, nil
+}
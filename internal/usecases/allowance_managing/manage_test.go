@@ -0,0 +1,149 @@
+package allowance_managing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_Update(t *testing.T) {
+	type mocks struct {
+		allowanceRepo *MockallowanceRepo
+	}
+
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	nextGrantTime := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	nextMonday := time.Date(2025, 2, 17, 9, 0, 0, 0, time.UTC)
+
+	current := &model.Allowance{
+		Amount:         200,
+		CronExpression: "0 0 1 * *",
+		IsActive:       true,
+		NextGrantTime:  &nextGrantTime,
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		params  model.AllowanceParams
+		wantRes *model.Allowance
+		wantErr error
+	}{
+		{
+			name: "success.amount_changed",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(current, nil)
+				m.allowanceRepo.EXPECT().Update(gomock.Any(), model.Allowance{
+					Amount:         300,
+					CronExpression: "0 0 1 * *",
+					IsActive:       true,
+					NextGrantTime:  &nextGrantTime,
+				}).Return(nil)
+			},
+			params: model.AllowanceParams{Amount: 300, CronExpression: "0 0 1 * *", IsActive: true},
+			wantRes: &model.Allowance{
+				Amount:         300,
+				CronExpression: "0 0 1 * *",
+				IsActive:       true,
+				NextGrantTime:  &nextGrantTime,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success.schedule_changed",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(current, nil)
+				m.allowanceRepo.EXPECT().Update(gomock.Any(), model.Allowance{
+					Amount:         50,
+					CronExpression: "0 9 * * 1",
+					IsActive:       true,
+					NextGrantTime:  &nextMonday,
+				}).Return(nil)
+			},
+			params: model.AllowanceParams{Amount: 50, CronExpression: "0 9 * * 1", IsActive: true},
+			wantRes: &model.Allowance{
+				Amount:         50,
+				CronExpression: "0 9 * * 1",
+				IsActive:       true,
+				NextGrantTime:  &nextMonday,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success.resumed",
+			prepare: func(m *mocks) {
+				staleGrantTime := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(&model.Allowance{
+					Amount:         200,
+					CronExpression: "0 0 1 * *",
+					IsActive:       false,
+					NextGrantTime:  &staleGrantTime,
+				}, nil)
+				m.allowanceRepo.EXPECT().Update(gomock.Any(), model.Allowance{
+					Amount:         200,
+					CronExpression: "0 0 1 * *",
+					IsActive:       true,
+					NextGrantTime:  &nextGrantTime,
+				}).Return(nil)
+			},
+			params: model.AllowanceParams{Amount: 200, CronExpression: "0 0 1 * *", IsActive: true},
+			wantRes: &model.Allowance{
+				Amount:         200,
+				CronExpression: "0 0 1 * *",
+				IsActive:       true,
+				NextGrantTime:  &nextGrantTime,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "error.invalid_amount",
+			prepare: func(m *mocks) {},
+			params:  model.AllowanceParams{Amount: 0, CronExpression: "0 0 1 * *", IsActive: true},
+			wantRes: nil,
+			wantErr: model.ErrInvalidAmount,
+		},
+		{
+			name:    "error.invalid_schedule",
+			prepare: func(m *mocks) {},
+			params:  model.AllowanceParams{Amount: 200, CronExpression: "every month", IsActive: true},
+			wantRes: nil,
+			wantErr: model.ErrInvalidSchedule,
+		},
+		{
+			name: "error.allowance_repo.update",
+			prepare: func(m *mocks) {
+				m.allowanceRepo.EXPECT().Get(gomock.Any()).Return(current, nil)
+				m.allowanceRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			params:  model.AllowanceParams{Amount: 200, CronExpression: "0 0 1 * *", IsActive: false},
+			wantRes: nil,
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				allowanceRepo: NewMockallowanceRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.allowanceRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.Update(context.Background(), tc.params)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
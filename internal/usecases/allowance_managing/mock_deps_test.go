@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package allowance_managing -typed -destination mock_deps_test.go
+//
+
+// Package allowance_managing is a generated GoMock package.
+package allowance_managing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockallowanceRepo is a mock of allowanceRepo interface.
+type MockallowanceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockallowanceRepoMockRecorder
+}
+
+// MockallowanceRepoMockRecorder is the mock recorder for MockallowanceRepo.
+type MockallowanceRepoMockRecorder struct {
+	mock *MockallowanceRepo
+}
+
+// NewMockallowanceRepo creates a new mock instance.
+func NewMockallowanceRepo(ctrl *gomock.Controller) *MockallowanceRepo {
+	mock := &MockallowanceRepo{ctrl: ctrl}
+	mock.recorder = &MockallowanceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockallowanceRepo) EXPECT() *MockallowanceRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockallowanceRepo) Get(ctx context.Context) (*model.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*model.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockallowanceRepoMockRecorder) Get(ctx any) *MockallowanceRepoGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockallowanceRepo)(nil).Get), ctx)
+	return &MockallowanceRepoGetCall{Call: call}
+}
+
+// MockallowanceRepoGetCall wrap *gomock.Call
+type MockallowanceRepoGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockallowanceRepoGetCall) Return(arg0 *model.Allowance, arg1 error) *MockallowanceRepoGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockallowanceRepoGetCall) Do(f func(context.Context) (*model.Allowance, error)) *MockallowanceRepoGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockallowanceRepoGetCall) DoAndReturn(f func(context.Context) (*model.Allowance, error)) *MockallowanceRepoGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockallowanceRepo) Update(ctx context.Context, allowance model.Allowance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, allowance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockallowanceRepoMockRecorder) Update(ctx, allowance any) *MockallowanceRepoUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockallowanceRepo)(nil).Update), ctx, allowance)
+	return &MockallowanceRepoUpdateCall{Call: call}
+}
+
+// MockallowanceRepoUpdateCall wrap *gomock.Call
+type MockallowanceRepoUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockallowanceRepoUpdateCall) Return(arg0 error) *MockallowanceRepoUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockallowanceRepoUpdateCall) Do(f func(context.Context, model.Allowance) error) *MockallowanceRepoUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockallowanceRepoUpdateCall) DoAndReturn(f func(context.Context, model.Allowance) error) *MockallowanceRepoUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
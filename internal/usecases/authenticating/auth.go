@@ -4,29 +4,58 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
-const initialCoins = 1000
-
 type UseCase struct {
+	trManager     trManager
 	employeeRepo  employeeRepo
+	coinLotRepo   coinLotRepo
 	tokenProvider tokenProvider
+	initialCoins  int64
+	passwordCost  int
+	now           func() time.Time
 }
 
-func New(userRepo employeeRepo, tokenProvider tokenProvider) (*UseCase, error) {
+// New returns the use case signing up new employees with initialCoins and passwords hashed with passwordCost of bcrypt.
+func New(
+	trManager trManager,
+	userRepo employeeRepo,
+	coinLotRepo coinLotRepo,
+	tokenProvider tokenProvider,
+	initialCoins int64,
+	passwordCost int,
+) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
 	if userRepo == nil {
 		return nil, errors.New("employeeRepo is nil")
 	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
 	if tokenProvider == nil {
 		return nil, errors.New("tokenProvider is nil")
 	}
+	if initialCoins < 0 {
+		return nil, fmt.Errorf("initial coins should not be negative, got %d", initialCoins)
+	}
+	if passwordCost < bcrypt.MinCost || passwordCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("password cost should be from %d to %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, passwordCost)
+	}
 	return &UseCase{
+		trManager:     trManager,
 		employeeRepo:  userRepo,
+		coinLotRepo:   coinLotRepo,
 		tokenProvider: tokenProvider,
+		initialCoins:  initialCoins,
+		passwordCost:  passwordCost,
+		now:           time.Now,
 	}, nil
 }
 
@@ -46,7 +75,7 @@ func (uc *UseCase) Auth(ctx context.Context, username, password string) (string,
 		}
 	}
 
-	token, err := uc.tokenProvider.CreateToken(employee.Username, employee.ID)
+	token, err := uc.tokenProvider.CreateToken(employee.Username, employee.ID, employee.Role)
 	if err != nil {
 		return "", fmt.Errorf("tokenProvider.CreateToken: %w", err)
 	}
@@ -78,14 +107,28 @@ func (uc *UseCase) getOrCreateEmployee(ctx context.Context, username, password s
 }
 
 func (uc *UseCase) createEmployee(ctx context.Context, username, password string) (*model.Employee, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), uc.passwordCost)
 	if err != nil {
 		return nil, fmt.Errorf("bcrypt.GenerateFromPassword: %w", err)
 	}
 
-	employee, err := uc.employeeRepo.Create(ctx, username, string(hashedPassword), initialCoins)
+	var employee *model.Employee
+	err = uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
+		employee, err = uc.employeeRepo.Create(ctx, username, string(hashedPassword), uc.initialCoins)
+		if err != nil {
+			return fmt.Errorf("employeeRepo.Create: %w", err)
+		}
+
+		now := uc.now()
+		err = uc.coinLotRepo.Add(ctx, employee.ID, uc.initialCoins, model.CoinLotExpireTime(now))
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.Add: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("employeeRepo.Create: %w", err)
+		return nil, fmt.Errorf("trManager.Do: %w", err)
 	}
 
 	return employee, nil
@@ -3,6 +3,7 @@ package authenticating
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,8 +14,12 @@ import (
 )
 
 func TestUseCase_Auth(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+
 	type mocks struct {
+		trManager     *MocktrManager
 		employeeRepo  *MockemployeeRepo
+		coinLotRepo   *MockcoinLotRepo
 		tokenProvider *MocktokenProvider
 	}
 	type args struct {
@@ -35,6 +40,11 @@ func TestUseCase_Auth(t *testing.T) {
 				m.employeeRepo.EXPECT().
 					GetByUsername(gomock.Any(), "test1").
 					Return(nil, model.ErrEmployeeNotFound)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
 				m.employeeRepo.EXPECT().
 					Create(gomock.Any(), "test1", gomock.Any(), int64(1000)).
 					Return(&model.Employee{
@@ -42,8 +52,12 @@ func TestUseCase_Auth(t *testing.T) {
 						Username: "test1",
 						Password: makePasswordHash("password1"),
 						Balance:  1000,
+						Role:     model.RoleEmployee,
 					}, nil)
-				m.tokenProvider.EXPECT().CreateToken("test1", int64(100)).Return("654321", nil)
+				m.coinLotRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(1000), time.Date(2026, 2, 14, 12, 0, 0, 0, time.UTC)).
+					Return(nil)
+				m.tokenProvider.EXPECT().CreateToken("test1", int64(100), model.RoleEmployee).Return("654321", nil)
 			},
 			args: args{
 				username: "test1",
@@ -62,8 +76,9 @@ func TestUseCase_Auth(t *testing.T) {
 						Username: "test1",
 						Password: makePasswordHash("password1"),
 						Balance:  0,
+						Role:     model.RoleAdmin,
 					}, nil)
-				m.tokenProvider.EXPECT().CreateToken("test1", int64(100)).Return("654321", nil)
+				m.tokenProvider.EXPECT().CreateToken("test1", int64(100), model.RoleAdmin).Return("654321", nil)
 			},
 			args: args{
 				username: "test1",
@@ -86,6 +101,31 @@ func TestUseCase_Auth(t *testing.T) {
 			wantRes: "",
 			wantErr: assert.AnError,
 		},
+		{
+			name: "error.coin_lot_repo.add",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "test1").
+					Return(nil, model.ErrEmployeeNotFound)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					Create(gomock.Any(), "test1", gomock.Any(), int64(1000)).
+					Return(&model.Employee{ID: 100, Username: "test1"}, nil)
+				m.coinLotRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(1000), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				username: "test1",
+				password: "password1",
+			},
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
 		{
 			name: "error.token_provider.create_token",
 			prepare: func(m *mocks) {
@@ -96,8 +136,9 @@ func TestUseCase_Auth(t *testing.T) {
 						Username: "test1",
 						Password: makePasswordHash("password1"),
 						Balance:  0,
+						Role:     model.RoleEmployee,
 					}, nil)
-				m.tokenProvider.EXPECT().CreateToken("test1", int64(100)).Return("", assert.AnError)
+				m.tokenProvider.EXPECT().CreateToken("test1", int64(100), model.RoleEmployee).Return("", assert.AnError)
 			},
 			args: args{
 				username: "test1",
@@ -113,14 +154,17 @@ func TestUseCase_Auth(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			m := &mocks{
+				trManager:     NewMocktrManager(ctrl),
 				employeeRepo:  NewMockemployeeRepo(ctrl),
+				coinLotRepo:   NewMockcoinLotRepo(ctrl),
 				tokenProvider: NewMocktokenProvider(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.employeeRepo, m.tokenProvider)
+			uc, err := New(m.trManager, m.employeeRepo, m.coinLotRepo, m.tokenProvider, 1000, bcrypt.MinCost)
 			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
 
 			res, err := uc.Auth(context.Background(), tc.args.username, tc.args.password)
 			require.ErrorIs(t, err, tc.wantErr)
@@ -3,15 +3,24 @@ package authenticating
 
 import (
 	"context"
+	"time"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
 type employeeRepo interface {
 	GetByUsername(ctx context.Context, username string) (*model.Employee, error)
 	Create(ctx context.Context, username, passwordHash string, balance int64) (*model.Employee, error)
 }
 
+type coinLotRepo interface {
+	Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error
+}
+
 type tokenProvider interface {
-	CreateToken(username string, userID int64) (string, error)
+	CreateToken(username string, userID int64, role model.Role) (string, error)
 }
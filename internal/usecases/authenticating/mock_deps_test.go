@@ -12,11 +12,73 @@ package authenticating
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/inna-maikut/avito-shop/internal/model"
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockemployeeRepo is a mock of employeeRepo interface.
 type MockemployeeRepo struct {
 	ctrl     *gomock.Controller
@@ -118,6 +180,67 @@ func (c *MockemployeeRepoGetByUsernameCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockcoinLotRepo) Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, amount, expireTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockcoinLotRepoMockRecorder) Add(ctx, employeeID, amount, expireTime any) *MockcoinLotRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockcoinLotRepo)(nil).Add), ctx, employeeID, amount, expireTime)
+	return &MockcoinLotRepoAddCall{Call: call}
+}
+
+// MockcoinLotRepoAddCall wrap *gomock.Call
+type MockcoinLotRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoAddCall) Return(arg0 error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoAddCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MocktokenProvider is a mock of tokenProvider interface.
 type MocktokenProvider struct {
 	ctrl     *gomock.Controller
@@ -142,18 +265,18 @@ func (m *MocktokenProvider) EXPECT() *MocktokenProviderMockRecorder {
 }
 
 // CreateToken mocks base method.
-func (m *MocktokenProvider) CreateToken(username string, userID int64) (string, error) {
+func (m *MocktokenProvider) CreateToken(username string, userID int64, role model.Role) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateToken", username, userID)
+	ret := m.ctrl.Call(m, "CreateToken", username, userID, role)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateToken indicates an expected call of CreateToken.
-func (mr *MocktokenProviderMockRecorder) CreateToken(username, userID any) *MocktokenProviderCreateTokenCall {
+func (mr *MocktokenProviderMockRecorder) CreateToken(username, userID, role any) *MocktokenProviderCreateTokenCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateToken), username, userID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateToken), username, userID, role)
 	return &MocktokenProviderCreateTokenCall{Call: call}
 }
 
@@ -169,13 +292,13 @@ func (c *MocktokenProviderCreateTokenCall) Return(arg0 string, arg1 error) *Mock
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MocktokenProviderCreateTokenCall) Do(f func(string, int64) (string, error)) *MocktokenProviderCreateTokenCall {
+func (c *MocktokenProviderCreateTokenCall) Do(f func(string, int64, model.Role) (string, error)) *MocktokenProviderCreateTokenCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MocktokenProviderCreateTokenCall) DoAndReturn(f func(string, int64) (string, error)) *MocktokenProviderCreateTokenCall {
+func (c *MocktokenProviderCreateTokenCall) DoAndReturn(f func(string, int64, model.Role) (string, error)) *MocktokenProviderCreateTokenCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
@@ -13,6 +14,11 @@ type UseCase struct {
 	employeeRepo  employeeRepo
 	inventoryRepo inventoryRepo
 	merchRepo     merchRepo
+	variantRepo   merchVariantRepo
+	coinLotRepo   coinLotRepo
+	cartRepo      cartRepo
+	orderRepo     orderRepo
+	now           func() time.Time
 }
 
 func New(
@@ -20,6 +26,10 @@ func New(
 	employeeRepo employeeRepo,
 	inventoryRepo inventoryRepo,
 	merchRepo merchRepo,
+	variantRepo merchVariantRepo,
+	coinLotRepo coinLotRepo,
+	cartRepo cartRepo,
+	orderRepo orderRepo,
 ) (*UseCase, error) {
 	if trManager == nil {
 		return nil, errors.New("trManager is nil")
@@ -33,39 +43,90 @@ func New(
 	if merchRepo == nil {
 		return nil, errors.New("merchRepo is nil")
 	}
+	if variantRepo == nil {
+		return nil, errors.New("variantRepo is nil")
+	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
+	if cartRepo == nil {
+		return nil, errors.New("cartRepo is nil")
+	}
+	if orderRepo == nil {
+		return nil, errors.New("orderRepo is nil")
+	}
 
 	return &UseCase{
 		trManager:     trManager,
 		employeeRepo:  employeeRepo,
 		inventoryRepo: inventoryRepo,
 		merchRepo:     merchRepo,
+		variantRepo:   variantRepo,
+		coinLotRepo:   coinLotRepo,
+		cartRepo:      cartRepo,
+		orderRepo:     orderRepo,
+		now:           time.Now,
 	}, nil
 }
 
-func (uc *UseCase) Buy(ctx context.Context, employeeID int64, merchName string) error {
+// Buy buys one item of merch and places an order to hand it over,
+// options choose the variant of merch with sizes or colors.
+func (uc *UseCase) Buy(ctx context.Context, employeeID int64, merchName string, options model.VariantOptions) error {
 	merch, err := uc.merchRepo.GetByName(ctx, merchName)
 	if err != nil {
 		return fmt.Errorf("merchRepo.GetByName: %w", err)
 	}
 
+	variants, err := uc.variantRepo.GetByMerch(ctx, merch.ID)
+	if err != nil {
+		return fmt.Errorf("variantRepo.GetByMerch: %w", err)
+	}
+
+	variant, err := model.SelectVariant(variants, options)
+	if err != nil {
+		return fmt.Errorf("model.SelectVariant: %w", err)
+	}
+
+	price := variant.Price(*merch)
+
 	err = uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
 		employee, err := uc.employeeRepo.GetByIDWithLock(ctx, employeeID)
 		if err != nil {
 			return fmt.Errorf("employeeRepo.GetByIDWithLock: %w", err)
 		}
 
-		if employee.Balance < merch.Price {
+		lots, err := uc.coinLotRepo.GetActive(ctx, employeeID, uc.now())
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.GetActive: %w", err)
+		}
+
+		if model.SpendableBalance(employee.Balance, lots) < price {
 			return model.ErrNotEnoughBalance
 		}
 
-		err = uc.employeeRepo.IncreaseBalance(ctx, employeeID, -merch.Price)
+		err = uc.variantRepo.DecreaseStock(ctx, variant.ID, 1)
+		if err != nil {
+			return fmt.Errorf("variantRepo.DecreaseStock: %w", err)
+		}
+
+		err = uc.employeeRepo.IncreaseBalance(ctx, employeeID, -price)
 		if err != nil {
 			return fmt.Errorf("increase balance of current user with negative amount: %w", err)
 		}
 
-		err = uc.inventoryRepo.AddOne(ctx, employeeID, merch.ID)
+		parts, err := uc.spendLots(ctx, lots, price)
+		if err != nil {
+			return fmt.Errorf("spendLots: %w", err)
+		}
+
+		err = uc.inventoryRepo.Add(ctx, employeeID, merch.ID, variant.ID, 1)
+		if err != nil {
+			return fmt.Errorf("inventoryRepo.Add: %w", err)
+		}
+
+		err = uc.placeOrder(ctx, employeeID, merch.ID, variant.ID, 1, price, parts)
 		if err != nil {
-			return fmt.Errorf("inventoryRepo.AddOne: %w", err)
+			return fmt.Errorf("placeOrder: %w", err)
 		}
 
 		return nil
@@ -76,3 +137,44 @@ func (uc *UseCase) Buy(ctx context.Context, employeeID int64, merchName string)
 
 	return nil
 }
+
+// spendLots takes amount coins from lots, the earliest expiring first, and returns the spent parts.
+// Remaining of lots is decreased, so the same lots can be spent again for the next cart line.
+func (uc *UseCase) spendLots(ctx context.Context, lots []model.CoinLot, amount int64) ([]model.CoinLotPart, error) {
+	parts, err := model.TakeFIFO(lots, amount)
+	if err != nil {
+		return nil, fmt.Errorf("model.TakeFIFO: %w", err)
+	}
+
+	for _, part := range parts {
+		err = uc.coinLotRepo.Decrease(ctx, part.LotID, part.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("coinLotRepo.Decrease: %w", err)
+		}
+	}
+
+	return parts, nil
+}
+
+// placeOrder places an order paid with parts and logs its purchase,
+// the parts are kept with the order to refund coins with their expire time.
+func (uc *UseCase) placeOrder(
+	ctx context.Context, employeeID, merchID, variantID, quantity, price int64, parts []model.CoinLotPart,
+) error {
+	orderID, err := uc.orderRepo.Add(ctx, employeeID, merchID, variantID, quantity, price)
+	if err != nil {
+		return fmt.Errorf("orderRepo.Add: %w", err)
+	}
+
+	err = uc.orderRepo.AddLots(ctx, orderID, parts)
+	if err != nil {
+		return fmt.Errorf("orderRepo.AddLots: %w", err)
+	}
+
+	err = uc.inventoryRepo.AddPurchase(ctx, employeeID, merchID, variantID, quantity, price, orderID)
+	if err != nil {
+		return fmt.Errorf("inventoryRepo.AddPurchase: %w", err)
+	}
+
+	return nil
+}
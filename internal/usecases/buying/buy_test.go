@@ -3,6 +3,7 @@ package buying
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,15 +13,23 @@ import (
 )
 
 func TestUseCase_Buy(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	expireTime := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
 	type mocks struct {
 		trManager     *MocktrManager
 		employeeRepo  *MockemployeeRepo
 		inventoryRepo *MockinventoryRepo
 		merchRepo     *MockmerchRepo
+		variantRepo   *MockmerchVariantRepo
+		coinLotRepo   *MockcoinLotRepo
+		cartRepo      *MockcartRepo
+		orderRepo     *MockorderRepo
 	}
 	type args struct {
 		employeeID int64
 		merchName  string
+		options    model.VariantOptions
 	}
 
 	testCases := []struct {
@@ -39,6 +48,9 @@ func TestUseCase_Buy(t *testing.T) {
 						Name:  "test1",
 						Price: 300,
 					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{{ID: 1, MerchID: 1, Active: true}}, nil)
 				m.trManager.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
@@ -50,11 +62,38 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      100,
 						Balance: 1000,
 					}, nil)
+				m.variantRepo.EXPECT().
+					DecreaseStock(gomock.Any(), int64(1), int64(1)).
+					Return(nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(100), int64(-300)).
 					Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{
+						{ID: 1, Remaining: 100, ExpireTime: expireTime},
+						{ID: 2, Remaining: 900, ExpireTime: expireTime.AddDate(0, 1, 0)},
+					}, nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(1), int64(100)).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(2), int64(200)).
+					Return(nil)
+				m.inventoryRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(1), int64(1), int64(1)).
+					Return(nil)
+				m.orderRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(1), int64(1), int64(1), int64(300)).
+					Return(int64(50), nil)
+				m.orderRepo.EXPECT().
+					AddLots(gomock.Any(), int64(50), []model.CoinLotPart{
+						{LotID: 1, Amount: 100, ExpireTime: expireTime},
+						{LotID: 2, Amount: 200, ExpireTime: expireTime.AddDate(0, 1, 0)},
+					}).
+					Return(nil)
 				m.inventoryRepo.EXPECT().
-					AddOne(gomock.Any(), int64(100), int64(1)).
+					AddPurchase(gomock.Any(), int64(100), int64(1), int64(1), int64(1), int64(300), int64(50)).
 					Return(nil)
 			},
 			args: args{
@@ -73,6 +112,9 @@ func TestUseCase_Buy(t *testing.T) {
 						Name:  "test1",
 						Price: 3000,
 					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{{ID: 1, MerchID: 1, Active: true}}, nil)
 				m.trManager.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
@@ -84,6 +126,9 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      100,
 						Balance: 1000,
 					}, nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
 			},
 			args: args{
 				employeeID: 100,
@@ -114,6 +159,9 @@ func TestUseCase_Buy(t *testing.T) {
 						Name:  "test1",
 						Price: 300,
 					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{{ID: 1, MerchID: 1, Active: true}}, nil)
 				m.trManager.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
@@ -139,6 +187,9 @@ func TestUseCase_Buy(t *testing.T) {
 						Name:  "test1",
 						Price: 300,
 					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{{ID: 1, MerchID: 1, Active: true}}, nil)
 				m.trManager.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
@@ -150,6 +201,12 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      100,
 						Balance: 1000,
 					}, nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.variantRepo.EXPECT().
+					DecreaseStock(gomock.Any(), int64(1), int64(1)).
+					Return(nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(100), int64(-300)).
 					Return(assert.AnError)
@@ -161,7 +218,42 @@ func TestUseCase_Buy(t *testing.T) {
 			wantErr: assert.AnError,
 		},
 		{
-			name: "error.inventoryRepo.AddOne",
+			name: "error.NotEnoughBalance.expired_lots",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().
+					GetByName(gomock.Any(), "test1").
+					Return(&model.Merch{
+						ID:    1,
+						Name:  "test1",
+						Price: 300,
+					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{{ID: 1, MerchID: 1, Active: true}}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(100)).
+					Return(&model.Employee{
+						ID:      100,
+						Balance: 1000,
+					}, nil)
+				// 800 coins of expired lots stay in the balance until the coin expiry worker writes them off
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 2, Remaining: 200, ExpireTime: expireTime}}, nil)
+			},
+			args: args{
+				employeeID: 100,
+				merchName:  "test1",
+			},
+			wantErr: model.ErrNotEnoughBalance,
+		},
+		{
+			name: "error.inventoryRepo.Add",
 			prepare: func(m *mocks) {
 				m.merchRepo.EXPECT().
 					GetByName(gomock.Any(), "test1").
@@ -170,6 +262,9 @@ func TestUseCase_Buy(t *testing.T) {
 						Name:  "test1",
 						Price: 300,
 					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{{ID: 1, MerchID: 1, Active: true}}, nil)
 				m.trManager.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
@@ -181,11 +276,26 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      100,
 						Balance: 1000,
 					}, nil)
+				m.variantRepo.EXPECT().
+					DecreaseStock(gomock.Any(), int64(1), int64(1)).
+					Return(nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(100), int64(-300)).
 					Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{
+						{ID: 1, Remaining: 100, ExpireTime: expireTime},
+						{ID: 2, Remaining: 900, ExpireTime: expireTime.AddDate(0, 1, 0)},
+					}, nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(1), int64(100)).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(2), int64(200)).
+					Return(nil)
 				m.inventoryRepo.EXPECT().
-					AddOne(gomock.Any(), int64(100), int64(1)).
+					Add(gomock.Any(), int64(100), int64(1), int64(1), int64(1)).
 					Return(assert.AnError)
 			},
 			args: args{
@@ -194,6 +304,126 @@ func TestUseCase_Buy(t *testing.T) {
 			},
 			wantErr: assert.AnError,
 		},
+		{
+			name: "success.buy_variant",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().
+					GetByName(gomock.Any(), "t-shirt").
+					Return(&model.Merch{
+						ID:    1,
+						Name:  "t-shirt",
+						Price: 80,
+					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{
+						{ID: 1, MerchID: 1, Active: false},
+						{ID: 20, MerchID: 1, Size: "L", Color: "black", Active: true},
+						{ID: 21, MerchID: 1, Size: "XXL", Color: "black", PriceDelta: 10, Active: true},
+					}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(100)).
+					Return(&model.Employee{
+						ID:      100,
+						Balance: 1000,
+					}, nil)
+				m.variantRepo.EXPECT().
+					DecreaseStock(gomock.Any(), int64(21), int64(1)).
+					Return(nil)
+				m.employeeRepo.EXPECT().
+					IncreaseBalance(gomock.Any(), int64(100), int64(-90)).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(1), int64(90)).
+					Return(nil)
+				m.inventoryRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(1), int64(21), int64(1)).
+					Return(nil)
+				m.orderRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(1), int64(21), int64(1), int64(90)).
+					Return(int64(50), nil)
+				m.orderRepo.EXPECT().
+					AddLots(gomock.Any(), int64(50), []model.CoinLotPart{{LotID: 1, Amount: 90, ExpireTime: expireTime}}).
+					Return(nil)
+				m.inventoryRepo.EXPECT().
+					AddPurchase(gomock.Any(), int64(100), int64(1), int64(21), int64(1), int64(90), int64(50)).
+					Return(nil)
+			},
+			args: args{
+				employeeID: 100,
+				merchName:  "t-shirt",
+				options:    model.VariantOptions{Size: "XXL", Color: "black"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error.VariantRequired",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().
+					GetByName(gomock.Any(), "t-shirt").
+					Return(&model.Merch{
+						ID:    1,
+						Name:  "t-shirt",
+						Price: 80,
+					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{
+						{ID: 20, MerchID: 1, Size: "L", Color: "black", Active: true},
+						{ID: 21, MerchID: 1, Size: "XXL", Color: "black", PriceDelta: 10, Active: true},
+					}, nil)
+			},
+			args: args{
+				employeeID: 100,
+				merchName:  "t-shirt",
+			},
+			wantErr: model.ErrVariantRequired,
+		},
+		{
+			name: "error.OutOfStock",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().
+					GetByName(gomock.Any(), "test1").
+					Return(&model.Merch{
+						ID:    1,
+						Name:  "test1",
+						Price: 300,
+					}, nil)
+				m.variantRepo.EXPECT().
+					GetByMerch(gomock.Any(), int64(1)).
+					Return([]model.MerchVariant{{ID: 1, MerchID: 1, Active: true}}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(100)).
+					Return(&model.Employee{
+						ID:      100,
+						Balance: 1000,
+					}, nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.variantRepo.EXPECT().
+					DecreaseStock(gomock.Any(), int64(1), int64(1)).
+					Return(model.ErrOutOfStock)
+			},
+			args: args{
+				employeeID: 100,
+				merchName:  "test1",
+			},
+			wantErr: model.ErrOutOfStock,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -205,14 +435,19 @@ func TestUseCase_Buy(t *testing.T) {
 				trManager:     NewMocktrManager(ctrl),
 				inventoryRepo: NewMockinventoryRepo(ctrl),
 				merchRepo:     NewMockmerchRepo(ctrl),
+				variantRepo:   NewMockmerchVariantRepo(ctrl),
+				coinLotRepo:   NewMockcoinLotRepo(ctrl),
+				cartRepo:      NewMockcartRepo(ctrl),
+				orderRepo:     NewMockorderRepo(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.trManager, m.employeeRepo, m.inventoryRepo, m.merchRepo)
+			uc, err := New(m.trManager, m.employeeRepo, m.inventoryRepo, m.merchRepo, m.variantRepo, m.coinLotRepo, m.cartRepo, m.orderRepo)
 			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
 
-			err = uc.Buy(context.Background(), tc.args.employeeID, tc.args.merchName)
+			err = uc.Buy(context.Background(), tc.args.employeeID, tc.args.merchName, tc.args.options)
 			require.ErrorIs(t, err, tc.wantErr)
 		})
 	}
@@ -0,0 +1,124 @@
+package buying
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// Checkout buys everything in the employee cart in one transaction: either every line is bought or nothing.
+// Every line places its own order.
+// Lines that can't be bought are reported all together with *model.CheckoutError.
+func (uc *UseCase) Checkout(ctx context.Context, employeeID int64) (model.Cart, error) {
+	var cart model.Cart
+
+	err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+		employee, err := uc.employeeRepo.GetByIDWithLock(ctx, employeeID)
+		if err != nil {
+			return fmt.Errorf("employeeRepo.GetByIDWithLock: %w", err)
+		}
+
+		items, err := uc.cartRepo.GetByEmployee(ctx, employeeID)
+		if err != nil {
+			return fmt.Errorf("cartRepo.GetByEmployee: %w", err)
+		}
+		if len(items) == 0 {
+			return model.ErrCartEmpty
+		}
+
+		merchIDs := make([]int64, 0, len(items))
+		variantIDs := make([]int64, 0, len(items))
+		for _, item := range items {
+			merchIDs = append(merchIDs, item.MerchID)
+			variantIDs = append(variantIDs, item.VariantID)
+		}
+
+		merches, err := uc.merchRepo.GetByIDs(ctx, merchIDs)
+		if err != nil {
+			return fmt.Errorf("merchRepo.GetByIDs: %w", err)
+		}
+
+		variants, err := uc.variantRepo.GetByIDs(ctx, variantIDs)
+		if err != nil {
+			return fmt.Errorf("variantRepo.GetByIDs: %w", err)
+		}
+
+		var lineErrors []model.CheckoutLineError
+		cart, lineErrors = model.PriceCart(items, merches, variants)
+
+		lots, err := uc.coinLotRepo.GetActive(ctx, employeeID, uc.now())
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.GetActive: %w", err)
+		}
+		balance := model.SpendableBalance(employee.Balance, lots)
+
+		// lines are paid in the cart order, the ones not covered by the balance are reported
+		var paid int64
+		for _, line := range cart.Lines {
+			if !line.Variant.InStock(line.Quantity) {
+				lineErrors = append(lineErrors, model.CheckoutLineError{
+					MerchID:   line.MerchID,
+					MerchName: line.MerchName,
+					Variant:   line.Variant,
+					Err:       model.ErrOutOfStock,
+				})
+				continue
+			}
+
+			paid += line.Amount()
+			if paid > balance {
+				lineErrors = append(lineErrors, model.CheckoutLineError{
+					MerchID:   line.MerchID,
+					MerchName: line.MerchName,
+					Variant:   line.Variant,
+					Err:       model.ErrNotEnoughBalance,
+				})
+			}
+		}
+		if len(lineErrors) > 0 {
+			return &model.CheckoutError{Lines: lineErrors}
+		}
+
+		err = uc.employeeRepo.IncreaseBalance(ctx, employeeID, -cart.Total)
+		if err != nil {
+			return fmt.Errorf("increase balance of current user with negative amount: %w", err)
+		}
+
+		for _, line := range cart.Lines {
+			err = uc.variantRepo.DecreaseStock(ctx, line.Variant.ID, line.Quantity)
+			if err != nil {
+				return fmt.Errorf("variantRepo.DecreaseStock: %w", err)
+			}
+
+			// every order remembers its own lots, the earliest expiring ones go to the first lines
+			var parts []model.CoinLotPart
+			parts, err = uc.spendLots(ctx, lots, line.Amount())
+			if err != nil {
+				return fmt.Errorf("spendLots: %w", err)
+			}
+
+			err = uc.inventoryRepo.Add(ctx, employeeID, line.MerchID, line.Variant.ID, line.Quantity)
+			if err != nil {
+				return fmt.Errorf("inventoryRepo.Add: %w", err)
+			}
+
+			err = uc.placeOrder(ctx, employeeID, line.MerchID, line.Variant.ID, line.Quantity, line.Price, parts)
+			if err != nil {
+				return fmt.Errorf("placeOrder: %w", err)
+			}
+		}
+
+		err = uc.cartRepo.Clear(ctx, employeeID)
+		if err != nil {
+			return fmt.Errorf("cartRepo.Clear: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return cart, nil
+}
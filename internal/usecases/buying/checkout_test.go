@@ -0,0 +1,234 @@
+package buying
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_Checkout(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	expireTime := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	laterExpireTime := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mocks struct {
+		trManager     *MocktrManager
+		employeeRepo  *MockemployeeRepo
+		inventoryRepo *MockinventoryRepo
+		merchRepo     *MockmerchRepo
+		variantRepo   *MockmerchVariantRepo
+		coinLotRepo   *MockcoinLotRepo
+		cartRepo      *MockcartRepo
+		orderRepo     *MockorderRepo
+	}
+
+	cartItems := []model.CartItem{
+		{MerchID: 1, VariantID: 20, Quantity: 1},
+		{MerchID: 2, VariantID: 2, Quantity: 2},
+		{MerchID: 8, VariantID: 8, Quantity: 3},
+	}
+	merches := []model.Merch{
+		{ID: 1, Name: "t-shirt", Price: 80},
+		{ID: 2, Name: "cup", Price: 20},
+		{ID: 8, Name: "socks", Price: 10},
+	}
+	tShirt := model.MerchVariant{ID: 20, MerchID: 1, Size: "L", Color: "black", Active: true}
+	cup := model.MerchVariant{ID: 2, MerchID: 2, Active: true}
+	socks := model.MerchVariant{ID: 8, MerchID: 8, Active: true}
+	variants := []model.MerchVariant{tShirt, cup, socks}
+
+	prepareCart := func(m *mocks, balance int64, lots []model.CoinLot, variants []model.MerchVariant) {
+		m.trManager.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+				return do(ctx)
+			})
+		m.employeeRepo.EXPECT().
+			GetByIDWithLock(gomock.Any(), int64(100)).
+			Return(&model.Employee{ID: 100, Balance: balance}, nil)
+		m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(cartItems, nil)
+		m.merchRepo.EXPECT().GetByIDs(gomock.Any(), []int64{1, 2, 8}).Return(merches, nil)
+		m.variantRepo.EXPECT().GetByIDs(gomock.Any(), []int64{20, 2, 8}).Return(variants, nil)
+		m.coinLotRepo.EXPECT().GetActive(gomock.Any(), int64(100), now).Return(lots, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantRes model.Cart
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				prepareCart(m, 200, []model.CoinLot{
+					{ID: 10, Remaining: 100, ExpireTime: expireTime},
+					{ID: 11, Remaining: 100, ExpireTime: laterExpireTime},
+				}, variants)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(-150)).Return(nil)
+				m.variantRepo.EXPECT().DecreaseStock(gomock.Any(), int64(20), int64(1)).Return(nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(10), int64(80)).Return(nil)
+				m.inventoryRepo.EXPECT().Add(gomock.Any(), int64(100), int64(1), int64(20), int64(1)).Return(nil)
+				m.orderRepo.EXPECT().Add(gomock.Any(), int64(100), int64(1), int64(20), int64(1), int64(80)).Return(int64(50), nil)
+				m.orderRepo.EXPECT().AddLots(gomock.Any(), int64(50), []model.CoinLotPart{
+					{LotID: 10, Amount: 80, ExpireTime: expireTime},
+				}).Return(nil)
+				m.inventoryRepo.EXPECT().AddPurchase(gomock.Any(), int64(100), int64(1), int64(20), int64(1), int64(80), int64(50)).Return(nil)
+				m.variantRepo.EXPECT().DecreaseStock(gomock.Any(), int64(2), int64(2)).Return(nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(10), int64(20)).Return(nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(11), int64(20)).Return(nil)
+				m.inventoryRepo.EXPECT().Add(gomock.Any(), int64(100), int64(2), int64(2), int64(2)).Return(nil)
+				m.orderRepo.EXPECT().Add(gomock.Any(), int64(100), int64(2), int64(2), int64(2), int64(20)).Return(int64(51), nil)
+				m.orderRepo.EXPECT().AddLots(gomock.Any(), int64(51), []model.CoinLotPart{
+					{LotID: 10, Amount: 20, ExpireTime: expireTime},
+					{LotID: 11, Amount: 20, ExpireTime: laterExpireTime},
+				}).Return(nil)
+				m.inventoryRepo.EXPECT().AddPurchase(gomock.Any(), int64(100), int64(2), int64(2), int64(2), int64(20), int64(51)).Return(nil)
+				m.variantRepo.EXPECT().DecreaseStock(gomock.Any(), int64(8), int64(3)).Return(nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(11), int64(30)).Return(nil)
+				m.inventoryRepo.EXPECT().Add(gomock.Any(), int64(100), int64(8), int64(8), int64(3)).Return(nil)
+				m.orderRepo.EXPECT().Add(gomock.Any(), int64(100), int64(8), int64(8), int64(3), int64(10)).Return(int64(52), nil)
+				m.orderRepo.EXPECT().AddLots(gomock.Any(), int64(52), []model.CoinLotPart{
+					{LotID: 11, Amount: 30, ExpireTime: laterExpireTime},
+				}).Return(nil)
+				m.inventoryRepo.EXPECT().AddPurchase(gomock.Any(), int64(100), int64(8), int64(8), int64(3), int64(10), int64(52)).Return(nil)
+				m.cartRepo.EXPECT().Clear(gomock.Any(), int64(100)).Return(nil)
+			},
+			wantRes: model.Cart{
+				Lines: []model.CartLine{
+					{MerchID: 1, MerchName: "t-shirt", Variant: tShirt, Quantity: 1, Price: 80},
+					{MerchID: 2, MerchName: "cup", Variant: cup, Quantity: 2, Price: 20},
+					{MerchID: 8, MerchName: "socks", Variant: socks, Quantity: 3, Price: 10},
+				},
+				Total: 150,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error.cart_empty",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(100)).
+					Return(&model.Employee{ID: 100, Balance: 200}, nil)
+				m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(nil, nil)
+			},
+			wantErr: model.ErrCartEmpty,
+		},
+		{
+			name: "error.not_enough_balance",
+			prepare: func(m *mocks) {
+				prepareCart(m, 100, []model.CoinLot{{ID: 10, Remaining: 100, ExpireTime: expireTime}}, variants)
+			},
+			wantErr: &model.CheckoutError{Lines: []model.CheckoutLineError{
+				{MerchID: 2, MerchName: "cup", Variant: cup, Err: model.ErrNotEnoughBalance},
+				{MerchID: 8, MerchName: "socks", Variant: socks, Err: model.ErrNotEnoughBalance},
+			}},
+		},
+		{
+			name: "error.not_enough_balance.expired_lots",
+			prepare: func(m *mocks) {
+				// 100 coins of expired lots stay in the balance until the coin expiry worker writes them off
+				prepareCart(m, 200, []model.CoinLot{{ID: 10, Remaining: 100, ExpireTime: expireTime}}, variants)
+			},
+			wantErr: &model.CheckoutError{Lines: []model.CheckoutLineError{
+				{MerchID: 2, MerchName: "cup", Variant: cup, Err: model.ErrNotEnoughBalance},
+				{MerchID: 8, MerchName: "socks", Variant: socks, Err: model.ErrNotEnoughBalance},
+			}},
+		},
+		{
+			name: "error.out_of_stock",
+			prepare: func(m *mocks) {
+				soldOut := tShirt
+				soldOut.Stock = new(int64)
+				prepareCart(m, 200, []model.CoinLot{{ID: 10, Remaining: 200, ExpireTime: expireTime}},
+					[]model.MerchVariant{soldOut, cup, socks})
+			},
+			wantErr: &model.CheckoutError{Lines: []model.CheckoutLineError{
+				{MerchID: 1, MerchName: "t-shirt", Variant: model.MerchVariant{
+					ID: 20, MerchID: 1, Size: "L", Color: "black", Stock: new(int64), Active: true,
+				}, Err: model.ErrOutOfStock},
+			}},
+		},
+		{
+			name: "error.merch_not_found",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(100)).
+					Return(&model.Employee{ID: 100, Balance: 200}, nil)
+				m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(cartItems, nil)
+				m.merchRepo.EXPECT().GetByIDs(gomock.Any(), []int64{1, 2, 8}).Return(merches[:2], nil)
+				m.variantRepo.EXPECT().GetByIDs(gomock.Any(), []int64{20, 2, 8}).Return(variants, nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 10, Remaining: 200, ExpireTime: expireTime}}, nil)
+			},
+			wantErr: &model.CheckoutError{Lines: []model.CheckoutLineError{
+				{MerchID: 8, Err: model.ErrMerchNotFound},
+			}},
+		},
+		{
+			name: "error.inventory_repo.add",
+			prepare: func(m *mocks) {
+				prepareCart(m, 200, []model.CoinLot{{ID: 10, Remaining: 200, ExpireTime: expireTime}}, variants)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(-150)).Return(nil)
+				m.variantRepo.EXPECT().DecreaseStock(gomock.Any(), int64(20), int64(1)).Return(nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(10), int64(80)).Return(nil)
+				m.inventoryRepo.EXPECT().Add(gomock.Any(), int64(100), int64(1), int64(20), int64(1)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:     NewMocktrManager(ctrl),
+				employeeRepo:  NewMockemployeeRepo(ctrl),
+				inventoryRepo: NewMockinventoryRepo(ctrl),
+				merchRepo:     NewMockmerchRepo(ctrl),
+				variantRepo:   NewMockmerchVariantRepo(ctrl),
+				coinLotRepo:   NewMockcoinLotRepo(ctrl),
+				cartRepo:      NewMockcartRepo(ctrl),
+				orderRepo:     NewMockorderRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.employeeRepo, m.inventoryRepo, m.merchRepo, m.variantRepo, m.coinLotRepo, m.cartRepo, m.orderRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.Checkout(context.Background(), 100)
+
+			var wantCheckoutErr *model.CheckoutError
+			if errors.As(tc.wantErr, &wantCheckoutErr) {
+				var checkoutErr *model.CheckoutError
+				require.ErrorAs(t, err, &checkoutErr)
+				require.Equal(t, wantCheckoutErr, checkoutErr)
+			} else {
+				require.ErrorIs(t, err, tc.wantErr)
+			}
+
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package buying
 
 import (
 	"context"
+	"time"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
@@ -18,9 +19,32 @@ type employeeRepo interface {
 }
 
 type inventoryRepo interface {
-	AddOne(ctx context.Context, employeeID, merchID int64) error
+	Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error
+	AddPurchase(ctx context.Context, employeeID, merchID, variantID, quantity, price, orderID int64) error
 }
 
 type merchRepo interface {
 	GetByName(ctx context.Context, name string) (*model.Merch, error)
+	GetByIDs(ctx context.Context, merchIDs []int64) ([]model.Merch, error)
+}
+
+type merchVariantRepo interface {
+	GetByMerch(ctx context.Context, merchID int64) ([]model.MerchVariant, error)
+	GetByIDs(ctx context.Context, variantIDs []int64) ([]model.MerchVariant, error)
+	DecreaseStock(ctx context.Context, variantID, quantity int64) error
+}
+
+type orderRepo interface {
+	Add(ctx context.Context, employeeID, merchID, variantID, quantity, price int64) (int64, error)
+	AddLots(ctx context.Context, orderID int64, parts []model.CoinLotPart) error
+}
+
+type cartRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.CartItem, error)
+	Clear(ctx context.Context, employeeID int64) error
+}
+
+type coinLotRepo interface {
+	GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error)
+	Decrease(ctx context.Context, lotID, amount int64) error
 }
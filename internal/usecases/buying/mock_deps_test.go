@@ -12,6 +12,7 @@ package buying
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/inna-maikut/avito-shop/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -240,40 +241,78 @@ func (m *MockinventoryRepo) EXPECT() *MockinventoryRepoMockRecorder {
 	return m.recorder
 }
 
-// AddOne mocks base method.
-func (m *MockinventoryRepo) AddOne(ctx context.Context, employeeID, merchID int64) error {
+// Add mocks base method.
+func (m *MockinventoryRepo) Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOne", ctx, employeeID, merchID)
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, merchID, variantID, quantity)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddOne indicates an expected call of AddOne.
-func (mr *MockinventoryRepoMockRecorder) AddOne(ctx, employeeID, merchID any) *MockinventoryRepoAddOneCall {
+// Add indicates an expected call of Add.
+func (mr *MockinventoryRepoMockRecorder) Add(ctx, employeeID, merchID, variantID, quantity any) *MockinventoryRepoAddCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOne", reflect.TypeOf((*MockinventoryRepo)(nil).AddOne), ctx, employeeID, merchID)
-	return &MockinventoryRepoAddOneCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockinventoryRepo)(nil).Add), ctx, employeeID, merchID, variantID, quantity)
+	return &MockinventoryRepoAddCall{Call: call}
 }
 
-// MockinventoryRepoAddOneCall wrap *gomock.Call
-type MockinventoryRepoAddOneCall struct {
+// MockinventoryRepoAddCall wrap *gomock.Call
+type MockinventoryRepoAddCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockinventoryRepoAddOneCall) Return(arg0 error) *MockinventoryRepoAddOneCall {
+func (c *MockinventoryRepoAddCall) Return(arg0 error) *MockinventoryRepoAddCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockinventoryRepoAddOneCall) Do(f func(context.Context, int64, int64) error) *MockinventoryRepoAddOneCall {
+func (c *MockinventoryRepoAddCall) Do(f func(context.Context, int64, int64, int64, int64) error) *MockinventoryRepoAddCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockinventoryRepoAddOneCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockinventoryRepoAddOneCall {
+func (c *MockinventoryRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, int64, int64) error) *MockinventoryRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddPurchase mocks base method.
+func (m *MockinventoryRepo) AddPurchase(ctx context.Context, employeeID, merchID, variantID, quantity, price, orderID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPurchase", ctx, employeeID, merchID, variantID, quantity, price, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPurchase indicates an expected call of AddPurchase.
+func (mr *MockinventoryRepoMockRecorder) AddPurchase(ctx, employeeID, merchID, variantID, quantity, price, orderID any) *MockinventoryRepoAddPurchaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPurchase", reflect.TypeOf((*MockinventoryRepo)(nil).AddPurchase), ctx, employeeID, merchID, variantID, quantity, price, orderID)
+	return &MockinventoryRepoAddPurchaseCall{Call: call}
+}
+
+// MockinventoryRepoAddPurchaseCall wrap *gomock.Call
+type MockinventoryRepoAddPurchaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockinventoryRepoAddPurchaseCall) Return(arg0 error) *MockinventoryRepoAddPurchaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockinventoryRepoAddPurchaseCall) Do(f func(context.Context, int64, int64, int64, int64, int64, int64) error) *MockinventoryRepoAddPurchaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockinventoryRepoAddPurchaseCall) DoAndReturn(f func(context.Context, int64, int64, int64, int64, int64, int64) error) *MockinventoryRepoAddPurchaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -301,6 +340,45 @@ func (m *MockmerchRepo) EXPECT() *MockmerchRepoMockRecorder {
 	return m.recorder
 }
 
+// GetByIDs mocks base method.
+func (m *MockmerchRepo) GetByIDs(ctx context.Context, merchIDs []int64) ([]model.Merch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, merchIDs)
+	ret0, _ := ret[0].([]model.Merch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockmerchRepoMockRecorder) GetByIDs(ctx, merchIDs any) *MockmerchRepoGetByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockmerchRepo)(nil).GetByIDs), ctx, merchIDs)
+	return &MockmerchRepoGetByIDsCall{Call: call}
+}
+
+// MockmerchRepoGetByIDsCall wrap *gomock.Call
+type MockmerchRepoGetByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchRepoGetByIDsCall) Return(arg0 []model.Merch, arg1 error) *MockmerchRepoGetByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchRepoGetByIDsCall) Do(f func(context.Context, []int64) ([]model.Merch, error)) *MockmerchRepoGetByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchRepoGetByIDsCall) DoAndReturn(f func(context.Context, []int64) ([]model.Merch, error)) *MockmerchRepoGetByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetByName mocks base method.
 func (m *MockmerchRepo) GetByName(ctx context.Context, name string) (*model.Merch, error) {
 	m.ctrl.T.Helper()
@@ -339,3 +417,442 @@ func (c *MockmerchRepoGetByNameCall) DoAndReturn(f func(context.Context, string)
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockmerchVariantRepo is a mock of merchVariantRepo interface.
+type MockmerchVariantRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockmerchVariantRepoMockRecorder
+}
+
+// MockmerchVariantRepoMockRecorder is the mock recorder for MockmerchVariantRepo.
+type MockmerchVariantRepoMockRecorder struct {
+	mock *MockmerchVariantRepo
+}
+
+// NewMockmerchVariantRepo creates a new mock instance.
+func NewMockmerchVariantRepo(ctrl *gomock.Controller) *MockmerchVariantRepo {
+	mock := &MockmerchVariantRepo{ctrl: ctrl}
+	mock.recorder = &MockmerchVariantRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmerchVariantRepo) EXPECT() *MockmerchVariantRepoMockRecorder {
+	return m.recorder
+}
+
+// DecreaseStock mocks base method.
+func (m *MockmerchVariantRepo) DecreaseStock(ctx context.Context, variantID, quantity int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecreaseStock", ctx, variantID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecreaseStock indicates an expected call of DecreaseStock.
+func (mr *MockmerchVariantRepoMockRecorder) DecreaseStock(ctx, variantID, quantity any) *MockmerchVariantRepoDecreaseStockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecreaseStock", reflect.TypeOf((*MockmerchVariantRepo)(nil).DecreaseStock), ctx, variantID, quantity)
+	return &MockmerchVariantRepoDecreaseStockCall{Call: call}
+}
+
+// MockmerchVariantRepoDecreaseStockCall wrap *gomock.Call
+type MockmerchVariantRepoDecreaseStockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchVariantRepoDecreaseStockCall) Return(arg0 error) *MockmerchVariantRepoDecreaseStockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchVariantRepoDecreaseStockCall) Do(f func(context.Context, int64, int64) error) *MockmerchVariantRepoDecreaseStockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchVariantRepoDecreaseStockCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockmerchVariantRepoDecreaseStockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByIDs mocks base method.
+func (m *MockmerchVariantRepo) GetByIDs(ctx context.Context, variantIDs []int64) ([]model.MerchVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, variantIDs)
+	ret0, _ := ret[0].([]model.MerchVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockmerchVariantRepoMockRecorder) GetByIDs(ctx, variantIDs any) *MockmerchVariantRepoGetByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockmerchVariantRepo)(nil).GetByIDs), ctx, variantIDs)
+	return &MockmerchVariantRepoGetByIDsCall{Call: call}
+}
+
+// MockmerchVariantRepoGetByIDsCall wrap *gomock.Call
+type MockmerchVariantRepoGetByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchVariantRepoGetByIDsCall) Return(arg0 []model.MerchVariant, arg1 error) *MockmerchVariantRepoGetByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchVariantRepoGetByIDsCall) Do(f func(context.Context, []int64) ([]model.MerchVariant, error)) *MockmerchVariantRepoGetByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchVariantRepoGetByIDsCall) DoAndReturn(f func(context.Context, []int64) ([]model.MerchVariant, error)) *MockmerchVariantRepoGetByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByMerch mocks base method.
+func (m *MockmerchVariantRepo) GetByMerch(ctx context.Context, merchID int64) ([]model.MerchVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMerch", ctx, merchID)
+	ret0, _ := ret[0].([]model.MerchVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMerch indicates an expected call of GetByMerch.
+func (mr *MockmerchVariantRepoMockRecorder) GetByMerch(ctx, merchID any) *MockmerchVariantRepoGetByMerchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMerch", reflect.TypeOf((*MockmerchVariantRepo)(nil).GetByMerch), ctx, merchID)
+	return &MockmerchVariantRepoGetByMerchCall{Call: call}
+}
+
+// MockmerchVariantRepoGetByMerchCall wrap *gomock.Call
+type MockmerchVariantRepoGetByMerchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchVariantRepoGetByMerchCall) Return(arg0 []model.MerchVariant, arg1 error) *MockmerchVariantRepoGetByMerchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchVariantRepoGetByMerchCall) Do(f func(context.Context, int64) ([]model.MerchVariant, error)) *MockmerchVariantRepoGetByMerchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchVariantRepoGetByMerchCall) DoAndReturn(f func(context.Context, int64) ([]model.MerchVariant, error)) *MockmerchVariantRepoGetByMerchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockorderRepo is a mock of orderRepo interface.
+type MockorderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockorderRepoMockRecorder
+}
+
+// MockorderRepoMockRecorder is the mock recorder for MockorderRepo.
+type MockorderRepoMockRecorder struct {
+	mock *MockorderRepo
+}
+
+// NewMockorderRepo creates a new mock instance.
+func NewMockorderRepo(ctrl *gomock.Controller) *MockorderRepo {
+	mock := &MockorderRepo{ctrl: ctrl}
+	mock.recorder = &MockorderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorderRepo) EXPECT() *MockorderRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockorderRepo) Add(ctx context.Context, employeeID, merchID, variantID, quantity, price int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, merchID, variantID, quantity, price)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockorderRepoMockRecorder) Add(ctx, employeeID, merchID, variantID, quantity, price any) *MockorderRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockorderRepo)(nil).Add), ctx, employeeID, merchID, variantID, quantity, price)
+	return &MockorderRepoAddCall{Call: call}
+}
+
+// MockorderRepoAddCall wrap *gomock.Call
+type MockorderRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderRepoAddCall) Return(arg0 int64, arg1 error) *MockorderRepoAddCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderRepoAddCall) Do(f func(context.Context, int64, int64, int64, int64, int64) (int64, error)) *MockorderRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, int64, int64, int64) (int64, error)) *MockorderRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddLots mocks base method.
+func (m *MockorderRepo) AddLots(ctx context.Context, orderID int64, parts []model.CoinLotPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLots", ctx, orderID, parts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLots indicates an expected call of AddLots.
+func (mr *MockorderRepoMockRecorder) AddLots(ctx, orderID, parts any) *MockorderRepoAddLotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLots", reflect.TypeOf((*MockorderRepo)(nil).AddLots), ctx, orderID, parts)
+	return &MockorderRepoAddLotsCall{Call: call}
+}
+
+// MockorderRepoAddLotsCall wrap *gomock.Call
+type MockorderRepoAddLotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderRepoAddLotsCall) Return(arg0 error) *MockorderRepoAddLotsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderRepoAddLotsCall) Do(f func(context.Context, int64, []model.CoinLotPart) error) *MockorderRepoAddLotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderRepoAddLotsCall) DoAndReturn(f func(context.Context, int64, []model.CoinLotPart) error) *MockorderRepoAddLotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcartRepo is a mock of cartRepo interface.
+type MockcartRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcartRepoMockRecorder
+}
+
+// MockcartRepoMockRecorder is the mock recorder for MockcartRepo.
+type MockcartRepoMockRecorder struct {
+	mock *MockcartRepo
+}
+
+// NewMockcartRepo creates a new mock instance.
+func NewMockcartRepo(ctrl *gomock.Controller) *MockcartRepo {
+	mock := &MockcartRepo{ctrl: ctrl}
+	mock.recorder = &MockcartRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcartRepo) EXPECT() *MockcartRepoMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockcartRepo) Clear(ctx context.Context, employeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, employeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockcartRepoMockRecorder) Clear(ctx, employeeID any) *MockcartRepoClearCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockcartRepo)(nil).Clear), ctx, employeeID)
+	return &MockcartRepoClearCall{Call: call}
+}
+
+// MockcartRepoClearCall wrap *gomock.Call
+type MockcartRepoClearCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcartRepoClearCall) Return(arg0 error) *MockcartRepoClearCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcartRepoClearCall) Do(f func(context.Context, int64) error) *MockcartRepoClearCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcartRepoClearCall) DoAndReturn(f func(context.Context, int64) error) *MockcartRepoClearCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByEmployee mocks base method.
+func (m *MockcartRepo) GetByEmployee(ctx context.Context, employeeID int64) ([]model.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmployee", ctx, employeeID)
+	ret0, _ := ret[0].([]model.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmployee indicates an expected call of GetByEmployee.
+func (mr *MockcartRepoMockRecorder) GetByEmployee(ctx, employeeID any) *MockcartRepoGetByEmployeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmployee", reflect.TypeOf((*MockcartRepo)(nil).GetByEmployee), ctx, employeeID)
+	return &MockcartRepoGetByEmployeeCall{Call: call}
+}
+
+// MockcartRepoGetByEmployeeCall wrap *gomock.Call
+type MockcartRepoGetByEmployeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcartRepoGetByEmployeeCall) Return(arg0 []model.CartItem, arg1 error) *MockcartRepoGetByEmployeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcartRepoGetByEmployeeCall) Do(f func(context.Context, int64) ([]model.CartItem, error)) *MockcartRepoGetByEmployeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcartRepoGetByEmployeeCall) DoAndReturn(f func(context.Context, int64) ([]model.CartItem, error)) *MockcartRepoGetByEmployeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// Decrease mocks base method.
+func (m *MockcoinLotRepo) Decrease(ctx context.Context, lotID, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrease", ctx, lotID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decrease indicates an expected call of Decrease.
+func (mr *MockcoinLotRepoMockRecorder) Decrease(ctx, lotID, amount any) *MockcoinLotRepoDecreaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrease", reflect.TypeOf((*MockcoinLotRepo)(nil).Decrease), ctx, lotID, amount)
+	return &MockcoinLotRepoDecreaseCall{Call: call}
+}
+
+// MockcoinLotRepoDecreaseCall wrap *gomock.Call
+type MockcoinLotRepoDecreaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoDecreaseCall) Return(arg0 error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoDecreaseCall) Do(f func(context.Context, int64, int64) error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoDecreaseCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetActive mocks base method.
+func (m *MockcoinLotRepo) GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx, employeeID, now)
+	ret0, _ := ret[0].([]model.CoinLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockcoinLotRepoMockRecorder) GetActive(ctx, employeeID, now any) *MockcoinLotRepoGetActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockcoinLotRepo)(nil).GetActive), ctx, employeeID, now)
+	return &MockcoinLotRepoGetActiveCall{Call: call}
+}
+
+// MockcoinLotRepoGetActiveCall wrap *gomock.Call
+type MockcoinLotRepoGetActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoGetActiveCall) Return(arg0 []model.CoinLot, arg1 error) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoGetActiveCall) Do(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoGetActiveCall) DoAndReturn(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,136 @@
+package cart_managing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type UseCase struct {
+	cartRepo    cartRepo
+	merchRepo   merchRepo
+	variantRepo merchVariantRepo
+}
+
+func New(cartRepo cartRepo, merchRepo merchRepo, variantRepo merchVariantRepo) (*UseCase, error) {
+	if cartRepo == nil {
+		return nil, errors.New("cartRepo is nil")
+	}
+	if merchRepo == nil {
+		return nil, errors.New("merchRepo is nil")
+	}
+	if variantRepo == nil {
+		return nil, errors.New("variantRepo is nil")
+	}
+
+	return &UseCase{
+		cartRepo:    cartRepo,
+		merchRepo:   merchRepo,
+		variantRepo: variantRepo,
+	}, nil
+}
+
+// Get returns the employee cart priced with the current merch prices.
+func (uc *UseCase) Get(ctx context.Context, employeeID int64) (model.Cart, error) {
+	items, err := uc.cartRepo.GetByEmployee(ctx, employeeID)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("cartRepo.GetByEmployee: %w", err)
+	}
+	if len(items) == 0 {
+		return model.Cart{}, nil
+	}
+
+	merchIDs := make([]int64, 0, len(items))
+	variantIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		merchIDs = append(merchIDs, item.MerchID)
+		variantIDs = append(variantIDs, item.VariantID)
+	}
+
+	merches, err := uc.merchRepo.GetByIDs(ctx, merchIDs)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("merchRepo.GetByIDs: %w", err)
+	}
+
+	variants, err := uc.variantRepo.GetByIDs(ctx, variantIDs)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("variantRepo.GetByIDs: %w", err)
+	}
+
+	// merch or variant missing from the catalog is reported at checkout
+	cart, _ := model.PriceCart(items, merches, variants)
+
+	return cart, nil
+}
+
+func (uc *UseCase) Add(
+	ctx context.Context,
+	employeeID int64,
+	merchName string,
+	options model.VariantOptions,
+	quantity int64,
+) (model.Cart, error) {
+	if quantity <= 0 || quantity > model.MaxCartItemQuantity {
+		return model.Cart{}, model.ErrInvalidQuantity
+	}
+
+	merch, err := uc.merchRepo.GetByName(ctx, merchName)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("merchRepo.GetByName: %w", err)
+	}
+
+	variants, err := uc.variantRepo.GetByMerch(ctx, merch.ID)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("variantRepo.GetByMerch: %w", err)
+	}
+
+	variant, err := model.SelectVariant(variants, options)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("model.SelectVariant: %w", err)
+	}
+
+	err = uc.cartRepo.Add(ctx, employeeID, merch.ID, variant.ID, quantity)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("cartRepo.Add: %w", err)
+	}
+
+	return uc.Get(ctx, employeeID)
+}
+
+// Remove removes the merch variant from the cart, variants no longer sold can be removed too.
+func (uc *UseCase) Remove(
+	ctx context.Context,
+	employeeID int64,
+	merchName string,
+	options model.VariantOptions,
+) (model.Cart, error) {
+	merch, err := uc.merchRepo.GetByName(ctx, merchName)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("merchRepo.GetByName: %w", err)
+	}
+
+	variants, err := uc.variantRepo.GetByMerch(ctx, merch.ID)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("variantRepo.GetByMerch: %w", err)
+	}
+
+	variantID := int64(0)
+	for _, variant := range variants {
+		if variant.Size == options.Size && variant.Color == options.Color {
+			variantID = variant.ID
+			break
+		}
+	}
+	if variantID == 0 {
+		return model.Cart{}, model.ErrCartItemNotFound
+	}
+
+	err = uc.cartRepo.Remove(ctx, employeeID, variantID)
+	if err != nil {
+		return model.Cart{}, fmt.Errorf("cartRepo.Remove: %w", err)
+	}
+
+	return uc.Get(ctx, employeeID)
+}
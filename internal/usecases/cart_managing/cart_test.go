@@ -0,0 +1,260 @@
+package cart_managing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+var tShirtVariants = []model.MerchVariant{
+	{ID: 1, MerchID: 1, Active: false},
+	{ID: 20, MerchID: 1, Size: "L", Color: "black", Active: true},
+	{ID: 21, MerchID: 1, Size: "XXL", Color: "black", PriceDelta: 10, Active: true},
+}
+
+func TestUseCase_Get(t *testing.T) {
+	type mocks struct {
+		cartRepo    *MockcartRepo
+		merchRepo   *MockmerchRepo
+		variantRepo *MockmerchVariantRepo
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantRes model.Cart
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return([]model.CartItem{
+					{MerchID: 2, VariantID: 2, Quantity: 2},
+					{MerchID: 1, VariantID: 21, Quantity: 1},
+				}, nil)
+				m.merchRepo.EXPECT().GetByIDs(gomock.Any(), []int64{2, 1}).Return([]model.Merch{
+					{ID: 1, Name: "t-shirt", Price: 80},
+					{ID: 2, Name: "cup", Price: 20},
+				}, nil)
+				m.variantRepo.EXPECT().GetByIDs(gomock.Any(), []int64{2, 21}).Return([]model.MerchVariant{
+					tShirtVariants[2],
+					{ID: 2, MerchID: 2, Active: true},
+				}, nil)
+			},
+			wantRes: model.Cart{
+				Lines: []model.CartLine{
+					{MerchID: 2, MerchName: "cup", Variant: model.MerchVariant{ID: 2, MerchID: 2, Active: true}, Quantity: 2, Price: 20},
+					{MerchID: 1, MerchName: "t-shirt", Variant: tShirtVariants[2], Quantity: 1, Price: 90},
+				},
+				Total: 130,
+			},
+		},
+		{
+			name: "success.empty",
+			prepare: func(m *mocks) {
+				m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(nil, nil)
+			},
+			wantRes: model.Cart{},
+		},
+		{
+			name: "error.cart_repo.get_by_employee",
+			prepare: func(m *mocks) {
+				m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				cartRepo:    NewMockcartRepo(ctrl),
+				merchRepo:   NewMockmerchRepo(ctrl),
+				variantRepo: NewMockmerchVariantRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.cartRepo, m.merchRepo, m.variantRepo)
+			require.NoError(t, err)
+
+			res, err := uc.Get(context.Background(), 100)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_Add(t *testing.T) {
+	type mocks struct {
+		cartRepo    *MockcartRepo
+		merchRepo   *MockmerchRepo
+		variantRepo *MockmerchVariantRepo
+	}
+
+	type args struct {
+		merchName string
+		options   model.VariantOptions
+		quantity  int64
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "cup").Return(&model.Merch{ID: 2, Name: "cup", Price: 20}, nil)
+				m.variantRepo.EXPECT().GetByMerch(gomock.Any(), int64(2)).Return([]model.MerchVariant{
+					{ID: 2, MerchID: 2, Active: true},
+				}, nil)
+				m.cartRepo.EXPECT().Add(gomock.Any(), int64(100), int64(2), int64(2), int64(3)).Return(nil)
+				m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return([]model.CartItem{
+					{MerchID: 2, VariantID: 2, Quantity: 3},
+				}, nil)
+				m.merchRepo.EXPECT().GetByIDs(gomock.Any(), []int64{2}).Return([]model.Merch{
+					{ID: 2, Name: "cup", Price: 20},
+				}, nil)
+				m.variantRepo.EXPECT().GetByIDs(gomock.Any(), []int64{2}).Return([]model.MerchVariant{
+					{ID: 2, MerchID: 2, Active: true},
+				}, nil)
+			},
+			args: args{merchName: "cup", quantity: 3},
+		},
+		{
+			name: "success.variant",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "t-shirt").Return(&model.Merch{ID: 1, Name: "t-shirt", Price: 80}, nil)
+				m.variantRepo.EXPECT().GetByMerch(gomock.Any(), int64(1)).Return(tShirtVariants, nil)
+				m.cartRepo.EXPECT().Add(gomock.Any(), int64(100), int64(1), int64(20), int64(1)).Return(nil)
+				m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(nil, nil)
+			},
+			args: args{merchName: "t-shirt", options: model.VariantOptions{Size: "L", Color: "black"}, quantity: 1},
+		},
+		{
+			name: "error.variant_required",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "t-shirt").Return(&model.Merch{ID: 1, Name: "t-shirt", Price: 80}, nil)
+				m.variantRepo.EXPECT().GetByMerch(gomock.Any(), int64(1)).Return(tShirtVariants, nil)
+			},
+			args:    args{merchName: "t-shirt", quantity: 1},
+			wantErr: model.ErrVariantRequired,
+		},
+		{
+			name: "error.variant_not_found",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "t-shirt").Return(&model.Merch{ID: 1, Name: "t-shirt", Price: 80}, nil)
+				m.variantRepo.EXPECT().GetByMerch(gomock.Any(), int64(1)).Return(tShirtVariants, nil)
+			},
+			args:    args{merchName: "t-shirt", options: model.VariantOptions{Size: "XS", Color: "black"}, quantity: 1},
+			wantErr: model.ErrVariantNotFound,
+		},
+		{
+			name:    "error.invalid_quantity",
+			prepare: func(_ *mocks) {},
+			args:    args{merchName: "cup", quantity: 0},
+			wantErr: model.ErrInvalidQuantity,
+		},
+		{
+			name:    "error.too_big_quantity",
+			prepare: func(_ *mocks) {},
+			args:    args{merchName: "cup", quantity: model.MaxCartItemQuantity + 1},
+			wantErr: model.ErrInvalidQuantity,
+		},
+		{
+			name: "error.merch_not_found",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "car").Return(nil, model.ErrMerchNotFound)
+			},
+			args:    args{merchName: "car", quantity: 1},
+			wantErr: model.ErrMerchNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				cartRepo:    NewMockcartRepo(ctrl),
+				merchRepo:   NewMockmerchRepo(ctrl),
+				variantRepo: NewMockmerchVariantRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.cartRepo, m.merchRepo, m.variantRepo)
+			require.NoError(t, err)
+
+			_, err = uc.Add(context.Background(), 100, tc.args.merchName, tc.args.options, tc.args.quantity)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_Remove(t *testing.T) {
+	type mocks struct {
+		cartRepo    *MockcartRepo
+		merchRepo   *MockmerchRepo
+		variantRepo *MockmerchVariantRepo
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "cup").Return(&model.Merch{ID: 2, Name: "cup", Price: 20}, nil)
+				m.variantRepo.EXPECT().GetByMerch(gomock.Any(), int64(2)).Return([]model.MerchVariant{
+					{ID: 2, MerchID: 2, Active: true},
+				}, nil)
+				m.cartRepo.EXPECT().Remove(gomock.Any(), int64(100), int64(2)).Return(nil)
+				m.cartRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(nil, nil)
+			},
+		},
+		{
+			name: "error.not_in_cart",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "cup").Return(&model.Merch{ID: 2, Name: "cup", Price: 20}, nil)
+				m.variantRepo.EXPECT().GetByMerch(gomock.Any(), int64(2)).Return([]model.MerchVariant{
+					{ID: 2, MerchID: 2, Active: true},
+				}, nil)
+				m.cartRepo.EXPECT().Remove(gomock.Any(), int64(100), int64(2)).Return(model.ErrCartItemNotFound)
+			},
+			wantErr: model.ErrCartItemNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				cartRepo:    NewMockcartRepo(ctrl),
+				merchRepo:   NewMockmerchRepo(ctrl),
+				variantRepo: NewMockmerchVariantRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.cartRepo, m.merchRepo, m.variantRepo)
+			require.NoError(t, err)
+
+			_, err = uc.Remove(context.Background(), 100, "cup", model.VariantOptions{})
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package cart_managing
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type cartRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.CartItem, error)
+	Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error
+	Remove(ctx context.Context, employeeID, variantID int64) error
+}
+
+type merchRepo interface {
+	GetByName(ctx context.Context, name string) (*model.Merch, error)
+	GetByIDs(ctx context.Context, merchIDs []int64) ([]model.Merch, error)
+}
+
+type merchVariantRepo interface {
+	GetByMerch(ctx context.Context, merchID int64) ([]model.MerchVariant, error)
+	GetByIDs(ctx context.Context, variantIDs []int64) ([]model.MerchVariant, error)
+}
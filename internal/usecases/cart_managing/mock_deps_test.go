@@ -0,0 +1,358 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package cart_managing -typed -destination mock_deps_test.go
+//
+
+// Package cart_managing is a generated GoMock package.
+package cart_managing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcartRepo is a mock of cartRepo interface.
+type MockcartRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcartRepoMockRecorder
+}
+
+// MockcartRepoMockRecorder is the mock recorder for MockcartRepo.
+type MockcartRepoMockRecorder struct {
+	mock *MockcartRepo
+}
+
+// NewMockcartRepo creates a new mock instance.
+func NewMockcartRepo(ctrl *gomock.Controller) *MockcartRepo {
+	mock := &MockcartRepo{ctrl: ctrl}
+	mock.recorder = &MockcartRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcartRepo) EXPECT() *MockcartRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockcartRepo) Add(ctx context.Context, employeeID, merchID, variantID, quantity int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, merchID, variantID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockcartRepoMockRecorder) Add(ctx, employeeID, merchID, variantID, quantity any) *MockcartRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockcartRepo)(nil).Add), ctx, employeeID, merchID, variantID, quantity)
+	return &MockcartRepoAddCall{Call: call}
+}
+
+// MockcartRepoAddCall wrap *gomock.Call
+type MockcartRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcartRepoAddCall) Return(arg0 error) *MockcartRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcartRepoAddCall) Do(f func(context.Context, int64, int64, int64, int64) error) *MockcartRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcartRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, int64, int64) error) *MockcartRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByEmployee mocks base method.
+func (m *MockcartRepo) GetByEmployee(ctx context.Context, employeeID int64) ([]model.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmployee", ctx, employeeID)
+	ret0, _ := ret[0].([]model.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmployee indicates an expected call of GetByEmployee.
+func (mr *MockcartRepoMockRecorder) GetByEmployee(ctx, employeeID any) *MockcartRepoGetByEmployeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmployee", reflect.TypeOf((*MockcartRepo)(nil).GetByEmployee), ctx, employeeID)
+	return &MockcartRepoGetByEmployeeCall{Call: call}
+}
+
+// MockcartRepoGetByEmployeeCall wrap *gomock.Call
+type MockcartRepoGetByEmployeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcartRepoGetByEmployeeCall) Return(arg0 []model.CartItem, arg1 error) *MockcartRepoGetByEmployeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcartRepoGetByEmployeeCall) Do(f func(context.Context, int64) ([]model.CartItem, error)) *MockcartRepoGetByEmployeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcartRepoGetByEmployeeCall) DoAndReturn(f func(context.Context, int64) ([]model.CartItem, error)) *MockcartRepoGetByEmployeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Remove mocks base method.
+func (m *MockcartRepo) Remove(ctx context.Context, employeeID, variantID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, employeeID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockcartRepoMockRecorder) Remove(ctx, employeeID, variantID any) *MockcartRepoRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockcartRepo)(nil).Remove), ctx, employeeID, variantID)
+	return &MockcartRepoRemoveCall{Call: call}
+}
+
+// MockcartRepoRemoveCall wrap *gomock.Call
+type MockcartRepoRemoveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcartRepoRemoveCall) Return(arg0 error) *MockcartRepoRemoveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcartRepoRemoveCall) Do(f func(context.Context, int64, int64) error) *MockcartRepoRemoveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcartRepoRemoveCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockcartRepoRemoveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockmerchRepo is a mock of merchRepo interface.
+type MockmerchRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockmerchRepoMockRecorder
+}
+
+// MockmerchRepoMockRecorder is the mock recorder for MockmerchRepo.
+type MockmerchRepoMockRecorder struct {
+	mock *MockmerchRepo
+}
+
+// NewMockmerchRepo creates a new mock instance.
+func NewMockmerchRepo(ctrl *gomock.Controller) *MockmerchRepo {
+	mock := &MockmerchRepo{ctrl: ctrl}
+	mock.recorder = &MockmerchRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmerchRepo) EXPECT() *MockmerchRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByIDs mocks base method.
+func (m *MockmerchRepo) GetByIDs(ctx context.Context, merchIDs []int64) ([]model.Merch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, merchIDs)
+	ret0, _ := ret[0].([]model.Merch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockmerchRepoMockRecorder) GetByIDs(ctx, merchIDs any) *MockmerchRepoGetByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockmerchRepo)(nil).GetByIDs), ctx, merchIDs)
+	return &MockmerchRepoGetByIDsCall{Call: call}
+}
+
+// MockmerchRepoGetByIDsCall wrap *gomock.Call
+type MockmerchRepoGetByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchRepoGetByIDsCall) Return(arg0 []model.Merch, arg1 error) *MockmerchRepoGetByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchRepoGetByIDsCall) Do(f func(context.Context, []int64) ([]model.Merch, error)) *MockmerchRepoGetByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchRepoGetByIDsCall) DoAndReturn(f func(context.Context, []int64) ([]model.Merch, error)) *MockmerchRepoGetByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByName mocks base method.
+func (m *MockmerchRepo) GetByName(ctx context.Context, name string) (*model.Merch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(*model.Merch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockmerchRepoMockRecorder) GetByName(ctx, name any) *MockmerchRepoGetByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockmerchRepo)(nil).GetByName), ctx, name)
+	return &MockmerchRepoGetByNameCall{Call: call}
+}
+
+// MockmerchRepoGetByNameCall wrap *gomock.Call
+type MockmerchRepoGetByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchRepoGetByNameCall) Return(arg0 *model.Merch, arg1 error) *MockmerchRepoGetByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchRepoGetByNameCall) Do(f func(context.Context, string) (*model.Merch, error)) *MockmerchRepoGetByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchRepoGetByNameCall) DoAndReturn(f func(context.Context, string) (*model.Merch, error)) *MockmerchRepoGetByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockmerchVariantRepo is a mock of merchVariantRepo interface.
+type MockmerchVariantRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockmerchVariantRepoMockRecorder
+}
+
+// MockmerchVariantRepoMockRecorder is the mock recorder for MockmerchVariantRepo.
+type MockmerchVariantRepoMockRecorder struct {
+	mock *MockmerchVariantRepo
+}
+
+// NewMockmerchVariantRepo creates a new mock instance.
+func NewMockmerchVariantRepo(ctrl *gomock.Controller) *MockmerchVariantRepo {
+	mock := &MockmerchVariantRepo{ctrl: ctrl}
+	mock.recorder = &MockmerchVariantRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmerchVariantRepo) EXPECT() *MockmerchVariantRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByIDs mocks base method.
+func (m *MockmerchVariantRepo) GetByIDs(ctx context.Context, variantIDs []int64) ([]model.MerchVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, variantIDs)
+	ret0, _ := ret[0].([]model.MerchVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockmerchVariantRepoMockRecorder) GetByIDs(ctx, variantIDs any) *MockmerchVariantRepoGetByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockmerchVariantRepo)(nil).GetByIDs), ctx, variantIDs)
+	return &MockmerchVariantRepoGetByIDsCall{Call: call}
+}
+
+// MockmerchVariantRepoGetByIDsCall wrap *gomock.Call
+type MockmerchVariantRepoGetByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchVariantRepoGetByIDsCall) Return(arg0 []model.MerchVariant, arg1 error) *MockmerchVariantRepoGetByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchVariantRepoGetByIDsCall) Do(f func(context.Context, []int64) ([]model.MerchVariant, error)) *MockmerchVariantRepoGetByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchVariantRepoGetByIDsCall) DoAndReturn(f func(context.Context, []int64) ([]model.MerchVariant, error)) *MockmerchVariantRepoGetByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByMerch mocks base method.
+func (m *MockmerchVariantRepo) GetByMerch(ctx context.Context, merchID int64) ([]model.MerchVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMerch", ctx, merchID)
+	ret0, _ := ret[0].([]model.MerchVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMerch indicates an expected call of GetByMerch.
+func (mr *MockmerchVariantRepoMockRecorder) GetByMerch(ctx, merchID any) *MockmerchVariantRepoGetByMerchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMerch", reflect.TypeOf((*MockmerchVariantRepo)(nil).GetByMerch), ctx, merchID)
+	return &MockmerchVariantRepoGetByMerchCall{Call: call}
+}
+
+// MockmerchVariantRepoGetByMerchCall wrap *gomock.Call
+type MockmerchVariantRepoGetByMerchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchVariantRepoGetByMerchCall) Return(arg0 []model.MerchVariant, arg1 error) *MockmerchVariantRepoGetByMerchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchVariantRepoGetByMerchCall) Do(f func(context.Context, int64) ([]model.MerchVariant, error)) *MockmerchVariantRepoGetByMerchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchVariantRepoGetByMerchCall) DoAndReturn(f func(context.Context, int64) ([]model.MerchVariant, error)) *MockmerchVariantRepoGetByMerchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,28 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package coin_expiring
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type employeeRepo interface {
+	GetByIDWithLock(ctx context.Context, employeeID int64) (*model.Employee, error)
+	IncreaseBalance(ctx context.Context, employeeID, amount int64) error
+}
+
+type coinLotRepo interface {
+	GetEmployeesWithExpired(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	GetExpired(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error)
+	Decrease(ctx context.Context, lotID, amount int64) error
+}
+
+type ledgerEntryRepo interface {
+	Add(ctx context.Context, entry model.LedgerEntry) error
+}
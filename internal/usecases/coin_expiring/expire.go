@@ -0,0 +1,121 @@
+package coin_expiring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const employeesBatchSize = 100
+
+type UseCase struct {
+	trManager       trManager
+	employeeRepo    employeeRepo
+	coinLotRepo     coinLotRepo
+	ledgerEntryRepo ledgerEntryRepo
+}
+
+func New(
+	trManager trManager,
+	employeeRepo employeeRepo,
+	coinLotRepo coinLotRepo,
+	ledgerEntryRepo ledgerEntryRepo,
+) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
+	if ledgerEntryRepo == nil {
+		return nil, errors.New("ledgerEntryRepo is nil")
+	}
+
+	return &UseCase{
+		trManager:       trManager,
+		employeeRepo:    employeeRepo,
+		coinLotRepo:     coinLotRepo,
+		ledgerEntryRepo: ledgerEntryRepo,
+	}, nil
+}
+
+// ExpireDue writes off remaining coins of lots expired by now and returns the number of written off coins.
+// Every employee is processed in a separate transaction.
+func (uc *UseCase) ExpireDue(ctx context.Context, now time.Time) (int64, error) {
+	var expired int64
+	for {
+		employeeIDs, err := uc.coinLotRepo.GetEmployeesWithExpired(ctx, now, employeesBatchSize)
+		if err != nil {
+			return expired, fmt.Errorf("coinLotRepo.GetEmployeesWithExpired: %w", err)
+		}
+
+		for _, employeeID := range employeeIDs {
+			amount, err := uc.expireEmployee(ctx, employeeID, now)
+			if err != nil {
+				return expired, fmt.Errorf("expireEmployee: %w", err)
+			}
+			expired += amount
+		}
+
+		if len(employeeIDs) < employeesBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+func (uc *UseCase) expireEmployee(ctx context.Context, employeeID int64, now time.Time) (int64, error) {
+	var total int64
+	err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+		// the employee lock serializes expiry with buying and sending which spend the same lots
+		_, err := uc.employeeRepo.GetByIDWithLock(ctx, employeeID)
+		if err != nil {
+			return fmt.Errorf("employeeRepo.GetByIDWithLock: %w", err)
+		}
+
+		lots, err := uc.coinLotRepo.GetExpired(ctx, employeeID, now)
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.GetExpired: %w", err)
+		}
+
+		for _, lot := range lots {
+			err = uc.coinLotRepo.Decrease(ctx, lot.ID, lot.Remaining)
+			if err != nil {
+				return fmt.Errorf("coinLotRepo.Decrease: %w", err)
+			}
+
+			err = uc.ledgerEntryRepo.Add(ctx, model.LedgerEntry{
+				EmployeeID: employeeID,
+				Amount:     -lot.Remaining,
+				Kind:       model.LedgerEntryKindExpiry,
+				CoinLotID:  &lot.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("ledgerEntryRepo.Add: %w", err)
+			}
+
+			total += lot.Remaining
+		}
+
+		if total == 0 {
+			return nil
+		}
+
+		err = uc.employeeRepo.IncreaseBalance(ctx, employeeID, -total)
+		if err != nil {
+			return fmt.Errorf("increase balance with negative expired amount: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return total, nil
+}
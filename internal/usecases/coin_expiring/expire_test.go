@@ -0,0 +1,146 @@
+package coin_expiring
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_ExpireDue(t *testing.T) {
+	type mocks struct {
+		trManager       *MocktrManager
+		employeeRepo    *MockemployeeRepo
+		coinLotRepo     *MockcoinLotRepo
+		ledgerEntryRepo *MockledgerEntryRepo
+	}
+
+	now := time.Date(2025, 2, 14, 3, 0, 0, 0, time.UTC)
+	lotID1, lotID2, lotID3 := int64(1), int64(2), int64(3)
+
+	doTr := func(ctx context.Context, do func(context.Context) error) error {
+		return do(ctx)
+	}
+
+	testCases := []struct {
+		name        string
+		prepare     func(m *mocks)
+		wantExpired int64
+		wantErr     error
+	}{
+		{
+			name: "success.nothing_expired",
+			prepare: func(m *mocks) {
+				m.coinLotRepo.EXPECT().
+					GetEmployeesWithExpired(gomock.Any(), now, employeesBatchSize).
+					Return(nil, nil)
+			},
+			wantExpired: 0,
+			wantErr:     nil,
+		},
+		{
+			name: "success.expired",
+			prepare: func(m *mocks) {
+				m.coinLotRepo.EXPECT().
+					GetEmployeesWithExpired(gomock.Any(), now, employeesBatchSize).
+					Return([]int64{100, 200}, nil)
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr).Times(2)
+
+				m.employeeRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(100)).Return(&model.Employee{ID: 100}, nil)
+				m.coinLotRepo.EXPECT().
+					GetExpired(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 30}, {ID: 2, Remaining: 20}}, nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), int64(30)).Return(nil)
+				m.ledgerEntryRepo.EXPECT().
+					Add(gomock.Any(), model.LedgerEntry{
+						EmployeeID: 100,
+						Amount:     -30,
+						Kind:       model.LedgerEntryKindExpiry,
+						CoinLotID:  &lotID1,
+					}).
+					Return(nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(2), int64(20)).Return(nil)
+				m.ledgerEntryRepo.EXPECT().
+					Add(gomock.Any(), model.LedgerEntry{
+						EmployeeID: 100,
+						Amount:     -20,
+						Kind:       model.LedgerEntryKindExpiry,
+						CoinLotID:  &lotID2,
+					}).
+					Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(-50)).Return(nil)
+
+				m.employeeRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(200)).Return(&model.Employee{ID: 200}, nil)
+				m.coinLotRepo.EXPECT().
+					GetExpired(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 3, Remaining: 5}}, nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(3), int64(5)).Return(nil)
+				m.ledgerEntryRepo.EXPECT().
+					Add(gomock.Any(), model.LedgerEntry{
+						EmployeeID: 200,
+						Amount:     -5,
+						Kind:       model.LedgerEntryKindExpiry,
+						CoinLotID:  &lotID3,
+					}).
+					Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(-5)).Return(nil)
+			},
+			wantExpired: 55,
+			wantErr:     nil,
+		},
+		{
+			name: "error.coinLotRepo.GetEmployeesWithExpired",
+			prepare: func(m *mocks) {
+				m.coinLotRepo.EXPECT().
+					GetEmployeesWithExpired(gomock.Any(), now, employeesBatchSize).
+					Return(nil, assert.AnError)
+			},
+			wantExpired: 0,
+			wantErr:     assert.AnError,
+		},
+		{
+			name: "error.ledgerEntryRepo.Add",
+			prepare: func(m *mocks) {
+				m.coinLotRepo.EXPECT().
+					GetEmployeesWithExpired(gomock.Any(), now, employeesBatchSize).
+					Return([]int64{100}, nil)
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				m.employeeRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(100)).Return(&model.Employee{ID: 100}, nil)
+				m.coinLotRepo.EXPECT().
+					GetExpired(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 30}}, nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), int64(30)).Return(nil)
+				m.ledgerEntryRepo.EXPECT().Add(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			wantExpired: 0,
+			wantErr:     assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:       NewMocktrManager(ctrl),
+				employeeRepo:    NewMockemployeeRepo(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
+				ledgerEntryRepo: NewMockledgerEntryRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.employeeRepo, m.coinLotRepo, m.ledgerEntryRepo)
+			require.NoError(t, err)
+
+			expired, err := uc.ExpireDue(context.Background(), now)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantExpired, expired)
+		})
+	}
+}
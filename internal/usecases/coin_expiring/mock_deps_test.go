@@ -0,0 +1,380 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package coin_expiring -typed -destination mock_deps_test.go
+//
+
+// Package coin_expiring is a generated GoMock package.
+package coin_expiring
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByIDWithLock mocks base method.
+func (m *MockemployeeRepo) GetByIDWithLock(ctx context.Context, employeeID int64) (*model.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDWithLock", ctx, employeeID)
+	ret0, _ := ret[0].(*model.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDWithLock indicates an expected call of GetByIDWithLock.
+func (mr *MockemployeeRepoMockRecorder) GetByIDWithLock(ctx, employeeID any) *MockemployeeRepoGetByIDWithLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithLock", reflect.TypeOf((*MockemployeeRepo)(nil).GetByIDWithLock), ctx, employeeID)
+	return &MockemployeeRepoGetByIDWithLockCall{Call: call}
+}
+
+// MockemployeeRepoGetByIDWithLockCall wrap *gomock.Call
+type MockemployeeRepoGetByIDWithLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoGetByIDWithLockCall) Return(arg0 *model.Employee, arg1 error) *MockemployeeRepoGetByIDWithLockCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoGetByIDWithLockCall) Do(f func(context.Context, int64) (*model.Employee, error)) *MockemployeeRepoGetByIDWithLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoGetByIDWithLockCall) DoAndReturn(f func(context.Context, int64) (*model.Employee, error)) *MockemployeeRepoGetByIDWithLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IncreaseBalance mocks base method.
+func (m *MockemployeeRepo) IncreaseBalance(ctx context.Context, employeeID, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseBalance", ctx, employeeID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseBalance indicates an expected call of IncreaseBalance.
+func (mr *MockemployeeRepoMockRecorder) IncreaseBalance(ctx, employeeID, amount any) *MockemployeeRepoIncreaseBalanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseBalance", reflect.TypeOf((*MockemployeeRepo)(nil).IncreaseBalance), ctx, employeeID, amount)
+	return &MockemployeeRepoIncreaseBalanceCall{Call: call}
+}
+
+// MockemployeeRepoIncreaseBalanceCall wrap *gomock.Call
+type MockemployeeRepoIncreaseBalanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoIncreaseBalanceCall) Return(arg0 error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoIncreaseBalanceCall) Do(f func(context.Context, int64, int64) error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoIncreaseBalanceCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// Decrease mocks base method.
+func (m *MockcoinLotRepo) Decrease(ctx context.Context, lotID, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrease", ctx, lotID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decrease indicates an expected call of Decrease.
+func (mr *MockcoinLotRepoMockRecorder) Decrease(ctx, lotID, amount any) *MockcoinLotRepoDecreaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrease", reflect.TypeOf((*MockcoinLotRepo)(nil).Decrease), ctx, lotID, amount)
+	return &MockcoinLotRepoDecreaseCall{Call: call}
+}
+
+// MockcoinLotRepoDecreaseCall wrap *gomock.Call
+type MockcoinLotRepoDecreaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoDecreaseCall) Return(arg0 error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoDecreaseCall) Do(f func(context.Context, int64, int64) error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoDecreaseCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetEmployeesWithExpired mocks base method.
+func (m *MockcoinLotRepo) GetEmployeesWithExpired(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeesWithExpired", ctx, now, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeesWithExpired indicates an expected call of GetEmployeesWithExpired.
+func (mr *MockcoinLotRepoMockRecorder) GetEmployeesWithExpired(ctx, now, limit any) *MockcoinLotRepoGetEmployeesWithExpiredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeesWithExpired", reflect.TypeOf((*MockcoinLotRepo)(nil).GetEmployeesWithExpired), ctx, now, limit)
+	return &MockcoinLotRepoGetEmployeesWithExpiredCall{Call: call}
+}
+
+// MockcoinLotRepoGetEmployeesWithExpiredCall wrap *gomock.Call
+type MockcoinLotRepoGetEmployeesWithExpiredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoGetEmployeesWithExpiredCall) Return(arg0 []int64, arg1 error) *MockcoinLotRepoGetEmployeesWithExpiredCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoGetEmployeesWithExpiredCall) Do(f func(context.Context, time.Time, int) ([]int64, error)) *MockcoinLotRepoGetEmployeesWithExpiredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoGetEmployeesWithExpiredCall) DoAndReturn(f func(context.Context, time.Time, int) ([]int64, error)) *MockcoinLotRepoGetEmployeesWithExpiredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetExpired mocks base method.
+func (m *MockcoinLotRepo) GetExpired(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpired", ctx, employeeID, now)
+	ret0, _ := ret[0].([]model.CoinLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpired indicates an expected call of GetExpired.
+func (mr *MockcoinLotRepoMockRecorder) GetExpired(ctx, employeeID, now any) *MockcoinLotRepoGetExpiredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpired", reflect.TypeOf((*MockcoinLotRepo)(nil).GetExpired), ctx, employeeID, now)
+	return &MockcoinLotRepoGetExpiredCall{Call: call}
+}
+
+// MockcoinLotRepoGetExpiredCall wrap *gomock.Call
+type MockcoinLotRepoGetExpiredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoGetExpiredCall) Return(arg0 []model.CoinLot, arg1 error) *MockcoinLotRepoGetExpiredCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoGetExpiredCall) Do(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetExpiredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoGetExpiredCall) DoAndReturn(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetExpiredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockledgerEntryRepo is a mock of ledgerEntryRepo interface.
+type MockledgerEntryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockledgerEntryRepoMockRecorder
+}
+
+// MockledgerEntryRepoMockRecorder is the mock recorder for MockledgerEntryRepo.
+type MockledgerEntryRepoMockRecorder struct {
+	mock *MockledgerEntryRepo
+}
+
+// NewMockledgerEntryRepo creates a new mock instance.
+func NewMockledgerEntryRepo(ctrl *gomock.Controller) *MockledgerEntryRepo {
+	mock := &MockledgerEntryRepo{ctrl: ctrl}
+	mock.recorder = &MockledgerEntryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockledgerEntryRepo) EXPECT() *MockledgerEntryRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockledgerEntryRepo) Add(ctx context.Context, entry model.LedgerEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockledgerEntryRepoMockRecorder) Add(ctx, entry any) *MockledgerEntryRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockledgerEntryRepo)(nil).Add), ctx, entry)
+	return &MockledgerEntryRepoAddCall{Call: call}
+}
+
+// MockledgerEntryRepoAddCall wrap *gomock.Call
+type MockledgerEntryRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockledgerEntryRepoAddCall) Return(arg0 error) *MockledgerEntryRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockledgerEntryRepoAddCall) Do(f func(context.Context, model.LedgerEntry) error) *MockledgerEntryRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockledgerEntryRepoAddCall) DoAndReturn(f func(context.Context, model.LedgerEntry) error) *MockledgerEntryRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
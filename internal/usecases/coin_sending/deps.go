@@ -3,6 +3,7 @@ package coin_sending
 
 import (
 	"context"
+	"time"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
@@ -13,10 +14,27 @@ type trManager interface {
 
 type employeeRepo interface {
 	GetByUsername(ctx context.Context, username string) (*model.Employee, error)
+	GetByUsernames(ctx context.Context, usernames []string) ([]model.Employee, error)
 	GetByIDWithLock(ctx context.Context, employeeID int64) (*model.Employee, error)
 	IncreaseBalance(ctx context.Context, employeeID, amount int64) error
+	SetGivingBudget(ctx context.Context, employeeID, budget int64, period time.Time) error
 }
 
 type transactionRepo interface {
 	Add(ctx context.Context, senderID, receiverID, amount int64) error
 }
+
+type transferPolicy interface {
+	Check(ctx context.Context, transfers []model.PolicyTransfer) error
+}
+
+type coinLotRepo interface {
+	GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error)
+	Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error
+	Decrease(ctx context.Context, lotID, amount int64) error
+}
+
+type transferRequestRepo interface {
+	Create(ctx context.Context, request model.TransferRequest) (*model.TransferRequest, error)
+	AddLots(ctx context.Context, requestID int64, parts []model.CoinLotPart) error
+}
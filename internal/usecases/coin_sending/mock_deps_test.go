@@ -12,6 +12,7 @@ package coin_sending
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/inna-maikut/avito-shop/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -179,6 +180,45 @@ func (c *MockemployeeRepoGetByUsernameCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetByUsernames mocks base method.
+func (m *MockemployeeRepo) GetByUsernames(ctx context.Context, usernames []string) ([]model.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUsernames", ctx, usernames)
+	ret0, _ := ret[0].([]model.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUsernames indicates an expected call of GetByUsernames.
+func (mr *MockemployeeRepoMockRecorder) GetByUsernames(ctx, usernames any) *MockemployeeRepoGetByUsernamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsernames", reflect.TypeOf((*MockemployeeRepo)(nil).GetByUsernames), ctx, usernames)
+	return &MockemployeeRepoGetByUsernamesCall{Call: call}
+}
+
+// MockemployeeRepoGetByUsernamesCall wrap *gomock.Call
+type MockemployeeRepoGetByUsernamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoGetByUsernamesCall) Return(arg0 []model.Employee, arg1 error) *MockemployeeRepoGetByUsernamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoGetByUsernamesCall) Do(f func(context.Context, []string) ([]model.Employee, error)) *MockemployeeRepoGetByUsernamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoGetByUsernamesCall) DoAndReturn(f func(context.Context, []string) ([]model.Employee, error)) *MockemployeeRepoGetByUsernamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IncreaseBalance mocks base method.
 func (m *MockemployeeRepo) IncreaseBalance(ctx context.Context, employeeID, amount int64) error {
 	m.ctrl.T.Helper()
@@ -217,6 +257,44 @@ func (c *MockemployeeRepoIncreaseBalanceCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// SetGivingBudget mocks base method.
+func (m *MockemployeeRepo) SetGivingBudget(ctx context.Context, employeeID, budget int64, period time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGivingBudget", ctx, employeeID, budget, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGivingBudget indicates an expected call of SetGivingBudget.
+func (mr *MockemployeeRepoMockRecorder) SetGivingBudget(ctx, employeeID, budget, period any) *MockemployeeRepoSetGivingBudgetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGivingBudget", reflect.TypeOf((*MockemployeeRepo)(nil).SetGivingBudget), ctx, employeeID, budget, period)
+	return &MockemployeeRepoSetGivingBudgetCall{Call: call}
+}
+
+// MockemployeeRepoSetGivingBudgetCall wrap *gomock.Call
+type MockemployeeRepoSetGivingBudgetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoSetGivingBudgetCall) Return(arg0 error) *MockemployeeRepoSetGivingBudgetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoSetGivingBudgetCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockemployeeRepoSetGivingBudgetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoSetGivingBudgetCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockemployeeRepoSetGivingBudgetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MocktransactionRepo is a mock of transactionRepo interface.
 type MocktransactionRepo struct {
 	ctrl     *gomock.Controller
@@ -277,3 +355,302 @@ func (c *MocktransactionRepoAddCall) DoAndReturn(f func(context.Context, int64,
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MocktransferPolicy is a mock of transferPolicy interface.
+type MocktransferPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransferPolicyMockRecorder
+}
+
+// MocktransferPolicyMockRecorder is the mock recorder for MocktransferPolicy.
+type MocktransferPolicyMockRecorder struct {
+	mock *MocktransferPolicy
+}
+
+// NewMocktransferPolicy creates a new mock instance.
+func NewMocktransferPolicy(ctrl *gomock.Controller) *MocktransferPolicy {
+	mock := &MocktransferPolicy{ctrl: ctrl}
+	mock.recorder = &MocktransferPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransferPolicy) EXPECT() *MocktransferPolicyMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MocktransferPolicy) Check(ctx context.Context, transfers []model.PolicyTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, transfers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MocktransferPolicyMockRecorder) Check(ctx, transfers any) *MocktransferPolicyCheckCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MocktransferPolicy)(nil).Check), ctx, transfers)
+	return &MocktransferPolicyCheckCall{Call: call}
+}
+
+// MocktransferPolicyCheckCall wrap *gomock.Call
+type MocktransferPolicyCheckCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferPolicyCheckCall) Return(arg0 error) *MocktransferPolicyCheckCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferPolicyCheckCall) Do(f func(context.Context, []model.PolicyTransfer) error) *MocktransferPolicyCheckCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferPolicyCheckCall) DoAndReturn(f func(context.Context, []model.PolicyTransfer) error) *MocktransferPolicyCheckCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockcoinLotRepo) Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, amount, expireTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockcoinLotRepoMockRecorder) Add(ctx, employeeID, amount, expireTime any) *MockcoinLotRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockcoinLotRepo)(nil).Add), ctx, employeeID, amount, expireTime)
+	return &MockcoinLotRepoAddCall{Call: call}
+}
+
+// MockcoinLotRepoAddCall wrap *gomock.Call
+type MockcoinLotRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoAddCall) Return(arg0 error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoAddCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Decrease mocks base method.
+func (m *MockcoinLotRepo) Decrease(ctx context.Context, lotID, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrease", ctx, lotID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decrease indicates an expected call of Decrease.
+func (mr *MockcoinLotRepoMockRecorder) Decrease(ctx, lotID, amount any) *MockcoinLotRepoDecreaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrease", reflect.TypeOf((*MockcoinLotRepo)(nil).Decrease), ctx, lotID, amount)
+	return &MockcoinLotRepoDecreaseCall{Call: call}
+}
+
+// MockcoinLotRepoDecreaseCall wrap *gomock.Call
+type MockcoinLotRepoDecreaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoDecreaseCall) Return(arg0 error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoDecreaseCall) Do(f func(context.Context, int64, int64) error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoDecreaseCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockcoinLotRepoDecreaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetActive mocks base method.
+func (m *MockcoinLotRepo) GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx, employeeID, now)
+	ret0, _ := ret[0].([]model.CoinLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockcoinLotRepoMockRecorder) GetActive(ctx, employeeID, now any) *MockcoinLotRepoGetActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockcoinLotRepo)(nil).GetActive), ctx, employeeID, now)
+	return &MockcoinLotRepoGetActiveCall{Call: call}
+}
+
+// MockcoinLotRepoGetActiveCall wrap *gomock.Call
+type MockcoinLotRepoGetActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoGetActiveCall) Return(arg0 []model.CoinLot, arg1 error) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoGetActiveCall) Do(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoGetActiveCall) DoAndReturn(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktransferRequestRepo is a mock of transferRequestRepo interface.
+type MocktransferRequestRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransferRequestRepoMockRecorder
+}
+
+// MocktransferRequestRepoMockRecorder is the mock recorder for MocktransferRequestRepo.
+type MocktransferRequestRepoMockRecorder struct {
+	mock *MocktransferRequestRepo
+}
+
+// NewMocktransferRequestRepo creates a new mock instance.
+func NewMocktransferRequestRepo(ctrl *gomock.Controller) *MocktransferRequestRepo {
+	mock := &MocktransferRequestRepo{ctrl: ctrl}
+	mock.recorder = &MocktransferRequestRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransferRequestRepo) EXPECT() *MocktransferRequestRepoMockRecorder {
+	return m.recorder
+}
+
+// AddLots mocks base method.
+func (m *MocktransferRequestRepo) AddLots(ctx context.Context, requestID int64, parts []model.CoinLotPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLots", ctx, requestID, parts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLots indicates an expected call of AddLots.
+func (mr *MocktransferRequestRepoMockRecorder) AddLots(ctx, requestID, parts any) *MocktransferRequestRepoAddLotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLots", reflect.TypeOf((*MocktransferRequestRepo)(nil).AddLots), ctx, requestID, parts)
+	return &MocktransferRequestRepoAddLotsCall{Call: call}
+}
+
+// MocktransferRequestRepoAddLotsCall wrap *gomock.Call
+type MocktransferRequestRepoAddLotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoAddLotsCall) Return(arg0 error) *MocktransferRequestRepoAddLotsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoAddLotsCall) Do(f func(context.Context, int64, []model.CoinLotPart) error) *MocktransferRequestRepoAddLotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoAddLotsCall) DoAndReturn(f func(context.Context, int64, []model.CoinLotPart) error) *MocktransferRequestRepoAddLotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Create mocks base method.
+func (m *MocktransferRequestRepo) Create(ctx context.Context, request model.TransferRequest) (*model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(*model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MocktransferRequestRepoMockRecorder) Create(ctx, request any) *MocktransferRequestRepoCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktransferRequestRepo)(nil).Create), ctx, request)
+	return &MocktransferRequestRepoCreateCall{Call: call}
+}
+
+// MocktransferRequestRepoCreateCall wrap *gomock.Call
+type MocktransferRequestRepoCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoCreateCall) Return(arg0 *model.TransferRequest, arg1 error) *MocktransferRequestRepoCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoCreateCall) Do(f func(context.Context, model.TransferRequest) (*model.TransferRequest, error)) *MocktransferRequestRepoCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoCreateCall) DoAndReturn(f func(context.Context, model.TransferRequest) (*model.TransferRequest, error)) *MocktransferRequestRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,99 @@
+package coin_sending
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+	"github.com/inna-maikut/avito-shop/internal/repository/memory"
+	"github.com/inna-maikut/avito-shop/internal/usecases/policy_checking"
+)
+
+// newMemoryUseCase returns the use case on the memory storage with the real policy checker,
+// the sender "alice" has 1000 coins and the receivers are "bob" and "carol".
+func newMemoryUseCase(t *testing.T, policy model.TransferPolicy, approval model.TransferApproval) (*UseCase, int64) {
+	t.Helper()
+	ctx := context.Background()
+
+	s := memory.NewStorage()
+	trManager, err := memory.NewTrManager(s)
+	require.NoError(t, err)
+	employeeRepo, err := memory.NewEmployeeRepository(s)
+	require.NoError(t, err)
+	transactionRepo, err := memory.NewTransactionRepository(s)
+	require.NoError(t, err)
+	coinLotRepo, err := memory.NewCoinLotRepository(s)
+	require.NoError(t, err)
+	transferRequestRepo, err := memory.NewTransferRequestRepository(s)
+	require.NoError(t, err)
+
+	policyChecking, err := policy_checking.NewFromConfig(policy, transactionRepo)
+	require.NoError(t, err)
+
+	alice, err := employeeRepo.Create(ctx, "alice", "hash", 1000)
+	require.NoError(t, err)
+	require.NoError(t, coinLotRepo.Add(ctx, alice.ID, 1000, model.CoinLotExpireTime(time.Now())))
+	for _, username := range []string{"bob", "carol"} {
+		_, err = employeeRepo.Create(ctx, username, "hash", 0)
+		require.NoError(t, err)
+	}
+
+	uc, err := New(trManager, employeeRepo, transactionRepo, policyChecking, coinLotRepo, transferRequestRepo,
+		model.GivingBudget{}, approval)
+	require.NoError(t, err)
+
+	return uc, alice.ID
+}
+
+func TestUseCase_Send_PendingHoldsCountAgainstPolicy(t *testing.T) {
+	ctx := context.Background()
+	approval := model.TransferApproval{Threshold: 300, TTL: time.Hour, AcceptanceTTL: time.Hour}
+
+	t.Run("daily_limit.acceptance", func(t *testing.T) {
+		uc, aliceID := newMemoryUseCase(t, model.TransferPolicy{DailyLimit: 100}, approval)
+
+		// every transfer waits for the receiver, none of them is a transaction yet
+		for _, receiver := range []string{"bob", "carol", "bob"} {
+			request, err := uc.Send(ctx, aliceID, receiver, 30, true)
+			require.NoError(t, err)
+			require.NotNil(t, request)
+		}
+
+		_, err := uc.Send(ctx, aliceID, "carol", 30, true)
+		require.ErrorIs(t, err, model.ErrDailyLimitExceeded)
+		_, err = uc.Send(ctx, aliceID, "carol", 30, false)
+		require.ErrorIs(t, err, model.ErrDailyLimitExceeded)
+
+		_, err = uc.Send(ctx, aliceID, "carol", 10, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("monthly_limit.approval", func(t *testing.T) {
+		uc, aliceID := newMemoryUseCase(t, model.TransferPolicy{MonthlyLimit: 800}, approval)
+
+		request, err := uc.Send(ctx, aliceID, "bob", 400, false)
+		require.NoError(t, err)
+		require.Equal(t, model.TransferRequestKindApproval, request.Kind)
+
+		_, err = uc.Send(ctx, aliceID, "carol", 401, false)
+		require.ErrorIs(t, err, model.ErrMonthlyLimitExceeded)
+	})
+
+	t.Run("cooldown.acceptance", func(t *testing.T) {
+		uc, aliceID := newMemoryUseCase(t, model.TransferPolicy{Cooldown: time.Hour}, approval)
+
+		_, err := uc.Send(ctx, aliceID, "bob", 30, true)
+		require.NoError(t, err)
+
+		_, err = uc.Send(ctx, aliceID, "bob", 30, true)
+		require.ErrorIs(t, err, model.ErrTransferCooldown)
+		_, err = uc.Send(ctx, aliceID, "bob", 30, false)
+		require.ErrorIs(t, err, model.ErrTransferCooldown)
+
+		_, err = uc.Send(ctx, aliceID, "carol", 30, false)
+		require.NoError(t, err)
+	})
+}
@@ -4,20 +4,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
 type UseCase struct {
-	trManager       trManager
-	employeeRepo    employeeRepo
-	transactionRepo transactionRepo
+	trManager           trManager
+	employeeRepo        employeeRepo
+	transactionRepo     transactionRepo
+	transferPolicy      transferPolicy
+	coinLotRepo         coinLotRepo
+	transferRequestRepo transferRequestRepo
+	givingBudget        model.GivingBudget
+	approval            model.TransferApproval
+	now                 func() time.Time
 }
 
 func New(
 	trManager trManager,
 	employeeRepo employeeRepo,
 	transactionRepo transactionRepo,
+	transferPolicy transferPolicy,
+	coinLotRepo coinLotRepo,
+	transferRequestRepo transferRequestRepo,
+	givingBudget model.GivingBudget,
+	approval model.TransferApproval,
 ) (*UseCase, error) {
 	if trManager == nil {
 		return nil, errors.New("trManager is nil")
@@ -28,68 +41,438 @@ func New(
 	if transactionRepo == nil {
 		return nil, errors.New("transactionRepo is nil")
 	}
+	if transferPolicy == nil {
+		return nil, errors.New("transferPolicy is nil")
+	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
+	if transferRequestRepo == nil {
+		return nil, errors.New("transferRequestRepo is nil")
+	}
 
 	return &UseCase{
-		trManager:       trManager,
-		employeeRepo:    employeeRepo,
-		transactionRepo: transactionRepo,
+		trManager:           trManager,
+		employeeRepo:        employeeRepo,
+		transactionRepo:     transactionRepo,
+		transferPolicy:      transferPolicy,
+		coinLotRepo:         coinLotRepo,
+		transferRequestRepo: transferRequestRepo,
+		givingBudget:        givingBudget,
+		approval:            approval,
+		now:                 time.Now,
 	}, nil
 }
 
-func (uc *UseCase) Send(ctx context.Context, employeeID int64, targetUsername string, amount int64) error {
+// Send transfers amount coins to the target employee. A transfer above the approval threshold or one that requires
+// acceptance isn't executed: the coins are held from the sender and the created pending request is returned,
+// otherwise the request is nil. An approved transfer that requires acceptance then waits for the receiver.
+func (uc *UseCase) Send(
+	ctx context.Context,
+	employeeID int64,
+	targetUsername string,
+	amount int64,
+	requireAcceptance bool,
+) (*model.TransferRequest, error) {
 	targetEmployee, err := uc.employeeRepo.GetByUsername(ctx, targetUsername)
 	if err != nil {
-		return fmt.Errorf("employeeRepo.GetByUsername: %w", err)
+		return nil, fmt.Errorf("employeeRepo.GetByUsername: %w", err)
+	}
+
+	if targetEmployee.ID == employeeID {
+		return nil, model.ErrSendingCoinsToMyselfNotAllowed
+	}
+
+	t := transfer{
+		receiverID:       targetEmployee.ID,
+		receiverUsername: targetEmployee.Username,
+		amount:           amount,
+	}
+
+	var request *model.TransferRequest
+	err = uc.trManager.Do(ctx, func(ctx context.Context) error {
+		var holdErr error
+		switch {
+		case uc.approval.Required(amount):
+			request, holdErr = uc.hold(ctx, employeeID, t, model.TransferRequestKindApproval, requireAcceptance)
+		case requireAcceptance:
+			request, holdErr = uc.hold(ctx, employeeID, t, model.TransferRequestKindAcceptance, false)
+		default:
+			return uc.transfer(ctx, employeeID, []transfer{t})
+		}
+		return holdErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return request, nil
+}
+
+// SendBatch sends coins to several employees in one transaction: either every transfer is applied or none.
+// Invalid items are reported all together with *model.BatchTransferError, so is the item
+// rejected by a transfer policy or the first one the sender can't afford.
+func (uc *UseCase) SendBatch(ctx context.Context, employeeID int64, items []model.TransferItem) error {
+	usernames := make([]string, 0, len(items))
+	for _, item := range items {
+		usernames = append(usernames, item.ReceiverUsername)
+	}
+
+	employees, err := uc.employeeRepo.GetByUsernames(ctx, usernames)
+	if err != nil {
+		return fmt.Errorf("employeeRepo.GetByUsernames: %w", err)
 	}
 
-	targetEmployeeID := targetEmployee.ID
+	employeeIDs := make(map[string]int64, len(employees))
+	for _, employee := range employees {
+		employeeIDs[employee.Username] = employee.ID
+	}
+
+	var batchErr model.BatchTransferError
+	transfers := make([]transfer, 0, len(items))
+	for i, item := range items {
+		receiverID, found := employeeIDs[item.ReceiverUsername]
+
+		var itemErr error
+		switch {
+		case item.Amount <= 0:
+			itemErr = model.ErrInvalidAmount
+		case !found:
+			itemErr = model.ErrEmployeeNotFound
+		case receiverID == employeeID:
+			itemErr = model.ErrSendingCoinsToMyselfNotAllowed
+		case uc.approval.Required(item.Amount):
+			itemErr = model.ErrApprovalRequired
+		}
+		if itemErr != nil {
+			batchErr.Items = append(batchErr.Items, model.BatchTransferItemError{
+				Index:            i,
+				ReceiverUsername: item.ReceiverUsername,
+				Err:              itemErr,
+			})
+			continue
+		}
 
-	if targetEmployeeID == employeeID {
-		return model.ErrSendingCoinsToMyselfNotAllowed
+		transfers = append(transfers, transfer{
+			receiverID:       receiverID,
+			receiverUsername: item.ReceiverUsername,
+			amount:           item.Amount,
+		})
+	}
+	if len(batchErr.Items) > 0 {
+		return &batchErr
 	}
 
-	isTargetEmployeeIDGreaterThenSource := targetEmployeeID > employeeID // couldn't be equal because of the check above
+	err = uc.trManager.Do(ctx, func(ctx context.Context) error {
+		return uc.transfer(ctx, employeeID, transfers)
+	})
+	if err != nil {
+		// every item is valid here, so transfers are in the order of items
+		var itemErr *model.TransferItemError
+		if errors.As(err, &itemErr) && itemErr.Index < len(items) {
+			return &model.BatchTransferError{Items: []model.BatchTransferItemError{{
+				Index:            itemErr.Index,
+				ReceiverUsername: items[itemErr.Index].ReceiverUsername,
+				Err:              itemErr.Err,
+			}}}
+		}
+		return fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return nil
+}
+
+type transfer struct {
+	receiverID       int64
+	receiverUsername string
+	amount           int64
+}
+
+// transfer moves coins from the sender to receivers, it should be called inside a transaction.
+// The sender's giving budget is spent first, those coins come to the receiver as a new lot.
+// The rest is taken from the sender lots FIFO and keeps its expire time on the receiver side.
+func (uc *UseCase) transfer(ctx context.Context, senderID int64, transfers []transfer) error {
+	now := uc.now()
+
+	var total, fromBudget, fromBalance int64
+	for _, t := range transfers {
+		total += t.amount
+	}
 
-	err = uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
-		// need to follow lock order to avoid deadlocks
-		// first lock lower employeeID with either IncreaseBalance or GetByIDWithLock
-		if !isTargetEmployeeIDGreaterThenSource {
-			err = uc.employeeRepo.IncreaseBalance(ctx, targetEmployeeID, amount)
+	var lots []model.CoinLot
+	err := uc.lockParticipants(ctx, senderID, transfers, func(employee model.Employee) (err error) {
+		budget := uc.givingBudget.Available(employee, now)
+		fromBudget = min(budget, total)
+		fromBalance = total - fromBudget
+
+		if fromBalance > 0 {
+			lots, err = uc.coinLotRepo.GetActive(ctx, senderID, now)
 			if err != nil {
-				return fmt.Errorf("increase balance of target employee with lower employeeID: %w", err)
+				return fmt.Errorf("coinLotRepo.GetActive: %w", err)
+			}
+
+			spendable := model.SpendableBalance(employee.Balance, lots)
+			if spendable < fromBalance {
+				return notEnoughBalance(transfers, fromBudget+spendable)
 			}
 		}
 
-		employee, err := uc.employeeRepo.GetByIDWithLock(ctx, employeeID)
-		if err != nil {
-			return fmt.Errorf("employeeRepo.GetByIDWithLock: %w", err)
+		if fromBudget > 0 {
+			err = uc.employeeRepo.SetGivingBudget(ctx, senderID, budget-fromBudget, uc.givingBudget.Period(now))
+			if err != nil {
+				return fmt.Errorf("employeeRepo.SetGivingBudget: %w", err)
+			}
 		}
 
-		if employee.Balance < amount {
-			return model.ErrNotEnoughBalance
+		if fromBalance > 0 {
+			err = uc.employeeRepo.IncreaseBalance(ctx, senderID, -fromBalance)
+			if err != nil {
+				return fmt.Errorf("increase balance of current user with negative amount: %w", err)
+			}
 		}
 
-		err = uc.employeeRepo.IncreaseBalance(ctx, employeeID, -amount)
-		if err != nil {
-			return fmt.Errorf("increase balance of current user with negative amount: %w", err)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("lockParticipants: %w", err)
+	}
+
+	for _, t := range transfers {
+		budgetAmount := min(fromBudget, t.amount)
+		fromBudget -= budgetAmount
+
+		if budgetAmount > 0 {
+			err = uc.coinLotRepo.Add(ctx, t.receiverID, budgetAmount, model.CoinLotExpireTime(now))
+			if err != nil {
+				return fmt.Errorf("coinLotRepo.Add: %w", err)
+			}
 		}
 
-		if isTargetEmployeeIDGreaterThenSource {
-			err = uc.employeeRepo.IncreaseBalance(ctx, targetEmployeeID, amount)
+		if budgetAmount < t.amount {
+			err = uc.moveLots(ctx, lots, t.receiverID, t.amount-budgetAmount)
 			if err != nil {
-				return fmt.Errorf("increase balance of target employee with greater employeeID: %w", err)
+				return fmt.Errorf("moveLots: %w", err)
 			}
 		}
 
-		err = uc.transactionRepo.Add(ctx, employeeID, targetEmployeeID, amount)
+		err = uc.transactionRepo.Add(ctx, senderID, t.receiverID, t.amount)
 		if err != nil {
 			return fmt.Errorf("transactionRepo.Add: %w", err)
 		}
+	}
 
+	return nil
+}
+
+// notEnoughBalance rejects the first transfer with which the running total goes over available coins.
+func notEnoughBalance(transfers []transfer, available int64) error {
+	var total int64
+	for i, t := range transfers {
+		total += t.amount
+		if total > available {
+			return &model.TransferItemError{Index: i, Err: model.ErrNotEnoughBalance}
+		}
+	}
+	return model.ErrNotEnoughBalance
+}
+
+// SendHeld settles a transfer held by the request: the held coins go to the receiver keeping the expire time
+// of the sender lots they were taken from and the transfer is recorded as a usual transaction.
+// It should be called inside a transaction with the request already decided. Participants are locked and
+// transfer policies are checked like for a usual transfer, the sender balance isn't changed,
+// the coins were taken from it when the transfer was held.
+func (uc *UseCase) SendHeld(ctx context.Context, request model.TransferRequest, lots []model.CoinLotPart) error {
+	t := transfer{
+		receiverID:       request.ReceiverID,
+		receiverUsername: request.ReceiverUsername,
+		amount:           request.Amount,
+	}
+
+	err := uc.lockParticipants(ctx, request.SenderID, []transfer{t}, func(model.Employee) error {
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("trManager.Do: %w", err)
+		return fmt.Errorf("lockParticipants: %w", err)
+	}
+
+	for _, lot := range lots {
+		err = uc.coinLotRepo.Add(ctx, request.ReceiverID, lot.Amount, lot.ExpireTime)
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.Add: %w", err)
+		}
+	}
+
+	err = uc.transactionRepo.Add(ctx, request.SenderID, request.ReceiverID, request.Amount)
+	if err != nil {
+		return fmt.Errorf("transactionRepo.Add: %w", err)
+	}
+
+	return nil
+}
+
+// lockParticipants credits receivers and calls debit for the sender. To avoid deadlocks every participant row
+// is locked in ascending employeeID order: the sender with GetByIDWithLock, receivers with IncreaseBalance.
+// Transfer policies are checked while the sender is locked, before debit.
+func (uc *UseCase) lockParticipants(
+	ctx context.Context,
+	senderID int64,
+	transfers []transfer,
+	debit func(sender model.Employee) error,
+) error {
+	credits := make(map[int64]int64, len(transfers))
+	for _, t := range transfers {
+		credits[t.receiverID] += t.amount
+	}
+
+	participantIDs := make([]int64, 0, len(credits)+1)
+	participantIDs = append(participantIDs, senderID)
+	for receiverID := range credits {
+		participantIDs = append(participantIDs, receiverID)
+	}
+	slices.Sort(participantIDs)
+
+	for _, participantID := range participantIDs {
+		if participantID != senderID {
+			err := uc.employeeRepo.IncreaseBalance(ctx, participantID, credits[participantID])
+			if err != nil {
+				return fmt.Errorf("increase balance of target employee: %w", err)
+			}
+			continue
+		}
+
+		employee, err := uc.employeeRepo.GetByIDWithLock(ctx, senderID)
+		if err != nil {
+			return fmt.Errorf("employeeRepo.GetByIDWithLock: %w", err)
+		}
+
+		err = uc.checkPolicy(ctx, *employee, transfers)
+		if err != nil {
+			return fmt.Errorf("checkPolicy: %w", err)
+		}
+
+		err = debit(*employee)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hold takes the transfer amount from the sender lots and keeps it in a new pending request
+// until an approver or the receiver decides, it should be called inside a transaction.
+// Transfer policies are checked as for an executed transfer, the giving budget isn't spent on held transfers.
+func (uc *UseCase) hold(
+	ctx context.Context,
+	senderID int64,
+	t transfer,
+	kind model.TransferRequestKind,
+	requireAcceptance bool,
+) (*model.TransferRequest, error) {
+	now := uc.now()
+
+	employee, err := uc.employeeRepo.GetByIDWithLock(ctx, senderID)
+	if err != nil {
+		return nil, fmt.Errorf("employeeRepo.GetByIDWithLock: %w", err)
+	}
+
+	err = uc.checkPolicy(ctx, *employee, []transfer{t})
+	if err != nil {
+		return nil, fmt.Errorf("checkPolicy: %w", err)
+	}
+
+	lots, err := uc.coinLotRepo.GetActive(ctx, senderID, now)
+	if err != nil {
+		return nil, fmt.Errorf("coinLotRepo.GetActive: %w", err)
+	}
+
+	if model.SpendableBalance(employee.Balance, lots) < t.amount {
+		return nil, model.ErrNotEnoughBalance
+	}
+
+	err = uc.employeeRepo.IncreaseBalance(ctx, senderID, -t.amount)
+	if err != nil {
+		return nil, fmt.Errorf("increase balance of current user with negative amount: %w", err)
+	}
+
+	parts, err := model.TakeFIFO(lots, t.amount)
+	if err != nil {
+		return nil, fmt.Errorf("model.TakeFIFO: %w", err)
+	}
+
+	for _, part := range parts {
+		err = uc.coinLotRepo.Decrease(ctx, part.LotID, part.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("coinLotRepo.Decrease: %w", err)
+		}
+	}
+
+	ttl := uc.approval.TTL
+	if kind == model.TransferRequestKindAcceptance {
+		ttl = uc.approval.AcceptanceTTL
+	}
+
+	request, err := uc.transferRequestRepo.Create(ctx, model.TransferRequest{
+		Kind:              kind,
+		SenderID:          senderID,
+		SenderUsername:    employee.Username,
+		ReceiverID:        t.receiverID,
+		ReceiverUsername:  t.receiverUsername,
+		Amount:            t.amount,
+		Status:            model.TransferRequestStatusPending,
+		RequireAcceptance: requireAcceptance,
+		ExpireTime:        now.Add(ttl),
+		CreateTime:        now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transferRequestRepo.Create: %w", err)
+	}
+
+	err = uc.transferRequestRepo.AddLots(ctx, request.ID, parts)
+	if err != nil {
+		return nil, fmt.Errorf("transferRequestRepo.AddLots: %w", err)
+	}
+
+	return request, nil
+}
+
+func (uc *UseCase) checkPolicy(ctx context.Context, sender model.Employee, transfers []transfer) error {
+	policyTransfers := make([]model.PolicyTransfer, 0, len(transfers))
+	for _, t := range transfers {
+		policyTransfers = append(policyTransfers, model.PolicyTransfer{
+			SenderID:         sender.ID,
+			SenderUsername:   sender.Username,
+			ReceiverID:       t.receiverID,
+			ReceiverUsername: t.receiverUsername,
+			Amount:           t.amount,
+		})
+	}
+
+	err := uc.transferPolicy.Check(ctx, policyTransfers)
+	if err != nil {
+		return fmt.Errorf("transferPolicy.Check: %w", err)
+	}
+
+	return nil
+}
+
+// moveLots takes amount coins from the sender lots and adds them to the receiver as lots with the same expire time.
+func (uc *UseCase) moveLots(ctx context.Context, senderLots []model.CoinLot, receiverID, amount int64) error {
+	parts, err := model.TakeFIFO(senderLots, amount)
+	if err != nil {
+		return fmt.Errorf("model.TakeFIFO: %w", err)
+	}
+
+	for _, part := range parts {
+		err = uc.coinLotRepo.Decrease(ctx, part.LotID, part.Amount)
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.Decrease: %w", err)
+		}
+
+		err = uc.coinLotRepo.Add(ctx, receiverID, part.Amount, part.ExpireTime)
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.Add: %w", err)
+		}
 	}
 
 	return nil
@@ -2,7 +2,9 @@ package coin_sending
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -11,11 +13,18 @@ import (
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
+var (
+	now        = time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	expireTime = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+)
+
 func TestUseCase_Buy(t *testing.T) {
 	type mocks struct {
 		trManager       *MocktrManager
 		employeeRepo    *MockemployeeRepo
 		transactionRepo *MocktransactionRepo
+		transferPolicy  *MocktransferPolicy
+		coinLotRepo     *MockcoinLotRepo
 	}
 	type args struct {
 		employeeID     int64
@@ -53,10 +62,22 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      200,
 						Balance: 1000,
 					}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(200), int64(-500)).
 					Return(nil)
 
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(1), int64(500)).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(500), expireTime).
+					Return(nil)
 				m.transactionRepo.EXPECT().
 					Add(gomock.Any(), int64(200), int64(100), int64(500)).
 					Return(nil)
@@ -89,12 +110,24 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      50,
 						Balance: 1000,
 					}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(50), int64(-500)).
 					Return(nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(100), int64(500)).
 					Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(50), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(1), int64(500)).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(500), expireTime).
+					Return(nil)
 				m.transactionRepo.EXPECT().
 					Add(gomock.Any(), int64(50), int64(100), int64(500)).
 					Return(nil)
@@ -148,10 +181,22 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      200,
 						Balance: 1000,
 					}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(200), int64(-500)).
 					Return(nil)
 
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(1), int64(500)).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(500), expireTime).
+					Return(nil)
 				m.transactionRepo.EXPECT().
 					Add(gomock.Any(), int64(200), int64(100), int64(500)).
 					Return(assert.AnError)
@@ -184,6 +229,12 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      50,
 						Balance: 1000,
 					}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(50), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(50), int64(-500)).
 					Return(nil)
@@ -222,6 +273,12 @@ func TestUseCase_Buy(t *testing.T) {
 						ID:      200,
 						Balance: 1000,
 					}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
 				m.employeeRepo.EXPECT().
 					IncreaseBalance(gomock.Any(), int64(200), int64(-500)).
 					Return(assert.AnError)
@@ -233,6 +290,87 @@ func TestUseCase_Buy(t *testing.T) {
 			},
 			wantErr: assert.AnError,
 		},
+		{
+			name: "error.NotEnoughBalance.expired_lots",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "test1").
+					Return(&model.Employee{
+						ID:       100,
+						Username: "test1",
+						Balance:  300,
+					}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					IncreaseBalance(gomock.Any(), int64(100), int64(500)).
+					Return(nil)
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{
+						ID:      200,
+						Balance: 1000,
+					}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(nil)
+				// the rest of the balance is in lots which are expired but not written off yet
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 400, ExpireTime: expireTime}}, nil)
+			},
+			args: args{
+				employeeID:     200,
+				targetUsername: "test1",
+				amount:         500,
+			},
+			wantErr: model.ErrNotEnoughBalance,
+		},
+		{
+			name: "error.transferPolicy.Check",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "test1").
+					Return(&model.Employee{
+						ID:       100,
+						Username: "test1",
+						Balance:  300,
+					}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					IncreaseBalance(gomock.Any(), int64(100), int64(500)).
+					Return(nil)
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{
+						ID:       200,
+						Username: "test2",
+						Balance:  1000,
+					}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), []model.PolicyTransfer{{
+						SenderID:         200,
+						SenderUsername:   "test2",
+						ReceiverID:       100,
+						ReceiverUsername: "test1",
+						Amount:           500,
+					}}).
+					Return(model.ErrDailyLimitExceeded)
+			},
+			args: args{
+				employeeID:     200,
+				targetUsername: "test1",
+				amount:         500,
+			},
+			wantErr: model.ErrDailyLimitExceeded,
+		},
 		{
 			name: "error.employeeRepo.GetByIDWithLock",
 			prepare: func(m *mocks) {
@@ -298,14 +436,744 @@ func TestUseCase_Buy(t *testing.T) {
 				employeeRepo:    NewMockemployeeRepo(ctrl),
 				trManager:       NewMocktrManager(ctrl),
 				transactionRepo: NewMocktransactionRepo(ctrl),
+				transferPolicy:  NewMocktransferPolicy(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.employeeRepo, m.transactionRepo, m.transferPolicy, m.coinLotRepo,
+				NewMocktransferRequestRepo(ctrl), model.GivingBudget{}, model.TransferApproval{})
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			_, err = uc.Send(context.Background(), tc.args.employeeID, tc.args.targetUsername, tc.args.amount, false)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_SendBatch(t *testing.T) {
+	type mocks struct {
+		trManager       *MocktrManager
+		employeeRepo    *MockemployeeRepo
+		transactionRepo *MocktransactionRepo
+		transferPolicy  *MocktransferPolicy
+		coinLotRepo     *MockcoinLotRepo
+	}
+	type args struct {
+		employeeID int64
+		items      []model.TransferItem
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success.locks_in_id_order",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsernames(gomock.Any(), []string{"test3", "test1", "test3"}).
+					Return([]model.Employee{
+						{ID: 100, Username: "test1"},
+						{ID: 300, Username: "test3"},
+					}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				gomock.InOrder(
+					m.employeeRepo.EXPECT().
+						IncreaseBalance(gomock.Any(), int64(100), int64(20)).
+						Return(nil),
+					m.employeeRepo.EXPECT().
+						GetByIDWithLock(gomock.Any(), int64(200)).
+						Return(&model.Employee{
+							ID:       200,
+							Username: "test2",
+							Balance:  100,
+						}, nil),
+					m.transferPolicy.EXPECT().
+						Check(gomock.Any(), []model.PolicyTransfer{
+							{SenderID: 200, SenderUsername: "test2", ReceiverID: 300, ReceiverUsername: "test3", Amount: 50},
+							{SenderID: 200, SenderUsername: "test2", ReceiverID: 100, ReceiverUsername: "test1", Amount: 20},
+							{SenderID: 200, SenderUsername: "test2", ReceiverID: 300, ReceiverUsername: "test3", Amount: 30},
+						}).
+						Return(nil),
+					m.coinLotRepo.EXPECT().
+						GetActive(gomock.Any(), int64(200), now).
+						Return([]model.CoinLot{
+							{ID: 1, Remaining: 60, ExpireTime: expireTime},
+							{ID: 2, Remaining: 40, ExpireTime: expireTime.AddDate(0, 1, 0)},
+						}, nil),
+					m.employeeRepo.EXPECT().
+						IncreaseBalance(gomock.Any(), int64(200), int64(-100)).
+						Return(nil),
+					m.employeeRepo.EXPECT().
+						IncreaseBalance(gomock.Any(), int64(300), int64(80)).
+						Return(nil),
+				)
+				gomock.InOrder(
+					m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), int64(50)).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(300), int64(50), expireTime).Return(nil),
+					m.transactionRepo.EXPECT().
+						Add(gomock.Any(), int64(200), int64(300), int64(50)).
+						Return(nil),
+					m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), int64(10)).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(10), expireTime).Return(nil),
+					m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(2), int64(10)).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(10), expireTime.AddDate(0, 1, 0)).Return(nil),
+					m.transactionRepo.EXPECT().
+						Add(gomock.Any(), int64(200), int64(100), int64(20)).
+						Return(nil),
+					m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(2), int64(30)).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(300), int64(30), expireTime.AddDate(0, 1, 0)).Return(nil),
+					m.transactionRepo.EXPECT().
+						Add(gomock.Any(), int64(200), int64(300), int64(30)).
+						Return(nil),
+				)
+			},
+			args: args{
+				employeeID: 200,
+				items: []model.TransferItem{
+					{ReceiverUsername: "test3", Amount: 50},
+					{ReceiverUsername: "test1", Amount: 20},
+					{ReceiverUsername: "test3", Amount: 30},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error.NotEnoughBalance",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsernames(gomock.Any(), []string{"test1", "test3"}).
+					Return([]model.Employee{
+						{ID: 100, Username: "test1"},
+						{ID: 300, Username: "test3"},
+					}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					IncreaseBalance(gomock.Any(), int64(100), int64(600)).
+					Return(nil)
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{
+						ID:      200,
+						Balance: 1000,
+					}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+			},
+			args: args{
+				employeeID: 200,
+				items: []model.TransferItem{
+					{ReceiverUsername: "test1", Amount: 600},
+					{ReceiverUsername: "test3", Amount: 600},
+				},
+			},
+			wantErr: &model.BatchTransferError{Items: []model.BatchTransferItemError{
+				{Index: 1, ReceiverUsername: "test3", Err: model.ErrNotEnoughBalance},
+			}},
+		},
+		{
+			name: "error.policy",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsernames(gomock.Any(), []string{"test1", "test3"}).
+					Return([]model.Employee{
+						{ID: 100, Username: "test1"},
+						{ID: 300, Username: "test3"},
+					}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					IncreaseBalance(gomock.Any(), int64(100), int64(300)).
+					Return(nil)
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{ID: 200, Balance: 1000}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(&model.TransferItemError{Index: 1, Err: model.ErrDailyLimitExceeded})
+			},
+			args: args{
+				employeeID: 200,
+				items: []model.TransferItem{
+					{ReceiverUsername: "test1", Amount: 300},
+					{ReceiverUsername: "test3", Amount: 300},
+				},
+			},
+			wantErr: &model.BatchTransferError{Items: []model.BatchTransferItemError{
+				{Index: 1, ReceiverUsername: "test3", Err: model.ErrDailyLimitExceeded},
+			}},
+		},
+		{
+			name: "error.per_recipient",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsernames(gomock.Any(), []string{"test1", "me", "nobody", "test3"}).
+					Return([]model.Employee{
+						{ID: 100, Username: "test1"},
+						{ID: 200, Username: "me"},
+						{ID: 300, Username: "test3"},
+					}, nil)
+			},
+			args: args{
+				employeeID: 200,
+				items: []model.TransferItem{
+					{ReceiverUsername: "test1", Amount: 10},
+					{ReceiverUsername: "me", Amount: 10},
+					{ReceiverUsername: "nobody", Amount: 10},
+					{ReceiverUsername: "test3", Amount: -10},
+				},
+			},
+			wantErr: &model.BatchTransferError{Items: []model.BatchTransferItemError{
+				{Index: 1, ReceiverUsername: "me", Err: model.ErrSendingCoinsToMyselfNotAllowed},
+				{Index: 2, ReceiverUsername: "nobody", Err: model.ErrEmployeeNotFound},
+				{Index: 3, ReceiverUsername: "test3", Err: model.ErrInvalidAmount},
+			}},
+		},
+		{
+			name: "error.employeeRepo.GetByUsernames",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsernames(gomock.Any(), []string{"test1"}).
+					Return(nil, assert.AnError)
+			},
+			args: args{
+				employeeID: 200,
+				items:      []model.TransferItem{{ReceiverUsername: "test1", Amount: 10}},
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.transactionRepo.Add",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsernames(gomock.Any(), []string{"test1"}).
+					Return([]model.Employee{{ID: 100, Username: "test1"}}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.employeeRepo.EXPECT().
+					IncreaseBalance(gomock.Any(), int64(100), int64(10)).
+					Return(nil)
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{ID: 200, Balance: 1000}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.employeeRepo.EXPECT().
+					IncreaseBalance(gomock.Any(), int64(200), int64(-10)).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.coinLotRepo.EXPECT().
+					Decrease(gomock.Any(), int64(1), int64(10)).
+					Return(nil)
+				m.coinLotRepo.EXPECT().
+					Add(gomock.Any(), int64(100), int64(10), expireTime).
+					Return(nil)
+				m.transactionRepo.EXPECT().
+					Add(gomock.Any(), int64(200), int64(100), int64(10)).
+					Return(assert.AnError)
+			},
+			args: args{
+				employeeID: 200,
+				items:      []model.TransferItem{{ReceiverUsername: "test1", Amount: 10}},
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				employeeRepo:    NewMockemployeeRepo(ctrl),
+				trManager:       NewMocktrManager(ctrl),
+				transactionRepo: NewMocktransactionRepo(ctrl),
+				transferPolicy:  NewMocktransferPolicy(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.employeeRepo, m.transactionRepo, m.transferPolicy, m.coinLotRepo,
+				NewMocktransferRequestRepo(ctrl), model.GivingBudget{}, model.TransferApproval{})
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			err = uc.SendBatch(context.Background(), tc.args.employeeID, tc.args.items)
+
+			var wantBatchErr *model.BatchTransferError
+			if errors.As(tc.wantErr, &wantBatchErr) {
+				var batchErr *model.BatchTransferError
+				require.ErrorAs(t, err, &batchErr)
+				require.Equal(t, wantBatchErr, batchErr)
+				return
+			}
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_Send_GivingBudget(t *testing.T) {
+	type mocks struct {
+		trManager       *MocktrManager
+		employeeRepo    *MockemployeeRepo
+		transactionRepo *MocktransactionRepo
+		transferPolicy  *MocktransferPolicy
+		coinLotRepo     *MockcoinLotRepo
+	}
+
+	period := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	previousPeriod := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	budgetExpireTime := model.CoinLotExpireTime(now)
+
+	testCases := []struct {
+		name    string
+		sender  model.Employee
+		amount  int64
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success.budget_only",
+			sender: model.Employee{
+				ID:                 200,
+				Balance:            0,
+				GivingBudget:       300,
+				GivingBudgetPeriod: &period,
+			},
+			amount: 100,
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().SetGivingBudget(gomock.Any(), int64(200), int64(200), period).Return(nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(100), budgetExpireTime).Return(nil)
+				m.transactionRepo.EXPECT().Add(gomock.Any(), int64(200), int64(100), int64(100)).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success.budget_refilled_then_balance",
+			sender: model.Employee{
+				ID:                 200,
+				Balance:            1000,
+				GivingBudget:       0,
+				GivingBudgetPeriod: &previousPeriod,
+			},
+			amount: 150,
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().SetGivingBudget(gomock.Any(), int64(200), int64(0), period).Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(-50)).Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(100), budgetExpireTime).Return(nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), int64(50)).Return(nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(50), expireTime).Return(nil)
+				m.transactionRepo.EXPECT().Add(gomock.Any(), int64(200), int64(100), int64(150)).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error.NotEnoughBalance",
+			sender: model.Employee{
+				ID:                 200,
+				Balance:            10,
+				GivingBudget:       0,
+				GivingBudgetPeriod: &period,
+			},
+			amount: 50,
+			prepare: func(m *mocks) {
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 10, ExpireTime: expireTime}}, nil)
+			},
+			wantErr: model.ErrNotEnoughBalance,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				employeeRepo:    NewMockemployeeRepo(ctrl),
+				trManager:       NewMocktrManager(ctrl),
+				transactionRepo: NewMocktransactionRepo(ctrl),
+				transferPolicy:  NewMocktransferPolicy(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
 			}
 
+			m.employeeRepo.EXPECT().
+				GetByUsername(gomock.Any(), "test1").
+				Return(&model.Employee{ID: 100, Username: "test1"}, nil)
+			m.trManager.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+					return do(ctx)
+				})
+			m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), tc.amount).Return(nil)
+			m.employeeRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(200)).Return(&tc.sender, nil)
+			m.transferPolicy.EXPECT().Check(gomock.Any(), gomock.Any()).Return(nil)
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.employeeRepo, m.transactionRepo, m.transferPolicy, m.coinLotRepo,
+				NewMocktransferRequestRepo(ctrl), model.GivingBudget{MonthlyAmount: 100}, model.TransferApproval{})
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			_, err = uc.Send(context.Background(), 200, "test1", tc.amount, false)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_Send_Approval(t *testing.T) {
+	type mocks struct {
+		trManager           *MocktrManager
+		employeeRepo        *MockemployeeRepo
+		transactionRepo     *MocktransactionRepo
+		transferPolicy      *MocktransferPolicy
+		coinLotRepo         *MockcoinLotRepo
+		transferRequestRepo *MocktransferRequestRepo
+	}
+
+	laterExpireTime := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	approval := model.TransferApproval{Threshold: 500, TTL: 72 * time.Hour, AcceptanceTTL: 168 * time.Hour}
+	pendingRequest := model.TransferRequest{
+		Kind:             model.TransferRequestKindApproval,
+		SenderID:         200,
+		SenderUsername:   "test2",
+		ReceiverID:       100,
+		ReceiverUsername: "test1",
+		Amount:           700,
+		Status:           model.TransferRequestStatusPending,
+		ExpireTime:       now.Add(72 * time.Hour),
+		CreateTime:       now,
+	}
+
+	withID := func(request model.TransferRequest) *model.TransferRequest {
+		request.ID = 10
+		return &request
+	}
+	acceptanceRequest := pendingRequest
+	acceptanceRequest.Kind = model.TransferRequestKindAcceptance
+	acceptanceRequest.Amount = 300
+	acceptanceRequest.ExpireTime = now.Add(168 * time.Hour)
+	approvalAndAcceptanceRequest := pendingRequest
+	approvalAndAcceptanceRequest.RequireAcceptance = true
+
+	expectHold := func(m *mocks, request model.TransferRequest) {
+		m.employeeRepo.EXPECT().
+			GetByIDWithLock(gomock.Any(), int64(200)).
+			Return(&model.Employee{ID: 200, Username: "test2", Balance: 1000}, nil)
+		m.transferPolicy.EXPECT().Check(gomock.Any(), gomock.Any()).Return(nil)
+		m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), -request.Amount).Return(nil)
+		m.coinLotRepo.EXPECT().
+			GetActive(gomock.Any(), int64(200), now).
+			Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+		m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), request.Amount).Return(nil)
+		m.transferRequestRepo.EXPECT().
+			Create(gomock.Any(), request).
+			DoAndReturn(func(_ context.Context, request model.TransferRequest) (*model.TransferRequest, error) {
+				return withID(request), nil
+			})
+		m.transferRequestRepo.EXPECT().
+			AddLots(gomock.Any(), int64(10), []model.CoinLotPart{{LotID: 1, Amount: request.Amount, ExpireTime: expireTime}}).
+			Return(nil)
+	}
+
+	testCases := []struct {
+		name              string
+		amount            int64
+		requireAcceptance bool
+		prepare           func(m *mocks)
+		wantRequest       *model.TransferRequest
+		wantErr           error
+	}{
+		{
+			name:   "success.below_threshold",
+			amount: 500,
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(500)).Return(nil)
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{ID: 200, Username: "test2", Balance: 1000}, nil)
+				m.transferPolicy.EXPECT().Check(gomock.Any(), gomock.Any()).Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(-500)).Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), int64(500)).Return(nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(500), expireTime).Return(nil)
+				m.transactionRepo.EXPECT().Add(gomock.Any(), int64(200), int64(100), int64(500)).Return(nil)
+			},
+		},
+		{
+			name:   "success.held",
+			amount: 700,
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{ID: 200, Username: "test2", Balance: 1000}, nil)
+				m.transferPolicy.EXPECT().
+					Check(gomock.Any(), []model.PolicyTransfer{{
+						SenderID:         200,
+						SenderUsername:   "test2",
+						ReceiverID:       100,
+						ReceiverUsername: "test1",
+						Amount:           700,
+					}}).
+					Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(-700)).Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{
+						{ID: 1, Remaining: 400, ExpireTime: expireTime},
+						{ID: 2, Remaining: 600, ExpireTime: laterExpireTime},
+					}, nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), int64(400)).Return(nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(2), int64(300)).Return(nil)
+				m.transferRequestRepo.EXPECT().
+					Create(gomock.Any(), pendingRequest).
+					DoAndReturn(func(_ context.Context, request model.TransferRequest) (*model.TransferRequest, error) {
+						request.ID = 10
+						return &request, nil
+					})
+				m.transferRequestRepo.EXPECT().
+					AddLots(gomock.Any(), int64(10), []model.CoinLotPart{
+						{LotID: 1, Amount: 400, ExpireTime: expireTime},
+						{LotID: 2, Amount: 300, ExpireTime: laterExpireTime},
+					}).
+					Return(nil)
+			},
+			wantRequest: withID(pendingRequest),
+		},
+		{
+			name:              "success.acceptance",
+			amount:            300,
+			requireAcceptance: true,
+			prepare: func(m *mocks) {
+				expectHold(m, acceptanceRequest)
+			},
+			wantRequest: withID(acceptanceRequest),
+		},
+		{
+			// approval goes first, the approved transfer then waits for the receiver
+			name:              "success.held.require_acceptance",
+			amount:            700,
+			requireAcceptance: true,
+			prepare: func(m *mocks) {
+				expectHold(m, approvalAndAcceptanceRequest)
+			},
+			wantRequest: withID(approvalAndAcceptanceRequest),
+		},
+		{
+			name:   "error.held.policy",
+			amount: 700,
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{ID: 200, Username: "test2", Balance: 1000}, nil)
+				m.transferPolicy.EXPECT().Check(gomock.Any(), gomock.Any()).Return(model.ErrDailyLimitExceeded)
+			},
+			wantErr: model.ErrDailyLimitExceeded,
+		},
+		{
+			name:   "error.held.not_enough_balance",
+			amount: 700,
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{ID: 200, Username: "test2", Balance: 600}, nil)
+				m.transferPolicy.EXPECT().Check(gomock.Any(), gomock.Any()).Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 600, ExpireTime: expireTime}}, nil)
+			},
+			wantErr: model.ErrNotEnoughBalance,
+		},
+		{
+			name:   "error.held.transfer_request_repo.create",
+			amount: 700,
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{ID: 200, Username: "test2", Balance: 1000}, nil)
+				m.transferPolicy.EXPECT().Check(gomock.Any(), gomock.Any()).Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(-700)).Return(nil)
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(200), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: expireTime}}, nil)
+				m.coinLotRepo.EXPECT().Decrease(gomock.Any(), int64(1), int64(700)).Return(nil)
+				m.transferRequestRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				employeeRepo:        NewMockemployeeRepo(ctrl),
+				trManager:           NewMocktrManager(ctrl),
+				transactionRepo:     NewMocktransactionRepo(ctrl),
+				transferPolicy:      NewMocktransferPolicy(ctrl),
+				coinLotRepo:         NewMockcoinLotRepo(ctrl),
+				transferRequestRepo: NewMocktransferRequestRepo(ctrl),
+			}
+
+			m.employeeRepo.EXPECT().
+				GetByUsername(gomock.Any(), "test1").
+				Return(&model.Employee{ID: 100, Username: "test1"}, nil)
+			m.trManager.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+					return do(ctx)
+				})
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.employeeRepo, m.transactionRepo, m.transferPolicy, m.coinLotRepo,
+				m.transferRequestRepo, model.GivingBudget{}, approval)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			request, err := uc.Send(context.Background(), 200, "test1", tc.amount, tc.requireAcceptance)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRequest, request)
+		})
+	}
+}
+
+func TestUseCase_SendBatch_ApprovalRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	employeeRepo := NewMockemployeeRepo(ctrl)
+
+	employeeRepo.EXPECT().
+		GetByUsernames(gomock.Any(), []string{"test1", "test3"}).
+		Return([]model.Employee{{ID: 100, Username: "test1"}, {ID: 300, Username: "test3"}}, nil)
+
+	uc, err := New(NewMocktrManager(ctrl), employeeRepo, NewMocktransactionRepo(ctrl), NewMocktransferPolicy(ctrl),
+		NewMockcoinLotRepo(ctrl), NewMocktransferRequestRepo(ctrl), model.GivingBudget{},
+		model.TransferApproval{Threshold: 500, TTL: time.Hour})
+	require.NoError(t, err)
+
+	err = uc.SendBatch(context.Background(), 200, []model.TransferItem{
+		{ReceiverUsername: "test1", Amount: 500},
+		{ReceiverUsername: "test3", Amount: 501},
+	})
+
+	var batchErr *model.BatchTransferError
+	require.ErrorAs(t, err, &batchErr)
+	require.Equal(t, []model.BatchTransferItemError{
+		{Index: 1, ReceiverUsername: "test3", Err: model.ErrApprovalRequired},
+	}, batchErr.Items)
+}
+
+func TestUseCase_SendHeld(t *testing.T) {
+	type mocks struct {
+		employeeRepo    *MockemployeeRepo
+		transactionRepo *MocktransactionRepo
+		transferPolicy  *MocktransferPolicy
+		coinLotRepo     *MockcoinLotRepo
+	}
+
+	request := model.TransferRequest{
+		ID:               10,
+		Kind:             model.TransferRequestKindAcceptance,
+		SenderID:         200,
+		SenderUsername:   "test2",
+		ReceiverID:       100,
+		ReceiverUsername: "test1",
+		Amount:           700,
+	}
+	lots := []model.CoinLotPart{
+		{LotID: 1, Amount: 400, ExpireTime: expireTime},
+		{LotID: 2, Amount: 300, ExpireTime: expireTime.AddDate(0, 1, 0)},
+	}
+	policyTransfers := []model.PolicyTransfer{{
+		SenderID:         200,
+		SenderUsername:   "test2",
+		ReceiverID:       100,
+		ReceiverUsername: "test1",
+		Amount:           700,
+	}}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				// the sender balance isn't changed, the coins were taken from it when the transfer was held
+				gomock.InOrder(
+					m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(700)).Return(nil),
+					m.employeeRepo.EXPECT().
+						GetByIDWithLock(gomock.Any(), int64(200)).
+						Return(&model.Employee{ID: 200, Username: "test2"}, nil),
+					m.transferPolicy.EXPECT().Check(gomock.Any(), policyTransfers).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(400), expireTime).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(300), expireTime.AddDate(0, 1, 0)).Return(nil),
+					m.transactionRepo.EXPECT().Add(gomock.Any(), int64(200), int64(100), int64(700)).Return(nil),
+				)
+			},
+		},
+		{
+			name: "error.policy",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(700)).Return(nil)
+				m.employeeRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(200)).
+					Return(&model.Employee{ID: 200, Username: "test2"}, nil)
+				m.transferPolicy.EXPECT().Check(gomock.Any(), policyTransfers).Return(model.ErrDailyLimitExceeded)
+			},
+			wantErr: model.ErrDailyLimitExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := &mocks{
+				employeeRepo:    NewMockemployeeRepo(ctrl),
+				transactionRepo: NewMocktransactionRepo(ctrl),
+				transferPolicy:  NewMocktransferPolicy(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
+			}
 			tc.prepare(m)
 
-			uc, err := New(m.trManager, m.employeeRepo, m.transactionRepo)
+			uc, err := New(NewMocktrManager(ctrl), m.employeeRepo, m.transactionRepo, m.transferPolicy, m.coinLotRepo,
+				NewMocktransferRequestRepo(ctrl), model.GivingBudget{}, model.TransferApproval{})
 			require.NoError(t, err)
 
-			err = uc.Send(context.Background(), tc.args.employeeID, tc.args.targetUsername, tc.args.amount)
+			err = uc.SendHeld(context.Background(), request, lots)
 			require.ErrorIs(t, err, tc.wantErr)
 		})
 	}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package employee_searching
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type employeeRepo interface {
+	Search(ctx context.Context, query string, limit, offset int) ([]model.EmployeeDirectoryEntry, error)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package employee_searching -typed -destination mock_deps_test.go
+//
+
+// Package employee_searching is a generated GoMock package.
+package employee_searching
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockemployeeRepo) Search(ctx context.Context, query string, limit, offset int) ([]model.EmployeeDirectoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit, offset)
+	ret0, _ := ret[0].([]model.EmployeeDirectoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockemployeeRepoMockRecorder) Search(ctx, query, limit, offset any) *MockemployeeRepoSearchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockemployeeRepo)(nil).Search), ctx, query, limit, offset)
+	return &MockemployeeRepoSearchCall{Call: call}
+}
+
+// MockemployeeRepoSearchCall wrap *gomock.Call
+type MockemployeeRepoSearchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoSearchCall) Return(arg0 []model.EmployeeDirectoryEntry, arg1 error) *MockemployeeRepoSearchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoSearchCall) Do(f func(context.Context, string, int, int) ([]model.EmployeeDirectoryEntry, error)) *MockemployeeRepoSearchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoSearchCall) DoAndReturn(f func(context.Context, string, int, int) ([]model.EmployeeDirectoryEntry, error)) *MockemployeeRepoSearchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,43 @@
+package employee_searching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type UseCase struct {
+	employeeRepo employeeRepo
+}
+
+func New(employeeRepo employeeRepo) (*UseCase, error) {
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+
+	return &UseCase{
+		employeeRepo: employeeRepo,
+	}, nil
+}
+
+// Search returns a page of employees matching query by prefix or similarity.
+func (uc *UseCase) Search(ctx context.Context, query string, limit, offset int) (model.EmployeeDirectoryPage, error) {
+	// one extra row tells whether there is a next page
+	employees, err := uc.employeeRepo.Search(ctx, strings.TrimSpace(query), limit+1, offset)
+	if err != nil {
+		return model.EmployeeDirectoryPage{}, fmt.Errorf("employeeRepo.Search: %w", err)
+	}
+
+	page := model.EmployeeDirectoryPage{
+		Employees: employees,
+	}
+	if len(employees) > limit {
+		page.Employees = employees[:limit]
+		page.HasMore = true
+	}
+
+	return page, nil
+}
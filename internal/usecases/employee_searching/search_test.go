@@ -0,0 +1,87 @@
+package employee_searching
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_Search(t *testing.T) {
+	type mocks struct {
+		employeeRepo *MockemployeeRepo
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		query   string
+		wantRes model.EmployeeDirectoryPage
+		wantErr error
+	}{
+		{
+			name: "success.has_more",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					Search(gomock.Any(), "ann", 3, 0).
+					Return([]model.EmployeeDirectoryEntry{
+						{Username: "anna"},
+						{Username: "anne"},
+						{Username: "joanna", DisplayName: "Joanna Smith"},
+					}, nil)
+			},
+			query: " ann ",
+			wantRes: model.EmployeeDirectoryPage{
+				Employees: []model.EmployeeDirectoryEntry{{Username: "anna"}, {Username: "anne"}},
+				HasMore:   true,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success.last_page",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					Search(gomock.Any(), "ann", 3, 0).
+					Return([]model.EmployeeDirectoryEntry{{Username: "anna"}}, nil)
+			},
+			query: "ann",
+			wantRes: model.EmployeeDirectoryPage{
+				Employees: []model.EmployeeDirectoryEntry{{Username: "anna"}},
+				HasMore:   false,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error.employee_repo.search",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().Search(gomock.Any(), "ann", 3, 0).Return(nil, assert.AnError)
+			},
+			query:   "ann",
+			wantRes: model.EmployeeDirectoryPage{},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				employeeRepo: NewMockemployeeRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.employeeRepo)
+			require.NoError(t, err)
+
+			res, err := uc.Search(context.Background(), tc.query, 2, 0)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -4,22 +4,49 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
 
+// expiringSoonPeriod is how far ahead expiring coins are shown.
+const expiringSoonPeriod = 30 * 24 * time.Hour
+
+// QueryMode is how the data of EmployeeInfo is read.
+type QueryMode string
+
+const (
+	// QueryModeParallel runs a query per repository concurrently, each of them takes a pool connection
+	QueryModeParallel QueryMode = "parallel"
+	// QueryModeBatch reads everything with one infoRepo call, in Postgres it's a single batch on one connection
+	QueryModeBatch QueryMode = "batch"
+	// QueryModeSingle reads everything with one SQL statement, lists are aggregated by the database
+	QueryModeSingle QueryMode = "single"
+)
+
 type UseCase struct {
-	employeeRepo    employeeRepo
-	transactionRepo transactionRepo
-	inventoryRepo   inventoryRepo
+	employeeRepo        employeeRepo
+	transactionRepo     transactionRepo
+	inventoryRepo       inventoryRepo
+	coinLotRepo         coinLotRepo
+	transferRequestRepo transferRequestRepo
+	infoRepo            infoRepo
+	givingBudget        model.GivingBudget
+	queryMode           QueryMode
+	now                 func() time.Time
 }
 
 func New(
 	employeeRepo employeeRepo,
 	transactionRepo transactionRepo,
 	inventoryRepo inventoryRepo,
+	coinLotRepo coinLotRepo,
+	transferRequestRepo transferRequestRepo,
+	infoRepo infoRepo,
+	givingBudget model.GivingBudget,
+	queryMode QueryMode,
 ) (*UseCase, error) {
 	if employeeRepo == nil {
 		return nil, errors.New("employeeRepo is nil")
@@ -30,19 +57,71 @@ func New(
 	if inventoryRepo == nil {
 		return nil, errors.New("inventoryRepo is nil")
 	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
+	if transferRequestRepo == nil {
+		return nil, errors.New("transferRequestRepo is nil")
+	}
+	if infoRepo == nil {
+		return nil, errors.New("infoRepo is nil")
+	}
+	if queryMode != QueryModeParallel && queryMode != QueryModeBatch && queryMode != QueryModeSingle {
+		return nil, fmt.Errorf("query mode should be %s, %s or %s, got %q",
+			QueryModeParallel, QueryModeBatch, QueryModeSingle, queryMode)
+	}
 	return &UseCase{
-		employeeRepo:    employeeRepo,
-		transactionRepo: transactionRepo,
-		inventoryRepo:   inventoryRepo,
+		employeeRepo:        employeeRepo,
+		transactionRepo:     transactionRepo,
+		inventoryRepo:       inventoryRepo,
+		coinLotRepo:         coinLotRepo,
+		transferRequestRepo: transferRequestRepo,
+		infoRepo:            infoRepo,
+		givingBudget:        givingBudget,
+		queryMode:           queryMode,
+		now:                 time.Now,
 	}, nil
 }
 
-func (uc *UseCase) Collect(ctx context.Context, employeeID int64) (model.EmployeeInfo, error) {
+// Collect returns the info of the employee with sent and received coins shown according to history.
+func (uc *UseCase) Collect(ctx context.Context, employeeID int64, history model.CoinHistory) (model.EmployeeInfo, error) {
+	now := uc.now()
+
+	var (
+		data model.EmployeeInfoData
+		err  error
+	)
+	switch uc.queryMode {
+	case QueryModeBatch:
+		data, err = uc.infoRepo.GetByEmployee(ctx, employeeID, now, history)
+		if err != nil {
+			return model.EmployeeInfo{}, fmt.Errorf("infoRepo.GetByEmployee: %w", err)
+		}
+	case QueryModeSingle:
+		data, err = uc.infoRepo.GetByEmployeeSingleQuery(ctx, employeeID, now, history)
+		if err != nil {
+			return model.EmployeeInfo{}, fmt.Errorf("infoRepo.GetByEmployeeSingleQuery: %w", err)
+		}
+	default:
+		data, err = uc.collectParallel(ctx, employeeID, now, history)
+		if err != nil {
+			return model.EmployeeInfo{}, err
+		}
+	}
+
+	return uc.build(data, employeeID, now), nil
+}
+
+func (uc *UseCase) collectParallel(
+	ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory,
+) (model.EmployeeInfoData, error) {
 	var (
 		eg           *errgroup.Group
 		employee     *model.Employee
 		transactions []model.Transaction
 		inventories  []model.Inventory
+		lots         []model.CoinLot
+		pending      []model.TransferRequest
 	)
 	eg, ctx = errgroup.WithContext(ctx)
 
@@ -56,6 +135,15 @@ func (uc *UseCase) Collect(ctx context.Context, employeeID int64) (model.Employe
 	})
 
 	eg.Go(func() (err error) {
+		if history == model.CoinHistoryByCounterparty {
+			transactions, err = uc.transactionRepo.GetTotalsByEmployee(ctx, employeeID)
+			if err != nil {
+				return fmt.Errorf("transactionRepo.GetTotalsByEmployee: %w", err)
+			}
+
+			return nil
+		}
+
 		transactions, err = uc.transactionRepo.GetByEmployee(ctx, employeeID)
 		if err != nil {
 			return fmt.Errorf("transactionRepo.GetByEmployee: %w", err)
@@ -73,21 +161,46 @@ func (uc *UseCase) Collect(ctx context.Context, employeeID int64) (model.Employe
 		return nil
 	})
 
+	eg.Go(func() (err error) {
+		lots, err = uc.coinLotRepo.GetActive(ctx, employeeID, now)
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.GetActive: %w", err)
+		}
+		return nil
+	})
+
+	eg.Go(func() (err error) {
+		pending, err = uc.transferRequestRepo.GetPendingByEmployee(ctx, employeeID)
+		if err != nil {
+			return fmt.Errorf("transferRequestRepo.GetPendingByEmployee: %w", err)
+		}
+		return nil
+	})
+
 	err := eg.Wait()
 	if err != nil {
-		return model.EmployeeInfo{}, fmt.Errorf("errgroup.Wait: %w", err)
+		return model.EmployeeInfoData{}, fmt.Errorf("errgroup.Wait: %w", err)
 	}
 
-	var info model.EmployeeInfo
-	if employee != nil { // err was nil, so employee is always not nil
-		info.Coins = employee.Balance
-	}
+	return model.EmployeeInfoData{
+		Employee:        *employee, // err was nil, so employee is always not nil
+		Transactions:    transactions,
+		Inventory:       inventories,
+		ActiveCoinLots:  lots,
+		PendingRequests: pending,
+	}, nil
+}
 
-	info.Inventory = inventories
+func (uc *UseCase) build(data model.EmployeeInfoData, employeeID int64, now time.Time) model.EmployeeInfo {
+	info := model.EmployeeInfo{
+		Coins:        model.SpendableBalance(data.Employee.Balance, data.ActiveCoinLots),
+		GivingBudget: uc.givingBudget.Available(data.Employee, now),
+		Inventory:    data.Inventory,
+	}
 
-	info.SentTransactions = make([]model.Transaction, 0, len(transactions))
-	info.ReceivedTransactions = make([]model.Transaction, 0, len(transactions))
-	for _, transaction := range transactions {
+	info.SentTransactions = make([]model.Transaction, 0, len(data.Transactions))
+	info.ReceivedTransactions = make([]model.Transaction, 0, len(data.Transactions))
+	for _, transaction := range data.Transactions {
 		if transaction.IsSender {
 			info.SentTransactions = append(info.SentTransactions, transaction)
 		} else {
@@ -95,5 +208,31 @@ func (uc *UseCase) Collect(ctx context.Context, employeeID int64) (model.Employe
 		}
 	}
 
-	return info, nil
+	// held coins are neither in the balance nor in transactions, pending requests explain where they are
+	info.PendingSent = make([]model.TransferRequest, 0)
+	info.PendingReceived = make([]model.TransferRequest, 0)
+	for _, request := range data.PendingRequests {
+		if request.SenderID == employeeID {
+			info.PendingSent = append(info.PendingSent, request)
+		} else {
+			info.PendingReceived = append(info.PendingReceived, request)
+		}
+	}
+
+	info.ExpiringSoon = make([]model.ExpiringCoins, 0)
+	for _, lot := range data.ActiveCoinLots { // lots are ordered by expire time
+		if lot.ExpireTime.Sub(now) > expiringSoonPeriod {
+			break
+		}
+		if last := len(info.ExpiringSoon) - 1; last >= 0 && info.ExpiringSoon[last].ExpireTime.Equal(lot.ExpireTime) {
+			info.ExpiringSoon[last].Amount += lot.Remaining
+			continue
+		}
+		info.ExpiringSoon = append(info.ExpiringSoon, model.ExpiringCoins{
+			Amount:     lot.Remaining,
+			ExpireTime: lot.ExpireTime,
+		})
+	}
+
+	return info
 }
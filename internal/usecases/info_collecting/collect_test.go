@@ -3,6 +3,7 @@ package info_collecting
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,13 +13,21 @@ import (
 )
 
 func TestUseCase_Collect(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	soon := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	notSoon := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
 	type mocks struct {
-		employeeRepo    *MockemployeeRepo
-		transactionRepo *MocktransactionRepo
-		inventoryRepo   *MockinventoryRepo
+		employeeRepo        *MockemployeeRepo
+		transactionRepo     *MocktransactionRepo
+		inventoryRepo       *MockinventoryRepo
+		coinLotRepo         *MockcoinLotRepo
+		transferRequestRepo *MocktransferRequestRepo
 	}
 	type args struct {
 		employeeID int64
+		history    model.CoinHistory
 	}
 
 	testCases := []struct {
@@ -82,12 +91,28 @@ func TestUseCase_Collect(t *testing.T) {
 							MerchName:  "socks",
 						},
 					}, nil).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{
+						{ID: 1, Remaining: 100, ExpireTime: soon},
+						{ID: 2, Remaining: 50, ExpireTime: soon},
+						{ID: 3, Remaining: 200, ExpireTime: later},
+						{ID: 4, Remaining: 650, ExpireTime: notSoon},
+					}, nil).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return([]model.TransferRequest{
+						{ID: 10, Kind: model.TransferRequestKindAcceptance, SenderID: 100, ReceiverID: 200, Amount: 300},
+						{ID: 11, Kind: model.TransferRequestKindApproval, SenderID: 300, ReceiverID: 100, Amount: 700},
+					}, nil).AnyTimes()
 			},
 			args: args{
 				employeeID: 100,
+				history:    model.CoinHistoryList,
 			},
 			wantRes: model.EmployeeInfo{
-				Coins: 1000,
+				Coins:        1000,
+				GivingBudget: 100,
 				Inventory: []model.Inventory{
 					{
 						EmployeeID: 100,
@@ -130,6 +155,16 @@ func TestUseCase_Collect(t *testing.T) {
 						Amount:                 100,
 					},
 				},
+				PendingSent: []model.TransferRequest{
+					{ID: 10, Kind: model.TransferRequestKindAcceptance, SenderID: 100, ReceiverID: 200, Amount: 300},
+				},
+				PendingReceived: []model.TransferRequest{
+					{ID: 11, Kind: model.TransferRequestKindApproval, SenderID: 300, ReceiverID: 100, Amount: 700},
+				},
+				ExpiringSoon: []model.ExpiringCoins{
+					{Amount: 150, ExpireTime: soon},
+					{Amount: 200, ExpireTime: later},
+				},
 			},
 			wantErr: nil,
 		},
@@ -149,15 +184,26 @@ func TestUseCase_Collect(t *testing.T) {
 				m.inventoryRepo.EXPECT().
 					GetByEmployee(gomock.Any(), int64(100)).
 					Return([]model.Inventory{}, nil).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: notSoon}}, nil).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return([]model.TransferRequest{}, nil).AnyTimes()
 			},
 			args: args{
 				employeeID: 100,
+				history:    model.CoinHistoryList,
 			},
 			wantRes: model.EmployeeInfo{
 				Coins:                1000,
+				GivingBudget:         100,
 				Inventory:            []model.Inventory{},
 				ReceivedTransactions: []model.Transaction{},
 				SentTransactions:     []model.Transaction{},
+				PendingSent:          []model.TransferRequest{},
+				PendingReceived:      []model.TransferRequest{},
+				ExpiringSoon:         []model.ExpiringCoins{},
 			},
 			wantErr: nil,
 		},
@@ -196,9 +242,16 @@ func TestUseCase_Collect(t *testing.T) {
 				m.inventoryRepo.EXPECT().
 					GetByEmployee(gomock.Any(), int64(100)).
 					Return(nil, assert.AnError).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{}, nil).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return([]model.TransferRequest{}, nil).AnyTimes()
 			},
 			args: args{
 				employeeID: 100,
+				history:    model.CoinHistoryList,
 			},
 			wantRes: model.EmployeeInfo{},
 			wantErr: assert.AnError,
@@ -238,9 +291,85 @@ func TestUseCase_Collect(t *testing.T) {
 							MerchName:  "socks",
 						},
 					}, nil).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{}, nil).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return([]model.TransferRequest{}, nil).AnyTimes()
+			},
+			args: args{
+				employeeID: 100,
+				history:    model.CoinHistoryList,
+			},
+			wantRes: model.EmployeeInfo{},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "success.byCounterparty",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByID(gomock.Any(), int64(100)).
+					Return(&model.Employee{ID: 100, Username: "test1", Balance: 1000}, nil).AnyTimes()
+				m.transactionRepo.EXPECT().
+					GetTotalsByEmployee(gomock.Any(), int64(100)).
+					Return([]model.Transaction{
+						{IsSender: false, CounterpartyEmployeeID: 300, CounterpartyUsername: "test3", Amount: 350},
+						{IsSender: true, CounterpartyEmployeeID: 200, CounterpartyUsername: "test2", Amount: 150},
+						{IsSender: true, CounterpartyEmployeeID: 300, CounterpartyUsername: "test3", Amount: 20},
+					}, nil).AnyTimes()
+				m.inventoryRepo.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100)).
+					Return([]model.Inventory{}, nil).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: notSoon}}, nil).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return([]model.TransferRequest{}, nil).AnyTimes()
+			},
+			args: args{
+				employeeID: 100,
+				history:    model.CoinHistoryByCounterparty,
+			},
+			wantRes: model.EmployeeInfo{
+				Coins:        1000,
+				GivingBudget: 100,
+				Inventory:    []model.Inventory{},
+				ReceivedTransactions: []model.Transaction{
+					{IsSender: false, CounterpartyEmployeeID: 300, CounterpartyUsername: "test3", Amount: 350},
+				},
+				SentTransactions: []model.Transaction{
+					{IsSender: true, CounterpartyEmployeeID: 200, CounterpartyUsername: "test2", Amount: 150},
+					{IsSender: true, CounterpartyEmployeeID: 300, CounterpartyUsername: "test3", Amount: 20},
+				},
+				PendingSent:     []model.TransferRequest{},
+				PendingReceived: []model.TransferRequest{},
+				ExpiringSoon:    []model.ExpiringCoins{},
+			},
+		},
+		{
+			name: "error.transactionRepo.GetTotalsByEmployee",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByID(gomock.Any(), int64(100)).
+					Return(&model.Employee{ID: 100, Username: "test1", Balance: 1000}, nil).AnyTimes()
+				m.transactionRepo.EXPECT().
+					GetTotalsByEmployee(gomock.Any(), int64(100)).
+					Return(nil, assert.AnError).AnyTimes()
+				m.inventoryRepo.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100)).
+					Return([]model.Inventory{}, nil).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{}, nil).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return([]model.TransferRequest{}, nil).AnyTimes()
 			},
 			args: args{
 				employeeID: 100,
+				history:    model.CoinHistoryByCounterparty,
 			},
 			wantRes: model.EmployeeInfo{},
 			wantErr: assert.AnError,
@@ -295,9 +424,68 @@ func TestUseCase_Collect(t *testing.T) {
 							MerchName:  "socks",
 						},
 					}, nil).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{}, nil).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return([]model.TransferRequest{}, nil).AnyTimes()
 			},
 			args: args{
 				employeeID: 100,
+				history:    model.CoinHistoryList,
+			},
+			wantRes: model.EmployeeInfo{},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.coinLotRepo.GetActive",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByID(gomock.Any(), int64(100)).
+					Return(&model.Employee{ID: 100, Username: "test1", Balance: 1000}, nil).AnyTimes()
+				m.transactionRepo.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100)).
+					Return([]model.Transaction{}, nil).AnyTimes()
+				m.inventoryRepo.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100)).
+					Return([]model.Inventory{}, nil).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return(nil, assert.AnError).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return([]model.TransferRequest{}, nil).AnyTimes()
+			},
+			args: args{
+				employeeID: 100,
+				history:    model.CoinHistoryList,
+			},
+			wantRes: model.EmployeeInfo{},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.transferRequestRepo.GetPendingByEmployee",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByID(gomock.Any(), int64(100)).
+					Return(&model.Employee{ID: 100, Username: "test1", Balance: 1000}, nil).AnyTimes()
+				m.transactionRepo.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100)).
+					Return([]model.Transaction{}, nil).AnyTimes()
+				m.inventoryRepo.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100)).
+					Return([]model.Inventory{}, nil).AnyTimes()
+				m.coinLotRepo.EXPECT().
+					GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{}, nil).AnyTimes()
+				m.transferRequestRepo.EXPECT().
+					GetPendingByEmployee(gomock.Any(), int64(100)).
+					Return(nil, assert.AnError).AnyTimes()
+			},
+			args: args{
+				employeeID: 100,
+				history:    model.CoinHistoryList,
 			},
 			wantRes: model.EmployeeInfo{},
 			wantErr: assert.AnError,
@@ -309,17 +497,208 @@ func TestUseCase_Collect(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			m := &mocks{
-				employeeRepo:    NewMockemployeeRepo(ctrl),
-				transactionRepo: NewMocktransactionRepo(ctrl),
-				inventoryRepo:   NewMockinventoryRepo(ctrl),
+				employeeRepo:        NewMockemployeeRepo(ctrl),
+				transactionRepo:     NewMocktransactionRepo(ctrl),
+				inventoryRepo:       NewMockinventoryRepo(ctrl),
+				coinLotRepo:         NewMockcoinLotRepo(ctrl),
+				transferRequestRepo: NewMocktransferRequestRepo(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.employeeRepo, m.transactionRepo, m.inventoryRepo)
+			uc, err := New(m.employeeRepo, m.transactionRepo, m.inventoryRepo, m.coinLotRepo, m.transferRequestRepo,
+				NewMockinfoRepo(ctrl), model.GivingBudget{MonthlyAmount: 100}, QueryModeParallel)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.Collect(context.Background(), tc.args.employeeID, tc.args.history)
+
+			require.ErrorIs(t, err, tc.wantErr)
+
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_Collect_Batch(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	soon := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	notSoon := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		prepare func(m *MockinfoRepo)
+		wantRes model.EmployeeInfo
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *MockinfoRepo) {
+				m.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100), now, model.CoinHistoryList).
+					Return(model.EmployeeInfoData{
+						Employee: model.Employee{ID: 100, Username: "test1", Balance: 1000},
+						Transactions: []model.Transaction{
+							{IsSender: true, CounterpartyEmployeeID: 200, CounterpartyUsername: "test2", Amount: 100},
+							{IsSender: false, CounterpartyEmployeeID: 300, CounterpartyUsername: "test3", Amount: 50},
+						},
+						Inventory: []model.Inventory{
+							{EmployeeID: 100, MerchID: 1, Quantity: 1, MerchName: "cup"},
+						},
+						ActiveCoinLots: []model.CoinLot{
+							{ID: 1, Remaining: 100, ExpireTime: soon},
+							{ID: 2, Remaining: 900, ExpireTime: notSoon},
+						},
+						PendingRequests: []model.TransferRequest{
+							{ID: 10, Kind: model.TransferRequestKindApproval, SenderID: 300, ReceiverID: 100, Amount: 700},
+						},
+					}, nil)
+			},
+			wantRes: model.EmployeeInfo{
+				Coins:        1000,
+				GivingBudget: 100,
+				Inventory: []model.Inventory{
+					{EmployeeID: 100, MerchID: 1, Quantity: 1, MerchName: "cup"},
+				},
+				ReceivedTransactions: []model.Transaction{
+					{IsSender: false, CounterpartyEmployeeID: 300, CounterpartyUsername: "test3", Amount: 50},
+				},
+				SentTransactions: []model.Transaction{
+					{IsSender: true, CounterpartyEmployeeID: 200, CounterpartyUsername: "test2", Amount: 100},
+				},
+				PendingSent: []model.TransferRequest{},
+				PendingReceived: []model.TransferRequest{
+					{ID: 10, Kind: model.TransferRequestKindApproval, SenderID: 300, ReceiverID: 100, Amount: 700},
+				},
+				ExpiringSoon: []model.ExpiringCoins{
+					{Amount: 100, ExpireTime: soon},
+				},
+			},
+		},
+		{
+			name: "success.expired_lots_not_written_off",
+			prepare: func(m *MockinfoRepo) {
+				// 900 coins of expired lots stay in the balance until the coin expiry worker writes them off
+				m.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100), now, model.CoinHistoryList).
+					Return(model.EmployeeInfoData{
+						Employee:       model.Employee{ID: 100, Username: "test1", Balance: 1000},
+						ActiveCoinLots: []model.CoinLot{{ID: 2, Remaining: 100, ExpireTime: notSoon}},
+					}, nil)
+			},
+			wantRes: model.EmployeeInfo{
+				Coins:                100,
+				GivingBudget:         100,
+				ReceivedTransactions: []model.Transaction{},
+				SentTransactions:     []model.Transaction{},
+				PendingSent:          []model.TransferRequest{},
+				PendingReceived:      []model.TransferRequest{},
+				ExpiringSoon:         []model.ExpiringCoins{},
+			},
+		},
+		{
+			name: "error.infoRepo.GetByEmployee",
+			prepare: func(m *MockinfoRepo) {
+				m.EXPECT().
+					GetByEmployee(gomock.Any(), int64(100), now, model.CoinHistoryList).
+					Return(model.EmployeeInfoData{}, assert.AnError)
+			},
+			wantRes: model.EmployeeInfo{},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			infoRepo := NewMockinfoRepo(ctrl)
+			tc.prepare(infoRepo)
+
+			// the per repository queries aren't used in batch mode
+			uc, err := New(NewMockemployeeRepo(ctrl), NewMocktransactionRepo(ctrl), NewMockinventoryRepo(ctrl),
+				NewMockcoinLotRepo(ctrl), NewMocktransferRequestRepo(ctrl), infoRepo,
+				model.GivingBudget{MonthlyAmount: 100}, QueryModeBatch)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.Collect(context.Background(), 100, model.CoinHistoryList)
+
+			require.ErrorIs(t, err, tc.wantErr)
+
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_Collect_Single(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	notSoon := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		prepare func(m *MockinfoRepo)
+		wantRes model.EmployeeInfo
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *MockinfoRepo) {
+				m.EXPECT().
+					GetByEmployeeSingleQuery(gomock.Any(), int64(100), now, model.CoinHistoryByCounterparty).
+					Return(model.EmployeeInfoData{
+						Employee: model.Employee{ID: 100, Username: "test1", Balance: 1000},
+						Transactions: []model.Transaction{
+							{IsSender: true, CounterpartyEmployeeID: 200, CounterpartyUsername: "test2", Amount: 100},
+						},
+						Inventory:      []model.Inventory{},
+						ActiveCoinLots: []model.CoinLot{{ID: 1, Remaining: 1000, ExpireTime: notSoon}},
+						PendingRequests: []model.TransferRequest{
+							{ID: 10, Kind: model.TransferRequestKindApproval, SenderID: 100, ReceiverID: 300, Amount: 700},
+						},
+					}, nil)
+			},
+			wantRes: model.EmployeeInfo{
+				Coins:                1000,
+				GivingBudget:         100,
+				Inventory:            []model.Inventory{},
+				ReceivedTransactions: []model.Transaction{},
+				SentTransactions: []model.Transaction{
+					{IsSender: true, CounterpartyEmployeeID: 200, CounterpartyUsername: "test2", Amount: 100},
+				},
+				PendingSent: []model.TransferRequest{
+					{ID: 10, Kind: model.TransferRequestKindApproval, SenderID: 100, ReceiverID: 300, Amount: 700},
+				},
+				PendingReceived: []model.TransferRequest{},
+				ExpiringSoon:    []model.ExpiringCoins{},
+			},
+		},
+		{
+			name: "error.infoRepo.GetByEmployeeSingleQuery",
+			prepare: func(m *MockinfoRepo) {
+				m.EXPECT().
+					GetByEmployeeSingleQuery(gomock.Any(), int64(100), now, model.CoinHistoryByCounterparty).
+					Return(model.EmployeeInfoData{}, assert.AnError)
+			},
+			wantRes: model.EmployeeInfo{},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			infoRepo := NewMockinfoRepo(ctrl)
+			tc.prepare(infoRepo)
+
+			uc, err := New(NewMockemployeeRepo(ctrl), NewMocktransactionRepo(ctrl), NewMockinventoryRepo(ctrl),
+				NewMockcoinLotRepo(ctrl), NewMocktransferRequestRepo(ctrl), infoRepo,
+				model.GivingBudget{MonthlyAmount: 100}, QueryModeSingle)
 			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
 
-			res, err := uc.Collect(context.Background(), tc.args.employeeID)
+			res, err := uc.Collect(context.Background(), 100, model.CoinHistoryByCounterparty)
 
 			require.ErrorIs(t, err, tc.wantErr)
 
@@ -3,6 +3,7 @@ package info_collecting
 
 import (
 	"context"
+	"time"
 
 	"github.com/inna-maikut/avito-shop/internal/model"
 )
@@ -13,8 +14,22 @@ type employeeRepo interface {
 
 type transactionRepo interface {
 	GetByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error)
+	GetTotalsByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error)
 }
 
 type inventoryRepo interface {
 	GetByEmployee(ctx context.Context, employeeID int64) ([]model.Inventory, error)
 }
+
+type coinLotRepo interface {
+	GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error)
+}
+
+type transferRequestRepo interface {
+	GetPendingByEmployee(ctx context.Context, employeeID int64) ([]model.TransferRequest, error)
+}
+
+type infoRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory) (model.EmployeeInfoData, error)
+	GetByEmployeeSingleQuery(ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory) (model.EmployeeInfoData, error)
+}
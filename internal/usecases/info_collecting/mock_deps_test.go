@@ -12,6 +12,7 @@ package info_collecting
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/inna-maikut/avito-shop/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -141,6 +142,45 @@ func (c *MocktransactionRepoGetByEmployeeCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetTotalsByEmployee mocks base method.
+func (m *MocktransactionRepo) GetTotalsByEmployee(ctx context.Context, employeeID int64) ([]model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalsByEmployee", ctx, employeeID)
+	ret0, _ := ret[0].([]model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalsByEmployee indicates an expected call of GetTotalsByEmployee.
+func (mr *MocktransactionRepoMockRecorder) GetTotalsByEmployee(ctx, employeeID any) *MocktransactionRepoGetTotalsByEmployeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalsByEmployee", reflect.TypeOf((*MocktransactionRepo)(nil).GetTotalsByEmployee), ctx, employeeID)
+	return &MocktransactionRepoGetTotalsByEmployeeCall{Call: call}
+}
+
+// MocktransactionRepoGetTotalsByEmployeeCall wrap *gomock.Call
+type MocktransactionRepoGetTotalsByEmployeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransactionRepoGetTotalsByEmployeeCall) Return(arg0 []model.Transaction, arg1 error) *MocktransactionRepoGetTotalsByEmployeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransactionRepoGetTotalsByEmployeeCall) Do(f func(context.Context, int64) ([]model.Transaction, error)) *MocktransactionRepoGetTotalsByEmployeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransactionRepoGetTotalsByEmployeeCall) DoAndReturn(f func(context.Context, int64) ([]model.Transaction, error)) *MocktransactionRepoGetTotalsByEmployeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockinventoryRepo is a mock of inventoryRepo interface.
 type MockinventoryRepo struct {
 	ctrl     *gomock.Controller
@@ -202,3 +242,228 @@ func (c *MockinventoryRepoGetByEmployeeCall) DoAndReturn(f func(context.Context,
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// GetActive mocks base method.
+func (m *MockcoinLotRepo) GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx, employeeID, now)
+	ret0, _ := ret[0].([]model.CoinLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockcoinLotRepoMockRecorder) GetActive(ctx, employeeID, now any) *MockcoinLotRepoGetActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockcoinLotRepo)(nil).GetActive), ctx, employeeID, now)
+	return &MockcoinLotRepoGetActiveCall{Call: call}
+}
+
+// MockcoinLotRepoGetActiveCall wrap *gomock.Call
+type MockcoinLotRepoGetActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoGetActiveCall) Return(arg0 []model.CoinLot, arg1 error) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoGetActiveCall) Do(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoGetActiveCall) DoAndReturn(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktransferRequestRepo is a mock of transferRequestRepo interface.
+type MocktransferRequestRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransferRequestRepoMockRecorder
+}
+
+// MocktransferRequestRepoMockRecorder is the mock recorder for MocktransferRequestRepo.
+type MocktransferRequestRepoMockRecorder struct {
+	mock *MocktransferRequestRepo
+}
+
+// NewMocktransferRequestRepo creates a new mock instance.
+func NewMocktransferRequestRepo(ctrl *gomock.Controller) *MocktransferRequestRepo {
+	mock := &MocktransferRequestRepo{ctrl: ctrl}
+	mock.recorder = &MocktransferRequestRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransferRequestRepo) EXPECT() *MocktransferRequestRepoMockRecorder {
+	return m.recorder
+}
+
+// GetPendingByEmployee mocks base method.
+func (m *MocktransferRequestRepo) GetPendingByEmployee(ctx context.Context, employeeID int64) ([]model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByEmployee", ctx, employeeID)
+	ret0, _ := ret[0].([]model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByEmployee indicates an expected call of GetPendingByEmployee.
+func (mr *MocktransferRequestRepoMockRecorder) GetPendingByEmployee(ctx, employeeID any) *MocktransferRequestRepoGetPendingByEmployeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByEmployee", reflect.TypeOf((*MocktransferRequestRepo)(nil).GetPendingByEmployee), ctx, employeeID)
+	return &MocktransferRequestRepoGetPendingByEmployeeCall{Call: call}
+}
+
+// MocktransferRequestRepoGetPendingByEmployeeCall wrap *gomock.Call
+type MocktransferRequestRepoGetPendingByEmployeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoGetPendingByEmployeeCall) Return(arg0 []model.TransferRequest, arg1 error) *MocktransferRequestRepoGetPendingByEmployeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoGetPendingByEmployeeCall) Do(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferRequestRepoGetPendingByEmployeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoGetPendingByEmployeeCall) DoAndReturn(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferRequestRepoGetPendingByEmployeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockinfoRepo is a mock of infoRepo interface.
+type MockinfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockinfoRepoMockRecorder
+}
+
+// MockinfoRepoMockRecorder is the mock recorder for MockinfoRepo.
+type MockinfoRepoMockRecorder struct {
+	mock *MockinfoRepo
+}
+
+// NewMockinfoRepo creates a new mock instance.
+func NewMockinfoRepo(ctrl *gomock.Controller) *MockinfoRepo {
+	mock := &MockinfoRepo{ctrl: ctrl}
+	mock.recorder = &MockinfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinfoRepo) EXPECT() *MockinfoRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByEmployee mocks base method.
+func (m *MockinfoRepo) GetByEmployee(ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory) (model.EmployeeInfoData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmployee", ctx, employeeID, now, history)
+	ret0, _ := ret[0].(model.EmployeeInfoData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmployee indicates an expected call of GetByEmployee.
+func (mr *MockinfoRepoMockRecorder) GetByEmployee(ctx, employeeID, now, history any) *MockinfoRepoGetByEmployeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmployee", reflect.TypeOf((*MockinfoRepo)(nil).GetByEmployee), ctx, employeeID, now, history)
+	return &MockinfoRepoGetByEmployeeCall{Call: call}
+}
+
+// MockinfoRepoGetByEmployeeCall wrap *gomock.Call
+type MockinfoRepoGetByEmployeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockinfoRepoGetByEmployeeCall) Return(arg0 model.EmployeeInfoData, arg1 error) *MockinfoRepoGetByEmployeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockinfoRepoGetByEmployeeCall) Do(f func(context.Context, int64, time.Time, model.CoinHistory) (model.EmployeeInfoData, error)) *MockinfoRepoGetByEmployeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockinfoRepoGetByEmployeeCall) DoAndReturn(f func(context.Context, int64, time.Time, model.CoinHistory) (model.EmployeeInfoData, error)) *MockinfoRepoGetByEmployeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByEmployeeSingleQuery mocks base method.
+func (m *MockinfoRepo) GetByEmployeeSingleQuery(ctx context.Context, employeeID int64, now time.Time, history model.CoinHistory) (model.EmployeeInfoData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmployeeSingleQuery", ctx, employeeID, now, history)
+	ret0, _ := ret[0].(model.EmployeeInfoData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmployeeSingleQuery indicates an expected call of GetByEmployeeSingleQuery.
+func (mr *MockinfoRepoMockRecorder) GetByEmployeeSingleQuery(ctx, employeeID, now, history any) *MockinfoRepoGetByEmployeeSingleQueryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmployeeSingleQuery", reflect.TypeOf((*MockinfoRepo)(nil).GetByEmployeeSingleQuery), ctx, employeeID, now, history)
+	return &MockinfoRepoGetByEmployeeSingleQueryCall{Call: call}
+}
+
+// MockinfoRepoGetByEmployeeSingleQueryCall wrap *gomock.Call
+type MockinfoRepoGetByEmployeeSingleQueryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockinfoRepoGetByEmployeeSingleQueryCall) Return(arg0 model.EmployeeInfoData, arg1 error) *MockinfoRepoGetByEmployeeSingleQueryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockinfoRepoGetByEmployeeSingleQueryCall) Do(f func(context.Context, int64, time.Time, model.CoinHistory) (model.EmployeeInfoData, error)) *MockinfoRepoGetByEmployeeSingleQueryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockinfoRepoGetByEmployeeSingleQueryCall) DoAndReturn(f func(context.Context, int64, time.Time, model.CoinHistory) (model.EmployeeInfoData, error)) *MockinfoRepoGetByEmployeeSingleQueryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,16 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package ledger_exporting
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type exportRepo interface {
+	Stream(ctx context.Context, filter model.ExportFilter, fn func(row model.ExportRow) error) error
+}
+
+type employeeRepo interface {
+	GetByUsername(ctx context.Context, username string) (*model.Employee, error)
+}
@@ -0,0 +1,71 @@
+package ledger_exporting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type UseCase struct {
+	exportRepo   exportRepo
+	employeeRepo employeeRepo
+}
+
+func New(exportRepo exportRepo, employeeRepo employeeRepo) (*UseCase, error) {
+	if exportRepo == nil {
+		return nil, errors.New("exportRepo is nil")
+	}
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+
+	return &UseCase{
+		exportRepo:   exportRepo,
+		employeeRepo: employeeRepo,
+	}, nil
+}
+
+// Export streams transfers, purchases and refunds to w in params.Format.
+// Invalid params are reported before anything is written to w.
+func (uc *UseCase) Export(ctx context.Context, w io.Writer, params model.ExportParams) error {
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return model.ErrInvalidPeriod
+	}
+
+	rw, err := newRowWriter(w, params.Format)
+	if err != nil {
+		return fmt.Errorf("newRowWriter: %w", err)
+	}
+
+	filter := model.ExportFilter{
+		From: params.From,
+		To:   params.To,
+	}
+	if params.EmployeeUsername != "" {
+		employee, err := uc.employeeRepo.GetByUsername(ctx, params.EmployeeUsername)
+		if err != nil {
+			return fmt.Errorf("employeeRepo.GetByUsername: %w", err)
+		}
+		filter.EmployeeID = &employee.ID
+	}
+
+	err = rw.WriteHeader()
+	if err != nil {
+		return fmt.Errorf("rw.WriteHeader: %w", err)
+	}
+
+	err = uc.exportRepo.Stream(ctx, filter, rw.Write)
+	if err != nil {
+		return fmt.Errorf("exportRepo.Stream: %w", err)
+	}
+
+	err = rw.Flush()
+	if err != nil {
+		return fmt.Errorf("rw.Flush: %w", err)
+	}
+
+	return nil
+}
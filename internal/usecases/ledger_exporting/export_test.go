@@ -0,0 +1,165 @@
+package ledger_exporting
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_Export(t *testing.T) {
+	type mocks struct {
+		exportRepo   *MockexportRepo
+		employeeRepo *MockemployeeRepo
+	}
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	employeeID := int64(100)
+
+	rows := []model.ExportRow{
+		{
+			Kind:     model.ExportRowKindTransfer,
+			ID:       1,
+			Time:     time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC),
+			FromUser: "test1",
+			ToUser:   "test2",
+			Amount:   100,
+		},
+		{
+			Kind:      model.ExportRowKindPurchase,
+			ID:        7,
+			Time:      time.Date(2025, 1, 3, 11, 0, 0, 0, time.UTC),
+			FromUser:  "test1",
+			MerchName: "cup",
+			Quantity:  1,
+			Amount:    20,
+		},
+	}
+	stream := func(_ context.Context, _ model.ExportFilter, fn func(row model.ExportRow) error) error {
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		params  model.ExportParams
+		wantRes string
+		wantErr error
+	}{
+		{
+			name: "success.csv",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "test1").
+					Return(&model.Employee{ID: employeeID, Username: "test1"}, nil)
+				m.exportRepo.EXPECT().
+					Stream(gomock.Any(), model.ExportFilter{From: &from, To: &to, EmployeeID: &employeeID}, gomock.Any()).
+					DoAndReturn(stream)
+			},
+			params: model.ExportParams{
+				Format:           model.ExportFormatCSV,
+				From:             &from,
+				To:               &to,
+				EmployeeUsername: "test1",
+			},
+			wantRes: "kind,id,time,from_user,to_user,merch,quantity,amount\n" +
+				"transfer,1,2025-01-02T10:00:00Z,test1,test2,,,100\n" +
+				"purchase,7,2025-01-03T11:00:00Z,test1,,cup,1,20\n",
+			wantErr: nil,
+		},
+		{
+			name: "success.ndjson",
+			prepare: func(m *mocks) {
+				m.exportRepo.EXPECT().
+					Stream(gomock.Any(), model.ExportFilter{}, gomock.Any()).
+					DoAndReturn(stream)
+			},
+			params: model.ExportParams{
+				Format: model.ExportFormatNDJSON,
+			},
+			wantRes: `{"kind":"transfer","id":1,"time":"2025-01-02T10:00:00Z","fromUser":"test1","toUser":"test2","amount":100}` + "\n" +
+				`{"kind":"purchase","id":7,"time":"2025-01-03T11:00:00Z","fromUser":"test1","merch":"cup","quantity":1,"amount":20}` + "\n",
+			wantErr: nil,
+		},
+		{
+			name:    "error.invalid_format",
+			prepare: func(m *mocks) {},
+			params: model.ExportParams{
+				Format: "xml",
+			},
+			wantRes: "",
+			wantErr: model.ErrInvalidExportFormat,
+		},
+		{
+			name:    "error.invalid_period",
+			prepare: func(m *mocks) {},
+			params: model.ExportParams{
+				Format: model.ExportFormatCSV,
+				From:   &to,
+				To:     &from,
+			},
+			wantRes: "",
+			wantErr: model.ErrInvalidPeriod,
+		},
+		{
+			name: "error.employee_not_found",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "nobody").
+					Return(nil, model.ErrEmployeeNotFound)
+			},
+			params: model.ExportParams{
+				Format:           model.ExportFormatCSV,
+				EmployeeUsername: "nobody",
+			},
+			wantRes: "",
+			wantErr: model.ErrEmployeeNotFound,
+		},
+		{
+			name: "error.export_repo.stream",
+			prepare: func(m *mocks) {
+				m.exportRepo.EXPECT().
+					Stream(gomock.Any(), model.ExportFilter{}, gomock.Any()).
+					Return(assert.AnError)
+			},
+			params: model.ExportParams{
+				Format: model.ExportFormatNDJSON,
+			},
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				exportRepo:   NewMockexportRepo(ctrl),
+				employeeRepo: NewMockemployeeRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.exportRepo, m.employeeRepo)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			err = uc.Export(context.Background(), &buf, tc.params)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, buf.String())
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package ledger_exporting -typed -destination mock_deps_test.go
+//
+
+// Package ledger_exporting is a generated GoMock package.
+package ledger_exporting
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockexportRepo is a mock of exportRepo interface.
+type MockexportRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockexportRepoMockRecorder
+}
+
+// MockexportRepoMockRecorder is the mock recorder for MockexportRepo.
+type MockexportRepoMockRecorder struct {
+	mock *MockexportRepo
+}
+
+// NewMockexportRepo creates a new mock instance.
+func NewMockexportRepo(ctrl *gomock.Controller) *MockexportRepo {
+	mock := &MockexportRepo{ctrl: ctrl}
+	mock.recorder = &MockexportRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexportRepo) EXPECT() *MockexportRepoMockRecorder {
+	return m.recorder
+}
+
+// Stream mocks base method.
+func (m *MockexportRepo) Stream(ctx context.Context, filter model.ExportFilter, fn func(model.ExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockexportRepoMockRecorder) Stream(ctx, filter, fn any) *MockexportRepoStreamCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockexportRepo)(nil).Stream), ctx, filter, fn)
+	return &MockexportRepoStreamCall{Call: call}
+}
+
+// MockexportRepoStreamCall wrap *gomock.Call
+type MockexportRepoStreamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockexportRepoStreamCall) Return(arg0 error) *MockexportRepoStreamCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockexportRepoStreamCall) Do(f func(context.Context, model.ExportFilter, func(model.ExportRow) error) error) *MockexportRepoStreamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockexportRepoStreamCall) DoAndReturn(f func(context.Context, model.ExportFilter, func(model.ExportRow) error) error) *MockexportRepoStreamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByUsername mocks base method.
+func (m *MockemployeeRepo) GetByUsername(ctx context.Context, username string) (*model.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUsername", ctx, username)
+	ret0, _ := ret[0].(*model.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUsername indicates an expected call of GetByUsername.
+func (mr *MockemployeeRepoMockRecorder) GetByUsername(ctx, username any) *MockemployeeRepoGetByUsernameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockemployeeRepo)(nil).GetByUsername), ctx, username)
+	return &MockemployeeRepoGetByUsernameCall{Call: call}
+}
+
+// MockemployeeRepoGetByUsernameCall wrap *gomock.Call
+type MockemployeeRepoGetByUsernameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoGetByUsernameCall) Return(arg0 *model.Employee, arg1 error) *MockemployeeRepoGetByUsernameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoGetByUsernameCall) Do(f func(context.Context, string) (*model.Employee, error)) *MockemployeeRepoGetByUsernameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoGetByUsernameCall) DoAndReturn(f func(context.Context, string) (*model.Employee, error)) *MockemployeeRepoGetByUsernameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,125 @@
+package ledger_exporting
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type rowWriter interface {
+	WriteHeader() error
+	Write(row model.ExportRow) error
+	Flush() error
+}
+
+func newRowWriter(w io.Writer, format model.ExportFormat) (rowWriter, error) {
+	switch format {
+	case model.ExportFormatCSV:
+		return &csvRowWriter{w: csv.NewWriter(w)}, nil
+	case model.ExportFormatNDJSON:
+		bw := bufio.NewWriter(w)
+		return &ndjsonRowWriter{w: bw, enc: json.NewEncoder(bw)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", model.ErrInvalidExportFormat, format)
+	}
+}
+
+type csvRowWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvRowWriter) WriteHeader() error {
+	err := c.w.Write([]string{"kind", "id", "time", "from_user", "to_user", "merch", "quantity", "amount"})
+	if err != nil {
+		return fmt.Errorf("csv.Write: %w", err)
+	}
+
+	return nil
+}
+
+func (c *csvRowWriter) Write(row model.ExportRow) error {
+	quantity := ""
+	if row.Kind == model.ExportRowKindPurchase {
+		quantity = strconv.FormatInt(row.Quantity, 10)
+	}
+
+	err := c.w.Write([]string{
+		string(row.Kind),
+		strconv.FormatInt(row.ID, 10),
+		row.Time.UTC().Format(time.RFC3339),
+		row.FromUser,
+		row.ToUser,
+		row.MerchName,
+		quantity,
+		strconv.FormatInt(row.Amount, 10),
+	})
+	if err != nil {
+		return fmt.Errorf("csv.Write: %w", err)
+	}
+
+	return nil
+}
+
+func (c *csvRowWriter) Flush() error {
+	c.w.Flush()
+
+	err := c.w.Error()
+	if err != nil {
+		return fmt.Errorf("csv.Flush: %w", err)
+	}
+
+	return nil
+}
+
+type ndjsonRowWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+type ndjsonRow struct {
+	Kind      model.ExportRowKind `json:"kind"`
+	ID        int64               `json:"id"`
+	Time      time.Time           `json:"time"`
+	FromUser  string              `json:"fromUser"`
+	ToUser    string              `json:"toUser,omitempty"`
+	MerchName string              `json:"merch,omitempty"`
+	Quantity  int64               `json:"quantity,omitempty"`
+	Amount    int64               `json:"amount"`
+}
+
+func (n *ndjsonRowWriter) WriteHeader() error {
+	return nil
+}
+
+func (n *ndjsonRowWriter) Write(row model.ExportRow) error {
+	err := n.enc.Encode(ndjsonRow{
+		Kind:      row.Kind,
+		ID:        row.ID,
+		Time:      row.Time.UTC(),
+		FromUser:  row.FromUser,
+		ToUser:    row.ToUser,
+		MerchName: row.MerchName,
+		Quantity:  row.Quantity,
+		Amount:    row.Amount,
+	})
+	if err != nil {
+		return fmt.Errorf("json.Encode: %w", err)
+	}
+
+	return nil
+}
+
+func (n *ndjsonRowWriter) Flush() error {
+	err := n.w.Flush()
+	if err != nil {
+		return fmt.Errorf("bufio.Flush: %w", err)
+	}
+
+	return nil
+}
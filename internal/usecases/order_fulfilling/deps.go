@@ -0,0 +1,41 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package order_fulfilling
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type orderRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.Order, error)
+	GetByStatuses(ctx context.Context, statuses []model.OrderStatus) ([]model.Order, error)
+	GetByIDWithLock(ctx context.Context, orderID int64) (*model.Order, error)
+	GetLots(ctx context.Context, orderID int64) ([]model.CoinLotPart, error)
+	UpdateStatus(ctx context.Context, orderID int64, status model.OrderStatus, updateTime time.Time) error
+}
+
+type employeeRepo interface {
+	IncreaseBalance(ctx context.Context, employeeID, amount int64) error
+}
+
+type inventoryRepo interface {
+	Remove(ctx context.Context, employeeID, variantID, quantity int64) error
+}
+
+type merchVariantRepo interface {
+	IncreaseStock(ctx context.Context, variantID, quantity int64) error
+}
+
+type coinLotRepo interface {
+	Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error
+}
+
+type ledgerEntryRepo interface {
+	Add(ctx context.Context, entry model.LedgerEntry) error
+}
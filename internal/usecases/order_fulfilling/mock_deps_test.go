@@ -0,0 +1,602 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package order_fulfilling -typed -destination mock_deps_test.go
+//
+
+// Package order_fulfilling is a generated GoMock package.
+package order_fulfilling
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockorderRepo is a mock of orderRepo interface.
+type MockorderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockorderRepoMockRecorder
+}
+
+// MockorderRepoMockRecorder is the mock recorder for MockorderRepo.
+type MockorderRepoMockRecorder struct {
+	mock *MockorderRepo
+}
+
+// NewMockorderRepo creates a new mock instance.
+func NewMockorderRepo(ctrl *gomock.Controller) *MockorderRepo {
+	mock := &MockorderRepo{ctrl: ctrl}
+	mock.recorder = &MockorderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorderRepo) EXPECT() *MockorderRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByEmployee mocks base method.
+func (m *MockorderRepo) GetByEmployee(ctx context.Context, employeeID int64) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmployee", ctx, employeeID)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmployee indicates an expected call of GetByEmployee.
+func (mr *MockorderRepoMockRecorder) GetByEmployee(ctx, employeeID any) *MockorderRepoGetByEmployeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmployee", reflect.TypeOf((*MockorderRepo)(nil).GetByEmployee), ctx, employeeID)
+	return &MockorderRepoGetByEmployeeCall{Call: call}
+}
+
+// MockorderRepoGetByEmployeeCall wrap *gomock.Call
+type MockorderRepoGetByEmployeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderRepoGetByEmployeeCall) Return(arg0 []model.Order, arg1 error) *MockorderRepoGetByEmployeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderRepoGetByEmployeeCall) Do(f func(context.Context, int64) ([]model.Order, error)) *MockorderRepoGetByEmployeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderRepoGetByEmployeeCall) DoAndReturn(f func(context.Context, int64) ([]model.Order, error)) *MockorderRepoGetByEmployeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByIDWithLock mocks base method.
+func (m *MockorderRepo) GetByIDWithLock(ctx context.Context, orderID int64) (*model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDWithLock", ctx, orderID)
+	ret0, _ := ret[0].(*model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDWithLock indicates an expected call of GetByIDWithLock.
+func (mr *MockorderRepoMockRecorder) GetByIDWithLock(ctx, orderID any) *MockorderRepoGetByIDWithLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithLock", reflect.TypeOf((*MockorderRepo)(nil).GetByIDWithLock), ctx, orderID)
+	return &MockorderRepoGetByIDWithLockCall{Call: call}
+}
+
+// MockorderRepoGetByIDWithLockCall wrap *gomock.Call
+type MockorderRepoGetByIDWithLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderRepoGetByIDWithLockCall) Return(arg0 *model.Order, arg1 error) *MockorderRepoGetByIDWithLockCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderRepoGetByIDWithLockCall) Do(f func(context.Context, int64) (*model.Order, error)) *MockorderRepoGetByIDWithLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderRepoGetByIDWithLockCall) DoAndReturn(f func(context.Context, int64) (*model.Order, error)) *MockorderRepoGetByIDWithLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByStatuses mocks base method.
+func (m *MockorderRepo) GetByStatuses(ctx context.Context, statuses []model.OrderStatus) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByStatuses", ctx, statuses)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByStatuses indicates an expected call of GetByStatuses.
+func (mr *MockorderRepoMockRecorder) GetByStatuses(ctx, statuses any) *MockorderRepoGetByStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByStatuses", reflect.TypeOf((*MockorderRepo)(nil).GetByStatuses), ctx, statuses)
+	return &MockorderRepoGetByStatusesCall{Call: call}
+}
+
+// MockorderRepoGetByStatusesCall wrap *gomock.Call
+type MockorderRepoGetByStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderRepoGetByStatusesCall) Return(arg0 []model.Order, arg1 error) *MockorderRepoGetByStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderRepoGetByStatusesCall) Do(f func(context.Context, []model.OrderStatus) ([]model.Order, error)) *MockorderRepoGetByStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderRepoGetByStatusesCall) DoAndReturn(f func(context.Context, []model.OrderStatus) ([]model.Order, error)) *MockorderRepoGetByStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetLots mocks base method.
+func (m *MockorderRepo) GetLots(ctx context.Context, orderID int64) ([]model.CoinLotPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLots", ctx, orderID)
+	ret0, _ := ret[0].([]model.CoinLotPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLots indicates an expected call of GetLots.
+func (mr *MockorderRepoMockRecorder) GetLots(ctx, orderID any) *MockorderRepoGetLotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLots", reflect.TypeOf((*MockorderRepo)(nil).GetLots), ctx, orderID)
+	return &MockorderRepoGetLotsCall{Call: call}
+}
+
+// MockorderRepoGetLotsCall wrap *gomock.Call
+type MockorderRepoGetLotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderRepoGetLotsCall) Return(arg0 []model.CoinLotPart, arg1 error) *MockorderRepoGetLotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderRepoGetLotsCall) Do(f func(context.Context, int64) ([]model.CoinLotPart, error)) *MockorderRepoGetLotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderRepoGetLotsCall) DoAndReturn(f func(context.Context, int64) ([]model.CoinLotPart, error)) *MockorderRepoGetLotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateStatus mocks base method.
+func (m *MockorderRepo) UpdateStatus(ctx context.Context, orderID int64, status model.OrderStatus, updateTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, orderID, status, updateTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockorderRepoMockRecorder) UpdateStatus(ctx, orderID, status, updateTime any) *MockorderRepoUpdateStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockorderRepo)(nil).UpdateStatus), ctx, orderID, status, updateTime)
+	return &MockorderRepoUpdateStatusCall{Call: call}
+}
+
+// MockorderRepoUpdateStatusCall wrap *gomock.Call
+type MockorderRepoUpdateStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderRepoUpdateStatusCall) Return(arg0 error) *MockorderRepoUpdateStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderRepoUpdateStatusCall) Do(f func(context.Context, int64, model.OrderStatus, time.Time) error) *MockorderRepoUpdateStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderRepoUpdateStatusCall) DoAndReturn(f func(context.Context, int64, model.OrderStatus, time.Time) error) *MockorderRepoUpdateStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// IncreaseBalance mocks base method.
+func (m *MockemployeeRepo) IncreaseBalance(ctx context.Context, employeeID, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseBalance", ctx, employeeID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseBalance indicates an expected call of IncreaseBalance.
+func (mr *MockemployeeRepoMockRecorder) IncreaseBalance(ctx, employeeID, amount any) *MockemployeeRepoIncreaseBalanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseBalance", reflect.TypeOf((*MockemployeeRepo)(nil).IncreaseBalance), ctx, employeeID, amount)
+	return &MockemployeeRepoIncreaseBalanceCall{Call: call}
+}
+
+// MockemployeeRepoIncreaseBalanceCall wrap *gomock.Call
+type MockemployeeRepoIncreaseBalanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoIncreaseBalanceCall) Return(arg0 error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoIncreaseBalanceCall) Do(f func(context.Context, int64, int64) error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoIncreaseBalanceCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockinventoryRepo is a mock of inventoryRepo interface.
+type MockinventoryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockinventoryRepoMockRecorder
+}
+
+// MockinventoryRepoMockRecorder is the mock recorder for MockinventoryRepo.
+type MockinventoryRepoMockRecorder struct {
+	mock *MockinventoryRepo
+}
+
+// NewMockinventoryRepo creates a new mock instance.
+func NewMockinventoryRepo(ctrl *gomock.Controller) *MockinventoryRepo {
+	mock := &MockinventoryRepo{ctrl: ctrl}
+	mock.recorder = &MockinventoryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinventoryRepo) EXPECT() *MockinventoryRepoMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockinventoryRepo) Remove(ctx context.Context, employeeID, variantID, quantity int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, employeeID, variantID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockinventoryRepoMockRecorder) Remove(ctx, employeeID, variantID, quantity any) *MockinventoryRepoRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockinventoryRepo)(nil).Remove), ctx, employeeID, variantID, quantity)
+	return &MockinventoryRepoRemoveCall{Call: call}
+}
+
+// MockinventoryRepoRemoveCall wrap *gomock.Call
+type MockinventoryRepoRemoveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockinventoryRepoRemoveCall) Return(arg0 error) *MockinventoryRepoRemoveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockinventoryRepoRemoveCall) Do(f func(context.Context, int64, int64, int64) error) *MockinventoryRepoRemoveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockinventoryRepoRemoveCall) DoAndReturn(f func(context.Context, int64, int64, int64) error) *MockinventoryRepoRemoveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockmerchVariantRepo is a mock of merchVariantRepo interface.
+type MockmerchVariantRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockmerchVariantRepoMockRecorder
+}
+
+// MockmerchVariantRepoMockRecorder is the mock recorder for MockmerchVariantRepo.
+type MockmerchVariantRepoMockRecorder struct {
+	mock *MockmerchVariantRepo
+}
+
+// NewMockmerchVariantRepo creates a new mock instance.
+func NewMockmerchVariantRepo(ctrl *gomock.Controller) *MockmerchVariantRepo {
+	mock := &MockmerchVariantRepo{ctrl: ctrl}
+	mock.recorder = &MockmerchVariantRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmerchVariantRepo) EXPECT() *MockmerchVariantRepoMockRecorder {
+	return m.recorder
+}
+
+// IncreaseStock mocks base method.
+func (m *MockmerchVariantRepo) IncreaseStock(ctx context.Context, variantID, quantity int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseStock", ctx, variantID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseStock indicates an expected call of IncreaseStock.
+func (mr *MockmerchVariantRepoMockRecorder) IncreaseStock(ctx, variantID, quantity any) *MockmerchVariantRepoIncreaseStockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseStock", reflect.TypeOf((*MockmerchVariantRepo)(nil).IncreaseStock), ctx, variantID, quantity)
+	return &MockmerchVariantRepoIncreaseStockCall{Call: call}
+}
+
+// MockmerchVariantRepoIncreaseStockCall wrap *gomock.Call
+type MockmerchVariantRepoIncreaseStockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchVariantRepoIncreaseStockCall) Return(arg0 error) *MockmerchVariantRepoIncreaseStockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchVariantRepoIncreaseStockCall) Do(f func(context.Context, int64, int64) error) *MockmerchVariantRepoIncreaseStockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchVariantRepoIncreaseStockCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockmerchVariantRepoIncreaseStockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockcoinLotRepo) Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, amount, expireTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockcoinLotRepoMockRecorder) Add(ctx, employeeID, amount, expireTime any) *MockcoinLotRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockcoinLotRepo)(nil).Add), ctx, employeeID, amount, expireTime)
+	return &MockcoinLotRepoAddCall{Call: call}
+}
+
+// MockcoinLotRepoAddCall wrap *gomock.Call
+type MockcoinLotRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoAddCall) Return(arg0 error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoAddCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockledgerEntryRepo is a mock of ledgerEntryRepo interface.
+type MockledgerEntryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockledgerEntryRepoMockRecorder
+}
+
+// MockledgerEntryRepoMockRecorder is the mock recorder for MockledgerEntryRepo.
+type MockledgerEntryRepoMockRecorder struct {
+	mock *MockledgerEntryRepo
+}
+
+// NewMockledgerEntryRepo creates a new mock instance.
+func NewMockledgerEntryRepo(ctrl *gomock.Controller) *MockledgerEntryRepo {
+	mock := &MockledgerEntryRepo{ctrl: ctrl}
+	mock.recorder = &MockledgerEntryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockledgerEntryRepo) EXPECT() *MockledgerEntryRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockledgerEntryRepo) Add(ctx context.Context, entry model.LedgerEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockledgerEntryRepoMockRecorder) Add(ctx, entry any) *MockledgerEntryRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockledgerEntryRepo)(nil).Add), ctx, entry)
+	return &MockledgerEntryRepoAddCall{Call: call}
+}
+
+// MockledgerEntryRepoAddCall wrap *gomock.Call
+type MockledgerEntryRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockledgerEntryRepoAddCall) Return(arg0 error) *MockledgerEntryRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockledgerEntryRepoAddCall) Do(f func(context.Context, model.LedgerEntry) error) *MockledgerEntryRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockledgerEntryRepoAddCall) DoAndReturn(f func(context.Context, model.LedgerEntry) error) *MockledgerEntryRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
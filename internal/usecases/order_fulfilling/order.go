@@ -0,0 +1,224 @@
+package order_fulfilling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type UseCase struct {
+	trManager       trManager
+	orderRepo       orderRepo
+	employeeRepo    employeeRepo
+	inventoryRepo   inventoryRepo
+	variantRepo     merchVariantRepo
+	coinLotRepo     coinLotRepo
+	ledgerEntryRepo ledgerEntryRepo
+	now             func() time.Time
+}
+
+func New(
+	trManager trManager,
+	orderRepo orderRepo,
+	employeeRepo employeeRepo,
+	inventoryRepo inventoryRepo,
+	variantRepo merchVariantRepo,
+	coinLotRepo coinLotRepo,
+	ledgerEntryRepo ledgerEntryRepo,
+) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if orderRepo == nil {
+		return nil, errors.New("orderRepo is nil")
+	}
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+	if inventoryRepo == nil {
+		return nil, errors.New("inventoryRepo is nil")
+	}
+	if variantRepo == nil {
+		return nil, errors.New("variantRepo is nil")
+	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
+	if ledgerEntryRepo == nil {
+		return nil, errors.New("ledgerEntryRepo is nil")
+	}
+
+	return &UseCase{
+		trManager:       trManager,
+		orderRepo:       orderRepo,
+		employeeRepo:    employeeRepo,
+		inventoryRepo:   inventoryRepo,
+		variantRepo:     variantRepo,
+		coinLotRepo:     coinLotRepo,
+		ledgerEntryRepo: ledgerEntryRepo,
+		now:             time.Now,
+	}, nil
+}
+
+// List returns orders of the employee, the latest first.
+func (uc *UseCase) List(ctx context.Context, employeeID int64) ([]model.Order, error) {
+	orders, err := uc.orderRepo.GetByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("orderRepo.GetByEmployee: %w", err)
+	}
+
+	return orders, nil
+}
+
+// ListByStatus returns orders of every employee with the status for staff, open orders when status is empty.
+func (uc *UseCase) ListByStatus(ctx context.Context, status model.OrderStatus) ([]model.Order, error) {
+	statuses := model.OpenOrderStatuses
+	if status != "" {
+		if !status.Valid() {
+			return nil, model.ErrInvalidOrderStatus
+		}
+		statuses = []model.OrderStatus{status}
+	}
+
+	orders, err := uc.orderRepo.GetByStatuses(ctx, statuses)
+	if err != nil {
+		return nil, fmt.Errorf("orderRepo.GetByStatuses: %w", err)
+	}
+
+	return orders, nil
+}
+
+// Move moves the order to the next status on behalf of staff. Cancelled orders are refunded.
+func (uc *UseCase) Move(ctx context.Context, orderID int64, status model.OrderStatus) (model.Order, error) {
+	if !status.Valid() {
+		return model.Order{}, model.ErrInvalidOrderStatus
+	}
+
+	var order model.Order
+	err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+		lockedOrder, err := uc.orderRepo.GetByIDWithLock(ctx, orderID)
+		if err != nil {
+			return fmt.Errorf("orderRepo.GetByIDWithLock: %w", err)
+		}
+
+		order, err = uc.move(ctx, *lockedOrder, status)
+		if err != nil {
+			return fmt.Errorf("move: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.Order{}, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return order, nil
+}
+
+// Cancel cancels the employee own order and refunds it, orders can be cancelled only while placed.
+func (uc *UseCase) Cancel(ctx context.Context, employeeID, orderID int64) (model.Order, error) {
+	var order model.Order
+	err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+		lockedOrder, err := uc.orderRepo.GetByIDWithLock(ctx, orderID)
+		if err != nil {
+			return fmt.Errorf("orderRepo.GetByIDWithLock: %w", err)
+		}
+		// orders of other employees are not disclosed
+		if lockedOrder.EmployeeID != employeeID {
+			return model.ErrOrderNotFound
+		}
+
+		order, err = uc.move(ctx, *lockedOrder, model.OrderStatusCancelled)
+		if err != nil {
+			return fmt.Errorf("move: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.Order{}, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return order, nil
+}
+
+// move should be called inside a transaction with the order locked.
+func (uc *UseCase) move(ctx context.Context, order model.Order, status model.OrderStatus) (model.Order, error) {
+	if !order.Status.CanMoveTo(status) {
+		return model.Order{}, model.ErrOrderStatusTransition
+	}
+
+	now := uc.now()
+
+	if status == model.OrderStatusCancelled {
+		err := uc.refund(ctx, order)
+		if err != nil {
+			return model.Order{}, fmt.Errorf("refund: %w", err)
+		}
+	}
+
+	err := uc.orderRepo.UpdateStatus(ctx, order.ID, status, now)
+	if err != nil {
+		return model.Order{}, fmt.Errorf("orderRepo.UpdateStatus: %w", err)
+	}
+
+	order.Status = status
+	order.UpdateTime = now
+
+	return order, nil
+}
+
+// refund returns the coins the order was paid with and takes the merch back to stock.
+// The coins keep their expire time, so buying and cancelling doesn't prolong them.
+func (uc *UseCase) refund(ctx context.Context, order model.Order) error {
+	err := uc.inventoryRepo.Remove(ctx, order.EmployeeID, order.VariantID, order.Quantity)
+	if err != nil {
+		return fmt.Errorf("inventoryRepo.Remove: %w", err)
+	}
+
+	err = uc.variantRepo.IncreaseStock(ctx, order.VariantID, order.Quantity)
+	if err != nil {
+		return fmt.Errorf("variantRepo.IncreaseStock: %w", err)
+	}
+
+	err = uc.employeeRepo.IncreaseBalance(ctx, order.EmployeeID, order.Amount())
+	if err != nil {
+		return fmt.Errorf("employeeRepo.IncreaseBalance: %w", err)
+	}
+
+	lots, err := uc.orderRepo.GetLots(ctx, order.ID)
+	if err != nil {
+		return fmt.Errorf("orderRepo.GetLots: %w", err)
+	}
+
+	rest := order.Amount()
+	for _, lot := range lots {
+		err = uc.coinLotRepo.Add(ctx, order.EmployeeID, lot.Amount, lot.ExpireTime)
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.Add: %w", err)
+		}
+		rest -= lot.Amount
+	}
+
+	// orders placed before their lots were kept are refunded as coins received at the order time
+	if rest > 0 {
+		err = uc.coinLotRepo.Add(ctx, order.EmployeeID, rest, model.CoinLotExpireTime(order.CreateTime))
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.Add: %w", err)
+		}
+	}
+
+	err = uc.ledgerEntryRepo.Add(ctx, model.LedgerEntry{
+		EmployeeID: order.EmployeeID,
+		Amount:     order.Amount(),
+		Kind:       model.LedgerEntryKindRefund,
+	})
+	if err != nil {
+		return fmt.Errorf("ledgerEntryRepo.Add: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,387 @@
+package order_fulfilling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+var (
+	now          = time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	createTime   = time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+	expireTime   = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	paidWithLots = []model.CoinLotPart{
+		{LotID: 3, Amount: 100, ExpireTime: expireTime},
+		{LotID: 4, Amount: 60, ExpireTime: expireTime.AddDate(0, 6, 0)},
+	}
+)
+
+func order(status model.OrderStatus) *model.Order {
+	return &model.Order{
+		ID:         7,
+		EmployeeID: 100,
+		MerchID:    1,
+		MerchName:  "t-shirt",
+		VariantID:  20,
+		Quantity:   2,
+		Price:      80,
+		Status:     status,
+		CreateTime: createTime,
+	}
+}
+
+func TestUseCase_ListByStatus(t *testing.T) {
+	type mocks struct {
+		trManager       *MocktrManager
+		orderRepo       *MockorderRepo
+		employeeRepo    *MockemployeeRepo
+		inventoryRepo   *MockinventoryRepo
+		variantRepo     *MockmerchVariantRepo
+		coinLotRepo     *MockcoinLotRepo
+		ledgerEntryRepo *MockledgerEntryRepo
+	}
+
+	testCases := []struct {
+		name    string
+		status  model.OrderStatus
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name:   "success.open",
+			status: "",
+			prepare: func(m *mocks) {
+				m.orderRepo.EXPECT().
+					GetByStatuses(gomock.Any(), []model.OrderStatus{model.OrderStatusPlaced, model.OrderStatusReadyForPickup}).
+					Return([]model.Order{*order(model.OrderStatusPlaced)}, nil)
+			},
+		},
+		{
+			name:   "success.delivered",
+			status: model.OrderStatusDelivered,
+			prepare: func(m *mocks) {
+				m.orderRepo.EXPECT().
+					GetByStatuses(gomock.Any(), []model.OrderStatus{model.OrderStatusDelivered}).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:    "error.invalid_status",
+			status:  "lost",
+			prepare: func(_ *mocks) {},
+			wantErr: model.ErrInvalidOrderStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:       NewMocktrManager(ctrl),
+				orderRepo:       NewMockorderRepo(ctrl),
+				employeeRepo:    NewMockemployeeRepo(ctrl),
+				inventoryRepo:   NewMockinventoryRepo(ctrl),
+				variantRepo:     NewMockmerchVariantRepo(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
+				ledgerEntryRepo: NewMockledgerEntryRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.orderRepo, m.employeeRepo, m.inventoryRepo, m.variantRepo, m.coinLotRepo,
+				m.ledgerEntryRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			_, err = uc.ListByStatus(context.Background(), tc.status)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_Move(t *testing.T) {
+	type mocks struct {
+		trManager       *MocktrManager
+		orderRepo       *MockorderRepo
+		employeeRepo    *MockemployeeRepo
+		inventoryRepo   *MockinventoryRepo
+		variantRepo     *MockmerchVariantRepo
+		coinLotRepo     *MockcoinLotRepo
+		ledgerEntryRepo *MockledgerEntryRepo
+	}
+
+	testCases := []struct {
+		name    string
+		status  model.OrderStatus
+		prepare func(m *mocks)
+		wantRes model.Order
+		wantErr error
+	}{
+		{
+			name:   "success.ready_for_pickup",
+			status: model.OrderStatusReadyForPickup,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusPlaced), nil)
+				m.orderRepo.EXPECT().UpdateStatus(gomock.Any(), int64(7), model.OrderStatusReadyForPickup, now).Return(nil)
+			},
+			wantRes: func() model.Order {
+				o := order(model.OrderStatusReadyForPickup)
+				o.UpdateTime = now
+				return *o
+			}(),
+		},
+		{
+			name:   "success.cancelled_with_refund",
+			status: model.OrderStatusCancelled,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusPlaced), nil)
+				m.inventoryRepo.EXPECT().Remove(gomock.Any(), int64(100), int64(20), int64(2)).Return(nil)
+				m.variantRepo.EXPECT().IncreaseStock(gomock.Any(), int64(20), int64(2)).Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(160)).Return(nil)
+				m.orderRepo.EXPECT().GetLots(gomock.Any(), int64(7)).Return(paidWithLots, nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(100), expireTime).Return(nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(60), expireTime.AddDate(0, 6, 0)).Return(nil)
+				m.ledgerEntryRepo.EXPECT().Add(gomock.Any(), model.LedgerEntry{
+					EmployeeID: 100,
+					Amount:     160,
+					Kind:       model.LedgerEntryKindRefund,
+				}).Return(nil)
+				m.orderRepo.EXPECT().UpdateStatus(gomock.Any(), int64(7), model.OrderStatusCancelled, now).Return(nil)
+			},
+			wantRes: func() model.Order {
+				o := order(model.OrderStatusCancelled)
+				o.UpdateTime = now
+				return *o
+			}(),
+		},
+		{
+			name:   "success.cancelled_without_lots",
+			status: model.OrderStatusCancelled,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusPlaced), nil)
+				m.inventoryRepo.EXPECT().Remove(gomock.Any(), int64(100), int64(20), int64(2)).Return(nil)
+				m.variantRepo.EXPECT().IncreaseStock(gomock.Any(), int64(20), int64(2)).Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(160)).Return(nil)
+				// placed before lots of orders were kept, the coins expire as if received at the order time
+				m.orderRepo.EXPECT().GetLots(gomock.Any(), int64(7)).Return(paidWithLots[:1], nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(100), expireTime).Return(nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(60), model.CoinLotExpireTime(createTime)).Return(nil)
+				m.ledgerEntryRepo.EXPECT().Add(gomock.Any(), model.LedgerEntry{
+					EmployeeID: 100,
+					Amount:     160,
+					Kind:       model.LedgerEntryKindRefund,
+				}).Return(nil)
+				m.orderRepo.EXPECT().UpdateStatus(gomock.Any(), int64(7), model.OrderStatusCancelled, now).Return(nil)
+			},
+			wantRes: func() model.Order {
+				o := order(model.OrderStatusCancelled)
+				o.UpdateTime = now
+				return *o
+			}(),
+		},
+		{
+			name:   "error.delivered_is_final",
+			status: model.OrderStatusCancelled,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusDelivered), nil)
+			},
+			wantErr: model.ErrOrderStatusTransition,
+		},
+		{
+			name:   "error.skip_ready_for_pickup",
+			status: model.OrderStatusDelivered,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusPlaced), nil)
+			},
+			wantErr: model.ErrOrderStatusTransition,
+		},
+		{
+			name:    "error.invalid_status",
+			status:  "lost",
+			prepare: func(_ *mocks) {},
+			wantErr: model.ErrInvalidOrderStatus,
+		},
+		{
+			name:   "error.not_found",
+			status: model.OrderStatusDelivered,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(nil, model.ErrOrderNotFound)
+			},
+			wantErr: model.ErrOrderNotFound,
+		},
+		{
+			name:   "error.refund",
+			status: model.OrderStatusCancelled,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusPlaced), nil)
+				m.inventoryRepo.EXPECT().Remove(gomock.Any(), int64(100), int64(20), int64(2)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:       NewMocktrManager(ctrl),
+				orderRepo:       NewMockorderRepo(ctrl),
+				employeeRepo:    NewMockemployeeRepo(ctrl),
+				inventoryRepo:   NewMockinventoryRepo(ctrl),
+				variantRepo:     NewMockmerchVariantRepo(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
+				ledgerEntryRepo: NewMockledgerEntryRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.orderRepo, m.employeeRepo, m.inventoryRepo, m.variantRepo, m.coinLotRepo,
+				m.ledgerEntryRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.Move(context.Background(), 7, tc.status)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_Cancel(t *testing.T) {
+	type mocks struct {
+		trManager       *MocktrManager
+		orderRepo       *MockorderRepo
+		employeeRepo    *MockemployeeRepo
+		inventoryRepo   *MockinventoryRepo
+		variantRepo     *MockmerchVariantRepo
+		coinLotRepo     *MockcoinLotRepo
+		ledgerEntryRepo *MockledgerEntryRepo
+	}
+
+	testCases := []struct {
+		name       string
+		employeeID int64
+		prepare    func(m *mocks)
+		wantErr    error
+	}{
+		{
+			name:       "success",
+			employeeID: 100,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusPlaced), nil)
+				m.inventoryRepo.EXPECT().Remove(gomock.Any(), int64(100), int64(20), int64(2)).Return(nil)
+				m.variantRepo.EXPECT().IncreaseStock(gomock.Any(), int64(20), int64(2)).Return(nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(100), int64(160)).Return(nil)
+				m.orderRepo.EXPECT().GetLots(gomock.Any(), int64(7)).Return(paidWithLots, nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(100), expireTime).Return(nil)
+				m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(100), int64(60), expireTime.AddDate(0, 6, 0)).Return(nil)
+				m.ledgerEntryRepo.EXPECT().Add(gomock.Any(), model.LedgerEntry{
+					EmployeeID: 100,
+					Amount:     160,
+					Kind:       model.LedgerEntryKindRefund,
+				}).Return(nil)
+				m.orderRepo.EXPECT().UpdateStatus(gomock.Any(), int64(7), model.OrderStatusCancelled, now).Return(nil)
+			},
+		},
+		{
+			name:       "error.ready_for_pickup",
+			employeeID: 100,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusReadyForPickup), nil)
+			},
+			wantErr: model.ErrOrderStatusTransition,
+		},
+		{
+			name:       "error.order_of_another_employee",
+			employeeID: 200,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.orderRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(7)).Return(order(model.OrderStatusPlaced), nil)
+			},
+			wantErr: model.ErrOrderNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:       NewMocktrManager(ctrl),
+				orderRepo:       NewMockorderRepo(ctrl),
+				employeeRepo:    NewMockemployeeRepo(ctrl),
+				inventoryRepo:   NewMockinventoryRepo(ctrl),
+				variantRepo:     NewMockmerchVariantRepo(ctrl),
+				coinLotRepo:     NewMockcoinLotRepo(ctrl),
+				ledgerEntryRepo: NewMockledgerEntryRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.orderRepo, m.employeeRepo, m.inventoryRepo, m.variantRepo, m.coinLotRepo,
+				m.ledgerEntryRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			_, err = uc.Cancel(context.Background(), tc.employeeID, 7)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
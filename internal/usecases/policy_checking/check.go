@@ -0,0 +1,59 @@
+package policy_checking
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type UseCase struct {
+	policies []Policy
+}
+
+// New creates a checker with the given policies, they are evaluated in order and the first rejection is returned.
+func New(policies ...Policy) (*UseCase, error) {
+	for _, policy := range policies {
+		if policy == nil {
+			return nil, errors.New("policy is nil")
+		}
+	}
+
+	return &UseCase{
+		policies: policies,
+	}, nil
+}
+
+// NewFromConfig creates a checker with the policies enabled in cfg.
+func NewFromConfig(cfg model.TransferPolicy, transactionRepo transactionRepo) (*UseCase, error) {
+	if transactionRepo == nil {
+		return nil, errors.New("transactionRepo is nil")
+	}
+
+	policies := []Policy{
+		AmountPolicy(cfg.MinAmount, cfg.MaxAmount),
+	}
+	if len(cfg.BlockedPairs) > 0 {
+		policies = append(policies, BlockedPairsPolicy(cfg.BlockedPairs))
+	}
+	if cfg.Cooldown > 0 {
+		policies = append(policies, CooldownPolicy(transactionRepo, time.Now, cfg.Cooldown))
+	}
+	if cfg.DailyLimit > 0 || cfg.MonthlyLimit > 0 {
+		policies = append(policies, OutgoingLimitPolicy(transactionRepo, time.Now, cfg.DailyLimit, cfg.MonthlyLimit))
+	}
+
+	return New(policies...)
+}
+
+// Check evaluates policies for transfers of one sending operation. It should be called inside the transfer
+// transaction after the sender is locked, so concurrent transfers of the same sender can't bypass limits.
+func (uc *UseCase) Check(ctx context.Context, transfers []model.PolicyTransfer) error {
+	for _, policy := range uc.policies {
+		if err := policy.Check(ctx, transfers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package policy_checking
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_Check(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	startOfDay := time.Date(2025, 2, 14, 0, 0, 0, 0, time.UTC)
+	startOfMonth := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	cfg := model.TransferPolicy{
+		MinAmount:    5,
+		MaxAmount:    500,
+		DailyLimit:   600,
+		MonthlyLimit: 2000,
+		Cooldown:     time.Hour,
+		BlockedPairs: []model.BlockedTransferPair{{SenderUsername: "lead", ReceiverUsername: "intern"}},
+	}
+
+	transfer := func(receiverID int64, receiverUsername string, amount int64) model.PolicyTransfer {
+		return model.PolicyTransfer{
+			SenderID:         100,
+			SenderUsername:   "lead",
+			ReceiverID:       receiverID,
+			ReceiverUsername: receiverUsername,
+			Amount:           amount,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		prepare   func(m *MocktransactionRepo)
+		transfers []model.PolicyTransfer
+		wantErr   error
+		// wantIndex is the index of the rejected transfer
+		wantIndex int
+	}{
+		{
+			name: "success",
+			prepare: func(m *MocktransactionRepo) {
+				lastSentTime := now.Add(-2 * time.Hour)
+				m.EXPECT().GetLastSentTime(gomock.Any(), int64(100), int64(200)).Return(&lastSentTime, nil)
+				m.EXPECT().GetLastSentTime(gomock.Any(), int64(100), int64(300)).Return(nil, nil)
+				m.EXPECT().GetSentAmountSince(gomock.Any(), int64(100), startOfDay).Return(int64(50), nil)
+				m.EXPECT().GetSentAmountSince(gomock.Any(), int64(100), startOfMonth).Return(int64(1000), nil)
+			},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 300), transfer(300, "dev", 200), transfer(200, "hr", 5)},
+			wantErr:   nil,
+		},
+		{
+			name:      "error.AmountBelowMinimum",
+			prepare:   func(_ *MocktransactionRepo) {},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 300), transfer(300, "dev", 4)},
+			wantErr:   model.ErrAmountBelowMinimum,
+			wantIndex: 1,
+		},
+		{
+			name:      "error.AmountAboveMaximum",
+			prepare:   func(_ *MocktransactionRepo) {},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 501)},
+			wantErr:   model.ErrAmountAboveMaximum,
+		},
+		{
+			name:      "error.TransferBlocked",
+			prepare:   func(_ *MocktransactionRepo) {},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 10), transfer(300, "intern", 10)},
+			wantErr:   model.ErrTransferBlocked,
+			wantIndex: 1,
+		},
+		{
+			name: "error.TransferCooldown",
+			prepare: func(m *MocktransactionRepo) {
+				lastSentTime := now.Add(-59 * time.Minute)
+				m.EXPECT().GetLastSentTime(gomock.Any(), int64(100), int64(200)).Return(&lastSentTime, nil)
+			},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 10)},
+			wantErr:   model.ErrTransferCooldown,
+		},
+		{
+			name: "error.DailyLimitExceeded",
+			prepare: func(m *MocktransactionRepo) {
+				m.EXPECT().GetLastSentTime(gomock.Any(), int64(100), int64(200)).Return(nil, nil)
+				m.EXPECT().GetSentAmountSince(gomock.Any(), int64(100), startOfDay).Return(int64(200), nil)
+			},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 401)},
+			wantErr:   model.ErrDailyLimitExceeded,
+		},
+		{
+			name: "error.DailyLimitExceeded.batch_total",
+			prepare: func(m *MocktransactionRepo) {
+				m.EXPECT().GetLastSentTime(gomock.Any(), int64(100), gomock.Any()).Return(nil, nil).Times(2)
+			},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 400), transfer(300, "dev", 400)},
+			wantErr:   model.ErrDailyLimitExceeded,
+			wantIndex: 1,
+		},
+		{
+			name: "error.MonthlyLimitExceeded",
+			prepare: func(m *MocktransactionRepo) {
+				m.EXPECT().GetLastSentTime(gomock.Any(), int64(100), int64(200)).Return(nil, nil)
+				m.EXPECT().GetSentAmountSince(gomock.Any(), int64(100), startOfDay).Return(int64(0), nil)
+				m.EXPECT().GetSentAmountSince(gomock.Any(), int64(100), startOfMonth).Return(int64(1900), nil)
+			},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 101)},
+			wantErr:   model.ErrMonthlyLimitExceeded,
+		},
+		{
+			name: "error.transactionRepo.GetSentAmountSince",
+			prepare: func(m *MocktransactionRepo) {
+				m.EXPECT().GetLastSentTime(gomock.Any(), int64(100), int64(200)).Return(nil, nil)
+				m.EXPECT().GetSentAmountSince(gomock.Any(), int64(100), startOfDay).Return(int64(0), assert.AnError)
+			},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 10)},
+			wantErr:   assert.AnError,
+		},
+		{
+			name: "error.transactionRepo.GetLastSentTime",
+			prepare: func(m *MocktransactionRepo) {
+				m.EXPECT().GetLastSentTime(gomock.Any(), int64(100), int64(200)).Return(nil, assert.AnError)
+			},
+			transfers: []model.PolicyTransfer{transfer(200, "hr", 10)},
+			wantErr:   assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			transactionRepo := NewMocktransactionRepo(ctrl)
+
+			tc.prepare(transactionRepo)
+
+			uc, err := New(
+				AmountPolicy(cfg.MinAmount, cfg.MaxAmount),
+				BlockedPairsPolicy(cfg.BlockedPairs),
+				CooldownPolicy(transactionRepo, func() time.Time { return now }, cfg.Cooldown),
+				OutgoingLimitPolicy(transactionRepo, func() time.Time { return now }, cfg.DailyLimit, cfg.MonthlyLimit),
+			)
+			require.NoError(t, err)
+
+			err = uc.Check(context.Background(), tc.transfers)
+			require.ErrorIs(t, err, tc.wantErr)
+
+			var itemErr *model.TransferItemError
+			if errors.As(err, &itemErr) {
+				require.Equal(t, tc.wantIndex, itemErr.Index)
+			}
+		})
+	}
+}
+
+func TestNewFromConfig_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	uc, err := NewFromConfig(model.TransferPolicy{}, NewMocktransactionRepo(ctrl))
+	require.NoError(t, err)
+
+	err = uc.Check(context.Background(), []model.PolicyTransfer{{SenderID: 100, ReceiverID: 200, Amount: 1_000_000}})
+	require.NoError(t, err)
+}
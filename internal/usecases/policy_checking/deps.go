@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package policy_checking
+
+import (
+	"context"
+	"time"
+)
+
+type transactionRepo interface {
+	GetSentAmountSince(ctx context.Context, senderID int64, since time.Time) (int64, error)
+	GetLastSentTime(ctx context.Context, senderID, receiverID int64) (*time.Time, error)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package policy_checking -typed -destination mock_deps_test.go
+//
+
+// Package policy_checking is a generated GoMock package.
+package policy_checking
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktransactionRepo is a mock of transactionRepo interface.
+type MocktransactionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactionRepoMockRecorder
+}
+
+// MocktransactionRepoMockRecorder is the mock recorder for MocktransactionRepo.
+type MocktransactionRepoMockRecorder struct {
+	mock *MocktransactionRepo
+}
+
+// NewMocktransactionRepo creates a new mock instance.
+func NewMocktransactionRepo(ctrl *gomock.Controller) *MocktransactionRepo {
+	mock := &MocktransactionRepo{ctrl: ctrl}
+	mock.recorder = &MocktransactionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransactionRepo) EXPECT() *MocktransactionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetLastSentTime mocks base method.
+func (m *MocktransactionRepo) GetLastSentTime(ctx context.Context, senderID, receiverID int64) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastSentTime", ctx, senderID, receiverID)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastSentTime indicates an expected call of GetLastSentTime.
+func (mr *MocktransactionRepoMockRecorder) GetLastSentTime(ctx, senderID, receiverID any) *MocktransactionRepoGetLastSentTimeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSentTime", reflect.TypeOf((*MocktransactionRepo)(nil).GetLastSentTime), ctx, senderID, receiverID)
+	return &MocktransactionRepoGetLastSentTimeCall{Call: call}
+}
+
+// MocktransactionRepoGetLastSentTimeCall wrap *gomock.Call
+type MocktransactionRepoGetLastSentTimeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransactionRepoGetLastSentTimeCall) Return(arg0 *time.Time, arg1 error) *MocktransactionRepoGetLastSentTimeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransactionRepoGetLastSentTimeCall) Do(f func(context.Context, int64, int64) (*time.Time, error)) *MocktransactionRepoGetLastSentTimeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransactionRepoGetLastSentTimeCall) DoAndReturn(f func(context.Context, int64, int64) (*time.Time, error)) *MocktransactionRepoGetLastSentTimeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSentAmountSince mocks base method.
+func (m *MocktransactionRepo) GetSentAmountSince(ctx context.Context, senderID int64, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSentAmountSince", ctx, senderID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSentAmountSince indicates an expected call of GetSentAmountSince.
+func (mr *MocktransactionRepoMockRecorder) GetSentAmountSince(ctx, senderID, since any) *MocktransactionRepoGetSentAmountSinceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentAmountSince", reflect.TypeOf((*MocktransactionRepo)(nil).GetSentAmountSince), ctx, senderID, since)
+	return &MocktransactionRepoGetSentAmountSinceCall{Call: call}
+}
+
+// MocktransactionRepoGetSentAmountSinceCall wrap *gomock.Call
+type MocktransactionRepoGetSentAmountSinceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransactionRepoGetSentAmountSinceCall) Return(arg0 int64, arg1 error) *MocktransactionRepoGetSentAmountSinceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransactionRepoGetSentAmountSinceCall) Do(f func(context.Context, int64, time.Time) (int64, error)) *MocktransactionRepoGetSentAmountSinceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransactionRepoGetSentAmountSinceCall) DoAndReturn(f func(context.Context, int64, time.Time) (int64, error)) *MocktransactionRepoGetSentAmountSinceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,133 @@
+package policy_checking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// Policy is a single transfer restriction. transfers are all transfers of one sending operation.
+type Policy interface {
+	Check(ctx context.Context, transfers []model.PolicyTransfer) error
+}
+
+// PolicyFunc adapts an ordinary function to Policy.
+type PolicyFunc func(ctx context.Context, transfers []model.PolicyTransfer) error
+
+func (f PolicyFunc) Check(ctx context.Context, transfers []model.PolicyTransfer) error {
+	return f(ctx, transfers)
+}
+
+// AmountPolicy checks bounds of every single transfer.
+func AmountPolicy(minAmount, maxAmount int64) Policy {
+	return PolicyFunc(func(_ context.Context, transfers []model.PolicyTransfer) error {
+		for i, t := range transfers {
+			if minAmount > 0 && t.Amount < minAmount {
+				return &model.TransferItemError{Index: i, Err: model.ErrAmountBelowMinimum}
+			}
+			if maxAmount > 0 && t.Amount > maxAmount {
+				return &model.TransferItemError{Index: i, Err: model.ErrAmountAboveMaximum}
+			}
+		}
+		return nil
+	})
+}
+
+// BlockedPairsPolicy rejects transfers between configured pairs of employees.
+func BlockedPairsPolicy(pairs []model.BlockedTransferPair) Policy {
+	blocked := make(map[model.BlockedTransferPair]struct{}, len(pairs))
+	for _, pair := range pairs {
+		blocked[pair] = struct{}{}
+	}
+
+	return PolicyFunc(func(_ context.Context, transfers []model.PolicyTransfer) error {
+		for i, t := range transfers {
+			pair := model.BlockedTransferPair{SenderUsername: t.SenderUsername, ReceiverUsername: t.ReceiverUsername}
+			if _, ok := blocked[pair]; ok {
+				return &model.TransferItemError{Index: i, Err: model.ErrTransferBlocked}
+			}
+		}
+		return nil
+	})
+}
+
+// OutgoingLimitPolicy limits the total amount sent since the start of the current UTC day and month,
+// coins held by pending transfer requests count as sent. The first transfer going over a limit is rejected.
+func OutgoingLimitPolicy(transactionRepo transactionRepo, now func() time.Time, dailyLimit, monthlyLimit int64) Policy {
+	return PolicyFunc(func(ctx context.Context, transfers []model.PolicyTransfer) error {
+		if len(transfers) == 0 {
+			return nil
+		}
+
+		var total int64
+		for _, t := range transfers {
+			total += t.Amount
+		}
+		senderID := transfers[0].SenderID
+
+		n := now().UTC()
+		limits := []struct {
+			limit int64
+			since time.Time
+			err   error
+		}{
+			{limit: dailyLimit, since: time.Date(n.Year(), n.Month(), n.Day(), 0, 0, 0, 0, time.UTC), err: model.ErrDailyLimitExceeded},
+			{limit: monthlyLimit, since: time.Date(n.Year(), n.Month(), 1, 0, 0, 0, 0, time.UTC), err: model.ErrMonthlyLimitExceeded},
+		}
+		for _, l := range limits {
+			if l.limit <= 0 {
+				continue
+			}
+			if total > l.limit {
+				return exceedingTransfer(transfers, l.limit, l.err)
+			}
+
+			sent, err := transactionRepo.GetSentAmountSince(ctx, senderID, l.since)
+			if err != nil {
+				return fmt.Errorf("transactionRepo.GetSentAmountSince: %w", err)
+			}
+			if sent+total > l.limit {
+				return exceedingTransfer(transfers, l.limit-sent, l.err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// CooldownPolicy requires a pause between two transfers from the same sender to the same receiver,
+// a pending transfer request counts as a transfer made at its create time.
+func CooldownPolicy(transactionRepo transactionRepo, now func() time.Time, cooldown time.Duration) Policy {
+	return PolicyFunc(func(ctx context.Context, transfers []model.PolicyTransfer) error {
+		checked := make(map[int64]struct{}, len(transfers))
+		for i, t := range transfers {
+			if _, ok := checked[t.ReceiverID]; ok {
+				continue
+			}
+			checked[t.ReceiverID] = struct{}{}
+
+			lastSentTime, err := transactionRepo.GetLastSentTime(ctx, t.SenderID, t.ReceiverID)
+			if err != nil {
+				return fmt.Errorf("transactionRepo.GetLastSentTime: %w", err)
+			}
+			if lastSentTime != nil && now().Sub(*lastSentTime) < cooldown {
+				return &model.TransferItemError{Index: i, Err: model.ErrTransferCooldown}
+			}
+		}
+		return nil
+	})
+}
+
+// exceedingTransfer rejects the first transfer with which the running total goes over allowed.
+func exceedingTransfer(transfers []model.PolicyTransfer, allowed int64, err error) error {
+	var total int64
+	for i, t := range transfers {
+		total += t.Amount
+		if total > allowed {
+			return &model.TransferItemError{Index: i, Err: err}
+		}
+	}
+	return err
+}
@@ -0,0 +1,32 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package scheduled_transfer_executing
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type scheduledTransferRepo interface {
+	GetDueWithLock(ctx context.Context, now time.Time) (*model.ScheduledTransfer, error)
+	SetNextRunTime(ctx context.Context, id int64, nextRunTime *time.Time, isActive bool) error
+}
+
+type scheduledTransferRunRepo interface {
+	Add(ctx context.Context, run model.ScheduledTransferRun) error
+}
+
+type coinSending interface {
+	Send(
+		ctx context.Context,
+		employeeID int64,
+		targetUsername string,
+		amount int64,
+		requireAcceptance bool,
+	) (*model.TransferRequest, error)
+}
@@ -0,0 +1,143 @@
+package scheduled_transfer_executing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/cron"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// maxRunsPerCall limits one ExecuteDue call, the rest is picked up on the next tick
+const maxRunsPerCall = 1000
+
+type UseCase struct {
+	trManager                trManager
+	nestedTrManager          trManager
+	scheduledTransferRepo    scheduledTransferRepo
+	scheduledTransferRunRepo scheduledTransferRunRepo
+	coinSending              coinSending
+}
+
+// New expects nestedTrManager to open a savepoint inside the already started transaction,
+// so a failed transfer is rolled back without losing the claimed schedule and the recorded run.
+func New(
+	trManager trManager,
+	nestedTrManager trManager,
+	scheduledTransferRepo scheduledTransferRepo,
+	scheduledTransferRunRepo scheduledTransferRunRepo,
+	coinSending coinSending,
+) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if nestedTrManager == nil {
+		return nil, errors.New("nestedTrManager is nil")
+	}
+	if scheduledTransferRepo == nil {
+		return nil, errors.New("scheduledTransferRepo is nil")
+	}
+	if scheduledTransferRunRepo == nil {
+		return nil, errors.New("scheduledTransferRunRepo is nil")
+	}
+	if coinSending == nil {
+		return nil, errors.New("coinSending is nil")
+	}
+
+	return &UseCase{
+		trManager:                trManager,
+		nestedTrManager:          nestedTrManager,
+		scheduledTransferRepo:    scheduledTransferRepo,
+		scheduledTransferRunRepo: scheduledTransferRunRepo,
+		coinSending:              coinSending,
+	}, nil
+}
+
+// ExecuteDue runs all scheduled transfers that are due at now and returns the number of executed runs.
+// Each run is a separate transaction: the schedule row is locked (skipping rows locked by other replicas),
+// the transfer is sent, the outcome is recorded and the next run time is saved.
+func (uc *UseCase) ExecuteDue(ctx context.Context, now time.Time) (int, error) {
+	executed := 0
+	for executed < maxRunsPerCall {
+		found, err := uc.executeOne(ctx, now)
+		if err != nil {
+			return executed, fmt.Errorf("executeOne: %w", err)
+		}
+		if !found {
+			break
+		}
+		executed++
+	}
+
+	return executed, nil
+}
+
+func (uc *UseCase) executeOne(ctx context.Context, now time.Time) (found bool, err error) {
+	err = uc.trManager.Do(ctx, func(ctx context.Context) error {
+		st, err := uc.scheduledTransferRepo.GetDueWithLock(ctx, now)
+		if err != nil {
+			if errors.Is(err, model.ErrScheduledTransferNotFound) {
+				return nil
+			}
+			return fmt.Errorf("scheduledTransferRepo.GetDueWithLock: %w", err)
+		}
+		found = true
+
+		run := model.ScheduledTransferRun{
+			ScheduledTransferID: st.ID,
+			RunTime:             now,
+			Status:              model.ScheduledTransferRunStatusSuccess,
+		}
+
+		// a transfer above the approval threshold only creates a pending request, the run is still successful
+		sendErr := uc.nestedTrManager.Do(ctx, func(ctx context.Context) error {
+			_, err := uc.coinSending.Send(ctx, st.OwnerID, st.ReceiverUsername, st.Amount, false)
+			return err
+		})
+		if sendErr != nil {
+			run.Status = model.ScheduledTransferRunStatusFailed
+			run.Error = sendErr.Error()
+		}
+
+		err = uc.scheduledTransferRunRepo.Add(ctx, run)
+		if err != nil {
+			return fmt.Errorf("scheduledTransferRunRepo.Add: %w", err)
+		}
+
+		nextRunTime, isActive := nextRun(st, now)
+
+		err = uc.scheduledTransferRepo.SetNextRunTime(ctx, st.ID, nextRunTime, isActive)
+		if err != nil {
+			return fmt.Errorf("scheduledTransferRepo.SetNextRunTime: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return found, nil
+}
+
+// nextRun deactivates one-off transfers and moves recurring ones to the next matching time.
+// Runs missed while the service was down are not caught up, the schedule continues from now.
+func nextRun(st *model.ScheduledTransfer, now time.Time) (*time.Time, bool) {
+	if st.CronExpression == "" {
+		return nil, false
+	}
+
+	schedule, err := cron.Parse(st.CronExpression)
+	if err != nil {
+		return nil, false
+	}
+
+	next, err := schedule.Next(now.UTC())
+	if err != nil {
+		return nil, false
+	}
+
+	return &next, true
+}
@@ -0,0 +1,224 @@
+package scheduled_transfer_executing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_ExecuteDue(t *testing.T) {
+	type mocks struct {
+		trManager                *MocktrManager
+		nestedTrManager          *MocktrManager
+		scheduledTransferRepo    *MockscheduledTransferRepo
+		scheduledTransferRunRepo *MockscheduledTransferRunRepo
+		coinSending              *MockcoinSending
+	}
+
+	// 2025-02-14 is Friday
+	now := time.Date(2025, 2, 14, 12, 0, 30, 0, time.UTC)
+	nextFriday := time.Date(2025, 2, 21, 12, 0, 0, 0, time.UTC)
+
+	doTr := func(ctx context.Context, do func(context.Context) error) error {
+		return do(ctx)
+	}
+
+	testCases := []struct {
+		name         string
+		prepare      func(m *mocks)
+		wantExecuted int
+		wantErr      error
+	}{
+		{
+			name: "success.nothing_due",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				m.scheduledTransferRepo.EXPECT().
+					GetDueWithLock(gomock.Any(), now).
+					Return(nil, model.ErrScheduledTransferNotFound)
+			},
+			wantExecuted: 0,
+			wantErr:      nil,
+		},
+		{
+			name: "success.recurring_and_one_off",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr).Times(3)
+				m.nestedTrManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr).Times(2)
+				gomock.InOrder(
+					m.scheduledTransferRepo.EXPECT().
+						GetDueWithLock(gomock.Any(), now).
+						Return(&model.ScheduledTransfer{
+							ID:               1,
+							OwnerID:          100,
+							ReceiverUsername: "intern",
+							Amount:           50,
+							CronExpression:   "0 12 * * 5",
+							IsActive:         true,
+						}, nil),
+					m.scheduledTransferRepo.EXPECT().
+						GetDueWithLock(gomock.Any(), now).
+						Return(&model.ScheduledTransfer{
+							ID:               2,
+							OwnerID:          100,
+							ReceiverUsername: "lead",
+							Amount:           10,
+							IsActive:         true,
+						}, nil),
+					m.scheduledTransferRepo.EXPECT().
+						GetDueWithLock(gomock.Any(), now).
+						Return(nil, model.ErrScheduledTransferNotFound),
+				)
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(100), "intern", int64(50), false).Return(nil, nil)
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(100), "lead", int64(10), false).Return(nil, nil)
+				m.scheduledTransferRunRepo.EXPECT().
+					Add(gomock.Any(), model.ScheduledTransferRun{
+						ScheduledTransferID: 1,
+						RunTime:             now,
+						Status:              model.ScheduledTransferRunStatusSuccess,
+					}).
+					Return(nil)
+				m.scheduledTransferRunRepo.EXPECT().
+					Add(gomock.Any(), model.ScheduledTransferRun{
+						ScheduledTransferID: 2,
+						RunTime:             now,
+						Status:              model.ScheduledTransferRunStatusSuccess,
+					}).
+					Return(nil)
+				m.scheduledTransferRepo.EXPECT().
+					SetNextRunTime(gomock.Any(), int64(1), &nextFriday, true).
+					Return(nil)
+				m.scheduledTransferRepo.EXPECT().
+					SetNextRunTime(gomock.Any(), int64(2), nil, false).
+					Return(nil)
+			},
+			wantExecuted: 2,
+			wantErr:      nil,
+		},
+		{
+			name: "success.not_enough_balance_recorded",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr).Times(2)
+				m.nestedTrManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				gomock.InOrder(
+					m.scheduledTransferRepo.EXPECT().
+						GetDueWithLock(gomock.Any(), now).
+						Return(&model.ScheduledTransfer{
+							ID:               1,
+							OwnerID:          100,
+							ReceiverUsername: "intern",
+							Amount:           50,
+							CronExpression:   "0 12 * * 5",
+							IsActive:         true,
+						}, nil),
+					m.scheduledTransferRepo.EXPECT().
+						GetDueWithLock(gomock.Any(), now).
+						Return(nil, model.ErrScheduledTransferNotFound),
+				)
+				m.coinSending.EXPECT().
+					Send(gomock.Any(), int64(100), "intern", int64(50), false).
+					Return(nil, model.ErrNotEnoughBalance)
+				m.scheduledTransferRunRepo.EXPECT().
+					Add(gomock.Any(), model.ScheduledTransferRun{
+						ScheduledTransferID: 1,
+						RunTime:             now,
+						Status:              model.ScheduledTransferRunStatusFailed,
+						Error:               model.ErrNotEnoughBalance.Error(),
+					}).
+					Return(nil)
+				m.scheduledTransferRepo.EXPECT().
+					SetNextRunTime(gomock.Any(), int64(1), &nextFriday, true).
+					Return(nil)
+			},
+			wantExecuted: 1,
+			wantErr:      nil,
+		},
+		{
+			name: "error.scheduledTransferRepo.GetDueWithLock",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				m.scheduledTransferRepo.EXPECT().
+					GetDueWithLock(gomock.Any(), now).
+					Return(nil, assert.AnError)
+			},
+			wantExecuted: 0,
+			wantErr:      assert.AnError,
+		},
+		{
+			name: "error.scheduledTransferRunRepo.Add",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				m.nestedTrManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				m.scheduledTransferRepo.EXPECT().
+					GetDueWithLock(gomock.Any(), now).
+					Return(&model.ScheduledTransfer{
+						ID:               2,
+						OwnerID:          100,
+						ReceiverUsername: "lead",
+						Amount:           10,
+						IsActive:         true,
+					}, nil)
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(100), "lead", int64(10), false).Return(nil, nil)
+				m.scheduledTransferRunRepo.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantExecuted: 0,
+			wantErr:      assert.AnError,
+		},
+		{
+			name: "error.scheduledTransferRepo.SetNextRunTime",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				m.nestedTrManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(doTr)
+				m.scheduledTransferRepo.EXPECT().
+					GetDueWithLock(gomock.Any(), now).
+					Return(&model.ScheduledTransfer{
+						ID:               2,
+						OwnerID:          100,
+						ReceiverUsername: "lead",
+						Amount:           10,
+						IsActive:         true,
+					}, nil)
+				m.coinSending.EXPECT().Send(gomock.Any(), int64(100), "lead", int64(10), false).Return(nil, nil)
+				m.scheduledTransferRunRepo.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.scheduledTransferRepo.EXPECT().
+					SetNextRunTime(gomock.Any(), int64(2), nil, false).
+					Return(assert.AnError)
+			},
+			wantExecuted: 0,
+			wantErr:      assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:                NewMocktrManager(ctrl),
+				nestedTrManager:          NewMocktrManager(ctrl),
+				scheduledTransferRepo:    NewMockscheduledTransferRepo(ctrl),
+				scheduledTransferRunRepo: NewMockscheduledTransferRunRepo(ctrl),
+				coinSending:              NewMockcoinSending(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.nestedTrManager, m.scheduledTransferRepo, m.scheduledTransferRunRepo, m.coinSending)
+			require.NoError(t, err)
+
+			executed, err := uc.ExecuteDue(context.Background(), now)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantExecuted, executed)
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package scheduled_transfer_executing -typed -destination mock_deps_test.go
+//
+
+// Package scheduled_transfer_executing is a generated GoMock package.
+package scheduled_transfer_executing
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockscheduledTransferRepo is a mock of scheduledTransferRepo interface.
+type MockscheduledTransferRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduledTransferRepoMockRecorder
+}
+
+// MockscheduledTransferRepoMockRecorder is the mock recorder for MockscheduledTransferRepo.
+type MockscheduledTransferRepoMockRecorder struct {
+	mock *MockscheduledTransferRepo
+}
+
+// NewMockscheduledTransferRepo creates a new mock instance.
+func NewMockscheduledTransferRepo(ctrl *gomock.Controller) *MockscheduledTransferRepo {
+	mock := &MockscheduledTransferRepo{ctrl: ctrl}
+	mock.recorder = &MockscheduledTransferRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduledTransferRepo) EXPECT() *MockscheduledTransferRepoMockRecorder {
+	return m.recorder
+}
+
+// GetDueWithLock mocks base method.
+func (m *MockscheduledTransferRepo) GetDueWithLock(ctx context.Context, now time.Time) (*model.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueWithLock", ctx, now)
+	ret0, _ := ret[0].(*model.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueWithLock indicates an expected call of GetDueWithLock.
+func (mr *MockscheduledTransferRepoMockRecorder) GetDueWithLock(ctx, now any) *MockscheduledTransferRepoGetDueWithLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueWithLock", reflect.TypeOf((*MockscheduledTransferRepo)(nil).GetDueWithLock), ctx, now)
+	return &MockscheduledTransferRepoGetDueWithLockCall{Call: call}
+}
+
+// MockscheduledTransferRepoGetDueWithLockCall wrap *gomock.Call
+type MockscheduledTransferRepoGetDueWithLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRepoGetDueWithLockCall) Return(arg0 *model.ScheduledTransfer, arg1 error) *MockscheduledTransferRepoGetDueWithLockCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRepoGetDueWithLockCall) Do(f func(context.Context, time.Time) (*model.ScheduledTransfer, error)) *MockscheduledTransferRepoGetDueWithLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRepoGetDueWithLockCall) DoAndReturn(f func(context.Context, time.Time) (*model.ScheduledTransfer, error)) *MockscheduledTransferRepoGetDueWithLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetNextRunTime mocks base method.
+func (m *MockscheduledTransferRepo) SetNextRunTime(ctx context.Context, id int64, nextRunTime *time.Time, isActive bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNextRunTime", ctx, id, nextRunTime, isActive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNextRunTime indicates an expected call of SetNextRunTime.
+func (mr *MockscheduledTransferRepoMockRecorder) SetNextRunTime(ctx, id, nextRunTime, isActive any) *MockscheduledTransferRepoSetNextRunTimeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNextRunTime", reflect.TypeOf((*MockscheduledTransferRepo)(nil).SetNextRunTime), ctx, id, nextRunTime, isActive)
+	return &MockscheduledTransferRepoSetNextRunTimeCall{Call: call}
+}
+
+// MockscheduledTransferRepoSetNextRunTimeCall wrap *gomock.Call
+type MockscheduledTransferRepoSetNextRunTimeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRepoSetNextRunTimeCall) Return(arg0 error) *MockscheduledTransferRepoSetNextRunTimeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRepoSetNextRunTimeCall) Do(f func(context.Context, int64, *time.Time, bool) error) *MockscheduledTransferRepoSetNextRunTimeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRepoSetNextRunTimeCall) DoAndReturn(f func(context.Context, int64, *time.Time, bool) error) *MockscheduledTransferRepoSetNextRunTimeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockscheduledTransferRunRepo is a mock of scheduledTransferRunRepo interface.
+type MockscheduledTransferRunRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduledTransferRunRepoMockRecorder
+}
+
+// MockscheduledTransferRunRepoMockRecorder is the mock recorder for MockscheduledTransferRunRepo.
+type MockscheduledTransferRunRepoMockRecorder struct {
+	mock *MockscheduledTransferRunRepo
+}
+
+// NewMockscheduledTransferRunRepo creates a new mock instance.
+func NewMockscheduledTransferRunRepo(ctrl *gomock.Controller) *MockscheduledTransferRunRepo {
+	mock := &MockscheduledTransferRunRepo{ctrl: ctrl}
+	mock.recorder = &MockscheduledTransferRunRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduledTransferRunRepo) EXPECT() *MockscheduledTransferRunRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockscheduledTransferRunRepo) Add(ctx context.Context, run model.ScheduledTransferRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockscheduledTransferRunRepoMockRecorder) Add(ctx, run any) *MockscheduledTransferRunRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockscheduledTransferRunRepo)(nil).Add), ctx, run)
+	return &MockscheduledTransferRunRepoAddCall{Call: call}
+}
+
+// MockscheduledTransferRunRepoAddCall wrap *gomock.Call
+type MockscheduledTransferRunRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRunRepoAddCall) Return(arg0 error) *MockscheduledTransferRunRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRunRepoAddCall) Do(f func(context.Context, model.ScheduledTransferRun) error) *MockscheduledTransferRunRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRunRepoAddCall) DoAndReturn(f func(context.Context, model.ScheduledTransferRun) error) *MockscheduledTransferRunRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinSending is a mock of coinSending interface.
+type MockcoinSending struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinSendingMockRecorder
+}
+
+// MockcoinSendingMockRecorder is the mock recorder for MockcoinSending.
+type MockcoinSendingMockRecorder struct {
+	mock *MockcoinSending
+}
+
+// NewMockcoinSending creates a new mock instance.
+func NewMockcoinSending(ctrl *gomock.Controller) *MockcoinSending {
+	mock := &MockcoinSending{ctrl: ctrl}
+	mock.recorder = &MockcoinSendingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinSending) EXPECT() *MockcoinSendingMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockcoinSending) Send(ctx context.Context, employeeID int64, targetUsername string, amount int64, requireAcceptance bool) (*model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, employeeID, targetUsername, amount, requireAcceptance)
+	ret0, _ := ret[0].(*model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockcoinSendingMockRecorder) Send(ctx, employeeID, targetUsername, amount, requireAcceptance any) *MockcoinSendingSendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockcoinSending)(nil).Send), ctx, employeeID, targetUsername, amount, requireAcceptance)
+	return &MockcoinSendingSendCall{Call: call}
+}
+
+// MockcoinSendingSendCall wrap *gomock.Call
+type MockcoinSendingSendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinSendingSendCall) Return(arg0 *model.TransferRequest, arg1 error) *MockcoinSendingSendCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinSendingSendCall) Do(f func(context.Context, int64, string, int64, bool) (*model.TransferRequest, error)) *MockcoinSendingSendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinSendingSendCall) DoAndReturn(f func(context.Context, int64, string, int64, bool) (*model.TransferRequest, error)) *MockcoinSendingSendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,103 @@
+package stats_collecting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type UseCase struct {
+	statsRepo    statsRepo
+	employeeRepo employeeRepo
+}
+
+func New(statsRepo statsRepo, employeeRepo employeeRepo) (*UseCase, error) {
+	if statsRepo == nil {
+		return nil, errors.New("statsRepo is nil")
+	}
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+
+	return &UseCase{
+		statsRepo:    statsRepo,
+		employeeRepo: employeeRepo,
+	}, nil
+}
+
+// Leaderboard returns top receivers, givers and merch from day from to day to (exclusive), both in UTC.
+func (uc *UseCase) Leaderboard(ctx context.Context, from, to time.Time, limit int) (model.Leaderboard, error) {
+	from = truncateDay(from)
+	to = truncateDay(to)
+	if !from.Before(to) {
+		return model.Leaderboard{}, model.ErrInvalidPeriod
+	}
+
+	res := model.Leaderboard{
+		From: from,
+		To:   to,
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	eg.Go(func() (err error) {
+		res.TopReceivers, err = uc.statsRepo.GetTopReceivers(ctx, from, to, limit)
+		if err != nil {
+			return fmt.Errorf("statsRepo.GetTopReceivers: %w", err)
+		}
+		return nil
+	})
+
+	eg.Go(func() (err error) {
+		res.TopGivers, err = uc.statsRepo.GetTopGivers(ctx, from, to, limit)
+		if err != nil {
+			return fmt.Errorf("statsRepo.GetTopGivers: %w", err)
+		}
+		return nil
+	})
+
+	eg.Go(func() (err error) {
+		res.TopMerch, err = uc.statsRepo.GetTopMerch(ctx, from, to, limit)
+		if err != nil {
+			return fmt.Errorf("statsRepo.GetTopMerch: %w", err)
+		}
+		return nil
+	})
+
+	err := eg.Wait()
+	if err != nil {
+		return model.Leaderboard{}, fmt.Errorf("errgroup.Wait: %w", err)
+	}
+
+	return res, nil
+}
+
+// SetHidden opts the employee out of leaderboards or back in, it takes effect immediately.
+func (uc *UseCase) SetHidden(ctx context.Context, employeeID int64, hidden bool) error {
+	err := uc.employeeRepo.SetHiddenFromStats(ctx, employeeID, hidden)
+	if err != nil {
+		return fmt.Errorf("employeeRepo.SetHiddenFromStats: %w", err)
+	}
+
+	return nil
+}
+
+// Refresh recalculates the leaderboard aggregates.
+func (uc *UseCase) Refresh(ctx context.Context) error {
+	err := uc.statsRepo.Refresh(ctx)
+	if err != nil {
+		return fmt.Errorf("statsRepo.Refresh: %w", err)
+	}
+
+	return nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
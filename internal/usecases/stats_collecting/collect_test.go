@@ -0,0 +1,110 @@
+package stats_collecting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_Leaderboard(t *testing.T) {
+	type mocks struct {
+		statsRepo    *MockstatsRepo
+		employeeRepo *MockemployeeRepo
+	}
+	type args struct {
+		from  time.Time
+		to    time.Time
+		limit int
+	}
+
+	from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		args    args
+		wantRes model.Leaderboard
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.statsRepo.EXPECT().
+					GetTopReceivers(gomock.Any(), from, to, 10).
+					Return([]model.LeaderboardEmployee{{Username: "test1", Amount: 300, Count: 2}}, nil)
+				m.statsRepo.EXPECT().
+					GetTopGivers(gomock.Any(), from, to, 10).
+					Return([]model.LeaderboardEmployee{{Username: "test2", Amount: 300, Count: 2}}, nil)
+				m.statsRepo.EXPECT().
+					GetTopMerch(gomock.Any(), from, to, 10).
+					Return([]model.LeaderboardMerch{{MerchName: "cup", Quantity: 5}}, nil)
+			},
+			args: args{
+				from:  time.Date(2025, 2, 1, 15, 0, 0, 0, time.UTC),
+				to:    to,
+				limit: 10,
+			},
+			wantRes: model.Leaderboard{
+				From:         from,
+				To:           to,
+				TopReceivers: []model.LeaderboardEmployee{{Username: "test1", Amount: 300, Count: 2}},
+				TopGivers:    []model.LeaderboardEmployee{{Username: "test2", Amount: 300, Count: 2}},
+				TopMerch:     []model.LeaderboardMerch{{MerchName: "cup", Quantity: 5}},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "error.invalid_period",
+			prepare: func(m *mocks) {},
+			args: args{
+				from:  to,
+				to:    from,
+				limit: 10,
+			},
+			wantRes: model.Leaderboard{},
+			wantErr: model.ErrInvalidPeriod,
+		},
+		{
+			name: "error.stats_repo.get_top_merch",
+			prepare: func(m *mocks) {
+				m.statsRepo.EXPECT().GetTopReceivers(gomock.Any(), from, to, 10).Return(nil, nil).AnyTimes()
+				m.statsRepo.EXPECT().GetTopGivers(gomock.Any(), from, to, 10).Return(nil, nil).AnyTimes()
+				m.statsRepo.EXPECT().GetTopMerch(gomock.Any(), from, to, 10).Return(nil, assert.AnError)
+			},
+			args: args{
+				from:  from,
+				to:    to,
+				limit: 10,
+			},
+			wantRes: model.Leaderboard{},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				statsRepo:    NewMockstatsRepo(ctrl),
+				employeeRepo: NewMockemployeeRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.statsRepo, m.employeeRepo)
+			require.NoError(t, err)
+
+			res, err := uc.Leaderboard(context.Background(), tc.args.from, tc.args.to, tc.args.limit)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package stats_collecting
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type statsRepo interface {
+	GetTopReceivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error)
+	GetTopGivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error)
+	GetTopMerch(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardMerch, error)
+	Refresh(ctx context.Context) error
+}
+
+type employeeRepo interface {
+	SetHiddenFromStats(ctx context.Context, employeeID int64, hidden bool) error
+}
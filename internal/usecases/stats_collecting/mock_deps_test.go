@@ -0,0 +1,258 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package stats_collecting -typed -destination mock_deps_test.go
+//
+
+// Package stats_collecting is a generated GoMock package.
+package stats_collecting
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockstatsRepo is a mock of statsRepo interface.
+type MockstatsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockstatsRepoMockRecorder
+}
+
+// MockstatsRepoMockRecorder is the mock recorder for MockstatsRepo.
+type MockstatsRepoMockRecorder struct {
+	mock *MockstatsRepo
+}
+
+// NewMockstatsRepo creates a new mock instance.
+func NewMockstatsRepo(ctrl *gomock.Controller) *MockstatsRepo {
+	mock := &MockstatsRepo{ctrl: ctrl}
+	mock.recorder = &MockstatsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstatsRepo) EXPECT() *MockstatsRepoMockRecorder {
+	return m.recorder
+}
+
+// GetTopGivers mocks base method.
+func (m *MockstatsRepo) GetTopGivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopGivers", ctx, from, to, limit)
+	ret0, _ := ret[0].([]model.LeaderboardEmployee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopGivers indicates an expected call of GetTopGivers.
+func (mr *MockstatsRepoMockRecorder) GetTopGivers(ctx, from, to, limit any) *MockstatsRepoGetTopGiversCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopGivers", reflect.TypeOf((*MockstatsRepo)(nil).GetTopGivers), ctx, from, to, limit)
+	return &MockstatsRepoGetTopGiversCall{Call: call}
+}
+
+// MockstatsRepoGetTopGiversCall wrap *gomock.Call
+type MockstatsRepoGetTopGiversCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsRepoGetTopGiversCall) Return(arg0 []model.LeaderboardEmployee, arg1 error) *MockstatsRepoGetTopGiversCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsRepoGetTopGiversCall) Do(f func(context.Context, time.Time, time.Time, int) ([]model.LeaderboardEmployee, error)) *MockstatsRepoGetTopGiversCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsRepoGetTopGiversCall) DoAndReturn(f func(context.Context, time.Time, time.Time, int) ([]model.LeaderboardEmployee, error)) *MockstatsRepoGetTopGiversCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTopMerch mocks base method.
+func (m *MockstatsRepo) GetTopMerch(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardMerch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopMerch", ctx, from, to, limit)
+	ret0, _ := ret[0].([]model.LeaderboardMerch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopMerch indicates an expected call of GetTopMerch.
+func (mr *MockstatsRepoMockRecorder) GetTopMerch(ctx, from, to, limit any) *MockstatsRepoGetTopMerchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopMerch", reflect.TypeOf((*MockstatsRepo)(nil).GetTopMerch), ctx, from, to, limit)
+	return &MockstatsRepoGetTopMerchCall{Call: call}
+}
+
+// MockstatsRepoGetTopMerchCall wrap *gomock.Call
+type MockstatsRepoGetTopMerchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsRepoGetTopMerchCall) Return(arg0 []model.LeaderboardMerch, arg1 error) *MockstatsRepoGetTopMerchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsRepoGetTopMerchCall) Do(f func(context.Context, time.Time, time.Time, int) ([]model.LeaderboardMerch, error)) *MockstatsRepoGetTopMerchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsRepoGetTopMerchCall) DoAndReturn(f func(context.Context, time.Time, time.Time, int) ([]model.LeaderboardMerch, error)) *MockstatsRepoGetTopMerchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTopReceivers mocks base method.
+func (m *MockstatsRepo) GetTopReceivers(ctx context.Context, from, to time.Time, limit int) ([]model.LeaderboardEmployee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopReceivers", ctx, from, to, limit)
+	ret0, _ := ret[0].([]model.LeaderboardEmployee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopReceivers indicates an expected call of GetTopReceivers.
+func (mr *MockstatsRepoMockRecorder) GetTopReceivers(ctx, from, to, limit any) *MockstatsRepoGetTopReceiversCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopReceivers", reflect.TypeOf((*MockstatsRepo)(nil).GetTopReceivers), ctx, from, to, limit)
+	return &MockstatsRepoGetTopReceiversCall{Call: call}
+}
+
+// MockstatsRepoGetTopReceiversCall wrap *gomock.Call
+type MockstatsRepoGetTopReceiversCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsRepoGetTopReceiversCall) Return(arg0 []model.LeaderboardEmployee, arg1 error) *MockstatsRepoGetTopReceiversCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsRepoGetTopReceiversCall) Do(f func(context.Context, time.Time, time.Time, int) ([]model.LeaderboardEmployee, error)) *MockstatsRepoGetTopReceiversCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsRepoGetTopReceiversCall) DoAndReturn(f func(context.Context, time.Time, time.Time, int) ([]model.LeaderboardEmployee, error)) *MockstatsRepoGetTopReceiversCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Refresh mocks base method.
+func (m *MockstatsRepo) Refresh(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockstatsRepoMockRecorder) Refresh(ctx any) *MockstatsRepoRefreshCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockstatsRepo)(nil).Refresh), ctx)
+	return &MockstatsRepoRefreshCall{Call: call}
+}
+
+// MockstatsRepoRefreshCall wrap *gomock.Call
+type MockstatsRepoRefreshCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsRepoRefreshCall) Return(arg0 error) *MockstatsRepoRefreshCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsRepoRefreshCall) Do(f func(context.Context) error) *MockstatsRepoRefreshCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsRepoRefreshCall) DoAndReturn(f func(context.Context) error) *MockstatsRepoRefreshCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// SetHiddenFromStats mocks base method.
+func (m *MockemployeeRepo) SetHiddenFromStats(ctx context.Context, employeeID int64, hidden bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHiddenFromStats", ctx, employeeID, hidden)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHiddenFromStats indicates an expected call of SetHiddenFromStats.
+func (mr *MockemployeeRepoMockRecorder) SetHiddenFromStats(ctx, employeeID, hidden any) *MockemployeeRepoSetHiddenFromStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHiddenFromStats", reflect.TypeOf((*MockemployeeRepo)(nil).SetHiddenFromStats), ctx, employeeID, hidden)
+	return &MockemployeeRepoSetHiddenFromStatsCall{Call: call}
+}
+
+// MockemployeeRepoSetHiddenFromStatsCall wrap *gomock.Call
+type MockemployeeRepoSetHiddenFromStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoSetHiddenFromStatsCall) Return(arg0 error) *MockemployeeRepoSetHiddenFromStatsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoSetHiddenFromStatsCall) Do(f func(context.Context, int64, bool) error) *MockemployeeRepoSetHiddenFromStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoSetHiddenFromStatsCall) DoAndReturn(f func(context.Context, int64, bool) error) *MockemployeeRepoSetHiddenFromStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,347 @@
+package transfer_approving
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+// policyErrors are the rejections of transfer policies, a transfer they reject isn't accepted automatically
+var policyErrors = []error{
+	model.ErrAmountBelowMinimum,
+	model.ErrAmountAboveMaximum,
+	model.ErrDailyLimitExceeded,
+	model.ErrMonthlyLimitExceeded,
+	model.ErrTransferCooldown,
+	model.ErrTransferBlocked,
+}
+
+type UseCase struct {
+	trManager           trManager
+	nestedTrManager     trManager
+	transferRequestRepo transferRequestRepo
+	employeeRepo        employeeRepo
+	coinLotRepo         coinLotRepo
+	coinSending         coinSending
+	approval            model.TransferApproval
+	now                 func() time.Time
+}
+
+// New expects nestedTrManager to open a savepoint inside the already started transaction,
+// so an automatic acceptance rejected by transfer policies is rolled back and the request expires instead.
+func New(
+	trManager trManager,
+	nestedTrManager trManager,
+	transferRequestRepo transferRequestRepo,
+	employeeRepo employeeRepo,
+	coinLotRepo coinLotRepo,
+	coinSending coinSending,
+	approval model.TransferApproval,
+) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if nestedTrManager == nil {
+		return nil, errors.New("nestedTrManager is nil")
+	}
+	if transferRequestRepo == nil {
+		return nil, errors.New("transferRequestRepo is nil")
+	}
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
+	if coinSending == nil {
+		return nil, errors.New("coinSending is nil")
+	}
+
+	return &UseCase{
+		trManager:           trManager,
+		nestedTrManager:     nestedTrManager,
+		transferRequestRepo: transferRequestRepo,
+		employeeRepo:        employeeRepo,
+		coinLotRepo:         coinLotRepo,
+		coinSending:         coinSending,
+		approval:            approval,
+		now:                 time.Now,
+	}, nil
+}
+
+// List returns transfer requests of the sender, the latest first.
+func (uc *UseCase) List(ctx context.Context, senderID int64) ([]model.TransferRequest, error) {
+	requests, err := uc.transferRequestRepo.GetBySender(ctx, senderID)
+	if err != nil {
+		return nil, fmt.Errorf("transferRequestRepo.GetBySender: %w", err)
+	}
+
+	return requests, nil
+}
+
+// ListByStatus returns approval requests of every employee with the status for approvers, pending when status is empty.
+func (uc *UseCase) ListByStatus(ctx context.Context, status model.TransferRequestStatus) ([]model.TransferRequest, error) {
+	if status == "" {
+		status = model.TransferRequestStatusPending
+	}
+	if !status.Valid() {
+		return nil, model.ErrInvalidTransferStatus
+	}
+
+	requests, err := uc.transferRequestRepo.GetByStatus(ctx, model.TransferRequestKindApproval, status)
+	if err != nil {
+		return nil, fmt.Errorf("transferRequestRepo.GetByStatus: %w", err)
+	}
+
+	return requests, nil
+}
+
+// Inbox returns transfers waiting for the receiver to accept or decline them, the oldest first.
+func (uc *UseCase) Inbox(ctx context.Context, receiverID int64) ([]model.TransferRequest, error) {
+	requests, err := uc.transferRequestRepo.GetPendingByEmployee(ctx, receiverID)
+	if err != nil {
+		return nil, fmt.Errorf("transferRequestRepo.GetPendingByEmployee: %w", err)
+	}
+
+	res := make([]model.TransferRequest, 0, len(requests))
+	for _, request := range requests {
+		if request.Kind == model.TransferRequestKindAcceptance && request.ReceiverID == receiverID {
+			res = append(res, request)
+		}
+	}
+
+	return res, nil
+}
+
+// Approve settles the pending transfer: the held coins go to the receiver keeping their expire time.
+// A transfer that requires acceptance then waits for the receiver in a new acceptance request.
+// The transfer is checked by transfer policies like a usual one, a rejected transfer stays pending.
+func (uc *UseCase) Approve(ctx context.Context, approverID, requestID int64) (model.TransferRequest, error) {
+	return uc.decide(ctx, approverID, requestID, model.TransferRequestKindApproval, model.TransferRequestStatusApproved)
+}
+
+// Reject returns the held coins to the sender.
+func (uc *UseCase) Reject(ctx context.Context, approverID, requestID int64) (model.TransferRequest, error) {
+	return uc.decide(ctx, approverID, requestID, model.TransferRequestKindApproval, model.TransferRequestStatusRejected)
+}
+
+// Accept gives the held coins to the receiver, only the receiver can accept a transfer.
+// The transfer is checked by transfer policies like a usual one, a rejected transfer stays pending.
+func (uc *UseCase) Accept(ctx context.Context, receiverID, requestID int64) (model.TransferRequest, error) {
+	return uc.decide(ctx, receiverID, requestID, model.TransferRequestKindAcceptance, model.TransferRequestStatusAccepted)
+}
+
+// Decline returns the held coins to the sender, only the receiver can decline a transfer.
+func (uc *UseCase) Decline(ctx context.Context, receiverID, requestID int64) (model.TransferRequest, error) {
+	return uc.decide(ctx, receiverID, requestID, model.TransferRequestKindAcceptance, model.TransferRequestStatusDeclined)
+}
+
+// ExpireDue settles requests nobody decided on till now and returns the number of settled requests:
+// coins held by approval requests go back to their senders, acceptance requests are accepted automatically.
+// An acceptance rejected by transfer policies expires and its coins go back to the sender too.
+// Every request is settled in its own transaction, so a failure doesn't roll back already settled ones.
+func (uc *UseCase) ExpireDue(ctx context.Context, now time.Time) (int, error) {
+	requestIDs, err := uc.transferRequestRepo.GetExpiredIDs(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("transferRequestRepo.GetExpiredIDs: %w", err)
+	}
+
+	var expired int
+	for _, requestID := range requestIDs {
+		err = uc.trManager.Do(ctx, func(ctx context.Context) error {
+			request, err := uc.transferRequestRepo.GetByIDWithLock(ctx, requestID)
+			if err != nil {
+				return fmt.Errorf("transferRequestRepo.GetByIDWithLock: %w", err)
+			}
+			// decided by an approver in the meantime
+			if request.Status != model.TransferRequestStatusPending {
+				return nil
+			}
+
+			if request.Kind == model.TransferRequestKindAcceptance {
+				err = uc.nestedTrManager.Do(ctx, func(ctx context.Context) error {
+					return uc.settle(ctx, *request, model.TransferRequestStatusAccepted, 0, now)
+				})
+				if err == nil {
+					expired++
+					return nil
+				}
+				if !isPolicyError(err) {
+					return fmt.Errorf("settle: %w", err)
+				}
+			}
+
+			err = uc.settle(ctx, *request, model.TransferRequestStatusExpired, 0, now)
+			if err != nil {
+				return fmt.Errorf("settle: %w", err)
+			}
+
+			expired++
+			return nil
+		})
+		if err != nil {
+			return expired, fmt.Errorf("trManager.Do: %w", err)
+		}
+	}
+
+	return expired, nil
+}
+
+// decide settles the request by an approver or the receiver depending on the request kind.
+// Requests of another kind and transfers to someone else are not found for the receiver.
+func (uc *UseCase) decide(
+	ctx context.Context,
+	deciderID int64,
+	requestID int64,
+	kind model.TransferRequestKind,
+	status model.TransferRequestStatus,
+) (model.TransferRequest, error) {
+	now := uc.now()
+
+	var request model.TransferRequest
+	err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+		lockedRequest, err := uc.transferRequestRepo.GetByIDWithLock(ctx, requestID)
+		if err != nil {
+			return fmt.Errorf("transferRequestRepo.GetByIDWithLock: %w", err)
+		}
+
+		if lockedRequest.Kind != kind {
+			return model.ErrTransferRequestNotFound
+		}
+		switch kind {
+		case model.TransferRequestKindApproval:
+			if lockedRequest.SenderID == deciderID || lockedRequest.ReceiverID == deciderID {
+				return model.ErrSelfApprovalNotAllowed
+			}
+		case model.TransferRequestKindAcceptance:
+			if lockedRequest.ReceiverID != deciderID {
+				return model.ErrTransferRequestNotFound
+			}
+		}
+		if lockedRequest.Status != model.TransferRequestStatusPending {
+			return model.ErrTransferRequestDecided
+		}
+		// the expiry worker settles the request soon, the decision is too late
+		if !now.Before(lockedRequest.ExpireTime) {
+			return model.ErrTransferRequestExpired
+		}
+
+		err = uc.settle(ctx, *lockedRequest, status, deciderID, now)
+		if err != nil {
+			return fmt.Errorf("settle: %w", err)
+		}
+
+		// read again for the decider username
+		decidedRequest, err := uc.transferRequestRepo.GetByIDWithLock(ctx, requestID)
+		if err != nil {
+			return fmt.Errorf("transferRequestRepo.GetByIDWithLock: %w", err)
+		}
+
+		request = *decidedRequest
+		return nil
+	})
+	if err != nil {
+		return model.TransferRequest{}, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return request, nil
+}
+
+// settle sets the final status and moves the held coins, it should be called inside a transaction
+// with the request locked. An approved transfer that requires acceptance is forwarded to the receiver.
+// The status is set first, so transfer policies don't count the settled request as a pending one.
+func (uc *UseCase) settle(
+	ctx context.Context,
+	request model.TransferRequest,
+	status model.TransferRequestStatus,
+	decidedBy int64,
+	now time.Time,
+) error {
+	err := uc.transferRequestRepo.Decide(ctx, request.ID, status, decidedBy, now)
+	if err != nil {
+		return fmt.Errorf("transferRequestRepo.Decide: %w", err)
+	}
+
+	if status == model.TransferRequestStatusApproved && request.RequireAcceptance {
+		err = uc.forward(ctx, request, now)
+		if err != nil {
+			return fmt.Errorf("forward: %w", err)
+		}
+	} else {
+		err = uc.release(ctx, request, status)
+		if err != nil {
+			return fmt.Errorf("release: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// forward hands the held coins over to a new acceptance request, so the approved transfer waits for the receiver.
+func (uc *UseCase) forward(ctx context.Context, request model.TransferRequest, now time.Time) error {
+	acceptance, err := uc.transferRequestRepo.Create(ctx, model.TransferRequest{
+		Kind:             model.TransferRequestKindAcceptance,
+		SenderID:         request.SenderID,
+		SenderUsername:   request.SenderUsername,
+		ReceiverID:       request.ReceiverID,
+		ReceiverUsername: request.ReceiverUsername,
+		Amount:           request.Amount,
+		Status:           model.TransferRequestStatusPending,
+		ExpireTime:       now.Add(uc.approval.AcceptanceTTL),
+		CreateTime:       now,
+	})
+	if err != nil {
+		return fmt.Errorf("transferRequestRepo.Create: %w", err)
+	}
+
+	err = uc.transferRequestRepo.MoveLots(ctx, request.ID, acceptance.ID)
+	if err != nil {
+		return fmt.Errorf("transferRequestRepo.MoveLots: %w", err)
+	}
+
+	return nil
+}
+
+// release gives the held coins out keeping the expire time of the sender lots they were taken from:
+// an approved or accepted transfer is sent to the receiver like a usual transfer, otherwise the coins go back
+// to the sender.
+func (uc *UseCase) release(ctx context.Context, request model.TransferRequest, status model.TransferRequestStatus) error {
+	lots, err := uc.transferRequestRepo.GetLots(ctx, request.ID)
+	if err != nil {
+		return fmt.Errorf("transferRequestRepo.GetLots: %w", err)
+	}
+
+	if status == model.TransferRequestStatusApproved || status == model.TransferRequestStatusAccepted {
+		err = uc.coinSending.SendHeld(ctx, request, lots)
+		if err != nil {
+			return fmt.Errorf("coinSending.SendHeld: %w", err)
+		}
+		return nil
+	}
+
+	err = uc.employeeRepo.IncreaseBalance(ctx, request.SenderID, request.Amount)
+	if err != nil {
+		return fmt.Errorf("employeeRepo.IncreaseBalance: %w", err)
+	}
+
+	for _, lot := range lots {
+		err = uc.coinLotRepo.Add(ctx, request.SenderID, lot.Amount, lot.ExpireTime)
+		if err != nil {
+			return fmt.Errorf("coinLotRepo.Add: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func isPolicyError(err error) bool {
+	for _, policyErr := range policyErrors {
+		if errors.Is(err, policyErr) {
+			return true
+		}
+	}
+	return false
+}
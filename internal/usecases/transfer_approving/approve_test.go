@@ -0,0 +1,735 @@
+package transfer_approving
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+var (
+	now             = time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	expireTime      = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	laterExpireTime = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var heldLots = []model.CoinLotPart{
+	{LotID: 1, Amount: 400, ExpireTime: expireTime},
+	{LotID: 2, Amount: 300, ExpireTime: laterExpireTime},
+}
+
+var pendingRequest = model.TransferRequest{
+	ID:               10,
+	Kind:             model.TransferRequestKindApproval,
+	SenderID:         200,
+	SenderUsername:   "test2",
+	ReceiverID:       100,
+	ReceiverUsername: "test1",
+	Amount:           700,
+	Status:           model.TransferRequestStatusPending,
+	ExpireTime:       now.Add(time.Hour),
+}
+
+var acceptanceRequest = model.TransferRequest{
+	ID:               10,
+	Kind:             model.TransferRequestKindAcceptance,
+	SenderID:         200,
+	SenderUsername:   "test2",
+	ReceiverID:       100,
+	ReceiverUsername: "test1",
+	Amount:           700,
+	Status:           model.TransferRequestStatusPending,
+	ExpireTime:       now.Add(time.Hour),
+}
+
+func withStatus(request model.TransferRequest, status model.TransferRequestStatus) *model.TransferRequest {
+	request.Status = status
+	return &request
+}
+
+func TestUseCase_ListByStatus(t *testing.T) {
+	type mocks struct {
+		trManager           *MocktrManager
+		nestedTrManager     *MocktrManager
+		transferRequestRepo *MocktransferRequestRepo
+		employeeRepo        *MockemployeeRepo
+		coinLotRepo         *MockcoinLotRepo
+		coinSending         *MockcoinSending
+	}
+
+	testCases := []struct {
+		name    string
+		status  model.TransferRequestStatus
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name:   "success.pending_by_default",
+			status: "",
+			prepare: func(m *mocks) {
+				m.transferRequestRepo.EXPECT().
+					GetByStatus(gomock.Any(), model.TransferRequestKindApproval, model.TransferRequestStatusPending).
+					Return([]model.TransferRequest{pendingRequest}, nil)
+			},
+		},
+		{
+			name:   "success.rejected",
+			status: model.TransferRequestStatusRejected,
+			prepare: func(m *mocks) {
+				m.transferRequestRepo.EXPECT().
+					GetByStatus(gomock.Any(), model.TransferRequestKindApproval, model.TransferRequestStatusRejected).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:    "error.invalid_status",
+			status:  "lost",
+			prepare: func(_ *mocks) {},
+			wantErr: model.ErrInvalidTransferStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:           NewMocktrManager(ctrl),
+				nestedTrManager:     NewMocktrManager(ctrl),
+				transferRequestRepo: NewMocktransferRequestRepo(ctrl),
+				employeeRepo:        NewMockemployeeRepo(ctrl),
+				coinLotRepo:         NewMockcoinLotRepo(ctrl),
+				coinSending:         NewMockcoinSending(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.nestedTrManager, m.transferRequestRepo, m.employeeRepo, m.coinLotRepo,
+				m.coinSending, model.TransferApproval{Threshold: 500, TTL: time.Hour, AcceptanceTTL: 168 * time.Hour})
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			_, err = uc.ListByStatus(context.Background(), tc.status)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_Approve(t *testing.T) {
+	type mocks struct {
+		trManager           *MocktrManager
+		nestedTrManager     *MocktrManager
+		transferRequestRepo *MocktransferRequestRepo
+		employeeRepo        *MockemployeeRepo
+		coinLotRepo         *MockcoinLotRepo
+		coinSending         *MockcoinSending
+	}
+
+	approved := withStatus(pendingRequest, model.TransferRequestStatusApproved)
+	approved.DecidedBy = 300
+	approved.DecidedByUsername = "approver"
+	approved.DecideTime = &now
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantRes model.TransferRequest
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				gomock.InOrder(
+					m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&pendingRequest, nil),
+					m.transferRequestRepo.EXPECT().
+						Decide(gomock.Any(), int64(10), model.TransferRequestStatusApproved, int64(300), now).
+						Return(nil),
+					m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil),
+					m.coinSending.EXPECT().SendHeld(gomock.Any(), pendingRequest, heldLots).Return(nil),
+					m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(approved, nil),
+				)
+			},
+			wantRes: *approved,
+		},
+		{
+			name: "error.not_found",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(10)).
+					Return(nil, model.ErrTransferRequestNotFound)
+			},
+			wantErr: model.ErrTransferRequestNotFound,
+		},
+		{
+			name: "success.require_acceptance",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				request := pendingRequest
+				request.RequireAcceptance = true
+				gomock.InOrder(
+					m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&request, nil),
+					m.transferRequestRepo.EXPECT().
+						Decide(gomock.Any(), int64(10), model.TransferRequestStatusApproved, int64(300), now).
+						Return(nil),
+					m.transferRequestRepo.EXPECT().
+						Create(gomock.Any(), model.TransferRequest{
+							Kind:             model.TransferRequestKindAcceptance,
+							SenderID:         200,
+							SenderUsername:   "test2",
+							ReceiverID:       100,
+							ReceiverUsername: "test1",
+							Amount:           700,
+							Status:           model.TransferRequestStatusPending,
+							ExpireTime:       now.Add(168 * time.Hour),
+							CreateTime:       now,
+						}).
+						Return(&model.TransferRequest{ID: 11}, nil),
+					// the coins stay held, now for the receiver
+					m.transferRequestRepo.EXPECT().MoveLots(gomock.Any(), int64(10), int64(11)).Return(nil),
+					m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(approved, nil),
+				)
+			},
+			wantRes: *approved,
+		},
+		{
+			name: "error.acceptance_request",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&acceptanceRequest, nil)
+			},
+			wantErr: model.ErrTransferRequestNotFound,
+		},
+		{
+			name: "error.own_transfer",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				request := pendingRequest
+				request.ReceiverID = 300
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&request, nil)
+			},
+			wantErr: model.ErrSelfApprovalNotAllowed,
+		},
+		{
+			name: "error.already_decided",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(10)).
+					Return(withStatus(pendingRequest, model.TransferRequestStatusRejected), nil)
+			},
+			wantErr: model.ErrTransferRequestDecided,
+		},
+		{
+			name: "error.expired",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				request := pendingRequest
+				request.ExpireTime = now
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&request, nil)
+			},
+			wantErr: model.ErrTransferRequestExpired,
+		},
+		{
+			// the transaction is rolled back, the request stays pending
+			name: "error.policy",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&pendingRequest, nil)
+				m.transferRequestRepo.EXPECT().
+					Decide(gomock.Any(), int64(10), model.TransferRequestStatusApproved, int64(300), now).
+					Return(nil)
+				m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil)
+				m.coinSending.EXPECT().
+					SendHeld(gomock.Any(), pendingRequest, heldLots).
+					Return(fmt.Errorf("checkPolicy: %w", model.ErrDailyLimitExceeded))
+			},
+			wantErr: model.ErrDailyLimitExceeded,
+		},
+		{
+			name: "error.coin_sending.send_held",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&pendingRequest, nil)
+				m.transferRequestRepo.EXPECT().
+					Decide(gomock.Any(), int64(10), model.TransferRequestStatusApproved, int64(300), now).
+					Return(nil)
+				m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil)
+				m.coinSending.EXPECT().SendHeld(gomock.Any(), pendingRequest, heldLots).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:           NewMocktrManager(ctrl),
+				nestedTrManager:     NewMocktrManager(ctrl),
+				transferRequestRepo: NewMocktransferRequestRepo(ctrl),
+				employeeRepo:        NewMockemployeeRepo(ctrl),
+				coinLotRepo:         NewMockcoinLotRepo(ctrl),
+				coinSending:         NewMockcoinSending(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.nestedTrManager, m.transferRequestRepo, m.employeeRepo, m.coinLotRepo,
+				m.coinSending, model.TransferApproval{Threshold: 500, TTL: time.Hour, AcceptanceTTL: 168 * time.Hour})
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.Approve(context.Background(), 300, 10)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_Reject(t *testing.T) {
+	rejected := withStatus(pendingRequest, model.TransferRequestStatusRejected)
+
+	ctrl := gomock.NewController(t)
+	trManager := NewMocktrManager(ctrl)
+	nestedTrManager := NewMocktrManager(ctrl)
+	transferRequestRepo := NewMocktransferRequestRepo(ctrl)
+	employeeRepo := NewMockemployeeRepo(ctrl)
+	coinLotRepo := NewMockcoinLotRepo(ctrl)
+	coinSending := NewMockcoinSending(ctrl)
+
+	trManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+			return do(ctx)
+		})
+	gomock.InOrder(
+		transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&pendingRequest, nil),
+		transferRequestRepo.EXPECT().
+			Decide(gomock.Any(), int64(10), model.TransferRequestStatusRejected, int64(300), now).
+			Return(nil),
+	)
+	// held coins go back to the sender, no transaction is recorded
+	gomock.InOrder(
+		transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil),
+		employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(700)).Return(nil),
+		coinLotRepo.EXPECT().Add(gomock.Any(), int64(200), int64(400), expireTime).Return(nil),
+		coinLotRepo.EXPECT().Add(gomock.Any(), int64(200), int64(300), laterExpireTime).Return(nil),
+	)
+	transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(rejected, nil)
+
+	uc, err := New(trManager, nestedTrManager, transferRequestRepo, employeeRepo, coinLotRepo,
+		coinSending, model.TransferApproval{Threshold: 500, TTL: time.Hour, AcceptanceTTL: 168 * time.Hour})
+	require.NoError(t, err)
+	uc.now = func() time.Time { return now }
+
+	res, err := uc.Reject(context.Background(), 300, 10)
+	require.NoError(t, err)
+	require.Equal(t, *rejected, res)
+}
+
+func TestUseCase_Inbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	trManager := NewMocktrManager(ctrl)
+	nestedTrManager := NewMocktrManager(ctrl)
+	transferRequestRepo := NewMocktransferRequestRepo(ctrl)
+	employeeRepo := NewMockemployeeRepo(ctrl)
+	coinLotRepo := NewMockcoinLotRepo(ctrl)
+	coinSending := NewMockcoinSending(ctrl)
+
+	sent := acceptanceRequest
+	sent.ID = 11
+	sent.SenderID, sent.ReceiverID = 100, 200
+	transferRequestRepo.EXPECT().GetPendingByEmployee(gomock.Any(), int64(100)).Return([]model.TransferRequest{
+		acceptanceRequest,
+		sent,
+		// waits for an approver, not for the receiver
+		{ID: 12, Kind: model.TransferRequestKindApproval, SenderID: 200, ReceiverID: 100, Amount: 700},
+	}, nil)
+
+	uc, err := New(trManager, nestedTrManager, transferRequestRepo, employeeRepo, coinLotRepo,
+		coinSending, model.TransferApproval{Threshold: 500, TTL: time.Hour, AcceptanceTTL: 168 * time.Hour})
+	require.NoError(t, err)
+	uc.now = func() time.Time { return now }
+
+	res, err := uc.Inbox(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, []model.TransferRequest{acceptanceRequest}, res)
+}
+
+func TestUseCase_Accept(t *testing.T) {
+	type mocks struct {
+		trManager           *MocktrManager
+		nestedTrManager     *MocktrManager
+		transferRequestRepo *MocktransferRequestRepo
+		employeeRepo        *MockemployeeRepo
+		coinLotRepo         *MockcoinLotRepo
+		coinSending         *MockcoinSending
+	}
+
+	accepted := withStatus(acceptanceRequest, model.TransferRequestStatusAccepted)
+	accepted.DecidedBy = 100
+	accepted.DecidedByUsername = "test1"
+	accepted.DecideTime = &now
+
+	testCases := []struct {
+		name       string
+		receiverID int64
+		prepare    func(m *mocks)
+		wantRes    model.TransferRequest
+		wantErr    error
+	}{
+		{
+			name:       "success",
+			receiverID: 100,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				gomock.InOrder(
+					m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&acceptanceRequest, nil),
+					m.transferRequestRepo.EXPECT().
+						Decide(gomock.Any(), int64(10), model.TransferRequestStatusAccepted, int64(100), now).
+						Return(nil),
+					m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil),
+					m.coinSending.EXPECT().SendHeld(gomock.Any(), acceptanceRequest, heldLots).Return(nil),
+					m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(accepted, nil),
+				)
+			},
+			wantRes: *accepted,
+		},
+		{
+			name:       "error.not_receiver",
+			receiverID: 300,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&acceptanceRequest, nil)
+			},
+			wantErr: model.ErrTransferRequestNotFound,
+		},
+		{
+			name:       "error.approval_request",
+			receiverID: 100,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&pendingRequest, nil)
+			},
+			wantErr: model.ErrTransferRequestNotFound,
+		},
+		{
+			name:       "error.already_declined",
+			receiverID: 100,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().
+					GetByIDWithLock(gomock.Any(), int64(10)).
+					Return(withStatus(acceptanceRequest, model.TransferRequestStatusDeclined), nil)
+			},
+			wantErr: model.ErrTransferRequestDecided,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:           NewMocktrManager(ctrl),
+				nestedTrManager:     NewMocktrManager(ctrl),
+				transferRequestRepo: NewMocktransferRequestRepo(ctrl),
+				employeeRepo:        NewMockemployeeRepo(ctrl),
+				coinLotRepo:         NewMockcoinLotRepo(ctrl),
+				coinSending:         NewMockcoinSending(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.nestedTrManager, m.transferRequestRepo, m.employeeRepo, m.coinLotRepo,
+				m.coinSending, model.TransferApproval{Threshold: 500, TTL: time.Hour, AcceptanceTTL: 168 * time.Hour})
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.Accept(context.Background(), tc.receiverID, 10)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_Decline(t *testing.T) {
+	declined := withStatus(acceptanceRequest, model.TransferRequestStatusDeclined)
+
+	ctrl := gomock.NewController(t)
+	trManager := NewMocktrManager(ctrl)
+	nestedTrManager := NewMocktrManager(ctrl)
+	transferRequestRepo := NewMocktransferRequestRepo(ctrl)
+	employeeRepo := NewMockemployeeRepo(ctrl)
+	coinLotRepo := NewMockcoinLotRepo(ctrl)
+	coinSending := NewMockcoinSending(ctrl)
+
+	trManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+			return do(ctx)
+		})
+	gomock.InOrder(
+		transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&acceptanceRequest, nil),
+		transferRequestRepo.EXPECT().
+			Decide(gomock.Any(), int64(10), model.TransferRequestStatusDeclined, int64(100), now).
+			Return(nil),
+	)
+	gomock.InOrder(
+		transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil),
+		employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(700)).Return(nil),
+		coinLotRepo.EXPECT().Add(gomock.Any(), int64(200), int64(400), expireTime).Return(nil),
+		coinLotRepo.EXPECT().Add(gomock.Any(), int64(200), int64(300), laterExpireTime).Return(nil),
+	)
+	transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(declined, nil)
+
+	uc, err := New(trManager, nestedTrManager, transferRequestRepo, employeeRepo, coinLotRepo,
+		coinSending, model.TransferApproval{Threshold: 500, TTL: time.Hour, AcceptanceTTL: 168 * time.Hour})
+	require.NoError(t, err)
+	uc.now = func() time.Time { return now }
+
+	res, err := uc.Decline(context.Background(), 100, 10)
+	require.NoError(t, err)
+	require.Equal(t, *declined, res)
+}
+
+func TestUseCase_ExpireDue(t *testing.T) {
+	type mocks struct {
+		trManager           *MocktrManager
+		nestedTrManager     *MocktrManager
+		transferRequestRepo *MocktransferRequestRepo
+		employeeRepo        *MockemployeeRepo
+		coinLotRepo         *MockcoinLotRepo
+		coinSending         *MockcoinSending
+	}
+
+	testCases := []struct {
+		name        string
+		prepare     func(m *mocks)
+		wantExpired int
+		wantErr     error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.transferRequestRepo.EXPECT().GetExpiredIDs(gomock.Any(), now).Return([]int64{10, 11}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					}).
+					Times(2)
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&pendingRequest, nil)
+				m.transferRequestRepo.EXPECT().
+					Decide(gomock.Any(), int64(10), model.TransferRequestStatusExpired, int64(0), now).
+					Return(nil)
+				gomock.InOrder(
+					m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil),
+					m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(700)).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(200), int64(400), expireTime).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(200), int64(300), laterExpireTime).Return(nil),
+				)
+				// approved after the expired ids were read
+				approved := withStatus(pendingRequest, model.TransferRequestStatusApproved)
+				approved.ID = 11
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(11)).Return(approved, nil)
+			},
+			wantExpired: 1,
+		},
+		{
+			name: "success.acceptance_accepted",
+			prepare: func(m *mocks) {
+				m.transferRequestRepo.EXPECT().GetExpiredIDs(gomock.Any(), now).Return([]int64{10}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.nestedTrManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&acceptanceRequest, nil)
+				m.transferRequestRepo.EXPECT().
+					Decide(gomock.Any(), int64(10), model.TransferRequestStatusAccepted, int64(0), now).
+					Return(nil)
+				m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil)
+				m.coinSending.EXPECT().SendHeld(gomock.Any(), acceptanceRequest, heldLots).Return(nil)
+			},
+			wantExpired: 1,
+		},
+		{
+			// the savepoint of the acceptance is rolled back, the coins go back to the sender
+			name: "success.acceptance_rejected_by_policy",
+			prepare: func(m *mocks) {
+				m.transferRequestRepo.EXPECT().GetExpiredIDs(gomock.Any(), now).Return([]int64{10}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.nestedTrManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&acceptanceRequest, nil)
+				gomock.InOrder(
+					m.transferRequestRepo.EXPECT().
+						Decide(gomock.Any(), int64(10), model.TransferRequestStatusAccepted, int64(0), now).
+						Return(nil),
+					m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil),
+					m.coinSending.EXPECT().
+						SendHeld(gomock.Any(), acceptanceRequest, heldLots).
+						Return(fmt.Errorf("checkPolicy: %w", model.ErrTransferCooldown)),
+					m.transferRequestRepo.EXPECT().
+						Decide(gomock.Any(), int64(10), model.TransferRequestStatusExpired, int64(0), now).
+						Return(nil),
+				)
+				gomock.InOrder(
+					m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil),
+					m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(700)).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(200), int64(400), expireTime).Return(nil),
+					m.coinLotRepo.EXPECT().Add(gomock.Any(), int64(200), int64(300), laterExpireTime).Return(nil),
+				)
+			},
+			wantExpired: 1,
+		},
+		{
+			name: "error.coin_sending.send_held",
+			prepare: func(m *mocks) {
+				m.transferRequestRepo.EXPECT().GetExpiredIDs(gomock.Any(), now).Return([]int64{10}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.nestedTrManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&acceptanceRequest, nil)
+				m.transferRequestRepo.EXPECT().
+					Decide(gomock.Any(), int64(10), model.TransferRequestStatusAccepted, int64(0), now).
+					Return(nil)
+				m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil)
+				m.coinSending.EXPECT().SendHeld(gomock.Any(), acceptanceRequest, heldLots).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.transfer_request_repo.get_expired_ids",
+			prepare: func(m *mocks) {
+				m.transferRequestRepo.EXPECT().GetExpiredIDs(gomock.Any(), now).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.employee_repo.increase_balance",
+			prepare: func(m *mocks) {
+				m.transferRequestRepo.EXPECT().GetExpiredIDs(gomock.Any(), now).Return([]int64{10}, nil)
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.transferRequestRepo.EXPECT().GetByIDWithLock(gomock.Any(), int64(10)).Return(&pendingRequest, nil)
+				m.transferRequestRepo.EXPECT().
+					Decide(gomock.Any(), int64(10), model.TransferRequestStatusExpired, int64(0), now).
+					Return(nil)
+				m.transferRequestRepo.EXPECT().GetLots(gomock.Any(), int64(10)).Return(heldLots, nil)
+				m.employeeRepo.EXPECT().IncreaseBalance(gomock.Any(), int64(200), int64(700)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:           NewMocktrManager(ctrl),
+				nestedTrManager:     NewMocktrManager(ctrl),
+				transferRequestRepo: NewMocktransferRequestRepo(ctrl),
+				employeeRepo:        NewMockemployeeRepo(ctrl),
+				coinLotRepo:         NewMockcoinLotRepo(ctrl),
+				coinSending:         NewMockcoinSending(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.nestedTrManager, m.transferRequestRepo, m.employeeRepo, m.coinLotRepo,
+				m.coinSending, model.TransferApproval{Threshold: 500, TTL: time.Hour, AcceptanceTTL: 168 * time.Hour})
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			expired, err := uc.ExpireDue(context.Background(), now)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantExpired, expired)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package transfer_approving
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type transferRequestRepo interface {
+	GetBySender(ctx context.Context, senderID int64) ([]model.TransferRequest, error)
+	GetByStatus(
+		ctx context.Context,
+		kind model.TransferRequestKind,
+		status model.TransferRequestStatus,
+	) ([]model.TransferRequest, error)
+	GetPendingByEmployee(ctx context.Context, employeeID int64) ([]model.TransferRequest, error)
+	GetExpiredIDs(ctx context.Context, now time.Time) ([]int64, error)
+	GetByIDWithLock(ctx context.Context, requestID int64) (*model.TransferRequest, error)
+	Create(ctx context.Context, request model.TransferRequest) (*model.TransferRequest, error)
+	MoveLots(ctx context.Context, fromRequestID, toRequestID int64) error
+	GetLots(ctx context.Context, requestID int64) ([]model.CoinLotPart, error)
+	Decide(ctx context.Context, requestID int64, status model.TransferRequestStatus, decidedBy int64, decideTime time.Time) error
+}
+
+type employeeRepo interface {
+	IncreaseBalance(ctx context.Context, employeeID, amount int64) error
+}
+
+type coinLotRepo interface {
+	Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error
+}
+
+type coinSending interface {
+	SendHeld(ctx context.Context, request model.TransferRequest, lots []model.CoinLotPart) error
+}
@@ -0,0 +1,635 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package transfer_approving -typed -destination mock_deps_test.go
+//
+
+// Package transfer_approving is a generated GoMock package.
+package transfer_approving
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktransferRequestRepo is a mock of transferRequestRepo interface.
+type MocktransferRequestRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransferRequestRepoMockRecorder
+}
+
+// MocktransferRequestRepoMockRecorder is the mock recorder for MocktransferRequestRepo.
+type MocktransferRequestRepoMockRecorder struct {
+	mock *MocktransferRequestRepo
+}
+
+// NewMocktransferRequestRepo creates a new mock instance.
+func NewMocktransferRequestRepo(ctrl *gomock.Controller) *MocktransferRequestRepo {
+	mock := &MocktransferRequestRepo{ctrl: ctrl}
+	mock.recorder = &MocktransferRequestRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransferRequestRepo) EXPECT() *MocktransferRequestRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MocktransferRequestRepo) Create(ctx context.Context, request model.TransferRequest) (*model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(*model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MocktransferRequestRepoMockRecorder) Create(ctx, request any) *MocktransferRequestRepoCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktransferRequestRepo)(nil).Create), ctx, request)
+	return &MocktransferRequestRepoCreateCall{Call: call}
+}
+
+// MocktransferRequestRepoCreateCall wrap *gomock.Call
+type MocktransferRequestRepoCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoCreateCall) Return(arg0 *model.TransferRequest, arg1 error) *MocktransferRequestRepoCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoCreateCall) Do(f func(context.Context, model.TransferRequest) (*model.TransferRequest, error)) *MocktransferRequestRepoCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoCreateCall) DoAndReturn(f func(context.Context, model.TransferRequest) (*model.TransferRequest, error)) *MocktransferRequestRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Decide mocks base method.
+func (m *MocktransferRequestRepo) Decide(ctx context.Context, requestID int64, status model.TransferRequestStatus, decidedBy int64, decideTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decide", ctx, requestID, status, decidedBy, decideTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decide indicates an expected call of Decide.
+func (mr *MocktransferRequestRepoMockRecorder) Decide(ctx, requestID, status, decidedBy, decideTime any) *MocktransferRequestRepoDecideCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decide", reflect.TypeOf((*MocktransferRequestRepo)(nil).Decide), ctx, requestID, status, decidedBy, decideTime)
+	return &MocktransferRequestRepoDecideCall{Call: call}
+}
+
+// MocktransferRequestRepoDecideCall wrap *gomock.Call
+type MocktransferRequestRepoDecideCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoDecideCall) Return(arg0 error) *MocktransferRequestRepoDecideCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoDecideCall) Do(f func(context.Context, int64, model.TransferRequestStatus, int64, time.Time) error) *MocktransferRequestRepoDecideCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoDecideCall) DoAndReturn(f func(context.Context, int64, model.TransferRequestStatus, int64, time.Time) error) *MocktransferRequestRepoDecideCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByIDWithLock mocks base method.
+func (m *MocktransferRequestRepo) GetByIDWithLock(ctx context.Context, requestID int64) (*model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDWithLock", ctx, requestID)
+	ret0, _ := ret[0].(*model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDWithLock indicates an expected call of GetByIDWithLock.
+func (mr *MocktransferRequestRepoMockRecorder) GetByIDWithLock(ctx, requestID any) *MocktransferRequestRepoGetByIDWithLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithLock", reflect.TypeOf((*MocktransferRequestRepo)(nil).GetByIDWithLock), ctx, requestID)
+	return &MocktransferRequestRepoGetByIDWithLockCall{Call: call}
+}
+
+// MocktransferRequestRepoGetByIDWithLockCall wrap *gomock.Call
+type MocktransferRequestRepoGetByIDWithLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoGetByIDWithLockCall) Return(arg0 *model.TransferRequest, arg1 error) *MocktransferRequestRepoGetByIDWithLockCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoGetByIDWithLockCall) Do(f func(context.Context, int64) (*model.TransferRequest, error)) *MocktransferRequestRepoGetByIDWithLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoGetByIDWithLockCall) DoAndReturn(f func(context.Context, int64) (*model.TransferRequest, error)) *MocktransferRequestRepoGetByIDWithLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetBySender mocks base method.
+func (m *MocktransferRequestRepo) GetBySender(ctx context.Context, senderID int64) ([]model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySender", ctx, senderID)
+	ret0, _ := ret[0].([]model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySender indicates an expected call of GetBySender.
+func (mr *MocktransferRequestRepoMockRecorder) GetBySender(ctx, senderID any) *MocktransferRequestRepoGetBySenderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySender", reflect.TypeOf((*MocktransferRequestRepo)(nil).GetBySender), ctx, senderID)
+	return &MocktransferRequestRepoGetBySenderCall{Call: call}
+}
+
+// MocktransferRequestRepoGetBySenderCall wrap *gomock.Call
+type MocktransferRequestRepoGetBySenderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoGetBySenderCall) Return(arg0 []model.TransferRequest, arg1 error) *MocktransferRequestRepoGetBySenderCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoGetBySenderCall) Do(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferRequestRepoGetBySenderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoGetBySenderCall) DoAndReturn(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferRequestRepoGetBySenderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByStatus mocks base method.
+func (m *MocktransferRequestRepo) GetByStatus(ctx context.Context, kind model.TransferRequestKind, status model.TransferRequestStatus) ([]model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByStatus", ctx, kind, status)
+	ret0, _ := ret[0].([]model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByStatus indicates an expected call of GetByStatus.
+func (mr *MocktransferRequestRepoMockRecorder) GetByStatus(ctx, kind, status any) *MocktransferRequestRepoGetByStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByStatus", reflect.TypeOf((*MocktransferRequestRepo)(nil).GetByStatus), ctx, kind, status)
+	return &MocktransferRequestRepoGetByStatusCall{Call: call}
+}
+
+// MocktransferRequestRepoGetByStatusCall wrap *gomock.Call
+type MocktransferRequestRepoGetByStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoGetByStatusCall) Return(arg0 []model.TransferRequest, arg1 error) *MocktransferRequestRepoGetByStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoGetByStatusCall) Do(f func(context.Context, model.TransferRequestKind, model.TransferRequestStatus) ([]model.TransferRequest, error)) *MocktransferRequestRepoGetByStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoGetByStatusCall) DoAndReturn(f func(context.Context, model.TransferRequestKind, model.TransferRequestStatus) ([]model.TransferRequest, error)) *MocktransferRequestRepoGetByStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetExpiredIDs mocks base method.
+func (m *MocktransferRequestRepo) GetExpiredIDs(ctx context.Context, now time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredIDs", ctx, now)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredIDs indicates an expected call of GetExpiredIDs.
+func (mr *MocktransferRequestRepoMockRecorder) GetExpiredIDs(ctx, now any) *MocktransferRequestRepoGetExpiredIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredIDs", reflect.TypeOf((*MocktransferRequestRepo)(nil).GetExpiredIDs), ctx, now)
+	return &MocktransferRequestRepoGetExpiredIDsCall{Call: call}
+}
+
+// MocktransferRequestRepoGetExpiredIDsCall wrap *gomock.Call
+type MocktransferRequestRepoGetExpiredIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoGetExpiredIDsCall) Return(arg0 []int64, arg1 error) *MocktransferRequestRepoGetExpiredIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoGetExpiredIDsCall) Do(f func(context.Context, time.Time) ([]int64, error)) *MocktransferRequestRepoGetExpiredIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoGetExpiredIDsCall) DoAndReturn(f func(context.Context, time.Time) ([]int64, error)) *MocktransferRequestRepoGetExpiredIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetLots mocks base method.
+func (m *MocktransferRequestRepo) GetLots(ctx context.Context, requestID int64) ([]model.CoinLotPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLots", ctx, requestID)
+	ret0, _ := ret[0].([]model.CoinLotPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLots indicates an expected call of GetLots.
+func (mr *MocktransferRequestRepoMockRecorder) GetLots(ctx, requestID any) *MocktransferRequestRepoGetLotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLots", reflect.TypeOf((*MocktransferRequestRepo)(nil).GetLots), ctx, requestID)
+	return &MocktransferRequestRepoGetLotsCall{Call: call}
+}
+
+// MocktransferRequestRepoGetLotsCall wrap *gomock.Call
+type MocktransferRequestRepoGetLotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoGetLotsCall) Return(arg0 []model.CoinLotPart, arg1 error) *MocktransferRequestRepoGetLotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoGetLotsCall) Do(f func(context.Context, int64) ([]model.CoinLotPart, error)) *MocktransferRequestRepoGetLotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoGetLotsCall) DoAndReturn(f func(context.Context, int64) ([]model.CoinLotPart, error)) *MocktransferRequestRepoGetLotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPendingByEmployee mocks base method.
+func (m *MocktransferRequestRepo) GetPendingByEmployee(ctx context.Context, employeeID int64) ([]model.TransferRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByEmployee", ctx, employeeID)
+	ret0, _ := ret[0].([]model.TransferRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByEmployee indicates an expected call of GetPendingByEmployee.
+func (mr *MocktransferRequestRepoMockRecorder) GetPendingByEmployee(ctx, employeeID any) *MocktransferRequestRepoGetPendingByEmployeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByEmployee", reflect.TypeOf((*MocktransferRequestRepo)(nil).GetPendingByEmployee), ctx, employeeID)
+	return &MocktransferRequestRepoGetPendingByEmployeeCall{Call: call}
+}
+
+// MocktransferRequestRepoGetPendingByEmployeeCall wrap *gomock.Call
+type MocktransferRequestRepoGetPendingByEmployeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoGetPendingByEmployeeCall) Return(arg0 []model.TransferRequest, arg1 error) *MocktransferRequestRepoGetPendingByEmployeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoGetPendingByEmployeeCall) Do(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferRequestRepoGetPendingByEmployeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoGetPendingByEmployeeCall) DoAndReturn(f func(context.Context, int64) ([]model.TransferRequest, error)) *MocktransferRequestRepoGetPendingByEmployeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MoveLots mocks base method.
+func (m *MocktransferRequestRepo) MoveLots(ctx context.Context, fromRequestID, toRequestID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveLots", ctx, fromRequestID, toRequestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveLots indicates an expected call of MoveLots.
+func (mr *MocktransferRequestRepoMockRecorder) MoveLots(ctx, fromRequestID, toRequestID any) *MocktransferRequestRepoMoveLotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveLots", reflect.TypeOf((*MocktransferRequestRepo)(nil).MoveLots), ctx, fromRequestID, toRequestID)
+	return &MocktransferRequestRepoMoveLotsCall{Call: call}
+}
+
+// MocktransferRequestRepoMoveLotsCall wrap *gomock.Call
+type MocktransferRequestRepoMoveLotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktransferRequestRepoMoveLotsCall) Return(arg0 error) *MocktransferRequestRepoMoveLotsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktransferRequestRepoMoveLotsCall) Do(f func(context.Context, int64, int64) error) *MocktransferRequestRepoMoveLotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktransferRequestRepoMoveLotsCall) DoAndReturn(f func(context.Context, int64, int64) error) *MocktransferRequestRepoMoveLotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// IncreaseBalance mocks base method.
+func (m *MockemployeeRepo) IncreaseBalance(ctx context.Context, employeeID, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseBalance", ctx, employeeID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseBalance indicates an expected call of IncreaseBalance.
+func (mr *MockemployeeRepoMockRecorder) IncreaseBalance(ctx, employeeID, amount any) *MockemployeeRepoIncreaseBalanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseBalance", reflect.TypeOf((*MockemployeeRepo)(nil).IncreaseBalance), ctx, employeeID, amount)
+	return &MockemployeeRepoIncreaseBalanceCall{Call: call}
+}
+
+// MockemployeeRepoIncreaseBalanceCall wrap *gomock.Call
+type MockemployeeRepoIncreaseBalanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoIncreaseBalanceCall) Return(arg0 error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoIncreaseBalanceCall) Do(f func(context.Context, int64, int64) error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoIncreaseBalanceCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockemployeeRepoIncreaseBalanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockcoinLotRepo) Add(ctx context.Context, employeeID, amount int64, expireTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, amount, expireTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockcoinLotRepoMockRecorder) Add(ctx, employeeID, amount, expireTime any) *MockcoinLotRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockcoinLotRepo)(nil).Add), ctx, employeeID, amount, expireTime)
+	return &MockcoinLotRepoAddCall{Call: call}
+}
+
+// MockcoinLotRepoAddCall wrap *gomock.Call
+type MockcoinLotRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoAddCall) Return(arg0 error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoAddCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockcoinLotRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinSending is a mock of coinSending interface.
+type MockcoinSending struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinSendingMockRecorder
+}
+
+// MockcoinSendingMockRecorder is the mock recorder for MockcoinSending.
+type MockcoinSendingMockRecorder struct {
+	mock *MockcoinSending
+}
+
+// NewMockcoinSending creates a new mock instance.
+func NewMockcoinSending(ctrl *gomock.Controller) *MockcoinSending {
+	mock := &MockcoinSending{ctrl: ctrl}
+	mock.recorder = &MockcoinSendingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinSending) EXPECT() *MockcoinSendingMockRecorder {
+	return m.recorder
+}
+
+// SendHeld mocks base method.
+func (m *MockcoinSending) SendHeld(ctx context.Context, request model.TransferRequest, lots []model.CoinLotPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeld", ctx, request, lots)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeld indicates an expected call of SendHeld.
+func (mr *MockcoinSendingMockRecorder) SendHeld(ctx, request, lots any) *MockcoinSendingSendHeldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeld", reflect.TypeOf((*MockcoinSending)(nil).SendHeld), ctx, request, lots)
+	return &MockcoinSendingSendHeldCall{Call: call}
+}
+
+// MockcoinSendingSendHeldCall wrap *gomock.Call
+type MockcoinSendingSendHeldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinSendingSendHeldCall) Return(arg0 error) *MockcoinSendingSendHeldCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinSendingSendHeldCall) Do(f func(context.Context, model.TransferRequest, []model.CoinLotPart) error) *MockcoinSendingSendHeldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinSendingSendHeldCall) DoAndReturn(f func(context.Context, model.TransferRequest, []model.CoinLotPart) error) *MockcoinSendingSendHeldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
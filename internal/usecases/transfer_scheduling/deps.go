@@ -0,0 +1,24 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package transfer_scheduling
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type employeeRepo interface {
+	GetByUsername(ctx context.Context, username string) (*model.Employee, error)
+}
+
+type scheduledTransferRepo interface {
+	Create(ctx context.Context, st model.ScheduledTransfer) (*model.ScheduledTransfer, error)
+	GetByID(ctx context.Context, id int64) (*model.ScheduledTransfer, error)
+	GetByOwner(ctx context.Context, ownerID int64) ([]model.ScheduledTransfer, error)
+	Update(ctx context.Context, st model.ScheduledTransfer) error
+	Delete(ctx context.Context, id, ownerID int64) error
+}
+
+type scheduledTransferRunRepo interface {
+	GetLastByScheduledTransfer(ctx context.Context, scheduledTransferID int64, limit int) ([]model.ScheduledTransferRun, error)
+}
@@ -0,0 +1,358 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package transfer_scheduling -typed -destination mock_deps_test.go
+//
+
+// Package transfer_scheduling is a generated GoMock package.
+package transfer_scheduling
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByUsername mocks base method.
+func (m *MockemployeeRepo) GetByUsername(ctx context.Context, username string) (*model.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUsername", ctx, username)
+	ret0, _ := ret[0].(*model.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUsername indicates an expected call of GetByUsername.
+func (mr *MockemployeeRepoMockRecorder) GetByUsername(ctx, username any) *MockemployeeRepoGetByUsernameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockemployeeRepo)(nil).GetByUsername), ctx, username)
+	return &MockemployeeRepoGetByUsernameCall{Call: call}
+}
+
+// MockemployeeRepoGetByUsernameCall wrap *gomock.Call
+type MockemployeeRepoGetByUsernameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoGetByUsernameCall) Return(arg0 *model.Employee, arg1 error) *MockemployeeRepoGetByUsernameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoGetByUsernameCall) Do(f func(context.Context, string) (*model.Employee, error)) *MockemployeeRepoGetByUsernameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoGetByUsernameCall) DoAndReturn(f func(context.Context, string) (*model.Employee, error)) *MockemployeeRepoGetByUsernameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockscheduledTransferRepo is a mock of scheduledTransferRepo interface.
+type MockscheduledTransferRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduledTransferRepoMockRecorder
+}
+
+// MockscheduledTransferRepoMockRecorder is the mock recorder for MockscheduledTransferRepo.
+type MockscheduledTransferRepoMockRecorder struct {
+	mock *MockscheduledTransferRepo
+}
+
+// NewMockscheduledTransferRepo creates a new mock instance.
+func NewMockscheduledTransferRepo(ctrl *gomock.Controller) *MockscheduledTransferRepo {
+	mock := &MockscheduledTransferRepo{ctrl: ctrl}
+	mock.recorder = &MockscheduledTransferRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduledTransferRepo) EXPECT() *MockscheduledTransferRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockscheduledTransferRepo) Create(ctx context.Context, st model.ScheduledTransfer) (*model.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, st)
+	ret0, _ := ret[0].(*model.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockscheduledTransferRepoMockRecorder) Create(ctx, st any) *MockscheduledTransferRepoCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockscheduledTransferRepo)(nil).Create), ctx, st)
+	return &MockscheduledTransferRepoCreateCall{Call: call}
+}
+
+// MockscheduledTransferRepoCreateCall wrap *gomock.Call
+type MockscheduledTransferRepoCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRepoCreateCall) Return(arg0 *model.ScheduledTransfer, arg1 error) *MockscheduledTransferRepoCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRepoCreateCall) Do(f func(context.Context, model.ScheduledTransfer) (*model.ScheduledTransfer, error)) *MockscheduledTransferRepoCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRepoCreateCall) DoAndReturn(f func(context.Context, model.ScheduledTransfer) (*model.ScheduledTransfer, error)) *MockscheduledTransferRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockscheduledTransferRepo) Delete(ctx context.Context, id, ownerID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockscheduledTransferRepoMockRecorder) Delete(ctx, id, ownerID any) *MockscheduledTransferRepoDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockscheduledTransferRepo)(nil).Delete), ctx, id, ownerID)
+	return &MockscheduledTransferRepoDeleteCall{Call: call}
+}
+
+// MockscheduledTransferRepoDeleteCall wrap *gomock.Call
+type MockscheduledTransferRepoDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRepoDeleteCall) Return(arg0 error) *MockscheduledTransferRepoDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRepoDeleteCall) Do(f func(context.Context, int64, int64) error) *MockscheduledTransferRepoDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRepoDeleteCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockscheduledTransferRepoDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockscheduledTransferRepo) GetByID(ctx context.Context, id int64) (*model.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockscheduledTransferRepoMockRecorder) GetByID(ctx, id any) *MockscheduledTransferRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockscheduledTransferRepo)(nil).GetByID), ctx, id)
+	return &MockscheduledTransferRepoGetByIDCall{Call: call}
+}
+
+// MockscheduledTransferRepoGetByIDCall wrap *gomock.Call
+type MockscheduledTransferRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRepoGetByIDCall) Return(arg0 *model.ScheduledTransfer, arg1 error) *MockscheduledTransferRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRepoGetByIDCall) Do(f func(context.Context, int64) (*model.ScheduledTransfer, error)) *MockscheduledTransferRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRepoGetByIDCall) DoAndReturn(f func(context.Context, int64) (*model.ScheduledTransfer, error)) *MockscheduledTransferRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByOwner mocks base method.
+func (m *MockscheduledTransferRepo) GetByOwner(ctx context.Context, ownerID int64) ([]model.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOwner", ctx, ownerID)
+	ret0, _ := ret[0].([]model.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOwner indicates an expected call of GetByOwner.
+func (mr *MockscheduledTransferRepoMockRecorder) GetByOwner(ctx, ownerID any) *MockscheduledTransferRepoGetByOwnerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwner", reflect.TypeOf((*MockscheduledTransferRepo)(nil).GetByOwner), ctx, ownerID)
+	return &MockscheduledTransferRepoGetByOwnerCall{Call: call}
+}
+
+// MockscheduledTransferRepoGetByOwnerCall wrap *gomock.Call
+type MockscheduledTransferRepoGetByOwnerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRepoGetByOwnerCall) Return(arg0 []model.ScheduledTransfer, arg1 error) *MockscheduledTransferRepoGetByOwnerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRepoGetByOwnerCall) Do(f func(context.Context, int64) ([]model.ScheduledTransfer, error)) *MockscheduledTransferRepoGetByOwnerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRepoGetByOwnerCall) DoAndReturn(f func(context.Context, int64) ([]model.ScheduledTransfer, error)) *MockscheduledTransferRepoGetByOwnerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockscheduledTransferRepo) Update(ctx context.Context, st model.ScheduledTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, st)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockscheduledTransferRepoMockRecorder) Update(ctx, st any) *MockscheduledTransferRepoUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockscheduledTransferRepo)(nil).Update), ctx, st)
+	return &MockscheduledTransferRepoUpdateCall{Call: call}
+}
+
+// MockscheduledTransferRepoUpdateCall wrap *gomock.Call
+type MockscheduledTransferRepoUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRepoUpdateCall) Return(arg0 error) *MockscheduledTransferRepoUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRepoUpdateCall) Do(f func(context.Context, model.ScheduledTransfer) error) *MockscheduledTransferRepoUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRepoUpdateCall) DoAndReturn(f func(context.Context, model.ScheduledTransfer) error) *MockscheduledTransferRepoUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockscheduledTransferRunRepo is a mock of scheduledTransferRunRepo interface.
+type MockscheduledTransferRunRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduledTransferRunRepoMockRecorder
+}
+
+// MockscheduledTransferRunRepoMockRecorder is the mock recorder for MockscheduledTransferRunRepo.
+type MockscheduledTransferRunRepoMockRecorder struct {
+	mock *MockscheduledTransferRunRepo
+}
+
+// NewMockscheduledTransferRunRepo creates a new mock instance.
+func NewMockscheduledTransferRunRepo(ctrl *gomock.Controller) *MockscheduledTransferRunRepo {
+	mock := &MockscheduledTransferRunRepo{ctrl: ctrl}
+	mock.recorder = &MockscheduledTransferRunRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduledTransferRunRepo) EXPECT() *MockscheduledTransferRunRepoMockRecorder {
+	return m.recorder
+}
+
+// GetLastByScheduledTransfer mocks base method.
+func (m *MockscheduledTransferRunRepo) GetLastByScheduledTransfer(ctx context.Context, scheduledTransferID int64, limit int) ([]model.ScheduledTransferRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastByScheduledTransfer", ctx, scheduledTransferID, limit)
+	ret0, _ := ret[0].([]model.ScheduledTransferRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastByScheduledTransfer indicates an expected call of GetLastByScheduledTransfer.
+func (mr *MockscheduledTransferRunRepoMockRecorder) GetLastByScheduledTransfer(ctx, scheduledTransferID, limit any) *MockscheduledTransferRunRepoGetLastByScheduledTransferCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastByScheduledTransfer", reflect.TypeOf((*MockscheduledTransferRunRepo)(nil).GetLastByScheduledTransfer), ctx, scheduledTransferID, limit)
+	return &MockscheduledTransferRunRepoGetLastByScheduledTransferCall{Call: call}
+}
+
+// MockscheduledTransferRunRepoGetLastByScheduledTransferCall wrap *gomock.Call
+type MockscheduledTransferRunRepoGetLastByScheduledTransferCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockscheduledTransferRunRepoGetLastByScheduledTransferCall) Return(arg0 []model.ScheduledTransferRun, arg1 error) *MockscheduledTransferRunRepoGetLastByScheduledTransferCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockscheduledTransferRunRepoGetLastByScheduledTransferCall) Do(f func(context.Context, int64, int) ([]model.ScheduledTransferRun, error)) *MockscheduledTransferRunRepoGetLastByScheduledTransferCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockscheduledTransferRunRepoGetLastByScheduledTransferCall) DoAndReturn(f func(context.Context, int64, int) ([]model.ScheduledTransferRun, error)) *MockscheduledTransferRunRepoGetLastByScheduledTransferCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
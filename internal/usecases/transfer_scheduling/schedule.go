@@ -0,0 +1,176 @@
+package transfer_scheduling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/infrastructure/cron"
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+const lastRunsLimit = 20
+
+type UseCase struct {
+	employeeRepo             employeeRepo
+	scheduledTransferRepo    scheduledTransferRepo
+	scheduledTransferRunRepo scheduledTransferRunRepo
+	now                      func() time.Time
+}
+
+func New(
+	employeeRepo employeeRepo,
+	scheduledTransferRepo scheduledTransferRepo,
+	scheduledTransferRunRepo scheduledTransferRunRepo,
+) (*UseCase, error) {
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+	if scheduledTransferRepo == nil {
+		return nil, errors.New("scheduledTransferRepo is nil")
+	}
+	if scheduledTransferRunRepo == nil {
+		return nil, errors.New("scheduledTransferRunRepo is nil")
+	}
+
+	return &UseCase{
+		employeeRepo:             employeeRepo,
+		scheduledTransferRepo:    scheduledTransferRepo,
+		scheduledTransferRunRepo: scheduledTransferRunRepo,
+		now:                      time.Now,
+	}, nil
+}
+
+func (uc *UseCase) Create(
+	ctx context.Context,
+	ownerID int64,
+	params model.ScheduledTransferParams,
+) (*model.ScheduledTransfer, error) {
+	st, err := uc.build(ctx, ownerID, params)
+	if err != nil {
+		return nil, fmt.Errorf("build: %w", err)
+	}
+
+	created, err := uc.scheduledTransferRepo.Create(ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("scheduledTransferRepo.Create: %w", err)
+	}
+
+	return created, nil
+}
+
+func (uc *UseCase) List(ctx context.Context, ownerID int64) ([]model.ScheduledTransfer, error) {
+	scheduledTransfers, err := uc.scheduledTransferRepo.GetByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("scheduledTransferRepo.GetByOwner: %w", err)
+	}
+
+	return scheduledTransfers, nil
+}
+
+// Get returns the scheduled transfer together with its latest runs, newest first.
+func (uc *UseCase) Get(
+	ctx context.Context,
+	ownerID, id int64,
+) (*model.ScheduledTransfer, []model.ScheduledTransferRun, error) {
+	st, err := uc.scheduledTransferRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("scheduledTransferRepo.GetByID: %w", err)
+	}
+
+	// do not reveal other employees' schedules
+	if st.OwnerID != ownerID {
+		return nil, nil, model.ErrScheduledTransferNotFound
+	}
+
+	runs, err := uc.scheduledTransferRunRepo.GetLastByScheduledTransfer(ctx, id, lastRunsLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("scheduledTransferRunRepo.GetLastByScheduledTransfer: %w", err)
+	}
+
+	return st, runs, nil
+}
+
+func (uc *UseCase) Update(
+	ctx context.Context,
+	ownerID, id int64,
+	params model.ScheduledTransferParams,
+) (*model.ScheduledTransfer, error) {
+	st, err := uc.build(ctx, ownerID, params)
+	if err != nil {
+		return nil, fmt.Errorf("build: %w", err)
+	}
+	st.ID = id
+
+	err = uc.scheduledTransferRepo.Update(ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("scheduledTransferRepo.Update: %w", err)
+	}
+
+	return &st, nil
+}
+
+func (uc *UseCase) Delete(ctx context.Context, ownerID, id int64) error {
+	err := uc.scheduledTransferRepo.Delete(ctx, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("scheduledTransferRepo.Delete: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *UseCase) build(
+	ctx context.Context,
+	ownerID int64,
+	params model.ScheduledTransferParams,
+) (model.ScheduledTransfer, error) {
+	if params.Amount <= 0 {
+		return model.ScheduledTransfer{}, model.ErrInvalidAmount
+	}
+
+	nextRunTime, err := uc.firstRunTime(params)
+	if err != nil {
+		return model.ScheduledTransfer{}, err
+	}
+
+	receiver, err := uc.employeeRepo.GetByUsername(ctx, params.ReceiverUsername)
+	if err != nil {
+		return model.ScheduledTransfer{}, fmt.Errorf("employeeRepo.GetByUsername: %w", err)
+	}
+
+	if receiver.ID == ownerID {
+		return model.ScheduledTransfer{}, model.ErrSendingCoinsToMyselfNotAllowed
+	}
+
+	return model.ScheduledTransfer{
+		OwnerID:          ownerID,
+		ReceiverID:       receiver.ID,
+		ReceiverUsername: receiver.Username,
+		Amount:           params.Amount,
+		CronExpression:   params.CronExpression,
+		NextRunTime:      &nextRunTime,
+		IsActive:         params.IsActive,
+	}, nil
+}
+
+func (uc *UseCase) firstRunTime(params model.ScheduledTransferParams) (time.Time, error) {
+	switch {
+	case params.RunAt != nil && params.CronExpression == "":
+		return params.RunAt.UTC(), nil
+	case params.RunAt == nil && params.CronExpression != "":
+		schedule, err := cron.Parse(params.CronExpression)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %w", model.ErrInvalidSchedule, err)
+		}
+
+		next, err := schedule.Next(uc.now().UTC())
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %w", model.ErrInvalidSchedule, err)
+		}
+
+		return next, nil
+	default:
+		return time.Time{}, fmt.Errorf("%w: exactly one of runAt and cron should be set", model.ErrInvalidSchedule)
+	}
+}
@@ -0,0 +1,297 @@
+package transfer_scheduling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_Create(t *testing.T) {
+	// 2025-02-12 is Wednesday
+	now := time.Date(2025, 2, 12, 10, 30, 0, 0, time.UTC)
+
+	type mocks struct {
+		employeeRepo             *MockemployeeRepo
+		scheduledTransferRepo    *MockscheduledTransferRepo
+		scheduledTransferRunRepo *MockscheduledTransferRunRepo
+	}
+
+	friday := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	runAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		params  model.ScheduledTransferParams
+		wantErr error
+	}{
+		{
+			name: "success.cron",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "intern").
+					Return(&model.Employee{ID: 200, Username: "intern"}, nil)
+				m.scheduledTransferRepo.EXPECT().
+					Create(gomock.Any(), model.ScheduledTransfer{
+						OwnerID:          100,
+						ReceiverID:       200,
+						ReceiverUsername: "intern",
+						Amount:           50,
+						CronExpression:   "0 12 * * 5",
+						NextRunTime:      &friday,
+						IsActive:         true,
+					}).
+					Return(&model.ScheduledTransfer{ID: 1}, nil)
+			},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "intern",
+				Amount:           50,
+				CronExpression:   "0 12 * * 5",
+				IsActive:         true,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success.one_off",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "intern").
+					Return(&model.Employee{ID: 200, Username: "intern"}, nil)
+				m.scheduledTransferRepo.EXPECT().
+					Create(gomock.Any(), model.ScheduledTransfer{
+						OwnerID:          100,
+						ReceiverID:       200,
+						ReceiverUsername: "intern",
+						Amount:           50,
+						NextRunTime:      &runAt,
+						IsActive:         true,
+					}).
+					Return(&model.ScheduledTransfer{ID: 1}, nil)
+			},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "intern",
+				Amount:           50,
+				RunAt:            &runAt,
+				IsActive:         true,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "error.InvalidAmount",
+			prepare: func(_ *mocks) {},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "intern",
+				Amount:           0,
+				CronExpression:   "0 12 * * 5",
+			},
+			wantErr: model.ErrInvalidAmount,
+		},
+		{
+			name:    "error.InvalidSchedule.both",
+			prepare: func(_ *mocks) {},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "intern",
+				Amount:           50,
+				RunAt:            &runAt,
+				CronExpression:   "0 12 * * 5",
+			},
+			wantErr: model.ErrInvalidSchedule,
+		},
+		{
+			name:    "error.InvalidSchedule.none",
+			prepare: func(_ *mocks) {},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "intern",
+				Amount:           50,
+			},
+			wantErr: model.ErrInvalidSchedule,
+		},
+		{
+			name:    "error.InvalidSchedule.cron",
+			prepare: func(_ *mocks) {},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "intern",
+				Amount:           50,
+				CronExpression:   "every friday",
+			},
+			wantErr: model.ErrInvalidSchedule,
+		},
+		{
+			name: "error.SendingCoinsToMyselfNotAllowed",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "me").
+					Return(&model.Employee{ID: 100, Username: "me"}, nil)
+			},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "me",
+				Amount:           50,
+				CronExpression:   "0 12 * * 5",
+			},
+			wantErr: model.ErrSendingCoinsToMyselfNotAllowed,
+		},
+		{
+			name: "error.employeeRepo.GetByUsername",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "nobody").
+					Return(nil, model.ErrEmployeeNotFound)
+			},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "nobody",
+				Amount:           50,
+				CronExpression:   "0 12 * * 5",
+			},
+			wantErr: model.ErrEmployeeNotFound,
+		},
+		{
+			name: "error.scheduledTransferRepo.Create",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().
+					GetByUsername(gomock.Any(), "intern").
+					Return(&model.Employee{ID: 200, Username: "intern"}, nil)
+				m.scheduledTransferRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			params: model.ScheduledTransferParams{
+				ReceiverUsername: "intern",
+				Amount:           50,
+				CronExpression:   "0 12 * * 5",
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				employeeRepo:             NewMockemployeeRepo(ctrl),
+				scheduledTransferRepo:    NewMockscheduledTransferRepo(ctrl),
+				scheduledTransferRunRepo: NewMockscheduledTransferRunRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.employeeRepo, m.scheduledTransferRepo, m.scheduledTransferRunRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			_, err = uc.Create(context.Background(), 100, tc.params)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_Get(t *testing.T) {
+	// 2025-02-12 is Wednesday
+	now := time.Date(2025, 2, 12, 10, 30, 0, 0, time.UTC)
+
+	type mocks struct {
+		employeeRepo             *MockemployeeRepo
+		scheduledTransferRepo    *MockscheduledTransferRepo
+		scheduledTransferRunRepo *MockscheduledTransferRunRepo
+	}
+
+	testCases := []struct {
+		name     string
+		prepare  func(m *mocks)
+		wantRuns []model.ScheduledTransferRun
+		wantErr  error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.scheduledTransferRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1)).
+					Return(&model.ScheduledTransfer{ID: 1, OwnerID: 100}, nil)
+				m.scheduledTransferRunRepo.EXPECT().
+					GetLastByScheduledTransfer(gomock.Any(), int64(1), lastRunsLimit).
+					Return([]model.ScheduledTransferRun{{ID: 5, Status: model.ScheduledTransferRunStatusSuccess}}, nil)
+			},
+			wantRuns: []model.ScheduledTransferRun{{ID: 5, Status: model.ScheduledTransferRunStatusSuccess}},
+			wantErr:  nil,
+		},
+		{
+			name: "error.other_owner",
+			prepare: func(m *mocks) {
+				m.scheduledTransferRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1)).
+					Return(&model.ScheduledTransfer{ID: 1, OwnerID: 300}, nil)
+			},
+			wantErr: model.ErrScheduledTransferNotFound,
+		},
+		{
+			name: "error.scheduledTransferRunRepo.GetLastByScheduledTransfer",
+			prepare: func(m *mocks) {
+				m.scheduledTransferRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1)).
+					Return(&model.ScheduledTransfer{ID: 1, OwnerID: 100}, nil)
+				m.scheduledTransferRunRepo.EXPECT().
+					GetLastByScheduledTransfer(gomock.Any(), int64(1), lastRunsLimit).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				employeeRepo:             NewMockemployeeRepo(ctrl),
+				scheduledTransferRepo:    NewMockscheduledTransferRepo(ctrl),
+				scheduledTransferRunRepo: NewMockscheduledTransferRunRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.employeeRepo, m.scheduledTransferRepo, m.scheduledTransferRunRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			_, runs, err := uc.Get(context.Background(), 100, 1)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRuns, runs)
+		})
+	}
+}
+
+func TestUseCase_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	employeeRepo := NewMockemployeeRepo(ctrl)
+	scheduledTransferRepo := NewMockscheduledTransferRepo(ctrl)
+	scheduledTransferRunRepo := NewMockscheduledTransferRunRepo(ctrl)
+
+	employeeRepo.EXPECT().
+		GetByUsername(gomock.Any(), "intern").
+		Return(&model.Employee{ID: 200, Username: "intern"}, nil)
+	scheduledTransferRepo.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, st model.ScheduledTransfer) error {
+			require.Equal(t, int64(1), st.ID)
+			require.Equal(t, int64(100), st.OwnerID)
+			require.False(t, st.IsActive)
+			return model.ErrScheduledTransferNotFound
+		})
+
+	uc, err := New(employeeRepo, scheduledTransferRepo, scheduledTransferRunRepo)
+	require.NoError(t, err)
+
+	_, err = uc.Update(context.Background(), 100, 1, model.ScheduledTransferParams{
+		ReceiverUsername: "intern",
+		Amount:           50,
+		CronExpression:   "0 12 * * 5",
+		IsActive:         false,
+	})
+	require.ErrorIs(t, err, model.ErrScheduledTransferNotFound)
+}
@@ -0,0 +1,27 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package wishlist_managing
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type wishlistRepo interface {
+	GetByEmployee(ctx context.Context, employeeID int64) ([]model.WishlistItem, error)
+	Add(ctx context.Context, employeeID, merchID, price int64) error
+	Remove(ctx context.Context, employeeID, merchID int64) error
+}
+
+type merchRepo interface {
+	GetByName(ctx context.Context, name string) (*model.Merch, error)
+}
+
+type employeeRepo interface {
+	GetByID(ctx context.Context, employeeID int64) (*model.Employee, error)
+}
+
+type coinLotRepo interface {
+	GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error)
+}
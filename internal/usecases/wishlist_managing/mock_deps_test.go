@@ -0,0 +1,343 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package wishlist_managing -typed -destination mock_deps_test.go
+//
+
+// Package wishlist_managing is a generated GoMock package.
+package wishlist_managing
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-shop/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwishlistRepo is a mock of wishlistRepo interface.
+type MockwishlistRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockwishlistRepoMockRecorder
+}
+
+// MockwishlistRepoMockRecorder is the mock recorder for MockwishlistRepo.
+type MockwishlistRepoMockRecorder struct {
+	mock *MockwishlistRepo
+}
+
+// NewMockwishlistRepo creates a new mock instance.
+func NewMockwishlistRepo(ctrl *gomock.Controller) *MockwishlistRepo {
+	mock := &MockwishlistRepo{ctrl: ctrl}
+	mock.recorder = &MockwishlistRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwishlistRepo) EXPECT() *MockwishlistRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockwishlistRepo) Add(ctx context.Context, employeeID, merchID, price int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, employeeID, merchID, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockwishlistRepoMockRecorder) Add(ctx, employeeID, merchID, price any) *MockwishlistRepoAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockwishlistRepo)(nil).Add), ctx, employeeID, merchID, price)
+	return &MockwishlistRepoAddCall{Call: call}
+}
+
+// MockwishlistRepoAddCall wrap *gomock.Call
+type MockwishlistRepoAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwishlistRepoAddCall) Return(arg0 error) *MockwishlistRepoAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwishlistRepoAddCall) Do(f func(context.Context, int64, int64, int64) error) *MockwishlistRepoAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwishlistRepoAddCall) DoAndReturn(f func(context.Context, int64, int64, int64) error) *MockwishlistRepoAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByEmployee mocks base method.
+func (m *MockwishlistRepo) GetByEmployee(ctx context.Context, employeeID int64) ([]model.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmployee", ctx, employeeID)
+	ret0, _ := ret[0].([]model.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmployee indicates an expected call of GetByEmployee.
+func (mr *MockwishlistRepoMockRecorder) GetByEmployee(ctx, employeeID any) *MockwishlistRepoGetByEmployeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmployee", reflect.TypeOf((*MockwishlistRepo)(nil).GetByEmployee), ctx, employeeID)
+	return &MockwishlistRepoGetByEmployeeCall{Call: call}
+}
+
+// MockwishlistRepoGetByEmployeeCall wrap *gomock.Call
+type MockwishlistRepoGetByEmployeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwishlistRepoGetByEmployeeCall) Return(arg0 []model.WishlistItem, arg1 error) *MockwishlistRepoGetByEmployeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwishlistRepoGetByEmployeeCall) Do(f func(context.Context, int64) ([]model.WishlistItem, error)) *MockwishlistRepoGetByEmployeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwishlistRepoGetByEmployeeCall) DoAndReturn(f func(context.Context, int64) ([]model.WishlistItem, error)) *MockwishlistRepoGetByEmployeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Remove mocks base method.
+func (m *MockwishlistRepo) Remove(ctx context.Context, employeeID, merchID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, employeeID, merchID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockwishlistRepoMockRecorder) Remove(ctx, employeeID, merchID any) *MockwishlistRepoRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockwishlistRepo)(nil).Remove), ctx, employeeID, merchID)
+	return &MockwishlistRepoRemoveCall{Call: call}
+}
+
+// MockwishlistRepoRemoveCall wrap *gomock.Call
+type MockwishlistRepoRemoveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwishlistRepoRemoveCall) Return(arg0 error) *MockwishlistRepoRemoveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwishlistRepoRemoveCall) Do(f func(context.Context, int64, int64) error) *MockwishlistRepoRemoveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwishlistRepoRemoveCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockwishlistRepoRemoveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockmerchRepo is a mock of merchRepo interface.
+type MockmerchRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockmerchRepoMockRecorder
+}
+
+// MockmerchRepoMockRecorder is the mock recorder for MockmerchRepo.
+type MockmerchRepoMockRecorder struct {
+	mock *MockmerchRepo
+}
+
+// NewMockmerchRepo creates a new mock instance.
+func NewMockmerchRepo(ctrl *gomock.Controller) *MockmerchRepo {
+	mock := &MockmerchRepo{ctrl: ctrl}
+	mock.recorder = &MockmerchRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmerchRepo) EXPECT() *MockmerchRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByName mocks base method.
+func (m *MockmerchRepo) GetByName(ctx context.Context, name string) (*model.Merch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(*model.Merch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockmerchRepoMockRecorder) GetByName(ctx, name any) *MockmerchRepoGetByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockmerchRepo)(nil).GetByName), ctx, name)
+	return &MockmerchRepoGetByNameCall{Call: call}
+}
+
+// MockmerchRepoGetByNameCall wrap *gomock.Call
+type MockmerchRepoGetByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmerchRepoGetByNameCall) Return(arg0 *model.Merch, arg1 error) *MockmerchRepoGetByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmerchRepoGetByNameCall) Do(f func(context.Context, string) (*model.Merch, error)) *MockmerchRepoGetByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmerchRepoGetByNameCall) DoAndReturn(f func(context.Context, string) (*model.Merch, error)) *MockmerchRepoGetByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockemployeeRepo is a mock of employeeRepo interface.
+type MockemployeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockemployeeRepoMockRecorder
+}
+
+// MockemployeeRepoMockRecorder is the mock recorder for MockemployeeRepo.
+type MockemployeeRepoMockRecorder struct {
+	mock *MockemployeeRepo
+}
+
+// NewMockemployeeRepo creates a new mock instance.
+func NewMockemployeeRepo(ctrl *gomock.Controller) *MockemployeeRepo {
+	mock := &MockemployeeRepo{ctrl: ctrl}
+	mock.recorder = &MockemployeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockemployeeRepo) EXPECT() *MockemployeeRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockemployeeRepo) GetByID(ctx context.Context, employeeID int64) (*model.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, employeeID)
+	ret0, _ := ret[0].(*model.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockemployeeRepoMockRecorder) GetByID(ctx, employeeID any) *MockemployeeRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockemployeeRepo)(nil).GetByID), ctx, employeeID)
+	return &MockemployeeRepoGetByIDCall{Call: call}
+}
+
+// MockemployeeRepoGetByIDCall wrap *gomock.Call
+type MockemployeeRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockemployeeRepoGetByIDCall) Return(arg0 *model.Employee, arg1 error) *MockemployeeRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockemployeeRepoGetByIDCall) Do(f func(context.Context, int64) (*model.Employee, error)) *MockemployeeRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockemployeeRepoGetByIDCall) DoAndReturn(f func(context.Context, int64) (*model.Employee, error)) *MockemployeeRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockcoinLotRepo is a mock of coinLotRepo interface.
+type MockcoinLotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoinLotRepoMockRecorder
+}
+
+// MockcoinLotRepoMockRecorder is the mock recorder for MockcoinLotRepo.
+type MockcoinLotRepoMockRecorder struct {
+	mock *MockcoinLotRepo
+}
+
+// NewMockcoinLotRepo creates a new mock instance.
+func NewMockcoinLotRepo(ctrl *gomock.Controller) *MockcoinLotRepo {
+	mock := &MockcoinLotRepo{ctrl: ctrl}
+	mock.recorder = &MockcoinLotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoinLotRepo) EXPECT() *MockcoinLotRepoMockRecorder {
+	return m.recorder
+}
+
+// GetActive mocks base method.
+func (m *MockcoinLotRepo) GetActive(ctx context.Context, employeeID int64, now time.Time) ([]model.CoinLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx, employeeID, now)
+	ret0, _ := ret[0].([]model.CoinLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockcoinLotRepoMockRecorder) GetActive(ctx, employeeID, now any) *MockcoinLotRepoGetActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockcoinLotRepo)(nil).GetActive), ctx, employeeID, now)
+	return &MockcoinLotRepoGetActiveCall{Call: call}
+}
+
+// MockcoinLotRepoGetActiveCall wrap *gomock.Call
+type MockcoinLotRepoGetActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockcoinLotRepoGetActiveCall) Return(arg0 []model.CoinLot, arg1 error) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockcoinLotRepoGetActiveCall) Do(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockcoinLotRepoGetActiveCall) DoAndReturn(f func(context.Context, int64, time.Time) ([]model.CoinLot, error)) *MockcoinLotRepoGetActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,95 @@
+package wishlist_managing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+type UseCase struct {
+	wishlistRepo wishlistRepo
+	merchRepo    merchRepo
+	employeeRepo employeeRepo
+	coinLotRepo  coinLotRepo
+	now          func() time.Time
+}
+
+func New(
+	wishlistRepo wishlistRepo,
+	merchRepo merchRepo,
+	employeeRepo employeeRepo,
+	coinLotRepo coinLotRepo,
+) (*UseCase, error) {
+	if wishlistRepo == nil {
+		return nil, errors.New("wishlistRepo is nil")
+	}
+	if merchRepo == nil {
+		return nil, errors.New("merchRepo is nil")
+	}
+	if employeeRepo == nil {
+		return nil, errors.New("employeeRepo is nil")
+	}
+	if coinLotRepo == nil {
+		return nil, errors.New("coinLotRepo is nil")
+	}
+
+	return &UseCase{
+		wishlistRepo: wishlistRepo,
+		merchRepo:    merchRepo,
+		employeeRepo: employeeRepo,
+		coinLotRepo:  coinLotRepo,
+		now:          time.Now,
+	}, nil
+}
+
+// List returns the employee wishlist with current prices and how many coins are missing for every item.
+func (uc *UseCase) List(ctx context.Context, employeeID int64) ([]model.WishlistItem, error) {
+	employee, err := uc.employeeRepo.GetByID(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("employeeRepo.GetByID: %w", err)
+	}
+
+	// coins of expired lots not written off yet can't be spent
+	lots, err := uc.coinLotRepo.GetActive(ctx, employeeID, uc.now())
+	if err != nil {
+		return nil, fmt.Errorf("coinLotRepo.GetActive: %w", err)
+	}
+
+	items, err := uc.wishlistRepo.GetByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("wishlistRepo.GetByEmployee: %w", err)
+	}
+
+	return model.AnnotateWishlist(items, model.SpendableBalance(employee.Balance, lots)), nil
+}
+
+func (uc *UseCase) Add(ctx context.Context, employeeID int64, merchName string) ([]model.WishlistItem, error) {
+	merch, err := uc.merchRepo.GetByName(ctx, merchName)
+	if err != nil {
+		return nil, fmt.Errorf("merchRepo.GetByName: %w", err)
+	}
+
+	err = uc.wishlistRepo.Add(ctx, employeeID, merch.ID, merch.Price)
+	if err != nil {
+		return nil, fmt.Errorf("wishlistRepo.Add: %w", err)
+	}
+
+	return uc.List(ctx, employeeID)
+}
+
+func (uc *UseCase) Remove(ctx context.Context, employeeID int64, merchName string) ([]model.WishlistItem, error) {
+	merch, err := uc.merchRepo.GetByName(ctx, merchName)
+	if err != nil {
+		return nil, fmt.Errorf("merchRepo.GetByName: %w", err)
+	}
+
+	err = uc.wishlistRepo.Remove(ctx, employeeID, merch.ID)
+	if err != nil {
+		return nil, fmt.Errorf("wishlistRepo.Remove: %w", err)
+	}
+
+	return uc.List(ctx, employeeID)
+}
@@ -0,0 +1,179 @@
+package wishlist_managing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-shop/internal/model"
+)
+
+func TestUseCase_List(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	expireTime := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	type mocks struct {
+		wishlistRepo *MockwishlistRepo
+		merchRepo    *MockmerchRepo
+		employeeRepo *MockemployeeRepo
+		coinLotRepo  *MockcoinLotRepo
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantRes []model.WishlistItem
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().GetByID(gomock.Any(), int64(100)).Return(&model.Employee{ID: 100, Balance: 300}, nil)
+				m.coinLotRepo.EXPECT().GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 300, ExpireTime: expireTime}}, nil)
+				m.wishlistRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return([]model.WishlistItem{
+					{MerchID: 10, MerchName: "pink-hoody", Price: 500, AddedPrice: 500},
+					{MerchID: 6, MerchName: "hoody", Price: 250, AddedPrice: 300},
+				}, nil)
+			},
+			wantRes: []model.WishlistItem{
+				{MerchID: 10, MerchName: "pink-hoody", Price: 500, AddedPrice: 500, MissingCoins: 200},
+				{MerchID: 6, MerchName: "hoody", Price: 250, AddedPrice: 300, MissingCoins: 0},
+			},
+		},
+		{
+			name: "success.expired_lots_not_written_off",
+			prepare: func(m *mocks) {
+				// 100 coins of expired lots stay in the balance until the coin expiry worker writes them off
+				m.employeeRepo.EXPECT().GetByID(gomock.Any(), int64(100)).Return(&model.Employee{ID: 100, Balance: 300}, nil)
+				m.coinLotRepo.EXPECT().GetActive(gomock.Any(), int64(100), now).
+					Return([]model.CoinLot{{ID: 1, Remaining: 200, ExpireTime: expireTime}}, nil)
+				m.wishlistRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return([]model.WishlistItem{
+					{MerchID: 6, MerchName: "hoody", Price: 250, AddedPrice: 300},
+				}, nil)
+			},
+			wantRes: []model.WishlistItem{
+				{MerchID: 6, MerchName: "hoody", Price: 250, AddedPrice: 300, MissingCoins: 50},
+			},
+		},
+		{
+			name: "error.employee_repo.get_by_id",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().GetByID(gomock.Any(), int64(100)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.wishlist_repo.get_by_employee",
+			prepare: func(m *mocks) {
+				m.employeeRepo.EXPECT().GetByID(gomock.Any(), int64(100)).Return(&model.Employee{ID: 100, Balance: 300}, nil)
+				m.coinLotRepo.EXPECT().GetActive(gomock.Any(), int64(100), now).Return(nil, nil)
+				m.wishlistRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				wishlistRepo: NewMockwishlistRepo(ctrl),
+				merchRepo:    NewMockmerchRepo(ctrl),
+				employeeRepo: NewMockemployeeRepo(ctrl),
+				coinLotRepo:  NewMockcoinLotRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.wishlistRepo, m.merchRepo, m.employeeRepo, m.coinLotRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.List(context.Background(), 100)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_Add(t *testing.T) {
+	now := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+
+	type mocks struct {
+		wishlistRepo *MockwishlistRepo
+		merchRepo    *MockmerchRepo
+		employeeRepo *MockemployeeRepo
+		coinLotRepo  *MockcoinLotRepo
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "pink-hoody").
+					Return(&model.Merch{ID: 10, Name: "pink-hoody", Price: 500}, nil)
+				m.wishlistRepo.EXPECT().Add(gomock.Any(), int64(100), int64(10), int64(500)).Return(nil)
+				m.employeeRepo.EXPECT().GetByID(gomock.Any(), int64(100)).Return(&model.Employee{ID: 100, Balance: 300}, nil)
+				m.coinLotRepo.EXPECT().GetActive(gomock.Any(), int64(100), now).Return(nil, nil)
+				m.wishlistRepo.EXPECT().GetByEmployee(gomock.Any(), int64(100)).Return(nil, nil)
+			},
+		},
+		{
+			name: "error.merch_not_found",
+			prepare: func(m *mocks) {
+				m.merchRepo.EXPECT().GetByName(gomock.Any(), "pink-hoody").Return(nil, model.ErrMerchNotFound)
+			},
+			wantErr: model.ErrMerchNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				wishlistRepo: NewMockwishlistRepo(ctrl),
+				merchRepo:    NewMockmerchRepo(ctrl),
+				employeeRepo: NewMockemployeeRepo(ctrl),
+				coinLotRepo:  NewMockcoinLotRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.wishlistRepo, m.merchRepo, m.employeeRepo, m.coinLotRepo)
+			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
+
+			_, err = uc.Add(context.Background(), 100, "pink-hoody")
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_Remove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	wishlistRepo := NewMockwishlistRepo(ctrl)
+	merchRepo := NewMockmerchRepo(ctrl)
+	employeeRepo := NewMockemployeeRepo(ctrl)
+	coinLotRepo := NewMockcoinLotRepo(ctrl)
+
+	merchRepo.EXPECT().GetByName(gomock.Any(), "pink-hoody").
+		Return(&model.Merch{ID: 10, Name: "pink-hoody", Price: 500}, nil)
+	wishlistRepo.EXPECT().Remove(gomock.Any(), int64(100), int64(10)).Return(model.ErrWishlistItemNotFound)
+
+	uc, err := New(wishlistRepo, merchRepo, employeeRepo, coinLotRepo)
+	require.NoError(t, err)
+
+	_, err = uc.Remove(context.Background(), 100, "pink-hoody")
+	require.ErrorIs(t, err, model.ErrWishlistItemNotFound)
+}
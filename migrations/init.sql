@@ -1,11 +1,22 @@
+create extension if not exists pg_trgm;
+
 create table employee (
     id serial primary key,
     username text not null,
+    display_name text not null default '', -- full name for the directory, filled by HR
     password text not null,
     balance integer not null,
+    role text not null default 'employee',
+    -- give-only coins left in giving_budget_period, refilled lazily at the start of every month
+    giving_budget integer not null default 0,
+    giving_budget_period timestamp with time zone,
+    hidden_from_stats boolean not null default false, -- opted out of leaderboards
     create_time timestamp with time zone default now()
 );
 create unique index employee_username on employee (username);
+-- directory search by prefix and similarity
+create index employee_username_trgm on employee using gin (username gin_trgm_ops);
+create index employee_display_name_trgm on employee using gin (display_name gin_trgm_ops);
 
 create table merch (
     id serial primary key,
@@ -36,6 +47,113 @@ create table inventory (
     primary key (employee_id, merch_id)
 );
 
+-- every purchase, inventory keeps only totals per employee and merch
+create table purchase (
+    id serial primary key,
+    employee_id integer not null,
+    merch_id integer not null,
+    quantity integer not null,
+    price integer not null,
+    purchase_time timestamp with time zone default now()
+);
+create index purchase_purchase_time on purchase (purchase_time);
+
+-- purchases made before the purchase log are taken from inventory
+insert into purchase (employee_id, merch_id, quantity, price, purchase_time)
+select i.employee_id, i.merch_id, i.quantity, m.price, i.create_time
+from inventory i inner join merch m on m.id = i.merch_id;
+
+-- sellable options of merch, e.g. sizes and colors. Every merch has a default variant without options,
+-- its id equals the merch id. Merch with sizes keeps the default variant inactive for goods bought before sizes.
+create table merch_variant (
+    id serial primary key,
+    merch_id integer not null,
+    size text not null default '',
+    color text not null default '',
+    price_delta integer not null default 0, -- added to merch price
+    stock integer, -- null is unlimited
+    active boolean not null default true,
+    create_time timestamp with time zone default now()
+);
+create unique index merch_variant_merch_id_size_color on merch_variant (merch_id, size, color);
+
+insert into merch_variant (id, merch_id) select id, id from merch;
+select setval('merch_variant_id_seq', (select max(id) from merch_variant));
+
+update merch_variant set active = false where merch_id in (select id from merch where name in ('t-shirt', 'hoody'));
+
+insert into merch_variant (merch_id, size, color, price_delta)
+select m.id, s.size, c.color, case when s.size = 'XXL' then 10 else 0 end
+from merch m
+cross join (values ('S'), ('M'), ('L'), ('XL'), ('XXL')) s(size)
+cross join (values ('white'), ('black')) c(color)
+where m.name = 't-shirt';
+
+insert into merch_variant (merch_id, size, price_delta)
+select m.id, s.size, case when s.size = 'XXL' then 10 else 0 end
+from merch m
+cross join (values ('S'), ('M'), ('L'), ('XL'), ('XXL')) s(size)
+where m.name = 'hoody';
+
+-- inventory and purchases are kept per variant, rows from before variants go to the default variant
+alter table inventory add column variant_id integer;
+update inventory set variant_id = merch_id;
+alter table inventory alter column variant_id set not null;
+alter table inventory drop constraint inventory_pkey;
+alter table inventory add primary key (employee_id, variant_id);
+
+alter table purchase add column variant_id integer;
+update purchase set variant_id = merch_id;
+alter table purchase alter column variant_id set not null;
+
+-- physical merch to be handed over by staff, every purchase creates an order.
+-- "order" is a reserved word, hence the prefix
+create table merch_order (
+    id serial primary key,
+    employee_id integer not null,
+    merch_id integer not null,
+    variant_id integer not null,
+    quantity integer not null,
+    price integer not null, -- price of one item at the purchase time
+    status text not null default 'placed',
+    create_time timestamp with time zone default now(),
+    update_time timestamp with time zone default now()
+);
+create index merch_order_employee_id on merch_order (employee_id);
+create index merch_order_status on merch_order (status);
+
+-- the purchase log row of the order, older purchases have no order
+alter table purchase add column order_id integer;
+create index purchase_order_id on purchase (order_id);
+
+-- coins the order was paid with, a cancelled order gives them back with their expire time
+create table merch_order_lot (
+    order_id integer not null,
+    coin_lot_id integer not null,
+    amount integer not null,
+    expire_time timestamp with time zone not null
+);
+create index merch_order_lot_order_id on merch_order_lot (order_id);
+
+-- merch saved for a checkout, prices are taken from merch at checkout time
+create table cart_item (
+    employee_id integer not null,
+    merch_id integer not null,
+    variant_id integer not null,
+    quantity integer not null,
+    create_time timestamp with time zone default now(),
+    primary key (employee_id, variant_id)
+);
+
+-- merch an employee saves up for, price is remembered to show price drops
+create table wishlist_item (
+    employee_id integer not null,
+    merch_id integer not null,
+    added_price integer not null,
+    create_time timestamp with time zone default now(),
+    primary key (employee_id, merch_id)
+);
+
 create table transaction (
     id serial primary key,
     sender_id integer not null,
@@ -45,3 +163,122 @@ create table transaction (
 );
 create index transactions_sender_id on transaction (sender_id);
 create index transactions_receiver_id on transaction (receiver_id);
+create index transactions_transaction_time on transaction (transaction_time);
+
+create table scheduled_transfer (
+    id serial primary key,
+    owner_id integer not null,
+    receiver_id integer not null,
+    amount integer not null,
+    cron_expression text not null default '', -- empty for one-off transfers
+    next_run_time timestamp with time zone, -- null when there is nothing left to run
+    is_active boolean not null default true,
+    create_time timestamp with time zone default now()
+);
+create index scheduled_transfer_owner_id on scheduled_transfer (owner_id);
+create index scheduled_transfer_next_run_time on scheduled_transfer (next_run_time) where is_active;
+
+create table scheduled_transfer_run (
+    id serial primary key,
+    scheduled_transfer_id integer not null,
+    run_time timestamp with time zone default now(),
+    status text not null,
+    error text not null default ''
+);
+create index scheduled_transfer_run_scheduled_transfer_id on scheduled_transfer_run (scheduled_transfer_id);
+
+-- employee.balance is the sum of remaining coins of the employee lots
+create table coin_lot (
+    id serial primary key,
+    employee_id integer not null,
+    amount integer not null,
+    remaining integer not null,
+    received_time timestamp with time zone not null default now(),
+    expire_time timestamp with time zone not null
+);
+create index coin_lot_employee_id_expire_time on coin_lot (employee_id, expire_time) where remaining > 0;
+create index coin_lot_expire_time on coin_lot (expire_time) where remaining > 0;
+
+-- balances existing before coin lots become one lot each
+insert into coin_lot (employee_id, amount, remaining, expire_time)
+select id, balance, balance, now() + interval '12 months' from employee where balance > 0;
+
+-- transfers above the approval threshold wait for an approver, the amount is held from the sender meanwhile
+create table transfer_request (
+    id serial primary key,
+    kind text not null default 'approval', -- approval waits for an approver, acceptance - for the receiver
+    sender_id integer not null,
+    receiver_id integer not null,
+    amount integer not null,
+    status text not null default 'pending',
+    require_acceptance boolean not null default false, -- approved transfer then waits for the receiver
+    expire_time timestamp with time zone not null, -- held coins go back to the sender (acceptance: to the receiver) if nobody decides till then
+    decided_by integer, -- approver or receiver, null for pending and expired requests
+    create_time timestamp with time zone default now(),
+    decide_time timestamp with time zone
+);
+create index transfer_request_sender_id on transfer_request (sender_id);
+create index transfer_request_receiver_id on transfer_request (receiver_id) where status = 'pending';
+create index transfer_request_status on transfer_request (status);
+create index transfer_request_expire_time on transfer_request (expire_time) where status = 'pending';
+
+-- coins held by a transfer request, they keep the expire time of the sender lot they were taken from
+create table transfer_request_lot (
+    transfer_request_id integer not null,
+    coin_lot_id integer not null,
+    amount integer not null,
+    expire_time timestamp with time zone not null
+);
+create index transfer_request_lot_transfer_request_id on transfer_request_lot (transfer_request_id);
+
+create table ledger_entry (
+    id serial primary key,
+    employee_id integer not null,
+    amount integer not null,
+    kind text not null,
+    coin_lot_id integer,
+    period_time timestamp with time zone, -- for periodic grants, one entry per employee and period
+    create_time timestamp with time zone default now()
+);
+create index ledger_entry_employee_id on ledger_entry (employee_id);
+create unique index ledger_entry_period on ledger_entry (employee_id, kind, period_time) where period_time is not null;
+
+-- single row with settings of the periodic allowance
+create table allowance (
+    id integer primary key default 1 check (id = 1),
+    amount integer not null,
+    cron_expression text not null,
+    is_active boolean not null default true,
+    next_grant_time timestamp with time zone,
+    update_time timestamp with time zone default now()
+);
+insert into allowance (amount, cron_expression, next_grant_time)
+values (200, '0 0 1 * *', date_trunc('month', now() at time zone 'UTC') at time zone 'UTC' + interval '1 month');
+
+-- leaderboard aggregates by UTC day, refreshed concurrently by the stats worker
+create materialized view employee_transfer_stats_daily as
+select day, employee_id,
+    sum(received_amount) as received_amount,
+    sum(received_count) as received_count,
+    sum(sent_amount) as sent_amount,
+    sum(sent_count) as sent_count
+from (
+    select (transaction_time at time zone 'UTC')::date as day, receiver_id as employee_id,
+        amount as received_amount, 1 as received_count, 0 as sent_amount, 0 as sent_count
+    from transaction
+    union all
+    select (transaction_time at time zone 'UTC')::date as day, sender_id as employee_id,
+        0 as received_amount, 0 as received_count, amount as sent_amount, 1 as sent_count
+    from transaction
+) t
+group by day, employee_id;
+create unique index employee_transfer_stats_daily_day_employee_id on employee_transfer_stats_daily (day, employee_id);
+
+-- merch of cancelled orders is given back, so it isn't counted as sold
+create materialized view merch_stats_daily as
+select (p.purchase_time at time zone 'UTC')::date as day, p.merch_id, sum(p.quantity) as quantity
+from purchase p
+left join merch_order o on o.id = p.order_id
+where o.status is distinct from 'cancelled'
+group by day, p.merch_id;
+create unique index merch_stats_daily_day_merch_id on merch_stats_daily (day, merch_id);
@@ -72,3 +72,27 @@ func Test_Buy_InvalidMerchName(t *testing.T) {
 
 	require.Equal(t, "no merch with name invalid-merch-name", *out.Errors)
 }
+
+func Test_Buy_Variant(t *testing.T) {
+	setUp()
+
+	username := makeUsername(t)
+	token := makeUserToken(t, username)
+
+	resp := apiGet(t, "/api/buy/t-shirt", token)
+	assertResponseError(t, resp, http.StatusBadRequest, "size or color of t-shirt should be chosen")
+
+	resp = apiGet(t, "/api/buy/t-shirt?size=XS&color=black", token)
+	assertResponseError(t, resp, http.StatusBadRequest, "no such size or color of t-shirt")
+
+	resp = apiGet(t, "/api/buy/t-shirt?size=XXL&color=white", token) // price = 80 + 10 for XXL
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	info := getInfo(t, token)
+
+	assert.Equal(t, 910, *info.Coins)
+	require.Len(t, *info.Inventory, 1)
+	assert.Equal(t, "t-shirt", *(*info.Inventory)[0].Type)
+	assert.Equal(t, "XXL", *(*info.Inventory)[0].Size)
+	assert.Equal(t, "white", *(*info.Inventory)[0].Color)
+}
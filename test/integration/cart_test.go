@@ -0,0 +1,82 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+)
+
+func Test_Cart_Checkout(t *testing.T) {
+	setUp()
+
+	username := makeUsername(t)
+	token := makeUserToken(t, username)
+
+	resp := apiPost(t, "/api/cart/items", token, api.CartItemRequest{
+		Item:     "t-shirt",
+		Size:     pointerOf("M"),
+		Color:    pointerOf("black"),
+		Quantity: 1,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = apiPost(t, "/api/cart/items", token, api.CartItemRequest{Item: "cup", Quantity: 1})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = apiPost(t, "/api/cart/items", token, api.CartItemRequest{Item: "socks", Quantity: 3})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = apiPost(t, "/api/cart/items", token, api.CartItemRequest{Item: "cup", Quantity: 1})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	cart := parseJSON[api.CartResponse](t, resp)
+	assert.Equal(t, 150, cart.Total) // 80 + 2*20 + 3*10
+
+	resp = apiPost(t, "/api/cart/checkout", token, struct{}{})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	info := getInfo(t, token)
+	assert.Equal(t, 850, *info.Coins)
+	require.Len(t, *info.Inventory, 3)
+
+	resp = apiGet(t, "/api/cart", token)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	cart = parseJSON[api.CartResponse](t, resp)
+	assert.Empty(t, cart.Lines)
+}
+
+func Test_Cart_CheckoutNotEnoughBalance(t *testing.T) {
+	setUp()
+
+	username := makeUsername(t)
+	token := makeUserToken(t, username)
+
+	resp := apiPost(t, "/api/cart/items", token, api.CartItemRequest{Item: "pink-hoody", Quantity: 2})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = apiPost(t, "/api/cart/items", token, api.CartItemRequest{Item: "pen", Quantity: 1})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = apiPost(t, "/api/cart/checkout", token, struct{}{})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	out := parseJSON[api.CheckoutErrorResponse](t, resp)
+	require.Equal(t, []api.CheckoutLineError{{Item: "pen", Error: "not enough balance"}}, *out.Lines)
+
+	// nothing is bought
+	info := getInfo(t, token)
+	assert.Equal(t, 1000, *info.Coins)
+	assert.Empty(t, *info.Inventory)
+}
+
+func Test_Cart_CheckoutEmpty(t *testing.T) {
+	setUp()
+
+	username := makeUsername(t)
+	token := makeUserToken(t, username)
+
+	resp := apiPost(t, "/api/cart/checkout", token, struct{}{})
+	assertResponseError(t, resp, http.StatusBadRequest, "cart is empty")
+}
@@ -0,0 +1,98 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+)
+
+func Test_Inbox_Accept(t *testing.T) {
+	setUp()
+
+	sender, receiver := makeUsername(t), makeUsername(t)
+	senderToken, receiverToken := makeUserToken(t, sender), makeUserToken(t, receiver)
+
+	resp := apiPost(t, "/api/sendCoin", senderToken, api.SendCoinRequest{
+		Amount:            300,
+		ToUser:            receiver,
+		RequireAcceptance: pointerOf(true),
+	})
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	request := parseJSON[api.TransferRequest](t, resp)
+	assert.Equal(t, api.Acceptance, request.Kind)
+	assert.Equal(t, api.Pending, request.Status)
+
+	// held coins are taken from the sender, but the transfer isn't in the history yet
+	info := getInfo(t, senderToken)
+	assert.Equal(t, 700, *info.Coins)
+	assert.Empty(t, *info.CoinHistory.Sent)
+	require.Len(t, *info.CoinHistory.PendingSent, 1)
+	assert.Equal(t, request.Id, (*info.CoinHistory.PendingSent)[0].Id)
+
+	info = getInfo(t, receiverToken)
+	assert.Equal(t, 1000, *info.Coins)
+	require.Len(t, *info.CoinHistory.PendingReceived, 1)
+
+	resp = apiGet(t, "/api/inbox", receiverToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	inbox := parseJSON[api.TransferRequestsResponse](t, resp)
+	require.Len(t, inbox.TransferRequests, 1)
+	assert.Equal(t, sender, inbox.TransferRequests[0].FromUser)
+
+	resp = apiPost(t, "/api/inbox/"+strconv.FormatInt(request.Id, 10)+"/accept", receiverToken, struct{}{})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	accepted := parseJSON[api.TransferRequest](t, resp)
+	assert.Equal(t, api.Accepted, accepted.Status)
+	assert.Equal(t, receiver, *accepted.DecidedBy)
+
+	info = getInfo(t, receiverToken)
+	assert.Equal(t, 1300, *info.Coins)
+	assert.Empty(t, *info.CoinHistory.PendingReceived)
+	require.Len(t, *info.CoinHistory.Received, 1)
+	assert.Equal(t, sender, *(*info.CoinHistory.Received)[0].FromUser)
+
+	info = getInfo(t, senderToken)
+	assert.Empty(t, *info.CoinHistory.PendingSent)
+	require.Len(t, *info.CoinHistory.Sent, 1)
+}
+
+func Test_Inbox_Decline(t *testing.T) {
+	setUp()
+
+	sender, receiver, other := makeUsername(t), makeUsername(t), makeUsername(t)
+	senderToken, receiverToken := makeUserToken(t, sender), makeUserToken(t, receiver)
+	otherToken := makeUserToken(t, other)
+
+	resp := apiPost(t, "/api/sendCoin", senderToken, api.SendCoinRequest{
+		Amount:            300,
+		ToUser:            receiver,
+		RequireAcceptance: pointerOf(true),
+	})
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	request := parseJSON[api.TransferRequest](t, resp)
+	path := "/api/inbox/" + strconv.FormatInt(request.Id, 10) + "/decline"
+
+	// only the receiver can decide
+	resp = apiPost(t, path, otherToken, struct{}{})
+	assertResponseError(t, resp, http.StatusNotFound, "transfer request not found")
+	resp = apiPost(t, path, senderToken, struct{}{})
+	assertResponseError(t, resp, http.StatusNotFound, "transfer request not found")
+
+	resp = apiPost(t, path, receiverToken, struct{}{})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = apiPost(t, path, receiverToken, struct{}{})
+	assertResponseError(t, resp, http.StatusBadRequest, "transfer request is already decided")
+
+	info := getInfo(t, senderToken)
+	assert.Equal(t, 1000, *info.Coins)
+	assert.Empty(t, *info.CoinHistory.PendingSent)
+	assert.Empty(t, *info.CoinHistory.Sent)
+}
@@ -66,4 +66,8 @@ func Test_Info(t *testing.T) {
 	assert.Equal(t, *(*info.Inventory)[0].Type, "pink-hoody")
 	assert.Equal(t, *(*info.Inventory)[1].Quantity, 2)
 	assert.Equal(t, *(*info.Inventory)[1].Type, "pen")
+
+	// granted coins live 12 months, nothing expires soon
+	require.NotNil(t, info.ExpiringSoon)
+	assert.Empty(t, *info.ExpiringSoon)
 }
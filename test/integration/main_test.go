@@ -100,6 +100,24 @@ func apiPost[In any](t *testing.T, path, token string, in In) *http.Response {
 	return resp
 }
 
+// apiDelete path should start with slash
+func apiDelete(t *testing.T, path, token string) *http.Response {
+	t.Helper()
+
+	url := "http://localhost:" + os.Getenv("SERVER_PORT") + path
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	require.NoError(t, err)
+
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return resp
+}
+
 func parseJSON[Out any](t *testing.T, resp *http.Response) Out {
 	var out Out
 
@@ -130,3 +148,7 @@ func assertResponsePlainError(t *testing.T, resp *http.Response, statusCode int,
 
 	require.Equal(t, errorText, string(bodyBytes))
 }
+
+func pointerOf[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,71 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+)
+
+func Test_Orders_CancelRefund(t *testing.T) {
+	setUp()
+
+	username := makeUsername(t)
+	token := makeUserToken(t, username)
+
+	resp := apiGet(t, "/api/buy/powerbank", token) // price = 200
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = apiGet(t, "/api/orders", token)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	orders := parseJSON[api.OrdersResponse](t, resp).Orders
+	require.Len(t, orders, 1)
+	assert.Equal(t, "powerbank", orders[0].Item)
+	assert.Equal(t, api.Placed, orders[0].Status)
+
+	cancelPath := "/api/orders/" + strconv.FormatInt(orders[0].Id, 10) + "/cancel"
+	resp = apiPost(t, cancelPath, token, struct{}{})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, api.Cancelled, parseJSON[api.Order](t, resp).Status)
+
+	info := getInfo(t, token)
+	assert.Equal(t, 1000, *info.Coins)
+	assert.Empty(t, *info.Inventory)
+
+	// cancelled order is final
+	resp = apiPost(t, cancelPath, token, struct{}{})
+	assertResponseError(t, resp, http.StatusBadRequest, "order can't be moved to this status")
+}
+
+func Test_Orders_CancelOtherEmployeeOrder(t *testing.T) {
+	setUp()
+
+	ownerToken := makeUserToken(t, makeUsername(t))
+	otherToken := makeUserToken(t, makeUsername(t))
+
+	resp := apiGet(t, "/api/buy/cup", ownerToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = apiGet(t, "/api/orders", ownerToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	orders := parseJSON[api.OrdersResponse](t, resp).Orders
+	require.Len(t, orders, 1)
+
+	resp = apiPost(t, "/api/orders/"+strconv.FormatInt(orders[0].Id, 10)+"/cancel", otherToken, struct{}{})
+	assertResponseError(t, resp, http.StatusNotFound, "order not found")
+}
+
+func Test_Orders_StaffOnly(t *testing.T) {
+	setUp()
+
+	token := makeUserToken(t, makeUsername(t))
+
+	resp := apiGet(t, "/api/staff/orders", token)
+	assertResponseError(t, resp, http.StatusForbidden, "staff role required")
+}
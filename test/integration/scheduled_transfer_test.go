@@ -0,0 +1,88 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-shop/internal/api"
+)
+
+func Test_ScheduledTransfer_CRUD(t *testing.T) {
+	setUp()
+
+	username1, username2 := makeUsername(t), makeUsername(t)
+	token1, _ := makeUserToken(t, username1), makeUserToken(t, username2)
+
+	cron := "0 12 * * 5"
+	resp := apiPost(t, "/api/scheduledTransfers", token1, api.ScheduledTransferRequest{
+		ToUser: username2,
+		Amount: 50,
+		Cron:   &cron,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	created := parseJSON[api.ScheduledTransfer](t, resp)
+
+	assert.Equal(t, username2, created.ToUser)
+	assert.Equal(t, 50, created.Amount)
+	assert.True(t, created.IsActive)
+	require.NotNil(t, created.NextRunTime)
+
+	path := "/api/scheduledTransfers/" + strconv.FormatInt(created.Id, 10)
+
+	resp = apiGet(t, path, token1)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	got := parseJSON[api.ScheduledTransferResponse](t, resp)
+	assert.Equal(t, created, got.ScheduledTransfer)
+	assert.Empty(t, got.Runs)
+
+	resp = apiGet(t, "/api/scheduledTransfers", token1)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	list := parseJSON[api.ScheduledTransferListResponse](t, resp)
+	assert.Equal(t, []api.ScheduledTransfer{created}, list.ScheduledTransfers)
+
+	resp = apiDelete(t, path, token1)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = apiGet(t, path, token1)
+	assertResponseError(t, resp, http.StatusNotFound, "scheduled transfer not found")
+}
+
+func Test_ScheduledTransfer_OtherOwner(t *testing.T) {
+	setUp()
+
+	username1, username2 := makeUsername(t), makeUsername(t)
+	token1, token2 := makeUserToken(t, username1), makeUserToken(t, username2)
+
+	cron := "0 12 * * 5"
+	resp := apiPost(t, "/api/scheduledTransfers", token1, api.ScheduledTransferRequest{
+		ToUser: username2,
+		Amount: 50,
+		Cron:   &cron,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	created := parseJSON[api.ScheduledTransfer](t, resp)
+
+	resp = apiGet(t, "/api/scheduledTransfers/"+strconv.FormatInt(created.Id, 10), token2)
+	assertResponseError(t, resp, http.StatusNotFound, "scheduled transfer not found")
+}
+
+func Test_ScheduledTransfer_InvalidSchedule(t *testing.T) {
+	setUp()
+
+	username1, username2 := makeUsername(t), makeUsername(t)
+	token1, _ := makeUserToken(t, username1), makeUserToken(t, username2)
+
+	cron := "every friday"
+	resp := apiPost(t, "/api/scheduledTransfers", token1, api.ScheduledTransferRequest{
+		ToUser: username2,
+		Amount: 50,
+		Cron:   &cron,
+	})
+	assertResponseError(t, resp, http.StatusBadRequest, "invalid schedule: set either runAt or a valid cron expression")
+}
@@ -65,3 +65,69 @@ func Test_SendCoin_NotEnoughBalance(t *testing.T) {
 	assert.Equal(t, 1000, *info1.Coins)
 	assert.Equal(t, 1000, *info2.Coins)
 }
+
+func Test_SendCoinBatch_OK(t *testing.T) {
+	setUp()
+
+	username1, username2, username3 := makeUsername(t), makeUsername(t), makeUsername(t)
+	token1, token2, token3 := makeUserToken(t, username1), makeUserToken(t, username2), makeUserToken(t, username3)
+
+	resp := apiPost(t, "/api/sendCoin/batch", token1, api.SendCoinBatchRequest{
+		Transfers: []api.SendCoinRequest{
+			{ToUser: username2, Amount: 200},
+			{ToUser: username3, Amount: 300},
+		},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	info1, info2, info3 := getInfo(t, token1), getInfo(t, token2), getInfo(t, token3)
+
+	assert.Equal(t, 500, *info1.Coins)
+	assert.Equal(t, 1200, *info2.Coins)
+	assert.Equal(t, 1300, *info3.Coins)
+}
+
+func Test_SendCoinBatch_NotEnoughBalance(t *testing.T) {
+	setUp()
+
+	username1, username2, username3 := makeUsername(t), makeUsername(t), makeUsername(t)
+	token1, token2, token3 := makeUserToken(t, username1), makeUserToken(t, username2), makeUserToken(t, username3)
+
+	resp := apiPost(t, "/api/sendCoin/batch", token1, api.SendCoinBatchRequest{
+		Transfers: []api.SendCoinRequest{
+			{ToUser: username2, Amount: 600},
+			{ToUser: username3, Amount: 600},
+		},
+	})
+	assertResponseError(t, resp, http.StatusBadRequest, "not enough balance")
+
+	info1, info2, info3 := getInfo(t, token1), getInfo(t, token2), getInfo(t, token3)
+
+	assert.Equal(t, 1000, *info1.Coins)
+	assert.Equal(t, 1000, *info2.Coins)
+	assert.Equal(t, 1000, *info3.Coins)
+}
+
+func Test_SendCoinBatch_RecipientNotFound(t *testing.T) {
+	setUp()
+
+	username1, username2, username3 := makeUsername(t), makeUsername(t), makeUsername(t)
+	token1, token2 := makeUserToken(t, username1), makeUserToken(t, username2)
+
+	resp := apiPost(t, "/api/sendCoin/batch", token1, api.SendCoinBatchRequest{
+		Transfers: []api.SendCoinRequest{
+			{ToUser: username2, Amount: 100},
+			{ToUser: username3, Amount: 100},
+		},
+	})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	out := parseJSON[api.SendCoinBatchErrorResponse](t, resp)
+	require.Equal(t, []api.SendCoinBatchRecipientError{
+		{Index: 1, ToUser: username3, Error: "recipient not found"},
+	}, *out.Recipients)
+
+	info1, info2 := getInfo(t, token1), getInfo(t, token2)
+
+	assert.Equal(t, 1000, *info1.Coins)
+	assert.Equal(t, 1000, *info2.Coins)
+}